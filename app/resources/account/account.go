@@ -34,8 +34,21 @@ type Account struct {
 	Admin    bool
 	Metadata map[string]any
 
-	DeletedAt opt.Optional[time.Time]
-	IndexedAt opt.Optional[time.Time]
+	DeletedAt  opt.Optional[time.Time]
+	IndexedAt  opt.Optional[time.Time]
+	Suspension opt.Optional[Suspension]
+
+	// DeletionScheduledAt is when the account will be deleted at the member's
+	// request, it's only present while the member can still cancel it.
+	DeletionScheduledAt opt.Optional[time.Time]
+}
+
+// Suspension describes why and for how long an account is suspended. It is
+// only present while the account is suspended (DeletedAt is set.)
+type Suspension struct {
+	Reason      opt.Optional[string]
+	Until       opt.Optional[time.Time] // Empty means indefinitely.
+	SuspendedBy opt.Optional[AccountID]
 }
 
 type AccountWithEdges struct {
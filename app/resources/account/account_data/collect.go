@@ -0,0 +1,372 @@
+// Package account_data gathers and erases everything stored about a member,
+// for members asking for a copy of their data or for their account to go.
+package account_data
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/role/held"
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_asset "github.com/Southclaws/storyden/internal/ent/asset"
+	ent_auth "github.com/Southclaws/storyden/internal/ent/authentication"
+	ent_collection "github.com/Southclaws/storyden/internal/ent/collection"
+	ent_message "github.com/Southclaws/storyden/internal/ent/conversationmessage"
+	ent_like "github.com/Southclaws/storyden/internal/ent/likepost"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_notification "github.com/Southclaws/storyden/internal/ent/notification"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	ent_react "github.com/Southclaws/storyden/internal/ent/react"
+)
+
+// Data is everything tied to an account, shaped for the member to read rather
+// than mirroring the database. Secrets such as password hashes, tokens and
+// authenticator seeds are never included.
+type Data struct {
+	Profile        Profile        `json:"profile"`
+	Authentication []AuthMethod   `json:"authentication"`
+	Posts          []Post         `json:"posts"`
+	Reacts         []React        `json:"reacts"`
+	Likes          []Like         `json:"likes"`
+	Collections    []Collection   `json:"collections"`
+	Nodes          []Node         `json:"nodes"`
+	Notifications  []Notification `json:"notifications"`
+	Messages       []Message      `json:"messages"`
+	Assets         []Asset        `json:"assets"`
+}
+
+type Profile struct {
+	ID        string         `json:"id"`
+	CreatedAt time.Time      `json:"created_at"`
+	Handle    string         `json:"handle"`
+	Name      string         `json:"name"`
+	Bio       string         `json:"bio"`
+	Links     []Link         `json:"links"`
+	Emails    []Email        `json:"emails"`
+	Roles     []string       `json:"roles"`
+	Admin     bool           `json:"admin"`
+	Metadata  map[string]any `json:"metadata,omitempty"`
+}
+
+type Link struct {
+	Text string `json:"text"`
+	URL  string `json:"url"`
+}
+
+type Email struct {
+	Address  string `json:"address"`
+	Verified bool   `json:"verified"`
+}
+
+type AuthMethod struct {
+	CreatedAt  time.Time `json:"created_at"`
+	Service    string    `json:"service"`
+	Identifier string    `json:"identifier"`
+	Name       *string   `json:"name,omitempty"`
+	Disabled   bool      `json:"disabled"`
+}
+
+// Post is either a thread, with a title, or a reply to the thread RootID.
+type Post struct {
+	ID         string     `json:"id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
+	Title      string     `json:"title,omitempty"`
+	Slug       string     `json:"slug,omitempty"`
+	RootID     *string    `json:"root_id,omitempty"`
+	Body       string     `json:"body"`
+	Visibility string     `json:"visibility"`
+}
+
+type React struct {
+	CreatedAt time.Time `json:"created_at"`
+	PostID    string    `json:"post_id"`
+	Emoji     string    `json:"emoji"`
+}
+
+type Like struct {
+	CreatedAt time.Time `json:"created_at"`
+	PostID    string    `json:"post_id"`
+}
+
+type Collection struct {
+	ID          string    `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description *string   `json:"description,omitempty"`
+	Visibility  string    `json:"visibility"`
+	Posts       []string  `json:"posts"`
+	Nodes       []string  `json:"nodes"`
+}
+
+type Node struct {
+	ID          string     `json:"id"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	Name        string     `json:"name"`
+	Slug        string     `json:"slug"`
+	Description *string    `json:"description,omitempty"`
+	Content     *string    `json:"content,omitempty"`
+	Visibility  string     `json:"visibility"`
+}
+
+type Notification struct {
+	CreatedAt time.Time `json:"created_at"`
+	Event     string    `json:"event"`
+	ItemKind  *string   `json:"item_kind,omitempty"`
+	ItemID    *string   `json:"item_id,omitempty"`
+	Read      bool      `json:"read"`
+}
+
+type Message struct {
+	CreatedAt      time.Time `json:"created_at"`
+	ConversationID string    `json:"conversation_id"`
+	Body           string    `json:"body"`
+}
+
+// Asset is a file the member uploaded, Path locates it in object storage.
+type Asset struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Filename  string    `json:"filename"`
+	Size      int       `json:"size"`
+	MIME      string    `json:"mime_type"`
+	Path      string    `json:"-"`
+}
+
+type Collector struct {
+	db           *ent.Client
+	accountQuery *account_querier.Querier
+}
+
+func NewCollector(db *ent.Client, accountQuery *account_querier.Querier) *Collector {
+	return &Collector{db: db, accountQuery: accountQuery}
+}
+
+// Collect reads everything tied to the account. Content the member wrote is
+// included regardless of its visibility or whether it was deleted.
+func (c *Collector) Collect(ctx context.Context, id account.AccountID) (*Data, error) {
+	acc, err := c.accountQuery.GetByID(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	aid := xid.ID(id)
+
+	auths, err := c.db.Authentication.Query().
+		Where(ent_auth.AccountAuthentication(aid)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	posts, err := c.db.Post.Query().
+		Where(ent_post.AccountPosts(aid)).
+		Order(ent.Asc(ent_post.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	reacts, err := c.db.React.Query().
+		Where(ent_react.AccountID(aid)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	likes, err := c.db.LikePost.Query().
+		Where(ent_like.AccountID(aid)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	collections, err := c.db.Collection.Query().
+		Where(ent_collection.HasOwnerWith(ent_account.ID(aid))).
+		WithCollectionPosts().
+		WithCollectionNodes().
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodes, err := c.db.Node.Query().
+		Where(ent_node.AccountID(aid)).
+		Order(ent.Asc(ent_node.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	notifications, err := c.db.Notification.Query().
+		Where(ent_notification.OwnerAccountID(aid)).
+		Order(ent.Asc(ent_notification.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	messages, err := c.db.ConversationMessage.Query().
+		Where(ent_message.AccountID(aid)).
+		Order(ent.Asc(ent_message.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	assets, err := c.db.Asset.Query().
+		Where(ent_asset.AccountID(aid)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &Data{
+		Profile:        mapProfile(acc),
+		Authentication: dt.Map(auths, mapAuthMethod),
+		Posts:          dt.Map(posts, mapPost),
+		Reacts:         dt.Map(reacts, mapReact),
+		Likes:          dt.Map(likes, mapLike),
+		Collections:    dt.Map(collections, mapCollection),
+		Nodes:          dt.Map(nodes, mapNode),
+		Notifications:  dt.Map(notifications, mapNotification),
+		Messages:       dt.Map(messages, mapMessage),
+		Assets:         dt.Map(assets, mapAsset),
+	}, nil
+}
+
+func mapProfile(a *account.AccountWithEdges) Profile {
+	return Profile{
+		ID:        a.ID.String(),
+		CreatedAt: a.CreatedAt,
+		Handle:    a.Handle,
+		Name:      a.Name,
+		Bio:       a.Bio.HTML(),
+		Links: dt.Map(a.ExternalLinks, func(l account.ExternalLink) Link {
+			return Link{Text: l.Text, URL: l.URL.String()}
+		}),
+		Emails: dt.Map(a.EmailAddresses, func(e *account.EmailAddress) Email {
+			return Email{Address: e.Email.Address, Verified: e.Verified}
+		}),
+		Roles:    dt.Map(a.Roles, func(r *held.Role) string { return r.Name }),
+		Admin:    a.Admin,
+		Metadata: a.Metadata,
+	}
+}
+
+func mapAuthMethod(a *ent.Authentication) AuthMethod {
+	return AuthMethod{
+		CreatedAt:  a.CreatedAt,
+		Service:    a.Service,
+		Identifier: a.Identifier,
+		Name:       a.Name,
+		Disabled:   a.Disabled,
+	}
+}
+
+func mapPost(p *ent.Post) Post {
+	return Post{
+		ID:         p.ID.String(),
+		CreatedAt:  p.CreatedAt,
+		UpdatedAt:  p.UpdatedAt,
+		DeletedAt:  p.DeletedAt,
+		Title:      p.Title,
+		Slug:       p.Slug,
+		RootID:     idString(p.RootPostID),
+		Body:       p.Body,
+		Visibility: p.Visibility.String(),
+	}
+}
+
+func mapReact(r *ent.React) React {
+	return React{
+		CreatedAt: r.CreatedAt,
+		PostID:    r.PostID.String(),
+		Emoji:     r.Emoji,
+	}
+}
+
+func mapLike(l *ent.LikePost) Like {
+	return Like{
+		CreatedAt: l.CreatedAt,
+		PostID:    l.PostID.String(),
+	}
+}
+
+func mapCollection(c *ent.Collection) Collection {
+	return Collection{
+		ID:          c.ID.String(),
+		CreatedAt:   c.CreatedAt,
+		Name:        c.Name,
+		Slug:        c.Slug,
+		Description: c.Description,
+		Visibility:  c.Visibility.String(),
+		Posts:       dt.Map(c.Edges.CollectionPosts, func(p *ent.CollectionPost) string { return p.PostID.String() }),
+		Nodes:       dt.Map(c.Edges.CollectionNodes, func(n *ent.CollectionNode) string { return n.NodeID.String() }),
+	}
+}
+
+func mapNode(n *ent.Node) Node {
+	return Node{
+		ID:          n.ID.String(),
+		CreatedAt:   n.CreatedAt,
+		UpdatedAt:   n.UpdatedAt,
+		DeletedAt:   n.DeletedAt,
+		Name:        n.Name,
+		Slug:        n.Slug,
+		Description: n.Description,
+		Content:     n.Content,
+		Visibility:  n.Visibility.String(),
+	}
+}
+
+func mapNotification(n *ent.Notification) Notification {
+	return Notification{
+		CreatedAt: n.CreatedAt,
+		Event:     n.EventType,
+		ItemKind:  n.DatagraphKind,
+		ItemID:    idString(n.DatagraphID),
+		Read:      n.Read,
+	}
+}
+
+func mapMessage(m *ent.ConversationMessage) Message {
+	return Message{
+		CreatedAt:      m.CreatedAt,
+		ConversationID: m.ConversationID.String(),
+		Body:           m.Body,
+	}
+}
+
+func mapAsset(a *ent.Asset) Asset {
+	return Asset{
+		ID:        a.ID.String(),
+		CreatedAt: a.CreatedAt,
+		Filename:  a.Filename,
+		Size:      a.Size,
+		MIME:      a.MimeType,
+		Path:      path.Join(asset.AssetsSubdirectory, a.Filename),
+	}
+}
+
+func idString(id *xid.ID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
@@ -0,0 +1,251 @@
+package account_data
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_block "github.com/Southclaws/storyden/internal/ent/accountblock"
+	ent_follow "github.com/Southclaws/storyden/internal/ent/accountfollow"
+	ent_account_roles "github.com/Southclaws/storyden/internal/ent/accountroles"
+	ent_asset "github.com/Southclaws/storyden/internal/ent/asset"
+	ent_auth "github.com/Southclaws/storyden/internal/ent/authentication"
+	ent_email "github.com/Southclaws/storyden/internal/ent/email"
+	ent_event "github.com/Southclaws/storyden/internal/ent/event"
+	ent_event_participant "github.com/Southclaws/storyden/internal/ent/eventparticipant"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_notification "github.com/Southclaws/storyden/internal/ent/notification"
+	ent_digest "github.com/Southclaws/storyden/internal/ent/notificationdigest"
+	ent_preference "github.com/Southclaws/storyden/internal/ent/notificationpreference"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	ent_post_read "github.com/Southclaws/storyden/internal/ent/postread"
+	ent_schedule "github.com/Southclaws/storyden/internal/ent/publishschedule"
+	ent_session "github.com/Southclaws/storyden/internal/ent/session"
+)
+
+// AnonymousName is shown as the author of content written by a member whose
+// account was anonymised.
+const AnonymousName = "Deleted member"
+
+// Erased lists the content affected by erasing an account, so the search index
+// can be brought up to date. Objects are storage paths of the member's files,
+// only populated when they were removed from the database.
+type Erased struct {
+	Threads []post.ID
+	Replies []post.ID
+	Nodes   []library.NodeID
+	Objects []string
+}
+
+type Eraser struct {
+	db *ent.Client
+}
+
+func NewEraser(db *ent.Client) *Eraser {
+	return &Eraser{db: db}
+}
+
+// Anonymise removes everything identifying the member from their account and
+// everything which only matters to them, such as sign in methods, follows and
+// notifications. What they wrote stays where it is, attributed to a nameless
+// placeholder account which can't be signed in to.
+func (e *Eraser) Anonymise(ctx context.Context, id account.AccountID) (*Erased, error) {
+	aid := xid.ID(id)
+
+	erased, err := e.authored(ctx, aid)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	tx, err := e.db.Tx(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+	defer tx.Rollback()
+
+	err = tx.Account.UpdateOneID(aid).
+		SetHandle("deleted-" + aid.String()).
+		SetName(AnonymousName).
+		SetAdmin(false).
+		SetDeletedAt(time.Now()).
+		ClearBio().
+		ClearLinks().
+		ClearMetadata().
+		ClearInvitedByID().
+		ClearSuspendedReason().
+		ClearSuspendedUntil().
+		ClearSuspendedByID().
+		ClearDeletionScheduledAt().
+		ClearCalendarToken().
+		ClearTags().
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	deletes := []func() (int, error){
+		func() (int, error) { return tx.Email.Delete().Where(ent_email.AccountID(aid)).Exec(ctx) },
+		func() (int, error) {
+			return tx.Authentication.Delete().Where(ent_auth.AccountAuthentication(aid)).Exec(ctx)
+		},
+		func() (int, error) { return tx.Session.Delete().Where(ent_session.AccountID(aid)).Exec(ctx) },
+		func() (int, error) {
+			return tx.Notification.Delete().Where(ent_notification.OwnerAccountID(aid)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.AccountFollow.Delete().Where(ent_follow.Or(
+				ent_follow.FollowerAccountID(aid),
+				ent_follow.FollowingAccountID(aid),
+			)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.AccountBlock.Delete().Where(ent_block.Or(
+				ent_block.AccountID(aid),
+				ent_block.BlockedAccountID(aid),
+			)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.AccountRoles.Delete().Where(ent_account_roles.AccountID(aid)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.NotificationPreference.Delete().Where(ent_preference.AccountID(aid)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.NotificationDigest.Delete().Where(ent_digest.AccountID(aid)).Exec(ctx)
+		},
+		func() (int, error) { return tx.PostRead.Delete().Where(ent_post_read.AccountID(aid)).Exec(ctx) },
+		func() (int, error) {
+			return tx.PublishSchedule.Delete().Where(ent_schedule.AccountID(aid)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.EventParticipant.Delete().Where(ent_event_participant.AccountID(aid)).Exec(ctx)
+		},
+	}
+
+	for _, fn := range deletes {
+		if _, err := fn(); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return erased, nil
+}
+
+// Remove deletes the account along with everything the member wrote. Replies
+// by other members in the member's threads are deleted with the threads.
+func (e *Eraser) Remove(ctx context.Context, id account.AccountID) (*Erased, error) {
+	aid := xid.ID(id)
+
+	erased, err := e.authored(ctx, aid)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	assets, err := e.db.Asset.Query().Where(ent_asset.AccountID(aid)).All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+	erased.Objects = dt.Map(assets, func(a *ent.Asset) string { return mapAsset(a).Path })
+
+	threads := dt.Map(erased.Threads, func(id post.ID) xid.ID { return xid.ID(id) })
+
+	tx, err := e.db.Tx(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Event.Delete().
+		Where(ent_event.HasThreadWith(ent_post.IDIn(threads...))).
+		Exec(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	// Replies first, deleting a thread would otherwise orphan its replies.
+	_, err = tx.Post.Delete().
+		Where(
+			ent_post.RootPostIDNotNil(),
+			ent_post.Or(
+				ent_post.AccountPosts(aid),
+				ent_post.RootPostIDIn(threads...),
+			),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	_, err = tx.Post.Delete().Where(ent_post.AccountPosts(aid)).Exec(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	// Everything else belonging to the account is removed by the database.
+	err = tx.Account.DeleteOneID(aid).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return erased, nil
+}
+
+// authored lists the threads, replies and library pages written by the member
+// along with replies by others in the member's threads.
+func (e *Eraser) authored(ctx context.Context, aid xid.ID) (*Erased, error) {
+	threads, err := e.db.Post.Query().
+		Where(ent_post.AccountPosts(aid), ent_post.RootPostIDIsNil()).
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	replies, err := e.db.Post.Query().
+		Where(
+			ent_post.RootPostIDNotNil(),
+			ent_post.Or(
+				ent_post.AccountPosts(aid),
+				ent_post.RootPostIDIn(threads...),
+			),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	nodes, err := e.db.Node.Query().
+		Where(ent_node.AccountID(aid)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return &Erased{
+		Threads: dt.Map(threads, func(id xid.ID) post.ID { return post.ID(id) }),
+		Replies: dt.Map(replies, func(id xid.ID) post.ID { return post.ID(id) }),
+		Nodes:   dt.Map(nodes, func(id xid.ID) library.NodeID { return library.NodeID(id) }),
+	}, nil
+}
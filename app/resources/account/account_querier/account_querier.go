@@ -2,6 +2,7 @@ package account_querier
 
 import (
 	"context"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqljson"
@@ -126,3 +127,36 @@ func (d *Querier) ListByHeldPermission(ctx context.Context, perms ...rbac.Permis
 
 	return dt.MapErr(accounts, account.MapRef)
 }
+
+// ListExpiredSuspensions returns suspended accounts whose suspension ended at
+// or before the given time and which are therefore due to be reinstated.
+func (d *Querier) ListExpiredSuspensions(ctx context.Context, now time.Time) ([]*account.Account, error) {
+	accounts, err := d.db.Account.Query().
+		Where(
+			account_ent.DeletedAtNotNil(),
+			account_ent.SuspendedUntilNotNil(),
+			account_ent.SuspendedUntilLTE(now),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.MapErr(accounts, account.MapRef)
+}
+
+// ListDueDeletions returns accounts whose deletion was scheduled for or before
+// the given time and which are therefore due to be deleted.
+func (d *Querier) ListDueDeletions(ctx context.Context, now time.Time) ([]*account.Account, error) {
+	accounts, err := d.db.Account.Query().
+		Where(
+			account_ent.DeletionScheduledAtNotNil(),
+			account_ent.DeletionScheduledAtLTE(now),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.MapErr(accounts, account.MapRef)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/internal/ent"
+	account_ent "github.com/Southclaws/storyden/internal/ent/account"
 	"github.com/Southclaws/storyden/internal/ent/schema"
 )
 
@@ -57,6 +58,20 @@ func WithBio(v datagraph.Content) Option {
 	}
 }
 
+func WithMeta(m map[string]any) Option {
+	return func(a *ent.AccountMutation) {
+		a.SetMetadata(m)
+	}
+}
+
+// WithCreatedAt backdates an account's registration, used when bringing
+// members over from another platform.
+func WithCreatedAt(t time.Time) Option {
+	return func(a *ent.AccountMutation) {
+		a.SetCreatedAt(t)
+	}
+}
+
 func WithInvitedBy(id xid.ID) Option {
 	return func(a *ent.AccountMutation) {
 		a.SetInvitedByID(id)
@@ -120,6 +135,47 @@ func SetDeleted(t opt.Optional[time.Time]) Mutation {
 	}
 }
 
+// SetSuspension records the details of a suspension, use alongside SetDeleted.
+func SetSuspension(v account.Suspension) Mutation {
+	return func(u *ent.AccountUpdateOne) {
+		if r, ok := v.Reason.Get(); ok {
+			u.SetSuspendedReason(r)
+		} else {
+			u.ClearSuspendedReason()
+		}
+
+		if t, ok := v.Until.Get(); ok {
+			u.SetSuspendedUntil(t)
+		} else {
+			u.ClearSuspendedUntil()
+		}
+
+		if id, ok := v.SuspendedBy.Get(); ok {
+			u.SetSuspendedByID(xid.ID(id))
+		} else {
+			u.ClearSuspendedByID()
+		}
+	}
+}
+
+func ClearSuspension() Mutation {
+	return func(u *ent.AccountUpdateOne) {
+		u.ClearSuspendedReason()
+		u.ClearSuspendedUntil()
+		u.ClearSuspendedByID()
+	}
+}
+
+func SetDeletionScheduled(t opt.Optional[time.Time]) Mutation {
+	return func(u *ent.AccountUpdateOne) {
+		if v, ok := t.Get(); ok {
+			u.SetDeletionScheduledAt(v)
+		} else {
+			u.ClearDeletionScheduledAt()
+		}
+	}
+}
+
 func (d *Writer) Create(ctx context.Context, handle string, opts ...Option) (*account.AccountWithEdges, error) {
 	create := d.db.Account.Create()
 	mutate := create.Mutation()
@@ -165,3 +221,45 @@ func (d *Writer) Update(ctx context.Context, id account.AccountID, opts ...Mutat
 
 	return d.accountQuerier.GetByID(ctx, account.AccountID(acc.ID))
 }
+
+// ReinstateExpired lifts the account's suspension, but only if it's still the
+// one which was due to end at the given time. It returns false when there was
+// nothing to lift, either because another instance already did so or because
+// the suspension was changed in the meantime and therefore still stands.
+func (d *Writer) ReinstateExpired(ctx context.Context, id account.AccountID, until time.Time) (bool, error) {
+	n, err := d.db.Account.Update().
+		Where(
+			account_ent.ID(xid.ID(id)),
+			account_ent.DeletedAtNotNil(),
+			account_ent.SuspendedUntil(until),
+		).
+		ClearDeletedAt().
+		ClearSuspendedReason().
+		ClearSuspendedUntil().
+		ClearSuspendedByID().
+		Save(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n == 1, nil
+}
+
+// ClaimDeletion pushes a due deletion back to retryAt so no other instance
+// starts on it, and if this one fails part way through it's tried again later.
+// It returns false if the deletion is no longer scheduled for the given time,
+// because it was cancelled or another instance claimed it first.
+func (d *Writer) ClaimDeletion(ctx context.Context, id account.AccountID, scheduledAt time.Time, retryAt time.Time) (bool, error) {
+	n, err := d.db.Account.Update().
+		Where(
+			account_ent.ID(xid.ID(id)),
+			account_ent.DeletionScheduledAt(scheduledAt),
+		).
+		SetDeletionScheduledAt(retryAt).
+		Save(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n == 1, nil
+}
@@ -86,6 +86,7 @@ var (
 	ServicePhoneVerify   = Service{servicePhoneVerify}
 	ServiceWebAuthn      = Service{serviceWebAuthn}
 	ServiceAccessKey     = Service{serviceAccessKey}
+	ServiceTOTP          = Service{serviceTOTP}
 	ServiceOAuthGoogle   = Service{serviceOAuthGoogle}
 	ServiceOAuthGitHub   = Service{serviceOAuthGitHub}
 	ServiceOAuthDiscord  = Service{serviceOAuthDiscord}
@@ -110,6 +111,8 @@ func (r Service) Format(f fmt.State, verb rune) {
 			fmt.Fprint(f, "WebAuthn/Passkey")
 		case ServiceAccessKey:
 			fmt.Fprint(f, "API access key")
+		case ServiceTOTP:
+			fmt.Fprint(f, "Authenticator app second factor")
 		case ServiceOAuthGoogle:
 			fmt.Fprint(f, "Google")
 		case ServiceOAuthGitHub:
@@ -162,6 +165,8 @@ func NewService(__iNpUt__ string) (Service, error) {
 		return ServiceWebAuthn, nil
 	case string(serviceAccessKey):
 		return ServiceAccessKey, nil
+	case string(serviceTOTP):
+		return ServiceTOTP, nil
 	case string(serviceOAuthGoogle):
 		return ServiceOAuthGoogle, nil
 	case string(serviceOAuthGitHub):
@@ -184,6 +189,7 @@ var (
 	TokenTypePasswordHash = TokenType{tokenTypePasswordHash}
 	TokenTypeWebAuthn     = TokenType{tokenTypeWebAuthn}
 	TokenTypeOAuth        = TokenType{tokenTypeOAuth}
+	TokenTypeTOTP         = TokenType{tokenTypeTOTP}
 )
 
 func (r TokenType) Format(f fmt.State, verb rune) {
@@ -202,6 +208,8 @@ func (r TokenType) Format(f fmt.State, verb rune) {
 			fmt.Fprint(f, "WebAuthn token")
 		case TokenTypeOAuth:
 			fmt.Fprint(f, "OAuth2 token")
+		case TokenTypeTOTP:
+			fmt.Fprint(f, "TOTP shared secret")
 		default:
 			fmt.Fprint(f, "")
 		}
@@ -244,6 +252,8 @@ func NewTokenType(__iNpUt__ string) (TokenType, error) {
 		return TokenTypeWebAuthn, nil
 	case string(tokenTypeOAuth):
 		return TokenTypeOAuth, nil
+	case string(tokenTypeTOTP):
+		return TokenTypeTOTP, nil
 	default:
 		return TokenType{}, fmt.Errorf("invalid value for type 'TokenType': '%s'", __iNpUt__)
 	}
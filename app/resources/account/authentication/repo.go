@@ -47,3 +47,15 @@ func WithName(name string) Option {
 		am.SetName(name)
 	}
 }
+
+func WithDisabled(disabled bool) Option {
+	return func(am *ent.AuthenticationMutation) {
+		am.SetDisabled(disabled)
+	}
+}
+
+func WithMetadata(metadata map[string]any) Option {
+	return func(am *ent.AuthenticationMutation) {
+		am.SetMetadata(metadata)
+	}
+}
@@ -10,6 +10,7 @@ const (
 	servicePhoneVerify serviceEnum = "phone_verify" // Phone number + verification code
 	serviceWebAuthn    serviceEnum = "webauthn"     // WebAuthn/Passkey
 	serviceAccessKey   serviceEnum = "access_key"   // API access key
+	serviceTOTP        serviceEnum = "totp"         // Authenticator app second factor
 
 	// OAuth services
 	serviceOAuthGoogle  serviceEnum = "oauth_google"  // Google
@@ -25,4 +26,5 @@ const (
 	tokenTypePasswordHash tokenTypeEnum = "password_hash" // argon2 hashed password
 	tokenTypeWebAuthn     tokenTypeEnum = "webauthn"      // WebAuthn token
 	tokenTypeOAuth        tokenTypeEnum = "oauth"         // OAuth2 token
+	tokenTypeTOTP         tokenTypeEnum = "totp"          // TOTP shared secret
 )
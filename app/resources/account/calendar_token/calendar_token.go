@@ -0,0 +1,92 @@
+// Package calendar_token stores the secret which authorises requests for an
+// account's personal calendar feed. Calendar clients can't sign in, so the
+// token in the subscription URL stands in for a session.
+package calendar_token
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+)
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+// Get returns the account's calendar token, issuing one on first use.
+func (r *Repository) Get(ctx context.Context, accountID account.AccountID) (string, error) {
+	acc, err := r.db.Account.Get(ctx, xid.ID(accountID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return "", fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	if acc.CalendarToken != nil {
+		return *acc.CalendarToken, nil
+	}
+
+	return r.Reset(ctx, accountID)
+}
+
+// Reset replaces the account's calendar token, existing subscriptions stop
+// working until they're updated with the new URL.
+func (r *Repository) Reset(ctx context.Context, accountID account.AccountID) (string, error) {
+	token, err := generate()
+	if err != nil {
+		return "", fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	err = r.db.Account.UpdateOneID(xid.ID(accountID)).
+		SetCalendarToken(token).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return "", fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return token, nil
+}
+
+// Lookup resolves the account a calendar token was issued to.
+func (r *Repository) Lookup(ctx context.Context, token string) (account.AccountID, bool, error) {
+	acc, err := r.db.Account.Query().
+		Where(
+			ent_account.CalendarToken(token),
+			ent_account.DeletedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return account.AccountID{}, false, nil
+		}
+		return account.AccountID{}, false, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return account.AccountID(acc.ID), true, nil
+}
+
+func generate() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
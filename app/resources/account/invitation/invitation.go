@@ -3,10 +3,21 @@ package invitation
 import (
 	"time"
 
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
 	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/profile"
 	"github.com/Southclaws/storyden/internal/ent"
-	"github.com/rs/xid"
+)
+
+var (
+	ErrRevoked   = fault.New("invitation has been revoked")
+	ErrExpired   = fault.New("invitation has expired")
+	ErrExhausted = fault.New("invitation has no uses remaining")
 )
 
 type Invitation struct {
@@ -16,6 +27,34 @@ type Invitation struct {
 	DeletedAt opt.Optional[time.Time]
 	Message   opt.Optional[string]
 	Creator   account.Account
+	ExpiresAt opt.Optional[time.Time]
+	MaxUses   opt.Optional[int]
+	Uses      int
+	RevokedAt opt.Optional[time.Time]
+
+	// Roles are granted to every account which registers with the invitation.
+	Roles role.Roles
+
+	// Invited is only populated when the invitation was queried with its
+	// registered accounts, it's not included for public reads.
+	Invited opt.Optional[[]*profile.Ref]
+}
+
+// Valid reports whether the invitation may still be used to register.
+func (i *Invitation) Valid(now time.Time) error {
+	if i.RevokedAt.Ok() {
+		return ErrRevoked
+	}
+
+	if exp, ok := i.ExpiresAt.Get(); ok && !now.Before(exp) {
+		return ErrExpired
+	}
+
+	if max, ok := i.MaxUses.Get(); ok && i.Uses >= max {
+		return ErrExhausted
+	}
+
+	return nil
 }
 
 func Map(in *ent.Invitation) (*Invitation, error) {
@@ -29,6 +68,20 @@ func Map(in *ent.Invitation) (*Invitation, error) {
 		return nil, err
 	}
 
+	roles, err := role.MapList(in.Edges.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	invited := opt.NewEmpty[[]*profile.Ref]()
+	if accs, err := in.Edges.InvitedOrErr(); err == nil {
+		refs, err := dt.MapErr(accs, profile.MapRef)
+		if err != nil {
+			return nil, err
+		}
+		invited = opt.New(refs)
+	}
+
 	return &Invitation{
 		ID:        in.ID,
 		CreatedAt: in.CreatedAt,
@@ -36,5 +89,11 @@ func Map(in *ent.Invitation) (*Invitation, error) {
 		DeletedAt: opt.NewPtr(in.DeletedAt),
 		Message:   opt.NewPtr(in.Message),
 		Creator:   *acc,
+		ExpiresAt: opt.NewPtr(in.ExpiresAt),
+		MaxUses:   opt.NewPtr(in.MaxUses),
+		Uses:      in.Uses,
+		RevokedAt: opt.NewPtr(in.RevokedAt),
+		Roles:     roles,
+		Invited:   invited,
 	}, nil
 }
@@ -31,11 +31,23 @@ func WithCreator(id account.AccountID) Filter {
 	}
 }
 
-func (d *Querier) GetByID(ctx context.Context, id xid.ID) (*invitation.Invitation, error) {
+// WithInvited includes the accounts which registered using each invitation.
+func WithInvited() Filter {
+	return func(q *ent.InvitationQuery) {
+		q.WithInvited()
+	}
+}
+
+func (d *Querier) GetByID(ctx context.Context, id xid.ID, opts ...Filter) (*invitation.Invitation, error) {
 	q := d.db.Invitation.
 		Query().
 		Where(invitation_ent.ID(id)).
-		WithCreator()
+		WithCreator().
+		WithRoles()
+
+	for _, opt := range opts {
+		opt(q)
+	}
 
 	result, err := q.Only(ctx)
 	if err != nil {
@@ -55,7 +67,10 @@ func (d *Querier) GetByID(ctx context.Context, id xid.ID) (*invitation.Invitatio
 }
 
 func (d *Querier) List(ctx context.Context, opts ...Filter) ([]*invitation.Invitation, error) {
-	q := d.db.Invitation.Query()
+	q := d.db.Invitation.Query().
+		WithCreator().
+		WithRoles().
+		Order(ent.Desc(invitation_ent.FieldCreatedAt))
 
 	for _, opt := range opts {
 		opt(q)
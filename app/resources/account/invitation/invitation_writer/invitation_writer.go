@@ -2,7 +2,10 @@ package invitation_writer
 
 import (
 	"context"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/ftag"
@@ -11,7 +14,9 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/invitation"
+	"github.com/Southclaws/storyden/app/resources/account/role"
 	"github.com/Southclaws/storyden/internal/ent"
+	invitation_ent "github.com/Southclaws/storyden/internal/ent/invitation"
 )
 
 type Writer struct {
@@ -22,12 +27,36 @@ func New(db *ent.Client) *Writer {
 	return &Writer{db: db}
 }
 
-func (d *Writer) Create(ctx context.Context, creator account.AccountID, message opt.Optional[string]) (*invitation.Invitation, error) {
+type Option func(*ent.InvitationMutation)
+
+func WithExpiresAt(t time.Time) Option {
+	return func(m *ent.InvitationMutation) {
+		m.SetExpiresAt(t)
+	}
+}
+
+func WithMaxUses(n int) Option {
+	return func(m *ent.InvitationMutation) {
+		m.SetMaxUses(n)
+	}
+}
+
+func WithRoles(ids ...role.RoleID) Option {
+	return func(m *ent.InvitationMutation) {
+		m.AddRoleIDs(dt.Map(ids, func(id role.RoleID) xid.ID { return xid.ID(id) })...)
+	}
+}
+
+func (d *Writer) Create(ctx context.Context, creator account.AccountID, message opt.Optional[string], opts ...Option) (*invitation.Invitation, error) {
 	create := d.db.Invitation.Create()
 
 	create.SetCreatorAccountID(xid.ID(creator))
 	create.SetNillableMessage(message.Ptr())
 
+	for _, fn := range opts {
+		fn(create.Mutation())
+	}
+
 	result, err := create.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -37,17 +66,77 @@ func (d *Writer) Create(ctx context.Context, creator account.AccountID, message
 		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
 	}
 
-	result.Edges.Creator, err = result.QueryCreator().Only(ctx)
-	if err != nil {
-		return nil, fault.Wrap(err, fctx.With(ctx))
+	return d.get(ctx, result.ID)
+}
+
+// Revoke prevents any further registrations with the invitation while keeping
+// the record of which accounts it has already produced.
+func (d *Writer) Revoke(ctx context.Context, id xid.ID) (*invitation.Invitation, error) {
+	err := d.db.Invitation.UpdateOneID(id).
+		Where(invitation_ent.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
 	}
 
-	inv, err := invitation.Map(result)
+	return d.get(ctx, id)
+}
+
+// Claim consumes one use of the invitation. The check and the increment happen
+// in a single statement so concurrent registrations can't exceed the maximum.
+func (d *Writer) Claim(ctx context.Context, id xid.ID) error {
+	now := time.Now()
+
+	n, err := d.db.Invitation.Update().
+		Where(
+			invitation_ent.ID(id),
+			invitation_ent.RevokedAtIsNil(),
+			invitation_ent.Or(
+				invitation_ent.ExpiresAtIsNil(),
+				invitation_ent.ExpiresAtGT(now),
+			),
+			func(s *sql.Selector) {
+				s.Where(sql.Or(
+					sql.IsNull(s.C(invitation_ent.FieldMaxUses)),
+					sql.ColumnsLT(s.C(invitation_ent.FieldUses), s.C(invitation_ent.FieldMaxUses)),
+				))
+			},
+		).
+		AddUses(1).
+		Save(ctx)
 	if err != nil {
-		return nil, fault.Wrap(err, fctx.With(ctx))
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
 	}
 
-	return inv, nil
+	if n == 0 {
+		inv, err := d.get(ctx, id)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if err := inv.Valid(now); err != nil {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.PermissionDenied))
+		}
+
+		// Lost a race for the final use.
+		return fault.Wrap(invitation.ErrExhausted, fctx.With(ctx), ftag.With(ftag.PermissionDenied))
+	}
+
+	return nil
+}
+
+// Release returns a use claimed for a registration which did not complete.
+func (d *Writer) Release(ctx context.Context, id xid.ID) error {
+	err := d.db.Invitation.UpdateOneID(id).
+		Where(invitation_ent.UsesGT(0)).
+		AddUses(-1).
+		Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return nil
 }
 
 func (d *Writer) Delete(ctx context.Context, id xid.ID) error {
@@ -58,3 +147,25 @@ func (d *Writer) Delete(ctx context.Context, id xid.ID) error {
 
 	return nil
 }
+
+func (d *Writer) get(ctx context.Context, id xid.ID) (*invitation.Invitation, error) {
+	result, err := d.db.Invitation.Query().
+		Where(invitation_ent.ID(id)).
+		WithCreator().
+		WithRoles().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	inv, err := invitation.Map(result)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return inv, nil
+}
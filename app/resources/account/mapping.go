@@ -6,6 +6,7 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account/role/held"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
@@ -23,6 +24,15 @@ func MapRef(a *ent.Account) (*Account, error) {
 		return nil, err
 	}
 
+	var suspension opt.Optional[Suspension]
+	if a.DeletedAt != nil {
+		suspension = opt.New(Suspension{
+			Reason:      opt.NewPtr(a.SuspendedReason),
+			Until:       opt.NewPtr(a.SuspendedUntil),
+			SuspendedBy: opt.Map(opt.NewPtr(a.SuspendedByID), func(id xid.ID) AccountID { return AccountID(id) }),
+		})
+	}
+
 	return &Account{
 		ID:        AccountID(a.ID),
 		CreatedAt: a.CreatedAt,
@@ -35,8 +45,11 @@ func MapRef(a *ent.Account) (*Account, error) {
 		Admin:    a.Admin, // TODO: should this be derived from roles?
 		Metadata: a.Metadata,
 
-		DeletedAt: opt.NewPtr(a.DeletedAt),
-		IndexedAt: opt.NewPtr(a.IndexedAt),
+		DeletedAt:  opt.NewPtr(a.DeletedAt),
+		IndexedAt:  opt.NewPtr(a.IndexedAt),
+		Suspension: suspension,
+
+		DeletionScheduledAt: opt.NewPtr(a.DeletionScheduledAt),
 	}, nil
 }
 
@@ -0,0 +1,45 @@
+package notification
+
+type deliveryEnum string
+
+// Delivery is how a member wants to receive a particular kind of notification.
+// Every method other than off also writes the notification to the in-app list.
+const (
+	deliveryInApp  deliveryEnum = "in_app" // Notification list only
+	deliveryEmail  deliveryEnum = "email"  // Emailed as soon as it happens
+	deliveryDigest deliveryEnum = "digest" // Collected into a periodic digest email
+	deliveryOff    deliveryEnum = "off"    // Not delivered at all
+)
+
+// DefaultDelivery applies to any event a member has not set a preference for.
+var DefaultDelivery = DeliveryInApp
+
+// Events lists every event a member may set a delivery preference for.
+var Events = []Event{
+	EventThreadReply,
+	EventReplyToReply,
+	EventPostLike,
+	EventFollow,
+	EventProfileMention,
+	EventEventHostAdded,
+	EventMemberAttendingEvent,
+	EventMemberDeclinedEvent,
+	EventAttendeeRemoved,
+	EventReportSubmitted,
+	EventReportUpdated,
+	EventAnswerAccepted,
+	EventDirectMessage,
+}
+
+// Preferences maps events to the member's chosen delivery method.
+type Preferences map[Event]Delivery
+
+func (p Preferences) For(e Event) Delivery {
+	if d, ok := p[e]; ok {
+		return d
+	}
+	return DefaultDelivery
+}
+
+func (d Delivery) InApp() bool { return d != DeliveryOff }
+func (d Delivery) Email() bool { return d == DeliveryEmail }
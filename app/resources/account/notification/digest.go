@@ -0,0 +1,25 @@
+package notification
+
+import "time"
+
+type digestFrequencyEnum string
+
+// DigestFrequency is how often a member is sent the digest email, a summary of
+// unread notifications and popular threads since the previous digest.
+const (
+	digestFrequencyOff    digestFrequencyEnum = "off"    // Never sent
+	digestFrequencyDaily  digestFrequencyEnum = "daily"  // Sent once a day
+	digestFrequencyWeekly digestFrequencyEnum = "weekly" // Sent once a week
+)
+
+// Period is the time between digests, zero when digests are off.
+func (f DigestFrequency) Period() time.Duration {
+	switch f {
+	case DigestFrequencyDaily:
+		return 24 * time.Hour
+	case DigestFrequencyWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
@@ -17,4 +17,7 @@ const (
 	eventAttendeeRemoved      eventEnum = `attendee_removed`
 	eventReportSubmitted      eventEnum = "report_submitted"
 	eventReportUpdated        eventEnum = "report_updated"
+	eventAnswerAccepted       eventEnum = "answer_accepted"
+	eventDirectMessage        eventEnum = "direct_message"
+	eventDataExportReady      eventEnum = "data_export_ready"
 )
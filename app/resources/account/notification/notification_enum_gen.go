@@ -7,6 +7,149 @@ import (
 	"fmt"
 )
 
+type Delivery struct {
+	v deliveryEnum
+}
+
+var (
+	DeliveryInApp  = Delivery{deliveryInApp}
+	DeliveryEmail  = Delivery{deliveryEmail}
+	DeliveryDigest = Delivery{deliveryDigest}
+	DeliveryOff    = Delivery{deliveryOff}
+)
+
+func (r Delivery) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		fmt.Fprint(f, r.v)
+	case 'q':
+		fmt.Fprintf(f, "%q", r.String())
+	case 'v':
+		switch r {
+		case DeliveryInApp:
+			fmt.Fprint(f, "Notification list only")
+		case DeliveryEmail:
+			fmt.Fprint(f, "Emailed as soon as it happens")
+		case DeliveryDigest:
+			fmt.Fprint(f, "Collected into a periodic digest email")
+		case DeliveryOff:
+			fmt.Fprint(f, "Not delivered at all")
+		default:
+			fmt.Fprint(f, "")
+		}
+	default:
+		fmt.Fprint(f, r.v)
+	}
+}
+func (r Delivery) String() string {
+	return string(r.v)
+}
+func (r Delivery) MarshalText() ([]byte, error) {
+	return []byte(r.v), nil
+}
+func (r *Delivery) UnmarshalText(__iNpUt__ []byte) error {
+	s, err := NewDelivery(string(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func (r Delivery) Value() (driver.Value, error) {
+	return r.v, nil
+}
+func (r *Delivery) Scan(__iNpUt__ any) error {
+	s, err := NewDelivery(fmt.Sprint(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func NewDelivery(__iNpUt__ string) (Delivery, error) {
+	switch __iNpUt__ {
+	case string(deliveryInApp):
+		return DeliveryInApp, nil
+	case string(deliveryEmail):
+		return DeliveryEmail, nil
+	case string(deliveryDigest):
+		return DeliveryDigest, nil
+	case string(deliveryOff):
+		return DeliveryOff, nil
+	default:
+		return Delivery{}, fmt.Errorf("invalid value for type 'Delivery': '%s'", __iNpUt__)
+	}
+}
+
+type DigestFrequency struct {
+	v digestFrequencyEnum
+}
+
+var (
+	DigestFrequencyOff    = DigestFrequency{digestFrequencyOff}
+	DigestFrequencyDaily  = DigestFrequency{digestFrequencyDaily}
+	DigestFrequencyWeekly = DigestFrequency{digestFrequencyWeekly}
+)
+
+func (r DigestFrequency) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		fmt.Fprint(f, r.v)
+	case 'q':
+		fmt.Fprintf(f, "%q", r.String())
+	case 'v':
+		switch r {
+		case DigestFrequencyOff:
+			fmt.Fprint(f, "Never sent")
+		case DigestFrequencyDaily:
+			fmt.Fprint(f, "Sent once a day")
+		case DigestFrequencyWeekly:
+			fmt.Fprint(f, "Sent once a week")
+		default:
+			fmt.Fprint(f, "")
+		}
+	default:
+		fmt.Fprint(f, r.v)
+	}
+}
+func (r DigestFrequency) String() string {
+	return string(r.v)
+}
+func (r DigestFrequency) MarshalText() ([]byte, error) {
+	return []byte(r.v), nil
+}
+func (r *DigestFrequency) UnmarshalText(__iNpUt__ []byte) error {
+	s, err := NewDigestFrequency(string(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func (r DigestFrequency) Value() (driver.Value, error) {
+	return r.v, nil
+}
+func (r *DigestFrequency) Scan(__iNpUt__ any) error {
+	s, err := NewDigestFrequency(fmt.Sprint(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func NewDigestFrequency(__iNpUt__ string) (DigestFrequency, error) {
+	switch __iNpUt__ {
+	case string(digestFrequencyOff):
+		return DigestFrequencyOff, nil
+	case string(digestFrequencyDaily):
+		return DigestFrequencyDaily, nil
+	case string(digestFrequencyWeekly):
+		return DigestFrequencyWeekly, nil
+	default:
+		return DigestFrequency{}, fmt.Errorf("invalid value for type 'DigestFrequency': '%s'", __iNpUt__)
+	}
+}
+
 type Event struct {
 	v eventEnum
 }
@@ -23,6 +166,9 @@ var (
 	EventAttendeeRemoved      = Event{eventAttendeeRemoved}
 	EventReportSubmitted      = Event{eventReportSubmitted}
 	EventReportUpdated        = Event{eventReportUpdated}
+	EventAnswerAccepted       = Event{eventAnswerAccepted}
+	EventDirectMessage        = Event{eventDirectMessage}
+	EventDataExportReady      = Event{eventDataExportReady}
 )
 
 func (r Event) Format(f fmt.State, verb rune) {
@@ -84,6 +230,12 @@ func NewEvent(__iNpUt__ string) (Event, error) {
 		return EventReportSubmitted, nil
 	case string(eventReportUpdated):
 		return EventReportUpdated, nil
+	case string(eventAnswerAccepted):
+		return EventAnswerAccepted, nil
+	case string(eventDirectMessage):
+		return EventDirectMessage, nil
+	case string(eventDataExportReady):
+		return EventDataExportReady, nil
 	default:
 		return Event{}, fmt.Errorf("invalid value for type 'Event': '%s'", __iNpUt__)
 	}
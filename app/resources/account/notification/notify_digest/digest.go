@@ -0,0 +1,255 @@
+// Package notify_digest stores member subscriptions to the digest email along
+// with a record of every item sent in a digest, and provides the queries used
+// to find content worth including in one.
+package notify_digest
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/internal/ent"
+	entnotification "github.com/Southclaws/storyden/internal/ent/notification"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigest"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigestitem"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+type Digest struct {
+	ID        xid.ID
+	AccountID account.AccountID
+	Frequency notification.DigestFrequency
+	LastSent  opt.Optional[time.Time]
+}
+
+// ItemKind distinguishes the kinds of item recorded as sent in a digest.
+type ItemKind string
+
+const (
+	ItemKindNotification ItemKind = "notification"
+	ItemKindThread       ItemKind = "thread"
+)
+
+type Item struct {
+	Kind ItemKind
+	ID   xid.ID
+}
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+func Map(in *ent.NotificationDigest) (*Digest, error) {
+	freq, err := notification.NewDigestFrequency(in.Frequency)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Digest{
+		ID:        in.ID,
+		AccountID: account.AccountID(in.AccountID),
+		Frequency: freq,
+		LastSent:  opt.NewPtr(in.LastSentAt),
+	}, nil
+}
+
+// Get returns the account's digest subscription, accounts which never chose a
+// frequency are returned with digests turned off.
+func (r *Repository) Get(ctx context.Context, accountID account.AccountID) (*Digest, error) {
+	d, err := r.db.NotificationDigest.Query().
+		Where(notificationdigest.AccountID(xid.ID(accountID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &Digest{
+				AccountID: accountID,
+				Frequency: notification.DigestFrequencyOff,
+			}, nil
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return Map(d)
+}
+
+func (r *Repository) SetFrequency(ctx context.Context, accountID account.AccountID, freq notification.DigestFrequency) (*Digest, error) {
+	err := r.db.NotificationDigest.Create().
+		SetAccountID(xid.ID(accountID)).
+		SetFrequency(freq.String()).
+		OnConflictColumns(notificationdigest.FieldAccountID).
+		Update(func(u *ent.NotificationDigestUpsert) {
+			u.UpdateFrequency()
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return r.Get(ctx, accountID)
+}
+
+// ListDue returns up to limit subscriptions whose period has elapsed since the
+// last digest was sent, or which have never been sent a digest at all.
+func (r *Repository) ListDue(ctx context.Context, now time.Time, limit int) ([]*Digest, error) {
+	ds, err := r.db.NotificationDigest.Query().
+		Where(notificationdigest.Or(
+			dueFor(notification.DigestFrequencyDaily, now),
+			dueFor(notification.DigestFrequencyWeekly, now),
+		)).
+		Order(ent.Asc(notificationdigest.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return dt.MapErr(ds, Map)
+}
+
+// Claim marks a due digest as sent at the given time, but only if nobody else
+// has done so since it was listed. It returns false when another instance got
+// there first, in which case the digest must not be sent.
+func (r *Repository) Claim(ctx context.Context, d *Digest, sentAt time.Time) (bool, error) {
+	unchanged := notificationdigest.LastSentAtIsNil()
+	if last, ok := d.LastSent.Get(); ok {
+		unchanged = notificationdigest.LastSentAt(last)
+	}
+
+	n, err := r.db.NotificationDigest.Update().
+		Where(notificationdigest.ID(d.ID), unchanged).
+		SetLastSentAt(sentAt).
+		Save(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return n == 1, nil
+}
+
+func dueFor(freq notification.DigestFrequency, now time.Time) predicate.NotificationDigest {
+	return notificationdigest.And(
+		notificationdigest.FrequencyEQ(freq.String()),
+		notificationdigest.Or(
+			notificationdigest.LastSentAtIsNil(),
+			notificationdigest.LastSentAtLTE(now.Add(-freq.Period())),
+		),
+	)
+}
+
+// Sent returns which of the given items have already been sent in a digest.
+func (r *Repository) Sent(ctx context.Context, digestID xid.ID, kind ItemKind, ids []xid.ID) (map[xid.ID]bool, error) {
+	if len(ids) == 0 {
+		return map[xid.ID]bool{}, nil
+	}
+
+	sent, err := r.db.NotificationDigestItem.Query().
+		Where(
+			notificationdigestitem.DigestID(digestID),
+			notificationdigestitem.ItemKind(string(kind)),
+			notificationdigestitem.ItemIDIn(ids...),
+		).
+		Select(notificationdigestitem.FieldItemID).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	out := make(map[xid.ID]bool, len(sent))
+	for _, s := range sent {
+		out[s.ItemID] = true
+	}
+
+	return out, nil
+}
+
+// Unread returns the account's newest unread notifications which haven't been
+// included in a digest yet, leaving out the given events, along with how many
+// such notifications there are in total.
+func (r *Repository) Unread(ctx context.Context, d *Digest, exclude []notification.Event, limit int) (notification.NotificationRefs, int, error) {
+	excluded := dt.Map(exclude, func(e notification.Event) string { return e.String() })
+
+	query := r.db.Notification.Query().
+		Where(
+			entnotification.OwnerAccountID(xid.ID(d.AccountID)),
+			entnotification.Read(false),
+			entnotification.DeletedAtIsNil(),
+			entnotification.EventTypeNotIn(excluded...),
+			notDigested(d.ID),
+		)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	ns, err := query.
+		Order(ent.Desc(entnotification.FieldCreatedAt)).
+		Limit(limit).
+		WithSource().
+		All(ctx)
+	if err != nil {
+		return nil, 0, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	refs, err := dt.MapErr(ns, notification.Map)
+	if err != nil {
+		return nil, 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return refs, total, nil
+}
+
+func notDigested(digestID xid.ID) predicate.Notification {
+	return func(s *sql.Selector) {
+		t := sql.Table(notificationdigestitem.Table)
+		s.Where(sql.NotExists(
+			sql.Select(t.C(notificationdigestitem.FieldID)).
+				From(t).
+				Where(sql.And(
+					sql.EQ(t.C(notificationdigestitem.FieldDigestID), digestID),
+					sql.EQ(t.C(notificationdigestitem.FieldItemKind), string(ItemKindNotification)),
+					sql.ColumnsEQ(t.C(notificationdigestitem.FieldItemID), s.C(entnotification.FieldID)),
+				)),
+		))
+	}
+}
+
+// Record stores the items included in a digest so they aren't sent again.
+func (r *Repository) Record(ctx context.Context, digestID xid.ID, items []Item) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	err := r.db.NotificationDigestItem.MapCreateBulk(items, func(c *ent.NotificationDigestItemCreate, i int) {
+		c.SetDigestID(digestID).
+			SetItemKind(string(items[i].Kind)).
+			SetItemID(items[i].ID)
+	}).
+		OnConflictColumns(
+			notificationdigestitem.FieldDigestID,
+			notificationdigestitem.FieldItemKind,
+			notificationdigestitem.FieldItemID,
+		).
+		DoNothing().
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return nil
+}
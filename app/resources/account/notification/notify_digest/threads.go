@@ -0,0 +1,235 @@
+package notify_digest
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_like "github.com/Southclaws/storyden/internal/ent/likepost"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	ent_postread "github.com/Southclaws/storyden/internal/ent/postread"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+)
+
+// topThreadCandidates bounds how many recently active threads are scored when
+// looking for the most popular threads of a period.
+const topThreadCandidates = 100
+
+// Thread is the minimal information about a thread needed to list it in an
+// email, along with its activity during the digest period.
+type Thread struct {
+	ID      post.ID
+	Title   string
+	Slug    string
+	Replies int
+	Likes   int
+}
+
+func mapThread(p *ent.Post) *Thread {
+	return &Thread{
+		ID:    post.ID(p.ID),
+		Title: p.Title,
+		Slug:  p.Slug,
+	}
+}
+
+func publishedThread() predicate.Post {
+	return ent_post.And(
+		ent_post.RootPostIDIsNil(),
+		ent_post.DeletedAtIsNil(),
+		ent_post.VisibilityEQ(ent_post.VisibilityPublished),
+	)
+}
+
+// NewThreads lists threads published since the given time by other members in
+// any category the account has started or replied to a thread in. Threads the
+// account has already read are left out.
+func (r *Repository) NewThreads(ctx context.Context, accountID account.AccountID, since time.Time, limit int) ([]*Thread, error) {
+	author := ent_post.HasAuthorWith(ent_account.ID(xid.ID(accountID)))
+
+	categoryIDs, err := r.db.Post.Query().
+		Where(
+			ent_post.RootPostIDIsNil(),
+			ent_post.DeletedAtIsNil(),
+			ent_post.Or(author, ent_post.HasPostsWith(author)),
+		).
+		QueryCategory().
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	if len(categoryIDs) == 0 {
+		return nil, nil
+	}
+
+	ps, err := r.db.Post.Query().
+		Where(
+			publishedThread(),
+			ent_post.CategoryIDIn(categoryIDs...),
+			ent_post.CreatedAtGT(since),
+			ent_post.Not(author),
+		).
+		Order(ent.Desc(ent_post.FieldCreatedAt)).
+		Limit(topThreadCandidates).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	ps, err = r.unread(ctx, accountID, ps)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(ps) > limit {
+		ps = ps[:limit]
+	}
+
+	threads := make([]*Thread, len(ps))
+	for i, p := range ps {
+		threads[i] = mapThread(p)
+	}
+
+	return threads, nil
+}
+
+// TopThreads lists the threads which received the most replies and likes since
+// the given time, most popular first. Threads with no activity and threads the
+// account has read since their latest reply are omitted.
+func (r *Repository) TopThreads(ctx context.Context, accountID account.AccountID, since time.Time, limit int) ([]*Thread, error) {
+	ps, err := r.db.Post.Query().
+		Where(
+			publishedThread(),
+			ent_post.Or(
+				ent_post.HasPostsWith(ent_post.CreatedAtGT(since), ent_post.DeletedAtIsNil()),
+				ent_post.HasLikesWith(ent_like.CreatedAtGT(since)),
+			),
+		).
+		Order(ent.Desc(ent_post.FieldLastReplyAt)).
+		Limit(topThreadCandidates).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	ps, err = r.unread(ctx, accountID, ps)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(ps) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]xid.ID, len(ps))
+	for i, p := range ps {
+		ids[i] = p.ID
+	}
+
+	var replies []struct {
+		RootPostID xid.ID `json:"root_post_id"`
+		Count      int    `json:"count"`
+	}
+	err = r.db.Post.Query().
+		Where(
+			ent_post.RootPostIDIn(ids...),
+			ent_post.CreatedAtGT(since),
+			ent_post.DeletedAtIsNil(),
+		).
+		GroupBy(ent_post.FieldRootPostID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &replies)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	var likes []struct {
+		PostID xid.ID `json:"post_id"`
+		Count  int    `json:"count"`
+	}
+	err = r.db.LikePost.Query().
+		Where(
+			ent_like.PostIDIn(ids...),
+			ent_like.CreatedAtGT(since),
+		).
+		GroupBy(ent_like.FieldPostID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &likes)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	threads := make(map[xid.ID]*Thread, len(ps))
+	for _, p := range ps {
+		threads[p.ID] = mapThread(p)
+	}
+	for _, c := range replies {
+		if t, ok := threads[c.RootPostID]; ok {
+			t.Replies = c.Count
+		}
+	}
+	for _, c := range likes {
+		if t, ok := threads[c.PostID]; ok {
+			t.Likes = c.Count
+		}
+	}
+
+	top := make([]*Thread, 0, len(threads))
+	for _, p := range ps {
+		t := threads[p.ID]
+		if t.Replies+t.Likes > 0 {
+			top = append(top, t)
+		}
+	}
+
+	// Stable so ties keep the most recently active thread first.
+	sort.SliceStable(top, func(i, j int) bool {
+		return top[i].Replies+top[i].Likes > top[j].Replies+top[j].Likes
+	})
+
+	if len(top) > limit {
+		top = top[:limit]
+	}
+
+	return top, nil
+}
+
+// unread drops the threads which the account has read since their latest reply.
+// Timestamps are compared here rather than in the query as SQLite stores them
+// as text which doesn't always sort chronologically.
+func (r *Repository) unread(ctx context.Context, accountID account.AccountID, ps []*ent.Post) ([]*ent.Post, error) {
+	if len(ps) == 0 {
+		return ps, nil
+	}
+
+	reads, err := r.db.PostRead.Query().
+		Where(
+			ent_postread.AccountID(xid.ID(accountID)),
+			ent_postread.RootPostIDIn(dt.Map(ps, func(p *ent.Post) xid.ID { return p.ID })...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	seen := make(map[xid.ID]time.Time, len(reads))
+	for _, pr := range reads {
+		seen[pr.RootPostID] = pr.LastSeenAt
+	}
+
+	return dt.Filter(ps, func(p *ent.Post) bool {
+		last, ok := seen[p.ID]
+		return !ok || last.Before(p.LastReplyAt)
+	}), nil
+}
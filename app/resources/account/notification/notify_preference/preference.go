@@ -0,0 +1,74 @@
+package notify_preference
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/notificationpreference"
+)
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+// Get returns the account's stored preferences. Events which have no stored
+// preference are absent, use Preferences.For to resolve the default for them.
+func (r *Repository) Get(ctx context.Context, accountID account.AccountID) (notification.Preferences, error) {
+	rows, err := r.db.NotificationPreference.Query().
+		Where(notificationpreference.AccountID(xid.ID(accountID))).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	prefs := notification.Preferences{}
+	for _, row := range rows {
+		// Rows for events or delivery methods which no longer exist are ignored
+		// rather than failing, so removing an event doesn't break preferences.
+		event, err := notification.NewEvent(row.EventType)
+		if err != nil {
+			continue
+		}
+
+		delivery, err := notification.NewDelivery(row.Delivery)
+		if err != nil {
+			continue
+		}
+
+		prefs[event] = delivery
+	}
+
+	return prefs, nil
+}
+
+// Set stores the given preferences, leaving any events not present unchanged.
+func (r *Repository) Set(ctx context.Context, accountID account.AccountID, prefs notification.Preferences) (notification.Preferences, error) {
+	for event, delivery := range prefs {
+		err := r.db.NotificationPreference.Create().
+			SetAccountID(xid.ID(accountID)).
+			SetEventType(event.String()).
+			SetDelivery(delivery.String()).
+			OnConflictColumns(notificationpreference.FieldAccountID, notificationpreference.FieldEventType).
+			Update(func(u *ent.NotificationPreferenceUpsert) {
+				u.UpdateDelivery()
+				u.UpdateUpdatedAt()
+			}).
+			Exec(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+		}
+	}
+
+	return r.Get(ctx, accountID)
+}
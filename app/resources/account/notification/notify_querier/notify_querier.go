@@ -6,6 +6,7 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/rs/xid"
 	"github.com/samber/lo"
 
@@ -51,6 +52,39 @@ func (n *Querier) ListNotifications(ctx context.Context, accountID account.Accou
 	return ns, nil
 }
 
+// Get returns a single notification owned by the account, with its item.
+func (n *Querier) Get(ctx context.Context, accountID account.AccountID, id xid.ID) (*notification.Notification, error) {
+	r, err := n.db.Notification.Query().
+		Where(
+			entnotification.ID(id),
+			entnotification.HasOwnerWith(entaccount.ID(xid.ID(accountID))),
+		).
+		WithSource().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ref, err := notification.Map(r)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ns, err := n.hydrateRefs(ctx, notification.NotificationRefs{ref})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(ns) == 0 {
+		return nil, fault.New("notification item no longer exists", fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	return ns[0], nil
+}
+
 func (n *Querier) hydrateRefs(ctx context.Context, refs notification.NotificationRefs) (notification.Notifications, error) {
 	grouped := lo.GroupBy(refs, func(n *notification.NotificationRef) datagraph.Kind {
 		return n.ItemRef.OrZero().Kind
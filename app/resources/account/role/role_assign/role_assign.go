@@ -12,6 +12,10 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/account/role/held"
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/profile/profile_cache"
 	"github.com/Southclaws/storyden/internal/ent"
@@ -22,11 +26,12 @@ type Assignment struct {
 	db             *ent.Client
 	accountQuerier *account_querier.Querier
 	profileCache   *profile_cache.Cache
+	auditWriter    *audit_writer.Writer
 	bus            *pubsub.Bus
 }
 
-func New(db *ent.Client, accountQuerier *account_querier.Querier, profileCache *profile_cache.Cache, bus *pubsub.Bus) *Assignment {
-	return &Assignment{db: db, accountQuerier: accountQuerier, profileCache: profileCache, bus: bus}
+func New(db *ent.Client, accountQuerier *account_querier.Querier, profileCache *profile_cache.Cache, auditWriter *audit_writer.Writer, bus *pubsub.Bus) *Assignment {
+	return &Assignment{db: db, accountQuerier: accountQuerier, profileCache: profileCache, auditWriter: auditWriter, bus: bus}
 }
 
 type Mutation struct {
@@ -63,7 +68,15 @@ func split(mutations ...Mutation) (adds, removes []xid.ID, admin opt.Optional[bo
 	return
 }
 
-func (w *Assignment) UpdateRoles(ctx context.Context, accountID account.AccountID, roles ...Mutation) (*account.AccountWithEdges, error) {
+// UpdateRoles applies role mutations to an account. Each assignment or removal
+// is recorded in the audit log against the actor, if there is one.
+func (w *Assignment) UpdateRoles(ctx context.Context, actor opt.Optional[account.AccountID], accountID account.AccountID, roles ...Mutation) (*account.AccountWithEdges, error) {
+	// Removed roles are only resolvable to a name before the update.
+	previous, err := w.accountQuerier.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	update := w.db.Account.UpdateOneID(xid.ID(accountID))
 	mutation := update.Mutation()
 
@@ -80,7 +93,7 @@ func (w *Assignment) UpdateRoles(ctx context.Context, accountID account.AccountI
 		mutation.SetAdmin(a)
 	}
 
-	err := w.profileCache.Invalidate(ctx, xid.ID(accountID))
+	err = w.profileCache.Invalidate(ctx, xid.ID(accountID))
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -94,5 +107,38 @@ func (w *Assignment) UpdateRoles(ctx context.Context, accountID account.AccountI
 		ID: accountID,
 	})
 
-	return w.accountQuerier.GetByID(ctx, accountID)
+	acc, err := w.accountQuerier.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, m := range roles {
+		if err := w.record(ctx, actor, accountID, m, previous.Roles, acc.Roles); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return acc, nil
+}
+
+func (w *Assignment) record(ctx context.Context, actor opt.Optional[account.AccountID], accountID account.AccountID, m Mutation, before, after held.Roles) error {
+	target := audit_writer.WithTarget(datagraph.Ref{ID: xid.ID(accountID), Kind: datagraph.KindProfile})
+
+	if m.delete {
+		return w.auditWriter.Record(ctx, actor, audit.ActionRoleRemoved, target,
+			audit_writer.WithBefore("role: "+roleName(before, m.id)))
+	}
+
+	return w.auditWriter.Record(ctx, actor, audit.ActionRoleAssigned, target,
+		audit_writer.WithAfter("role: "+roleName(after, m.id)))
+}
+
+func roleName(roles held.Roles, id role.RoleID) string {
+	for _, r := range roles {
+		if r.ID == id {
+			return r.Name
+		}
+	}
+
+	return xid.ID(id).String()
 }
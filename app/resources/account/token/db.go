@@ -2,10 +2,13 @@ package token
 
 import (
 	"context"
+	"sort"
 	"time"
 
+	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
 
@@ -30,13 +33,15 @@ func New(
 	}
 }
 
-func (r *persistedRepository) Issue(ctx context.Context, accountID account.AccountID) (*Session, error) {
+func (r *persistedRepository) Issue(ctx context.Context, accountID account.AccountID, device Device) (*Session, error) {
 	token := Token{xid.New()}
 
 	create := r.db.Session.Create().
 		SetID(token.ID).
 		SetAccountID(xid.ID(accountID)).
-		SetExpiresAt(time.Now().Add(Expiry))
+		SetExpiresAt(time.Now().Add(Expiry)).
+		SetNillableUserAgent(device.UserAgent.Ptr()).
+		SetNillableIPAddress(device.IPAddress.Ptr())
 
 	result, err := create.Save(ctx)
 	if err != nil {
@@ -59,6 +64,58 @@ func (r *persistedRepository) Revoke(ctx context.Context, id Token) error {
 	return nil
 }
 
+func (r *persistedRepository) RevokeAll(ctx context.Context, accountID account.AccountID, except ...Token) (int, error) {
+	update := r.db.Session.Update().Where(
+		session.AccountID(xid.ID(accountID)),
+		session.RevokedAtIsNil(),
+		session.IDNotIn(dt.Map(except, func(t Token) xid.ID { return t.ID })...),
+	)
+
+	update.SetRevokedAt(time.Now())
+
+	n, err := update.Save(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
+
+func (r *persistedRepository) Touch(ctx context.Context, t Token, at time.Time) error {
+	err := r.db.Session.UpdateOneID(t.ID).SetLastSeenAt(at).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *persistedRepository) List(ctx context.Context, accountID account.AccountID) ([]*Session, error) {
+	result, err := r.db.Session.Query().
+		Where(
+			session.AccountID(xid.ID(accountID)),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(time.Now()),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	sessions := dt.Map(result, Map)
+
+	// Most recently used first, sessions from before last-seen was recorded
+	// are ordered by when they were issued instead.
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].active().After(sessions[j].active())
+	})
+
+	return sessions, nil
+}
+
 func (r *persistedRepository) Validate(ctx context.Context, t Token) (*Validated, error) {
 	query := r.db.Session.Query().Where(session.ID(t.ID))
 
@@ -77,9 +134,15 @@ func (r *persistedRepository) Validate(ctx context.Context, t Token) (*Validated
 
 func Map(s *ent.Session) *Session {
 	return &Session{
-		Token:     Token{s.ID},
-		AccountID: account.AccountID(s.AccountID),
-		ExpiresAt: s.ExpiresAt,
-		RevokedAt: opt.NewPtr(s.RevokedAt),
+		Token:      Token{s.ID},
+		AccountID:  account.AccountID(s.AccountID),
+		CreatedAt:  s.CreatedAt,
+		ExpiresAt:  s.ExpiresAt,
+		RevokedAt:  opt.NewPtr(s.RevokedAt),
+		LastSeenAt: opt.NewPtr(s.LastSeenAt),
+		Device: Device{
+			UserAgent: opt.NewPtr(s.UserAgent),
+			IPAddress: opt.NewPtr(s.IPAddress),
+		},
 	}
 }
@@ -2,19 +2,31 @@ package token
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/internal/infrastructure/cache"
 )
 
+// lastSeenInterval limits how often a session's last-seen time is written, so
+// an active session doesn't result in a database write for every request.
+const lastSeenInterval = 5 * time.Minute
+
 type Repository interface {
-	Issue(context.Context, account.AccountID) (*Session, error)
+	Issue(context.Context, account.AccountID, Device) (*Session, error)
 	Revoke(context.Context, Token) error
+	// RevokeAll revokes every active session of the account, aside from those
+	// excluded such as the session making the request, and returns how many.
+	RevokeAll(ctx context.Context, accountID account.AccountID, except ...Token) (int, error)
 	Validate(context.Context, Token) (*Validated, error)
+	Touch(ctx context.Context, t Token, at time.Time) error
+	// List returns the account's active sessions, most recently used first.
+	List(context.Context, account.AccountID) ([]*Session, error)
 }
 
 type cachedRepo struct {
@@ -29,8 +41,8 @@ func NewCachedRepository(repo Repository, store cache.Store) Repository {
 	}
 }
 
-func (r *cachedRepo) Issue(ctx context.Context, accountID account.AccountID) (*Session, error) {
-	s, err := r.repo.Issue(ctx, accountID)
+func (r *cachedRepo) Issue(ctx context.Context, accountID account.AccountID, device Device) (*Session, error) {
+	s, err := r.repo.Issue(ctx, accountID, device)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -54,28 +66,76 @@ func (r *cachedRepo) Revoke(ctx context.Context, token Token) error {
 	return nil
 }
 
+func (r *cachedRepo) RevokeAll(ctx context.Context, accountID account.AccountID, except ...Token) (int, error) {
+	sessions, err := r.repo.List(ctx, accountID)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Evict before revoking so no request can validate against a stale entry
+	// between the two, in the same way as Revoke.
+	for _, s := range sessions {
+		if slices.Contains(except, s.Token) {
+			continue
+		}
+
+		if err := r.delete(ctx, s.Token); err != nil {
+			return 0, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	n, err := r.repo.RevokeAll(ctx, accountID, except...)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
+
 func (r *cachedRepo) Validate(ctx context.Context, t Token) (*Validated, error) {
 	sess, found, err := r.get(ctx, t)
 	if err != nil {
 		return nil, r.delete(ctx, t)
 	}
 
-	if found {
-		return sess, nil
+	if !found {
+		// Fall back to database query.
+		sess, err = r.repo.Validate(ctx, t)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
 	}
 
-	// Fall back to database query.
-	v, err := r.repo.Validate(ctx, t)
-	if err != nil {
-		return nil, fault.Wrap(err, fctx.With(ctx))
+	now := time.Now()
+	if last, ok := sess.LastSeenAt.Get(); !ok || now.Sub(last) > lastSeenInterval {
+		if err := r.repo.Touch(ctx, t, now); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		sess.LastSeenAt = opt.New(now)
+	} else if found {
+		return sess, nil
 	}
 
 	// Store in cache for future validations.
-	if err := r.cache(ctx, Session(*v)); err != nil {
+	if err := r.cache(ctx, Session(*sess)); err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	return v, nil
+	return sess, nil
+}
+
+func (r *cachedRepo) Touch(ctx context.Context, t Token, at time.Time) error {
+	// Evicted rather than updated, the next validation will re-populate it.
+	if err := r.delete(ctx, t); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r.repo.Touch(ctx, t, at)
+}
+
+func (r *cachedRepo) List(ctx context.Context, accountID account.AccountID) ([]*Session, error) {
+	return r.repo.List(ctx, accountID)
 }
 
 func (r *cachedRepo) get(ctx context.Context, t Token) (*Validated, bool, error) {
@@ -1,6 +1,8 @@
 package token
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"time"
 
@@ -58,10 +60,34 @@ func (t *Token) UnmarshalJSON(b []byte) error {
 }
 
 type Session struct {
-	Token     Token                   `json:"t"`
-	AccountID account.AccountID       `json:"a"`
-	ExpiresAt time.Time               `json:"e"`
-	RevokedAt opt.Optional[time.Time] `json:"r"`
+	Token      Token                   `json:"t"`
+	AccountID  account.AccountID       `json:"a"`
+	CreatedAt  time.Time               `json:"c"`
+	ExpiresAt  time.Time               `json:"e"`
+	RevokedAt  opt.Optional[time.Time] `json:"r"`
+	LastSeenAt opt.Optional[time.Time] `json:"l"`
+	Device     Device                  `json:"d"`
+}
+
+// Device describes the client a session was issued to.
+type Device struct {
+	UserAgent opt.Optional[string] `json:"u"`
+	IPAddress opt.Optional[string] `json:"i"`
+}
+
+// ID is a public identifier for a session. The token itself is a credential so
+// it must never be exposed, this is derived from it instead so sessions can be
+// listed and referred to without revealing the token.
+type ID string
+
+func (s Session) ID() ID {
+	h := sha256.Sum256(s.Token.Bytes())
+	return ID(hex.EncodeToString(h[:12]))
+}
+
+// active is the last time the session was known to be used.
+func (s Session) active() time.Time {
+	return s.LastSeenAt.Or(s.CreatedAt)
 }
 
 type Validated Session
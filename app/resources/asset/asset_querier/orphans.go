@@ -0,0 +1,316 @@
+package asset_querier
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_asset "github.com/Southclaws/storyden/internal/ent/asset"
+	ent_category "github.com/Southclaws/storyden/internal/ent/category"
+	ent_collection "github.com/Southclaws/storyden/internal/ent/collection"
+	ent_message "github.com/Southclaws/storyden/internal/ent/conversationmessage"
+	ent_link "github.com/Southclaws/storyden/internal/ent/link"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_node_revision "github.com/Southclaws/storyden/internal/ent/noderevision"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	ent_post_revision "github.com/Southclaws/storyden/internal/ent/postrevision"
+)
+
+const (
+	// lookupBatchSize bounds the number of values in a single IN (...) clause.
+	lookupBatchSize = 500
+
+	// richTextPageSize bounds how many rows of rich text are held at once
+	// while searching for embedded assets.
+	richTextPageSize = 1000
+
+	// encodedIDLength is the length of an xid in its string form.
+	encodedIDLength = 20
+)
+
+// ListUnreferenced returns assets created before the given time which are not
+// used by anything: not attached to a live post, node, link or event, not a
+// cover or primary image, not the parent of another version and not embedded
+// in any rich text: live posts and nodes, their revisions, conversation
+// messages or account bios.
+//
+// Assets which were not written with an ID-prefixed filename, such as the
+// instance icon and banner images, are owned by the system and never listed.
+func (q *Querier) ListUnreferenced(ctx context.Context, createdBefore time.Time) ([]*asset.Asset, error) {
+	candidates, err := q.db.Asset.Query().
+		Where(
+			ent_asset.CreatedAtLT(createdBefore),
+			ent_asset.Not(ent_asset.HasPostsWith(ent_post.DeletedAtIsNil())),
+			ent_asset.Not(ent_asset.HasNodesWith(ent_node.DeletedAtIsNil())),
+			ent_asset.Not(ent_asset.HasLinks()),
+			ent_asset.Not(ent_asset.HasEvent()),
+			ent_asset.Not(ent_asset.HasAssets()),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	candidates = dt.Filter(candidates, func(a *ent.Asset) bool {
+		return strings.HasPrefix(a.Filename, a.ID.String())
+	})
+
+	referenced, err := q.referencedByImageFields(ctx, dt.Map(candidates, func(a *ent.Asset) xid.ID { return a.ID }))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	candidates = dt.Filter(candidates, func(a *ent.Asset) bool {
+		return !referenced[a.ID]
+	})
+
+	embedded, err := q.embedded(ctx, candidates)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result := []*asset.Asset{}
+	for _, a := range candidates {
+		if embedded[a.ID] {
+			continue
+		}
+
+		result = append(result, asset.Map(a))
+	}
+
+	return result, nil
+}
+
+// FilenamesExist returns the subset of the given filenames which have a row.
+func (q *Querier) FilenamesExist(ctx context.Context, filenames []string) (map[string]bool, error) {
+	exists := map[string]bool{}
+
+	for _, batch := range chunk(filenames) {
+		rows, err := q.db.Asset.Query().
+			Where(ent_asset.FilenameIn(batch...)).
+			Select(ent_asset.FieldFilename).
+			Strings(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		for _, f := range rows {
+			exists[f] = true
+		}
+	}
+
+	return exists, nil
+}
+
+// referencedByImageFields finds which of the given assets are referenced by a
+// foreign key column on another table, these edges have no inverse on assets.
+func (q *Querier) referencedByImageFields(ctx context.Context, ids []xid.ID) (map[xid.ID]bool, error) {
+	referenced := map[xid.ID]bool{}
+
+	for _, batch := range chunk(ids) {
+		nodes, err := q.db.Node.Query().
+			Where(ent_node.PrimaryAssetIDIn(batch...), ent_node.DeletedAtIsNil()).
+			All(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		for _, n := range nodes {
+			if n.PrimaryAssetID != nil {
+				referenced[*n.PrimaryAssetID] = true
+			}
+		}
+
+		links, err := q.db.Link.Query().
+			Where(ent_link.Or(
+				ent_link.PrimaryAssetIDIn(batch...),
+				ent_link.FaviconAssetIDIn(batch...),
+			)).
+			All(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		for _, l := range links {
+			if l.PrimaryAssetID != nil {
+				referenced[*l.PrimaryAssetID] = true
+			}
+			if l.FaviconAssetID != nil {
+				referenced[*l.FaviconAssetID] = true
+			}
+		}
+
+		categories, err := q.db.Category.Query().
+			Where(ent_category.CoverImageAssetIDIn(batch...)).
+			All(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		for _, c := range categories {
+			if c.CoverImageAssetID != nil {
+				referenced[*c.CoverImageAssetID] = true
+			}
+		}
+
+		collections, err := q.db.Collection.Query().
+			Where(ent_collection.CoverAssetIDIn(batch...)).
+			All(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		for _, c := range collections {
+			if c.CoverAssetID != nil {
+				referenced[*c.CoverAssetID] = true
+			}
+		}
+	}
+
+	return referenced, nil
+}
+
+// richText is a single piece of rich text content which may embed assets.
+type richText struct {
+	id   xid.ID
+	text string
+}
+
+// embedded finds which of the given assets are linked from rich text content
+// without an explicit edge, which happens when an image is pasted into a post
+// body. Revisions are included so restoring an earlier version never yields a
+// broken image.
+//
+// Substring searches can't use an index, so instead of searching every table
+// once per asset, each table is read once a page at a time and the filenames
+// are matched in memory.
+func (q *Querier) embedded(ctx context.Context, candidates []*ent.Asset) (map[xid.ID]bool, error) {
+	// Candidates all have ID-prefixed filenames, so each ID sized window of the
+	// text is looked up by ID and then compared against the whole filename.
+	remaining := map[string]*ent.Asset{}
+	for _, a := range candidates {
+		remaining[a.ID.String()] = a
+	}
+
+	embedded := map[xid.ID]bool{}
+	match := func(text string) {
+		for i := 0; i+encodedIDLength <= len(text); i++ {
+			a, ok := remaining[text[i:i+encodedIDLength]]
+			if ok && strings.HasPrefix(text[i:], a.Filename) {
+				embedded[a.ID] = true
+				delete(remaining, a.ID.String())
+			}
+		}
+	}
+
+	sources := []func(after xid.ID) ([]richText, error){
+		func(after xid.ID) ([]richText, error) {
+			query := q.db.Post.Query().Where(ent_post.DeletedAtIsNil())
+			if !after.IsZero() {
+				query.Where(ent_post.IDGT(after))
+			}
+			rows, err := query.
+				Order(ent.Asc(ent_post.FieldID)).
+				Limit(richTextPageSize).
+				Select(ent_post.FieldID, ent_post.FieldBody).
+				All(ctx)
+			return dt.Map(rows, func(r *ent.Post) richText { return richText{r.ID, r.Body} }), err
+		},
+		func(after xid.ID) ([]richText, error) {
+			query := q.db.PostRevision.Query().Where(ent_post_revision.HasPostWith(ent_post.DeletedAtIsNil()))
+			if !after.IsZero() {
+				query.Where(ent_post_revision.IDGT(after))
+			}
+			rows, err := query.
+				Order(ent.Asc(ent_post_revision.FieldID)).
+				Limit(richTextPageSize).
+				Select(ent_post_revision.FieldID, ent_post_revision.FieldBody).
+				All(ctx)
+			return dt.Map(rows, func(r *ent.PostRevision) richText { return richText{r.ID, r.Body} }), err
+		},
+		func(after xid.ID) ([]richText, error) {
+			query := q.db.Node.Query().Where(ent_node.DeletedAtIsNil())
+			if !after.IsZero() {
+				query.Where(ent_node.IDGT(after))
+			}
+			rows, err := query.
+				Order(ent.Asc(ent_node.FieldID)).
+				Limit(richTextPageSize).
+				Select(ent_node.FieldID, ent_node.FieldContent).
+				All(ctx)
+			return dt.Map(rows, func(r *ent.Node) richText { return richText{r.ID, opt.NewPtr(r.Content).OrZero()} }), err
+		},
+		func(after xid.ID) ([]richText, error) {
+			query := q.db.NodeRevision.Query().Where(ent_node_revision.HasNodeWith(ent_node.DeletedAtIsNil()))
+			if !after.IsZero() {
+				query.Where(ent_node_revision.IDGT(after))
+			}
+			rows, err := query.
+				Order(ent.Asc(ent_node_revision.FieldID)).
+				Limit(richTextPageSize).
+				Select(ent_node_revision.FieldID, ent_node_revision.FieldContent).
+				All(ctx)
+			return dt.Map(rows, func(r *ent.NodeRevision) richText { return richText{r.ID, opt.NewPtr(r.Content).OrZero()} }), err
+		},
+		func(after xid.ID) ([]richText, error) {
+			query := q.db.ConversationMessage.Query()
+			if !after.IsZero() {
+				query.Where(ent_message.IDGT(after))
+			}
+			rows, err := query.
+				Order(ent.Asc(ent_message.FieldID)).
+				Limit(richTextPageSize).
+				Select(ent_message.FieldID, ent_message.FieldBody).
+				All(ctx)
+			return dt.Map(rows, func(r *ent.ConversationMessage) richText { return richText{r.ID, r.Body} }), err
+		},
+		func(after xid.ID) ([]richText, error) {
+			// Suspended accounts are soft deleted but may be reinstated, so
+			// every bio is considered.
+			query := q.db.Account.Query()
+			if !after.IsZero() {
+				query.Where(ent_account.IDGT(after))
+			}
+			rows, err := query.
+				Order(ent.Asc(ent_account.FieldID)).
+				Limit(richTextPageSize).
+				Select(ent_account.FieldID, ent_account.FieldBio).
+				All(ctx)
+			return dt.Map(rows, func(r *ent.Account) richText { return richText{r.ID, r.Bio} }), err
+		},
+	}
+
+	for _, page := range sources {
+		var after xid.ID
+		for len(remaining) > 0 {
+			rows, err := page(after)
+			if err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx))
+			}
+
+			for _, r := range rows {
+				match(r.text)
+			}
+
+			if len(rows) < richTextPageSize {
+				break
+			}
+			after = rows[len(rows)-1].id
+		}
+	}
+
+	return embedded, nil
+}
+
+func chunk[T any](in []T) [][]T {
+	out := [][]T{}
+	for i := 0; i < len(in); i += lookupBatchSize {
+		out = append(out, in[i:min(i+lookupBatchSize, len(in))])
+	}
+	return out
+}
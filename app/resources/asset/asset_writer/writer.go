@@ -83,3 +83,15 @@ func (w *Writer) Remove(ctx context.Context, accountID xid.ID, id asset.Filename
 
 	return nil
 }
+
+func (w *Writer) Delete(ctx context.Context, id asset.AssetID) error {
+	err := w.db.Asset.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return nil
+}
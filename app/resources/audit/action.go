@@ -0,0 +1,26 @@
+package audit
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type actionEnum string
+
+const (
+	actionReportUpdated        actionEnum = "report_updated"
+	actionAccountSuspended     actionEnum = "account_suspended"
+	actionAccountReinstated    actionEnum = "account_reinstated"
+	actionRoleAssigned         actionEnum = "role_assigned"
+	actionRoleRemoved          actionEnum = "role_removed"
+	actionSettingsUpdated      actionEnum = "settings_updated"
+	actionVisibilityChanged    actionEnum = "visibility_changed"
+	actionThreadDeleted        actionEnum = "thread_deleted"
+	actionThreadLocked         actionEnum = "thread_locked"
+	actionThreadUnlocked       actionEnum = "thread_unlocked"
+	actionReplyDeleted         actionEnum = "reply_deleted"
+	actionNodeDeleted          actionEnum = "node_deleted"
+	actionAccessKeyRevoked     actionEnum = "access_key_revoked"
+	actionSessionsRevoked      actionEnum = "sessions_revoked"
+	actionConversationAccessed actionEnum = "conversation_accessed"
+	actionTagUpdated           actionEnum = "tag_updated"
+	actionTagsMerged           actionEnum = "tags_merged"
+	actionTagDeleted           actionEnum = "tag_deleted"
+)
@@ -0,0 +1,73 @@
+// Package audit records privileged actions performed by moderators, admins and
+// the system so there is a history of who changed what and when.
+package audit
+
+import (
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type ID xid.ID
+
+func (i ID) String() string { return xid.ID(i).String() }
+
+type Entry struct {
+	ID        ID
+	CreatedAt time.Time
+
+	// Actor is empty when the action was performed by the system.
+	Actor  opt.Optional[account.Account]
+	Action Action
+	Target opt.Optional[datagraph.Ref]
+
+	// Before and After are short human readable summaries of the state of the
+	// target either side of the action, either may be empty.
+	Before opt.Optional[string]
+	After  opt.Optional[string]
+}
+
+type Entries []*Entry
+
+func Map(in *ent.AuditLog) (*Entry, error) {
+	actor, err := opt.MapErr(opt.NewPtr(in.Edges.Actor), func(a ent.Account) (account.Account, error) {
+		p, err := account.MapRef(&a)
+		if err != nil {
+			return account.Account{}, err
+		}
+		return *p, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	action, err := NewAction(in.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	var target opt.Optional[datagraph.Ref]
+	if in.TargetID != nil && in.TargetKind != nil {
+		kind, err := datagraph.NewKind(*in.TargetKind)
+		if err != nil {
+			return nil, err
+		}
+
+		target = opt.New(datagraph.Ref{ID: *in.TargetID, Kind: kind})
+	}
+
+	return &Entry{
+		ID:        ID(in.ID),
+		CreatedAt: in.CreatedAt,
+		Actor:     actor,
+		Action:    action,
+		Target:    target,
+		Before:    opt.NewPtr(in.Before),
+		After:     opt.NewPtr(in.After),
+	}, nil
+}
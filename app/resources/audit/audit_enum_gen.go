@@ -0,0 +1,111 @@
+// Code generated by enumerator. DO NOT EDIT.
+
+package audit
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+type Action struct {
+	v actionEnum
+}
+
+var (
+	ActionReportUpdated        = Action{actionReportUpdated}
+	ActionAccountSuspended     = Action{actionAccountSuspended}
+	ActionAccountReinstated    = Action{actionAccountReinstated}
+	ActionRoleAssigned         = Action{actionRoleAssigned}
+	ActionRoleRemoved          = Action{actionRoleRemoved}
+	ActionSettingsUpdated      = Action{actionSettingsUpdated}
+	ActionVisibilityChanged    = Action{actionVisibilityChanged}
+	ActionThreadDeleted        = Action{actionThreadDeleted}
+	ActionThreadLocked         = Action{actionThreadLocked}
+	ActionThreadUnlocked       = Action{actionThreadUnlocked}
+	ActionReplyDeleted         = Action{actionReplyDeleted}
+	ActionNodeDeleted          = Action{actionNodeDeleted}
+	ActionAccessKeyRevoked     = Action{actionAccessKeyRevoked}
+	ActionSessionsRevoked      = Action{actionSessionsRevoked}
+	ActionConversationAccessed = Action{actionConversationAccessed}
+	ActionTagUpdated           = Action{actionTagUpdated}
+	ActionTagsMerged           = Action{actionTagsMerged}
+	ActionTagDeleted           = Action{actionTagDeleted}
+)
+
+func (r Action) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		fmt.Fprint(f, r.v)
+	case 'q':
+		fmt.Fprintf(f, "%q", r.String())
+	default:
+		fmt.Fprint(f, r.v)
+	}
+}
+func (r Action) String() string {
+	return string(r.v)
+}
+func (r Action) MarshalText() ([]byte, error) {
+	return []byte(r.v), nil
+}
+func (r *Action) UnmarshalText(__iNpUt__ []byte) error {
+	s, err := NewAction(string(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func (r Action) Value() (driver.Value, error) {
+	return r.v, nil
+}
+func (r *Action) Scan(__iNpUt__ any) error {
+	s, err := NewAction(fmt.Sprint(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func NewAction(__iNpUt__ string) (Action, error) {
+	switch __iNpUt__ {
+	case string(actionReportUpdated):
+		return ActionReportUpdated, nil
+	case string(actionAccountSuspended):
+		return ActionAccountSuspended, nil
+	case string(actionAccountReinstated):
+		return ActionAccountReinstated, nil
+	case string(actionRoleAssigned):
+		return ActionRoleAssigned, nil
+	case string(actionRoleRemoved):
+		return ActionRoleRemoved, nil
+	case string(actionSettingsUpdated):
+		return ActionSettingsUpdated, nil
+	case string(actionVisibilityChanged):
+		return ActionVisibilityChanged, nil
+	case string(actionThreadDeleted):
+		return ActionThreadDeleted, nil
+	case string(actionThreadLocked):
+		return ActionThreadLocked, nil
+	case string(actionThreadUnlocked):
+		return ActionThreadUnlocked, nil
+	case string(actionReplyDeleted):
+		return ActionReplyDeleted, nil
+	case string(actionNodeDeleted):
+		return ActionNodeDeleted, nil
+	case string(actionAccessKeyRevoked):
+		return ActionAccessKeyRevoked, nil
+	case string(actionSessionsRevoked):
+		return ActionSessionsRevoked, nil
+	case string(actionConversationAccessed):
+		return ActionConversationAccessed, nil
+	case string(actionTagUpdated):
+		return ActionTagUpdated, nil
+	case string(actionTagsMerged):
+		return ActionTagsMerged, nil
+	case string(actionTagDeleted):
+		return ActionTagDeleted, nil
+	default:
+		return Action{}, fmt.Errorf("invalid value for type 'Action': '%s'", __iNpUt__)
+	}
+}
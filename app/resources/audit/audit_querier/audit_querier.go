@@ -0,0 +1,101 @@
+package audit_querier
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_auditlog "github.com/Southclaws/storyden/internal/ent/auditlog"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+type Query func(*ent.AuditLogQuery)
+
+func WithActions(actions ...audit.Action) Query {
+	return func(q *ent.AuditLogQuery) {
+		if len(actions) == 0 {
+			return
+		}
+		q.Where(ent_auditlog.ActionIn(dt.Map(actions, func(a audit.Action) string { return a.String() })...))
+	}
+}
+
+func WithActor(id account.AccountID) Query {
+	return func(q *ent.AuditLogQuery) {
+		q.Where(ent_auditlog.ActorID(xid.ID(id)))
+	}
+}
+
+func WithTargetKind(kind datagraph.Kind) Query {
+	return func(q *ent.AuditLogQuery) {
+		q.Where(ent_auditlog.TargetKind(kind.String()))
+	}
+}
+
+func WithTargetID(id xid.ID) Query {
+	return func(q *ent.AuditLogQuery) {
+		q.Where(ent_auditlog.TargetID(id))
+	}
+}
+
+func WithCreatedAfter(t time.Time) Query {
+	return func(q *ent.AuditLogQuery) {
+		q.Where(ent_auditlog.CreatedAtGTE(t))
+	}
+}
+
+func WithCreatedBefore(t time.Time) Query {
+	return func(q *ent.AuditLogQuery) {
+		q.Where(ent_auditlog.CreatedAtLT(t))
+	}
+}
+
+func (q *Querier) List(
+	ctx context.Context,
+	page pagination.Parameters,
+	opts ...Query,
+) (pagination.Result[*audit.Entry], error) {
+	query := q.db.AuditLog.Query()
+
+	for _, fn := range opts {
+		fn(query)
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return pagination.Result[*audit.Entry]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := query.
+		WithActor().
+		Order(ent.Desc(ent_auditlog.FieldCreatedAt), ent.Desc(ent_auditlog.FieldID)).
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		All(ctx)
+	if err != nil {
+		return pagination.Result[*audit.Entry]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	entries, err := dt.MapErr(result, audit.Map)
+	if err != nil {
+		return pagination.Result[*audit.Entry]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return pagination.NewPageResult(page, total, entries), nil
+}
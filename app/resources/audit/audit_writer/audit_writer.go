@@ -0,0 +1,68 @@
+package audit_writer
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+type Option func(*ent.AuditLogMutation)
+
+func WithTarget(ref datagraph.Ref) Option {
+	return func(m *ent.AuditLogMutation) {
+		m.SetTargetID(ref.ID)
+		m.SetTargetKind(ref.Kind.String())
+	}
+}
+
+func WithBefore(summary string) Option {
+	return func(m *ent.AuditLogMutation) {
+		m.SetBefore(summary)
+	}
+}
+
+func WithAfter(summary string) Option {
+	return func(m *ent.AuditLogMutation) {
+		m.SetAfter(summary)
+	}
+}
+
+// Record writes an entry to the audit log. An empty actor means the action was
+// performed by the system rather than a member.
+func (w *Writer) Record(
+	ctx context.Context,
+	actor opt.Optional[account.AccountID],
+	action audit.Action,
+	opts ...Option,
+) error {
+	create := w.db.AuditLog.Create().
+		SetAction(action.String())
+
+	actor.Call(func(id account.AccountID) { create.SetActorID(xid.ID(id)) })
+
+	for _, fn := range opts {
+		fn(create.Mutation())
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
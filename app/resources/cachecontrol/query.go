@@ -1,14 +1,16 @@
 package cachecontrol
 
 import (
+	"strings"
 	"time"
 
 	"github.com/Southclaws/opt"
 )
 
 type ETag struct {
-	Value string
-	Time  time.Time
+	Value   string
+	Time    time.Time
+	Version string
 }
 
 func NewETag(t time.Time) *ETag {
@@ -18,25 +20,41 @@ func NewETag(t time.Time) *ETag {
 	}
 }
 
+// NewVersionedETag is an ETag for a list of resources, where the most recent
+// update time alone doesn't identify the response. The version distinguishes
+// between lists which share an update time, such as after an item is removed or
+// when different filters were requested.
+func NewVersionedETag(t time.Time, version string) *ETag {
+	return &ETag{
+		Value:   "t-" + t.UTC().Format(time.RFC3339Nano) + "_" + version,
+		Time:    t,
+		Version: version,
+	}
+}
+
 func ParseETag(t string) ETag {
 	// Strip quotes if present (ETags are quoted in HTTP headers)
 	if len(t) >= 2 && t[0] == '"' && t[len(t)-1] == '"' {
 		t = t[1 : len(t)-1]
 	}
 
-	// expected format: t-<time in RFC3339Nano>
+	// expected format: t-<time in RFC3339Nano>, optionally followed by
+	// _<version> for versioned tags.
 	if len(t) < 3 || t[:2] != "t-" {
 		return ETag{}
 	}
 
-	parsedTime, err := time.Parse(time.RFC3339Nano, t[2:])
+	ts, version, _ := strings.Cut(t[2:], "_")
+
+	parsedTime, err := time.Parse(time.RFC3339Nano, ts)
 	if err != nil {
 		return ETag{}
 	}
 
 	return ETag{
-		Value: t,
-		Time:  parsedTime,
+		Value:   t,
+		Time:    parsedTime,
+		Version: version,
 	}
 }
 
@@ -91,3 +109,24 @@ func (q Query) Check(fn func() *time.Time) (*ETag, bool) {
 
 	return NewETag(*resourceUpdated), false
 }
+
+// CheckVersion is Check for a list of resources identified by a version as well
+// as its most recent update time. An If-None-Match tag only matches if it has
+// the same version. If-Modified-Since is not used because a time can't express
+// an item being removed from the list, so it'd serve a stale copy.
+func (q Query) CheckVersion(version string, fn func() *time.Time) (*ETag, bool) {
+	resourceUpdated := fn()
+	if resourceUpdated == nil {
+		return nil, false
+	}
+
+	current := NewVersionedETag(*resourceUpdated, version)
+
+	if etag, ok := q.ETag.Get(); ok {
+		modified := resourceUpdated.Compare(etag.Time)
+
+		return current, etag.Version == version && modified <= 0
+	}
+
+	return current, false
+}
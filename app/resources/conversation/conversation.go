@@ -0,0 +1,143 @@
+// Package conversation describes private conversations between members. A
+// conversation is either one-to-one or a small group, every participant can
+// read and send messages and each participant tracks their own read state.
+package conversation
+
+import (
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type ConversationID xid.ID
+
+func (i ConversationID) String() string { return xid.ID(i).String() }
+
+type MessageID xid.ID
+
+func (i MessageID) String() string { return xid.ID(i).String() }
+
+type Conversation struct {
+	ID            ConversationID
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Title         opt.Optional[string]
+	LastMessageAt time.Time
+	Participants  []*Participant
+}
+
+type Participant struct {
+	profile.Ref
+	LastReadAt opt.Optional[time.Time]
+}
+
+// Summary is a conversation as it appears in a participant's list.
+type Summary struct {
+	Conversation
+	// Unread is the number of messages from others since the participant the
+	// conversation was queried for last read it.
+	Unread int
+}
+
+// WithMessages is a single conversation with a page of its messages, the most
+// recent message first.
+type WithMessages struct {
+	Conversation
+	Messages pagination.Result[*Message]
+}
+
+type Message struct {
+	ID             MessageID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	ConversationID ConversationID
+	Author         profile.Ref
+	Content        datagraph.Content
+}
+
+// Direct is true for one-to-one conversations which have no title.
+func (c *Conversation) Direct() bool {
+	return len(c.Participants) == 2 && !c.Title.Ok()
+}
+
+func (c *Conversation) Participant(id account.AccountID) (*Participant, bool) {
+	return lo.Find(c.Participants, func(p *Participant) bool { return p.ID == id })
+}
+
+func (c *Conversation) ParticipantIDs() []account.AccountID {
+	return dt.Map(c.Participants, func(p *Participant) account.AccountID { return p.ID })
+}
+
+// Map expects the participants edge to be loaded with each participant's
+// account edge.
+func Map(in *ent.Conversation) (*Conversation, error) {
+	participantEdges, err := in.Edges.ParticipantsOrErr()
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := dt.MapErr(participantEdges, func(p *ent.ConversationParticipant) (*Participant, error) {
+		accountEdge, err := p.Edges.AccountOrErr()
+		if err != nil {
+			return nil, err
+		}
+
+		ref, err := profile.MapRef(accountEdge)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Participant{
+			Ref:        *ref,
+			LastReadAt: opt.NewPtr(p.LastReadAt),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Conversation{
+		ID:            ConversationID(in.ID),
+		CreatedAt:     in.CreatedAt,
+		UpdatedAt:     in.UpdatedAt,
+		Title:         opt.NewPtr(in.Title),
+		LastMessageAt: in.LastMessageAt,
+		Participants:  participants,
+	}, nil
+}
+
+// MapMessage expects the author edge to be loaded.
+func MapMessage(in *ent.ConversationMessage) (*Message, error) {
+	authorEdge, err := in.Edges.AuthorOrErr()
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := profile.MapRef(authorEdge)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := datagraph.NewRichText(in.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		ID:             MessageID(in.ID),
+		CreatedAt:      in.CreatedAt,
+		UpdatedAt:      in.UpdatedAt,
+		ConversationID: ConversationID(in.ConversationID),
+		Author:         *author,
+		Content:        content,
+	}, nil
+}
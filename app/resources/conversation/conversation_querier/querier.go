@@ -0,0 +1,202 @@
+package conversation_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/conversation"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_conversation "github.com/Southclaws/storyden/internal/ent/conversation"
+	ent_message "github.com/Southclaws/storyden/internal/ent/conversationmessage"
+	ent_participant "github.com/Southclaws/storyden/internal/ent/conversationparticipant"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+// List returns the conversations an account participates in, most recently
+// active first, with unread counts relative to that account's read state.
+func (q *Querier) List(ctx context.Context, accountID account.AccountID, page pagination.Parameters) (pagination.Result[*conversation.Summary], error) {
+	query := q.db.Conversation.Query().
+		Where(ent_conversation.HasParticipantsWith(ent_participant.AccountID(xid.ID(accountID))))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return pagination.Result[*conversation.Summary]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := query.
+		WithParticipants(func(pq *ent.ConversationParticipantQuery) {
+			pq.WithAccount().Order(ent.Asc(ent_participant.FieldCreatedAt))
+		}).
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		Order(ent.Desc(ent_conversation.FieldLastMessageAt), ent.Desc(ent_conversation.FieldID)).
+		All(ctx)
+	if err != nil {
+		return pagination.Result[*conversation.Summary]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	summaries, err := dt.MapErr(result, func(in *ent.Conversation) (*conversation.Summary, error) {
+		c, err := conversation.Map(in)
+		if err != nil {
+			return nil, err
+		}
+
+		unread, err := q.countUnread(ctx, c, accountID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &conversation.Summary{
+			Conversation: *c,
+			Unread:       unread,
+		}, nil
+	})
+	if err != nil {
+		return pagination.Result[*conversation.Summary]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return pagination.NewPageResult(page, total, summaries), nil
+}
+
+func (q *Querier) countUnread(ctx context.Context, c *conversation.Conversation, accountID account.AccountID) (int, error) {
+	p, ok := c.Participant(accountID)
+	if !ok {
+		return 0, nil
+	}
+
+	query := q.db.ConversationMessage.Query().
+		Where(
+			ent_message.ConversationID(xid.ID(c.ID)),
+			ent_message.AccountIDNEQ(xid.ID(accountID)),
+		)
+
+	if lastRead, ok := p.LastReadAt.Get(); ok {
+		query.Where(ent_message.CreatedAtGT(lastRead))
+	}
+
+	return query.Count(ctx)
+}
+
+// Probe returns a conversation and its participants without any messages.
+func (q *Querier) Probe(ctx context.Context, id conversation.ConversationID) (*conversation.Conversation, error) {
+	result, err := q.db.Conversation.Query().
+		Where(ent_conversation.ID(xid.ID(id))).
+		WithParticipants(func(pq *ent.ConversationParticipantQuery) {
+			pq.WithAccount().Order(ent.Asc(ent_participant.FieldCreatedAt))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	c, err := conversation.Map(result)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return c, nil
+}
+
+// Get returns a conversation with a page of messages, newest first so the
+// first page is always the latest activity.
+func (q *Querier) Get(ctx context.Context, id conversation.ConversationID, page pagination.Parameters) (*conversation.WithMessages, error) {
+	c, err := q.Probe(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	query := q.db.ConversationMessage.Query().
+		Where(ent_message.ConversationID(xid.ID(id)))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := query.
+		WithAuthor().
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		Order(ent.Desc(ent_message.FieldCreatedAt), ent.Desc(ent_message.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	messages, err := dt.MapErr(result, conversation.MapMessage)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &conversation.WithMessages{
+		Conversation: *c,
+		Messages:     pagination.NewPageResult(page, total, messages),
+	}, nil
+}
+
+// FindDirect looks up the untitled conversation between exactly two accounts.
+func (q *Querier) FindDirect(ctx context.Context, a, b account.AccountID) (*conversation.Conversation, bool, error) {
+	result, err := q.db.Conversation.Query().
+		Where(
+			ent_conversation.TitleIsNil(),
+			ent_conversation.HasParticipantsWith(ent_participant.AccountID(xid.ID(a))),
+			ent_conversation.HasParticipantsWith(ent_participant.AccountID(xid.ID(b))),
+		).
+		WithParticipants(func(pq *ent.ConversationParticipantQuery) {
+			pq.WithAccount().Order(ent.Asc(ent_participant.FieldCreatedAt))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, r := range result {
+		c, err := conversation.Map(r)
+		if err != nil {
+			return nil, false, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if c.Direct() {
+			return c, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+func (q *Querier) GetMessage(ctx context.Context, id conversation.MessageID) (*conversation.Message, error) {
+	result, err := q.db.ConversationMessage.Query().
+		Where(ent_message.ID(xid.ID(id))).
+		WithAuthor().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m, err := conversation.MapMessage(result)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return m, nil
+}
@@ -0,0 +1,139 @@
+package conversation_writer
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/conversation"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_message "github.com/Southclaws/storyden/internal/ent/conversationmessage"
+	ent_participant "github.com/Southclaws/storyden/internal/ent/conversationparticipant"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+// Create starts a conversation between the creator and the other participants.
+// The creator is marked as having read the conversation as of its creation.
+func (w *Writer) Create(ctx context.Context, creatorID account.AccountID, participants []account.AccountID, title opt.Optional[string]) (conversation.ConversationID, error) {
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return conversation.ConversationID{}, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+
+	c, err := tx.Conversation.Create().
+		SetNillableTitle(title.Ptr()).
+		SetLastMessageAt(now).
+		Save(ctx)
+	if err != nil {
+		return conversation.ConversationID{}, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	all := append([]account.AccountID{creatorID}, participants...)
+	err = tx.ConversationParticipant.MapCreateBulk(all, func(pc *ent.ConversationParticipantCreate, i int) {
+		pc.SetConversationID(c.ID).SetAccountID(xid.ID(all[i]))
+		if all[i] == creatorID {
+			pc.SetLastReadAt(now)
+		}
+	}).Exec(ctx)
+	if err != nil {
+		return conversation.ConversationID{}, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return conversation.ConversationID{}, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return conversation.ConversationID(c.ID), nil
+}
+
+// Send writes a message with a pre-generated ID, so content checks can refer
+// to it before it exists, and bumps the conversation's activity time. Sending
+// a message also counts as reading the conversation up to that point.
+func (w *Writer) Send(ctx context.Context, id conversation.ConversationID, messageID conversation.MessageID, authorID account.AccountID, content datagraph.Content) (*conversation.Message, error) {
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+	defer tx.Rollback()
+
+	m, err := tx.ConversationMessage.Create().
+		SetID(xid.ID(messageID)).
+		SetConversationID(xid.ID(id)).
+		SetAccountID(xid.ID(authorID)).
+		SetBody(content.HTML()).
+		SetShort(content.Short()).
+		Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	err = tx.Conversation.UpdateOneID(xid.ID(id)).
+		SetLastMessageAt(m.CreatedAt).
+		Exec(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	err = tx.ConversationParticipant.Update().
+		Where(
+			ent_participant.ConversationID(xid.ID(id)),
+			ent_participant.AccountID(xid.ID(authorID)),
+		).
+		SetLastReadAt(m.CreatedAt).
+		Exec(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	result, err := w.db.ConversationMessage.Query().
+		Where(ent_message.ID(m.ID)).
+		WithAuthor().
+		Only(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	msg, err := conversation.MapMessage(result)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return msg, nil
+}
+
+// MarkRead records that an account has read a conversation up to now.
+func (w *Writer) MarkRead(ctx context.Context, id conversation.ConversationID, accountID account.AccountID) error {
+	err := w.db.ConversationParticipant.Update().
+		Where(
+			ent_participant.ConversationID(xid.ID(id)),
+			ent_participant.AccountID(xid.ID(accountID)),
+		).
+		SetLastReadAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
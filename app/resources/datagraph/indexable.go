@@ -30,6 +30,10 @@ type (
 	WithTagNames interface{ GetTags() []string }         // Has a list of tag names
 )
 
+// WithAnswered is implemented by items which may be questions, the value is
+// only present if the item is a question and is true once it has an answer.
+type WithAnswered interface{ GetAnswered() opt.Optional[bool] }
+
 // Addressable describes a type that can be uniquely identified via either an ID
 // or a slug and also posses a human-readable display name.
 type Addressable interface {
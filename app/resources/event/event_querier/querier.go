@@ -9,7 +9,9 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/event"
 	"github.com/Southclaws/storyden/app/resources/event/event_ref"
+	"github.com/Southclaws/storyden/app/resources/visibility"
 	"github.com/Southclaws/storyden/internal/ent"
+	ent_event "github.com/Southclaws/storyden/internal/ent/event"
 )
 
 type Querier struct {
@@ -62,10 +64,24 @@ func (q *Querier) Get(ctx context.Context, mark event_ref.QueryKey) (*event.Even
 	return evt, nil
 }
 
-func (q *Querier) List(ctx context.Context) ([]*event_ref.Event, error) {
+type Filter func(*ent.EventQuery)
+
+func WithVisibility(vis visibility.Visibility) Filter {
+	return func(q *ent.EventQuery) {
+		q.Where(ent_event.VisibilityEQ(ent_event.Visibility(vis.String())))
+	}
+}
+
+func (q *Querier) List(ctx context.Context, filters ...Filter) ([]*event_ref.Event, error) {
 	query := q.db.Event.Query()
 
-	query.WithParticipants()
+	for _, fn := range filters {
+		fn(query)
+	}
+
+	query.WithParticipants(func(epq *ent.EventParticipantQuery) {
+		epq.WithAccount()
+	})
 	query.WithPrimaryImage()
 
 	r, err := query.All(ctx)
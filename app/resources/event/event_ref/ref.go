@@ -37,6 +37,9 @@ type Event struct {
 	Capacity     opt.Optional[int]
 	Participants participation.EventParticipants
 	Meta         map[string]any
+
+	// Sequence counts revisions to the event, see RFC 5545 section 3.8.7.4.
+	Sequence int
 }
 
 type TimeRange struct {
@@ -89,6 +92,7 @@ func Map(in *ent.Event) (*Event, error) {
 		Capacity:     opt.NewPtr(in.Capacity),
 		Participants: participants,
 		Meta:         in.Metadata,
+		Sequence:     in.Sequence,
 	}, nil
 }
 
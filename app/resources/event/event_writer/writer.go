@@ -148,6 +148,8 @@ func (w *Writer) Update(ctx context.Context, mk event_ref.QueryKey, opts ...Opti
 		opt(mutation)
 	}
 
+	mutation.AddSequence(1)
+
 	_, err := update.Save(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -159,7 +161,8 @@ func (w *Writer) Update(ctx context.Context, mk event_ref.QueryKey, opts ...Opti
 func (w *Writer) Delete(ctx context.Context, mk event_ref.QueryKey) (*event.Event, error) {
 	update := w.db.Event.Update().
 		Where(mk.Predicate()).
-		SetDeletedAt(time.Now())
+		SetDeletedAt(time.Now()).
+		AddSequence(1)
 
 	_, err := update.Save(ctx)
 	if err != nil {
@@ -23,6 +23,12 @@ func (p EventParticipants) IsHost(id account.AccountID) bool {
 	return isHost
 }
 
+func (p EventParticipants) Find(id account.AccountID) (*EventParticipant, bool) {
+	return lo.Find(p, func(p *EventParticipant) bool {
+		return p.Account.ID == id
+	})
+}
+
 func Map(in *ent.EventParticipant) (*EventParticipant, error) {
 	accountEdge, err := in.Edges.AccountOrErr()
 	if err != nil {
@@ -3,13 +3,16 @@ package participant_querier
 import (
 	"context"
 
+	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/event/event_ref"
 	"github.com/Southclaws/storyden/app/resources/event/participation"
 	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/eventparticipant"
 )
 
 type Querier struct {
@@ -40,3 +43,33 @@ func (w *Querier) Lookup(ctx context.Context, mk event_ref.QueryKey, accountID a
 
 	return participant, true, nil
 }
+
+// Attending lists the events the account has confirmed they're attending,
+// including those which have since been deleted.
+func (w *Querier) Attending(ctx context.Context, accountID account.AccountID) ([]*event_ref.Event, error) {
+	eps, err := w.db.EventParticipant.Query().
+		Where(
+			eventparticipant.AccountID(xid.ID(accountID)),
+			eventparticipant.Status(participation.StatusAttending.String()),
+		).
+		WithEvent(func(eq *ent.EventQuery) {
+			eq.WithPrimaryImage()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	evts, err := dt.MapErr(eps, func(ep *ent.EventParticipant) (*event_ref.Event, error) {
+		evt, err := ep.Edges.EventOrErr()
+		if err != nil {
+			return nil, err
+		}
+		return event_ref.Map(evt)
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return evts, nil
+}
@@ -433,6 +433,33 @@ func (q *Querier) ProbeMany(ctx context.Context, ids ...library.NodeID) ([]*libr
 	return result, nil
 }
 
+// ListRecentlyPublished returns the most recently created published nodes from
+// anywhere in the tree. Visibility rules are not needed as only published nodes
+// are ever included, which is suitable for public listings such as feeds.
+func (q *Querier) ListRecentlyPublished(ctx context.Context, limit int) ([]*library.Node, error) {
+	nodes, err := q.db.Node.Query().
+		Where(
+			node.VisibilityEQ(node.VisibilityPublished),
+			node.DeletedAtIsNil(),
+		).
+		WithOwner().
+		WithPrimaryImage().
+		WithTags().
+		Order(node.ByCreatedAt(sql.OrderDesc())).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := dt.MapErr(nodes, library.MapNode(false, nil))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return result, nil
+}
+
 func (q *Querier) getRequestingAccount(ctx context.Context, o *options) (opt.Optional[account.AccountWithEdges], error) {
 	if !o.visibilityRules {
 		return nil, nil
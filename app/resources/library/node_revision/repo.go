@@ -0,0 +1,110 @@
+package node_revision
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/noderevision"
+)
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+// Create stores a snapshot of a node's name and content. It should be called
+// with the values the node held *before* an update is written, by the account
+// that is performing the update.
+func (r *Repository) Create(
+	ctx context.Context,
+	nodeID library.NodeID,
+	editor account.AccountID,
+	name string,
+	content opt.Optional[datagraph.Content],
+) (*Revision, error) {
+	create := r.db.NodeRevision.Create().
+		SetNodeID(xid.ID(nodeID)).
+		SetAccountID(xid.ID(editor)).
+		SetName(name)
+
+	content.Call(func(c datagraph.Content) { create.SetContent(c.HTML()) })
+
+	created, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r.Get(ctx, nodeID, ID(created.ID))
+}
+
+// List returns the revisions of a node, most recent first.
+func (r *Repository) List(ctx context.Context, nodeID library.NodeID, page pagination.Parameters) (*pagination.Result[*Revision], error) {
+	query := r.db.NodeRevision.Query().
+		Where(noderevision.NodeID(xid.ID(nodeID)))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := query.
+		WithAuthor().
+		Order(ent.Desc(noderevision.FieldCreatedAt), ent.Desc(noderevision.FieldID)).
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	revisions, err := dt.MapErr(result, Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	paged := pagination.NewPageResult(page, total, revisions)
+
+	return &paged, nil
+}
+
+// Get returns a single revision, scoped to the node it belongs to so that a
+// revision ID from one node cannot be used to read or restore onto another.
+func (r *Repository) Get(ctx context.Context, nodeID library.NodeID, id ID) (*Revision, error) {
+	result, err := r.db.NodeRevision.Query().
+		Where(
+			noderevision.ID(xid.ID(id)),
+			noderevision.NodeID(xid.ID(nodeID)),
+		).
+		WithAuthor().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	rev, err := Map(result)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return rev, nil
+}
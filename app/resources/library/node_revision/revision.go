@@ -0,0 +1,57 @@
+package node_revision
+
+import (
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type ID xid.ID
+
+func (i ID) String() string { return xid.ID(i).String() }
+
+// Revision represents the state of a library page before an edit was made.
+type Revision struct {
+	ID        ID
+	CreatedAt time.Time
+	NodeID    library.NodeID
+	Author    opt.Optional[profile.Ref] // Empty once the editor's account is deleted.
+	Name      string
+	Content   opt.Optional[datagraph.Content]
+}
+
+type Revisions []*Revision
+
+func Map(in *ent.NodeRevision) (*Revision, error) {
+	author, err := opt.MapErr(opt.NewPtr(in.Edges.Author), func(a ent.Account) (profile.Ref, error) {
+		p, err := profile.MapRef(&a)
+		if err != nil {
+			return profile.Ref{}, err
+		}
+		return *p, nil
+	})
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	content, err := opt.MapErr(opt.NewPtr(in.Content), datagraph.NewRichText)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Revision{
+		ID:        ID(in.ID),
+		CreatedAt: in.CreatedAt,
+		NodeID:    library.NodeID(in.NodeID),
+		Author:    author,
+		Name:      in.Name,
+		Content:   content,
+	}, nil
+}
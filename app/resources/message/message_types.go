@@ -8,12 +8,14 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/conversation"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/event/event_ref"
 	"github.com/Southclaws/storyden/app/resources/library"
 	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/report"
 	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/webhook"
 	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
 )
 
@@ -66,6 +68,13 @@ type EventThreadReplyUnpublished struct {
 	ReplyID  post.ID
 }
 
+type EventThreadAnswerAccepted struct {
+	ThreadID      post.ID
+	ReplyID       post.ID
+	ReplyAuthorID account.AccountID
+	AcceptedByID  account.AccountID
+}
+
 type EventPostLiked struct {
 	PostID     post.ID
 	RootPostID post.ID
@@ -176,10 +185,18 @@ type EventAccountUpdated struct {
 	ID account.AccountID
 }
 
+type EventAccountDeleted struct {
+	ID account.AccountID
+}
+
 type CommandProfileIndex struct {
 	ID account.AccountID
 }
 
+type CommandAccountExport struct {
+	ID account.AccountID
+}
+
 // -
 // Notifications
 // -
@@ -191,6 +208,11 @@ type CommandSendNotification struct {
 	SourceID opt.Optional[account.AccountID]
 }
 
+type EventNotificationCreated struct {
+	ID       xid.ID
+	TargetID account.AccountID
+}
+
 type CommandSendEmail struct {
 	Message mailer.Message
 }
@@ -247,6 +269,17 @@ type EventActivityPublished struct {
 	ID event_ref.EventID
 }
 
+// -
+// Direct messages
+// -
+
+type EventConversationMessageSent struct {
+	ConversationID conversation.ConversationID
+	MessageID      conversation.MessageID
+	AuthorID       account.AccountID
+	RecipientIDs   []account.AccountID
+}
+
 // -
 // Settings events
 // -
@@ -254,3 +287,11 @@ type EventActivityPublished struct {
 type EventSettingsUpdated struct {
 	Settings *settings.Settings
 }
+
+// -
+// Webhooks
+// -
+
+type CommandDeliverWebhook struct {
+	ID webhook.DeliveryID
+}
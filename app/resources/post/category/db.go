@@ -66,6 +66,12 @@ func WithAdmin(v bool) Option {
 	}
 }
 
+func WithQAMode(v bool) Option {
+	return func(cm *ent.CategoryMutation) {
+		cm.SetQaMode(v)
+	}
+}
+
 func WithMeta(v map[string]any) Option {
 	return func(cm *ent.CategoryMutation) {
 		cm.SetMetadata(v)
@@ -0,0 +1,120 @@
+// Package poll describes polls attached to threads. A poll has a set of options
+// members may vote on, either one each or up to a configured number of choices.
+package poll
+
+import (
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type PollID xid.ID
+
+func (i PollID) String() string { return xid.ID(i).String() }
+
+type OptionID xid.ID
+
+func (i OptionID) String() string { return xid.ID(i).String() }
+
+type Poll struct {
+	ID         PollID
+	CreatedAt  time.Time
+	MaxChoices int
+	Anonymous  bool
+	ClosesAt   opt.Optional[time.Time]
+	Closed     bool
+	// Voters is the number of distinct accounts which have voted.
+	Voters  int
+	Options []*Option
+}
+
+type Option struct {
+	ID    OptionID
+	Text  string
+	Votes int
+	// Voted is true if the account the poll was queried for voted for this.
+	Voted bool
+	// Voters is always empty for anonymous polls.
+	Voters []profile.Ref
+}
+
+func (p *Poll) MultipleChoice() bool { return p.MaxChoices > 1 }
+
+// HasVotes is used to prevent changes to a poll's options after voting begins.
+func (p *Poll) HasVotes() bool { return p.Voters > 0 }
+
+func (p *Poll) Option(id OptionID) (*Option, bool) {
+	return lo.Find(p.Options, func(o *Option) bool { return o.ID == id })
+}
+
+// Map expects the options edge to be loaded. The votes are queried separately
+// and must have their account edges loaded unless the poll is anonymous.
+func Map(in *ent.Poll, voteEdges []*ent.PollVote, accountID opt.Optional[account.AccountID]) (*Poll, error) {
+	optionEdges, err := in.Edges.OptionsOrErr()
+	if err != nil {
+		return nil, err
+	}
+
+	votes := lo.GroupBy(voteEdges, func(v *ent.PollVote) xid.ID { return v.OptionID })
+	voters := lo.UniqBy(voteEdges, func(v *ent.PollVote) xid.ID { return v.AccountID })
+
+	options, err := dt.MapErr(optionEdges, func(o *ent.PollOption) (*Option, error) {
+		optionVotes := votes[o.ID]
+
+		voted := false
+		if aid, ok := accountID.Get(); ok {
+			voted = lo.ContainsBy(optionVotes, func(v *ent.PollVote) bool { return v.AccountID == xid.ID(aid) })
+		}
+
+		var profiles []profile.Ref
+		if !in.Anonymous {
+			profiles, err = dt.MapErr(optionVotes, func(v *ent.PollVote) (profile.Ref, error) {
+				acc, err := v.Edges.AccountOrErr()
+				if err != nil {
+					return profile.Ref{}, err
+				}
+
+				p, err := profile.MapRef(acc)
+				if err != nil {
+					return profile.Ref{}, err
+				}
+
+				return *p, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return &Option{
+			ID:     OptionID(o.ID),
+			Text:   o.Text,
+			Votes:  len(optionVotes),
+			Voted:  voted,
+			Voters: profiles,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	closesAt := opt.NewPtr(in.ClosesAt)
+
+	return &Poll{
+		ID:         PollID(in.ID),
+		CreatedAt:  in.CreatedAt,
+		MaxChoices: in.MaxChoices,
+		Anonymous:  in.Anonymous,
+		ClosesAt:   closesAt,
+		Closed:     closesAt.Ok() && !time.Now().Before(*in.ClosesAt),
+		Voters:     len(voters),
+		Options:    options,
+	}, nil
+}
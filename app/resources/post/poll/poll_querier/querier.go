@@ -0,0 +1,63 @@
+package poll_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/poll"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_poll "github.com/Southclaws/storyden/internal/ent/poll"
+	ent_poll_option "github.com/Southclaws/storyden/internal/ent/polloption"
+	ent_poll_vote "github.com/Southclaws/storyden/internal/ent/pollvote"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+// Lookup returns the poll attached to a thread, if there is one. When an
+// account is specified, the options it voted for are marked as voted.
+func (q *Querier) Lookup(ctx context.Context, threadID post.ID, accountID opt.Optional[account.AccountID]) (*poll.Poll, bool, error) {
+	result, err := q.db.Poll.Query().
+		Where(ent_poll.PostID(xid.ID(threadID))).
+		WithOptions(func(oq *ent.PollOptionQuery) {
+			oq.Order(ent.Asc(ent_poll_option.FieldSort))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	// Voter accounts are only needed when they're going to be exposed.
+	vq := q.db.PollVote.Query().Where(ent_poll_vote.PollID(result.ID))
+	if !result.Anonymous {
+		vq.WithAccount()
+	}
+
+	votes, err := vq.All(ctx)
+	if err != nil {
+		return nil, false, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	p, err := poll.Map(result, votes, accountID)
+	if err != nil {
+		return nil, false, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return p, true, nil
+}
@@ -0,0 +1,196 @@
+package poll_writer
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/poll"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_poll "github.com/Southclaws/storyden/internal/ent/poll"
+	ent_poll_option "github.com/Southclaws/storyden/internal/ent/polloption"
+	ent_poll_vote "github.com/Southclaws/storyden/internal/ent/pollvote"
+)
+
+var ErrTooManyChoices = fault.New("too many choices for poll", ftag.With(ftag.InvalidArgument))
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+type Option func(*ent.PollMutation)
+
+func WithMaxChoices(v int) Option {
+	return func(m *ent.PollMutation) {
+		m.SetMaxChoices(v)
+	}
+}
+
+func WithAnonymous(v bool) Option {
+	return func(m *ent.PollMutation) {
+		m.SetAnonymous(v)
+	}
+}
+
+func WithClosesAt(v time.Time) Option {
+	return func(m *ent.PollMutation) {
+		m.SetClosesAt(v)
+	}
+}
+
+// Create attaches a new poll to a thread with the given options, in order.
+func (w *Writer) Create(ctx context.Context, threadID post.ID, options []string, opts ...Option) (poll.PollID, error) {
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return poll.PollID{}, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+	defer tx.Rollback()
+
+	create := tx.Poll.Create().SetPostID(xid.ID(threadID))
+	for _, fn := range opts {
+		fn(create.Mutation())
+	}
+
+	p, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return poll.PollID{}, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.AlreadyExists),
+				fmsg.WithDesc("poll exists", "This thread already has a poll."))
+		}
+
+		return poll.PollID{}, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	if err := createOptions(ctx, tx, p.ID, options); err != nil {
+		return poll.PollID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return poll.PollID{}, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return poll.PollID(p.ID), nil
+}
+
+func (w *Writer) Update(ctx context.Context, id poll.PollID, opts ...Option) error {
+	update := w.db.Poll.UpdateOneID(xid.ID(id))
+	for _, fn := range opts {
+		fn(update.Mutation())
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return nil
+}
+
+// SetOptions replaces all of a poll's options, removing any votes cast on them.
+func (w *Writer) SetOptions(ctx context.Context, id poll.PollID, options []string) error {
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+	defer tx.Rollback()
+
+	_, err = tx.PollOption.Delete().Where(ent_poll_option.PollID(xid.ID(id))).Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	if err := createOptions(ctx, tx, xid.ID(id), options); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return nil
+}
+
+// Vote replaces the account's votes on the poll with the given options. An
+// empty list of options retracts all of the account's votes.
+func (w *Writer) Vote(ctx context.Context, id poll.PollID, accountID account.AccountID, options []poll.OptionID) error {
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+	defer tx.Rollback()
+
+	// Touching the poll row first holds its lock until commit, so concurrent
+	// votes from the same account run one after another and each replaces the
+	// last instead of both adding to it. The guard re-checks the choice limit
+	// against the locked row in case it changed since the caller read it.
+	n, err := tx.Poll.Update().
+		Where(
+			ent_poll.ID(xid.ID(id)),
+			ent_poll.MaxChoicesGTE(len(options)),
+		).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+	if n != 1 {
+		return fault.Wrap(ErrTooManyChoices,
+			fctx.With(ctx),
+			fmsg.WithDesc("too many choices", "You have chosen more options than this poll allows."),
+		)
+	}
+
+	_, err = tx.PollVote.Delete().
+		Where(
+			ent_poll_vote.PollID(xid.ID(id)),
+			ent_poll_vote.AccountID(xid.ID(accountID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	if len(options) > 0 {
+		err = tx.PollVote.MapCreateBulk(options, func(c *ent.PollVoteCreate, i int) {
+			c.SetPollID(xid.ID(id)).
+				SetOptionID(xid.ID(options[i])).
+				SetAccountID(xid.ID(accountID))
+		}).Exec(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return nil
+}
+
+func createOptions(ctx context.Context, tx *ent.Tx, pollID xid.ID, options []string) error {
+	err := tx.PollOption.MapCreateBulk(options, func(c *ent.PollOptionCreate, i int) {
+		c.SetPollID(pollID).
+			SetText(options[i]).
+			SetSort(i)
+	}).Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return nil
+}
@@ -31,3 +31,17 @@ func (q *Querier) Probe(ctx context.Context, id post.ID) (*post.PostRef, error)
 
 	return post.MapRef(p), nil
 }
+
+// Locked reports whether the thread with the given root post ID is locked.
+func (q *Querier) Locked(ctx context.Context, threadID post.ID) (bool, error) {
+	p, err := q.db.Post.
+		Query().
+		Where(ent_post.IDEQ(xid.ID(threadID))).
+		Select(ent_post.FieldLockedAt).
+		Only(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return p.LockedAt != nil, nil
+}
@@ -0,0 +1,107 @@
+package post_revision
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/postrevision"
+)
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+// Create stores a snapshot of a post's title and content. It should be called
+// with the values the post held *before* an update is written, by the account
+// that is performing the update.
+func (r *Repository) Create(
+	ctx context.Context,
+	postID post.ID,
+	editor account.AccountID,
+	title string,
+	content datagraph.Content,
+) (*Revision, error) {
+	created, err := r.db.PostRevision.Create().
+		SetPostID(xid.ID(postID)).
+		SetAccountID(xid.ID(editor)).
+		SetTitle(title).
+		SetBody(content.HTML()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r.Get(ctx, postID, ID(created.ID))
+}
+
+// List returns the revisions of a post, most recent first.
+func (r *Repository) List(ctx context.Context, postID post.ID, page pagination.Parameters) (*pagination.Result[*Revision], error) {
+	query := r.db.PostRevision.Query().
+		Where(postrevision.PostID(xid.ID(postID)))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result, err := query.
+		WithAuthor().
+		Order(ent.Desc(postrevision.FieldCreatedAt), ent.Desc(postrevision.FieldID)).
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	revisions, err := dt.MapErr(result, Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	paged := pagination.NewPageResult(page, total, revisions)
+
+	return &paged, nil
+}
+
+// Get returns a single revision, scoped to the post it belongs to so that a
+// revision ID from one post cannot be used to read or restore onto another.
+func (r *Repository) Get(ctx context.Context, postID post.ID, id ID) (*Revision, error) {
+	result, err := r.db.PostRevision.Query().
+		Where(
+			postrevision.ID(xid.ID(id)),
+			postrevision.PostID(xid.ID(postID)),
+		).
+		WithAuthor().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	rev, err := Map(result)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return rev, nil
+}
@@ -0,0 +1,58 @@
+package post_revision
+
+import (
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type ID xid.ID
+
+func (i ID) String() string { return xid.ID(i).String() }
+
+// Revision represents the state of a thread or reply before an edit was made.
+// The title is only present for threads, replies never have titles.
+type Revision struct {
+	ID        ID
+	CreatedAt time.Time
+	PostID    post.ID
+	Author    opt.Optional[profile.Ref] // Empty once the editor's account is deleted.
+	Title     string
+	Content   datagraph.Content
+}
+
+type Revisions []*Revision
+
+func Map(in *ent.PostRevision) (*Revision, error) {
+	author, err := opt.MapErr(opt.NewPtr(in.Edges.Author), func(a ent.Account) (profile.Ref, error) {
+		p, err := profile.MapRef(&a)
+		if err != nil {
+			return profile.Ref{}, err
+		}
+		return *p, nil
+	})
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	content, err := datagraph.NewRichText(in.Body)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Revision{
+		ID:        ID(in.ID),
+		CreatedAt: in.CreatedAt,
+		PostID:    post.ID(in.PostID),
+		Author:    author,
+		Title:     in.Title,
+		Content:   content,
+	}, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
 	"github.com/Southclaws/storyden/internal/ent"
 	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_category "github.com/Southclaws/storyden/internal/ent/category"
 	ent_post "github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
 	ent_tag "github.com/Southclaws/storyden/internal/ent/tag"
@@ -101,6 +102,22 @@ func WithCategories(ids ...category.CategoryID) Filter {
 	}
 }
 
+// WithAnswered only includes questions, threads in Q&A mode categories, which
+// either have an accepted answer or do not.
+func WithAnswered(answered bool) Filter {
+	return func(pq *ent.PostQuery) {
+		if answered {
+			pq.Where(ent_post.AcceptedAnswerIDNotNil())
+		} else {
+			pq.Where(
+				ent_post.RootPostIDIsNil(),
+				ent_post.AcceptedAnswerIDIsNil(),
+				ent_post.HasCategoryWith(ent_category.QaMode(true)),
+			)
+		}
+	}
+}
+
 func WithTags(names ...tag_ref.Name) Filter {
 	return func(pq *ent.PostQuery) {
 		if len(names) == 0 {
@@ -40,6 +40,9 @@ func (d *Querier) Get(ctx context.Context, id post.ID) (*reply.Reply, error) {
 		}).
 		Only(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
 		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
 	}
 
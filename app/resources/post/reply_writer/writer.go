@@ -45,6 +45,15 @@ func WithContent(v datagraph.Content) Option {
 	}
 }
 
+// WithCreatedAt backdates a reply, used when bringing content over from
+// another platform. The parent thread's last reply time follows it.
+func WithCreatedAt(t time.Time) Option {
+	return func(pm *ent.PostMutation) {
+		pm.SetCreatedAt(t)
+		pm.SetLastReplyAt(t)
+	}
+}
+
 func WithReplyTo(v post.ID) Option {
 	return func(pm *ent.PostMutation) {
 		pm.SetReplyToID(xid.ID(v))
@@ -144,7 +153,7 @@ func (d *Writer) Create(
 
 	err = tx.Post.
 		UpdateOneID(xid.ID(parentID)).
-		SetLastReplyAt(time.Now()).
+		SetLastReplyAt(p.CreatedAt).
 		Exec(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fmsg.With("failed to update parent thread timestamp"), fctx.With(ctx))
@@ -198,7 +207,9 @@ func (d *Writer) Update(ctx context.Context, id post.ID, opts ...Option) (*reply
 	return reply.Map(p)
 }
 
-func (d *Writer) Delete(ctx context.Context, id post.ID) error {
+// Delete soft-deletes a reply and reports whether it was the accepted answer to
+// its thread, in which case the thread is no longer answered.
+func (d *Writer) Delete(ctx context.Context, id post.ID) (bool, error) {
 	err := d.db.Post.
 		UpdateOneID(xid.ID(id)).
 		SetDeletedAt(time.Now()).
@@ -208,8 +219,18 @@ func (d *Writer) Delete(ctx context.Context, id post.ID) error {
 			err = fault.Wrap(err, ftag.With(ftag.NotFound), fmsg.WithDesc("not found", "Reply not found."))
 		}
 
-		return fault.Wrap(err, fmsg.With("failed to delete reply"), fctx.With(ctx))
+		return false, fault.Wrap(err, fmsg.With("failed to delete reply"), fctx.With(ctx))
+	}
+
+	// A deleted reply can no longer be the answer to a question.
+	n, err := d.db.Post.
+		Update().
+		Where(ent_post.AcceptedAnswerID(xid.ID(id))).
+		ClearAcceptedAnswerID().
+		Save(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fmsg.With("failed to clear accepted answer"), fctx.With(ctx))
 	}
 
-	return nil
+	return n > 0, nil
 }
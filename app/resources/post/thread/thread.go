@@ -16,6 +16,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/pagination"
 	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/resources/post/poll"
 	"github.com/Southclaws/storyden/app/resources/post/reaction"
 	"github.com/Southclaws/storyden/app/resources/post/reply"
 	"github.com/Southclaws/storyden/app/resources/profile"
@@ -31,8 +32,16 @@ type Thread struct {
 	Slug        string
 	Short       string
 	Pinned      bool
+	Lock        opt.Optional[Lock]
 	LastReplyAt opt.Optional[time.Time]
 
+	// AcceptedAnswer is the reply chosen as the answer to the thread's question
+	// and is only ever set on threads within Q&A mode categories.
+	AcceptedAnswer opt.Optional[post.ID]
+
+	// Poll is only hydrated when reading a single thread.
+	Poll opt.Optional[poll.Poll]
+
 	ReadStatus  opt.Optional[post.ReadStatus]
 	ReplyStatus post.ReplyStatus
 	Replies     pagination.Result[*reply.Reply]
@@ -47,7 +56,35 @@ type ThreadRef struct {
 	Slug        string
 	Short       string
 	Pinned      bool
+	Lock        opt.Optional[Lock]
 	LastReplyAt opt.Optional[time.Time]
+
+	AcceptedAnswer opt.Optional[post.ID]
+}
+
+// Lock is present on threads which no longer accept replies or reactions from
+// members without the Manage Posts permission.
+type Lock struct {
+	At time.Time
+	// By is empty when the thread was locked automatically due to inactivity.
+	By     opt.Optional[account.AccountID]
+	Reason opt.Optional[string]
+}
+
+func mapLock(m *ent.Post) opt.Optional[Lock] {
+	if m.LockedAt == nil {
+		return opt.NewEmpty[Lock]()
+	}
+
+	return opt.New(Lock{
+		At:     *m.LockedAt,
+		By:     opt.Map(opt.NewPtr(m.LockedByID), func(id xid.ID) account.AccountID { return account.AccountID(id) }),
+		Reason: opt.NewPtr(m.LockReason),
+	})
+}
+
+func mapAcceptedAnswer(m *ent.Post) opt.Optional[post.ID] {
+	return opt.Map(opt.NewPtr(m.AcceptedAnswerID), func(id xid.ID) post.ID { return post.ID(id) })
 }
 
 func (*Thread) GetResourceName() string { return "thread" }
@@ -66,6 +103,15 @@ func (t *Thread) GetCategory() xid.ID {
 	return xid.NilID()
 }
 
+// GetAnswered is only present for threads in Q&A mode categories.
+func (t *Thread) GetAnswered() opt.Optional[bool] {
+	cat, ok := t.Category.Get()
+	if !ok || !cat.QAMode {
+		return opt.NewEmpty[bool]()
+	}
+	return opt.New(t.AcceptedAnswer.Ok())
+}
+
 func (t *Thread) GetTags() []string {
 	tags := make([]string, len(t.Tags))
 	for i, tag := range t.Tags {
@@ -115,8 +161,11 @@ func Map(m *ent.Post) (*Thread, error) {
 		Slug:        m.Slug,
 		Short:       m.Short,
 		Pinned:      m.Pinned,
+		Lock:        mapLock(m),
 		LastReplyAt: opt.New(m.LastReplyAt),
 
+		AcceptedAnswer: mapAcceptedAnswer(m),
+
 		Category: category,
 		Tags:     tags,
 	}, nil
@@ -182,9 +231,12 @@ func Mapper(
 			Slug:   m.Slug,
 			Short:  m.Short,
 			Pinned: m.Pinned,
+			Lock:   mapLock(m),
 			// Only populate the last-reply-at if there are replies.
 			LastReplyAt: opt.NewSafe(m.LastReplyAt, rs.Status(m.ID).Count > 0),
 
+			AcceptedAnswer: mapAcceptedAnswer(m),
+
 			ReadStatus:  rr.Status(m.ID),
 			ReplyStatus: rs.Status(m.ID),
 			Category:    category,
@@ -206,7 +258,10 @@ func MapRef(m *ent.Post) *ThreadRef {
 		Slug:        m.Slug,
 		Short:       m.Short,
 		Pinned:      m.Pinned,
+		Lock:        mapLock(m),
 		LastReplyAt: opt.New(m.LastReplyAt),
+
+		AcceptedAnswer: mapAcceptedAnswer(m),
 	}
 }
 
@@ -216,6 +271,12 @@ func ItemRef(t *ent.Post) (datagraph.Item, error) {
 		return nil, fault.Wrap(err)
 	}
 
+	// The category edge is optional here, it's only needed for Q&A status.
+	cat := category.Category{ID: category.CategoryID(t.CategoryID)}
+	if t.Edges.Category != nil {
+		cat = *category.FromModel(t.Edges.Category)
+	}
+
 	return &Thread{
 		Post: post.Post{
 			ID:      post.ID(t.ID),
@@ -230,12 +291,11 @@ func ItemRef(t *ent.Post) (datagraph.Item, error) {
 			DeletedAt:  opt.NewPtr(t.DeletedAt),
 		},
 
-		Title: t.Title,
-		Slug:  t.Slug,
-		Category: opt.New(category.Category{
-			ID: category.CategoryID(t.CategoryID),
-		}),
-		Short: t.Short,
-		Tags:  dt.Map(t.Edges.Tags, tag_ref.Map(nil)),
+		Title:          t.Title,
+		Slug:           t.Slug,
+		Category:       opt.New(cat),
+		Short:          t.Short,
+		Tags:           dt.Map(t.Edges.Tags, tag_ref.Map(nil)),
+		AcceptedAnswer: mapAcceptedAnswer(t),
 	}, nil
 }
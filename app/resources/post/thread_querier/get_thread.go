@@ -2,7 +2,9 @@ package thread_querier
 
 import (
 	"context"
+	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
@@ -17,6 +19,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/collection/collection_item_status"
 	"github.com/Southclaws/storyden/app/resources/pagination"
 	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/poll"
 	"github.com/Southclaws/storyden/app/resources/post/reaction"
 	"github.com/Southclaws/storyden/app/resources/post/reply"
 	"github.com/Southclaws/storyden/app/resources/post/thread"
@@ -110,6 +113,22 @@ func (d *Querier) Get(ctx context.Context, threadID post.ID, pageParams paginati
 		return nil
 	})
 
+	var pollResult opt.Optional[poll.Poll]
+	pool1.SubmitErr(func() error {
+		ctx, span := d.ins.InstrumentNamed(ctx, "thread_poll")
+		defer span.End()
+
+		p, ok, err := d.polls.Lookup(ctx, threadID, accountID)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		if ok {
+			pollResult = opt.New(*p)
+		}
+
+		return nil
+	})
+
 	var repliesResult []*ent.Post
 	pool1.SubmitErr(func() error {
 		ctx, span := d.ins.InstrumentNamed(ctx, "thread_replies")
@@ -122,7 +141,7 @@ func (d *Querier) Get(ctx context.Context, threadID post.ID, pageParams paginati
 			).
 			Limit(pageParams.Limit()).
 			Offset(pageParams.Offset()).
-			Order(ent.Asc(ent_post.FieldCreatedAt)).
+			Order(acceptedAnswerFirst(threadID), ent.Asc(ent_post.FieldCreatedAt)).
 			WithReplyTo().
 			All(ctx)
 		if err != nil {
@@ -237,6 +256,19 @@ func (d *Querier) Get(ctx context.Context, threadID post.ID, pageParams paginati
 	p.Replies = repliesPage
 	p.Tags = tags
 	p.Assets = assets
+	p.Poll = pollResult
 
 	return p, nil
 }
+
+// acceptedAnswerFirst orders the thread's accepted answer, if any, before the
+// rest of the replies so it's always at the top of the first page.
+func acceptedAnswerFirst(threadID post.ID) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.OrderExpr(sql.ExprP(
+			fmt.Sprintf("CASE WHEN %s = (SELECT %s FROM %s WHERE %s = ?) THEN 0 ELSE 1 END",
+				s.C(ent_post.FieldID), ent_post.FieldAcceptedAnswerID, ent_post.Table, ent_post.FieldID),
+			xid.ID(threadID),
+		))
+	}
+}
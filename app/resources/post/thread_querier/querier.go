@@ -9,6 +9,7 @@ import (
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/post/poll/poll_querier"
 	"github.com/Southclaws/storyden/app/resources/post/thread"
 	"github.com/Southclaws/storyden/app/resources/visibility"
 	"github.com/Southclaws/storyden/internal/ent"
@@ -20,16 +21,18 @@ import (
 )
 
 type Querier struct {
-	ins spanner.Instrumentation
-	db  *ent.Client
-	raw *sqlx.DB
+	ins   spanner.Instrumentation
+	db    *ent.Client
+	raw   *sqlx.DB
+	polls *poll_querier.Querier
 }
 
-func New(ins spanner.Builder, db *ent.Client, raw *sqlx.DB) *Querier {
+func New(ins spanner.Builder, db *ent.Client, raw *sqlx.DB, polls *poll_querier.Querier) *Querier {
 	return &Querier{
-		ins: ins.Build(),
-		db:  db,
-		raw: raw,
+		ins:   ins.Build(),
+		db:    db,
+		raw:   raw,
+		polls: polls,
 	}
 }
 
@@ -87,6 +90,12 @@ func HasTags(ids []xid.ID) Query {
 	}
 }
 
+func HasTagNames(names ...string) Query {
+	return func(q *ent.PostQuery) {
+		q.Where(ent_post.HasTagsWith(ent_tag.NameIn(names...)))
+	}
+}
+
 func HasCategories(cf CategoryFilter) Query {
 	return func(q *ent.PostQuery) {
 		if len(cf.Slugs) > 0 {
@@ -108,6 +117,31 @@ func HasStatus(status ...visibility.Visibility) Query {
 	}
 }
 
+func HasLocked(locked bool) Query {
+	return func(q *ent.PostQuery) {
+		if locked {
+			q.Where(ent_post.LockedAtNotNil())
+		} else {
+			q.Where(ent_post.LockedAtIsNil())
+		}
+	}
+}
+
+// HasAnswered filters to questions, threads within Q&A mode categories, which
+// have an accepted answer or those which are still waiting for one.
+func HasAnswered(answered bool) Query {
+	return func(q *ent.PostQuery) {
+		if answered {
+			q.Where(ent_post.AcceptedAnswerIDNotNil())
+		} else {
+			q.Where(
+				ent_post.AcceptedAnswerIDIsNil(),
+				ent_post.HasCategoryWith(ent_category.QaMode(true)),
+			)
+		}
+	}
+}
+
 func HasPublishedOrOwnInReview(accountID opt.Optional[account.AccountID], isModerator bool) Query {
 	return func(q *ent.PostQuery) {
 		publishedStatus := ent_post.Visibility(visibility.VisibilityPublished.String())
@@ -142,3 +176,11 @@ func HasNotBeenDeleted() Query {
 		q.Where(ent_post.DeletedAtIsNil())
 	}
 }
+
+// OrderByNewest sorts threads by creation date instead of the default of most
+// recent activity, this takes precedence as it's applied before the default.
+func OrderByNewest() Query {
+	return func(q *ent.PostQuery) {
+		q.Order(ent.Desc(ent_post.FieldCreatedAt))
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/fmsg"
 	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/asset"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
@@ -23,6 +24,7 @@ import (
 	"github.com/Southclaws/storyden/internal/ent"
 	"github.com/Southclaws/storyden/internal/ent/category"
 	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
 )
 
 type Writer struct {
@@ -41,6 +43,14 @@ func WithID(id post.ID) Option {
 	}
 }
 
+// WithCreatedAt backdates a thread, used when bringing content over from
+// another platform. The thread's last reply time starts at the same instant.
+func WithCreatedAt(t time.Time) Option {
+	return func(m *ent.PostMutation) {
+		m.SetCreatedAt(t)
+	}
+}
+
 func WithIndexed() Option {
 	return func(m *ent.PostMutation) {
 		m.SetIndexedAt(time.Now())
@@ -72,6 +82,38 @@ func WithVisibility(v visibility.Visibility) Option {
 	}
 }
 
+func WithLock(by account.AccountID, reason opt.Optional[string]) Option {
+	return func(pm *ent.PostMutation) {
+		pm.SetLockedAt(time.Now())
+		pm.SetLockedByID(xid.ID(by))
+		if r, ok := reason.Get(); ok {
+			pm.SetLockReason(r)
+		} else {
+			pm.ClearLockReason()
+		}
+	}
+}
+
+func WithUnlock() Option {
+	return func(pm *ent.PostMutation) {
+		pm.ClearLockedAt()
+		pm.ClearLockedByID()
+		pm.ClearLockReason()
+	}
+}
+
+func WithAcceptedAnswer(id post.ID) Option {
+	return func(pm *ent.PostMutation) {
+		pm.SetAcceptedAnswerID(xid.ID(id))
+	}
+}
+
+func WithoutAcceptedAnswer() Option {
+	return func(pm *ent.PostMutation) {
+		pm.ClearAcceptedAnswerID()
+	}
+}
+
 func WithMeta(meta map[string]any) Option {
 	return func(m *ent.PostMutation) {
 		m.SetMetadata(meta)
@@ -140,7 +182,11 @@ func (d *Writer) Create(
 	}
 
 	mutate.SetTitle(title)
-	mutate.SetLastReplyAt(time.Now())
+	if createdAt, ok := mutate.CreatedAt(); ok {
+		mutate.SetLastReplyAt(createdAt)
+	} else {
+		mutate.SetLastReplyAt(time.Now())
+	}
 	mutate.SetAuthorID(xid.ID(authorID))
 	mutate.SetTitle(title)
 
@@ -219,6 +265,44 @@ func (d *Writer) Update(ctx context.Context, id post.ID, opts ...Option) (*threa
 	return thread.Map(p)
 }
 
+// LockInactive locks every unlocked thread which has had no replies since the
+// given time. The lock has no author, which indicates it was done by the system.
+// Only the threads locked by this call are returned, each thread is checked
+// again as it's locked so one which was locked elsewhere or received a reply
+// in the meantime is skipped.
+func (d *Writer) LockInactive(ctx context.Context, since time.Time, reason string) ([]post.ID, error) {
+	inactive := []predicate.Post{
+		ent_post.RootPostIDIsNil(),
+		ent_post.DeletedAtIsNil(),
+		ent_post.LockedAtIsNil(),
+		ent_post.LastReplyAtLT(since),
+	}
+
+	ids, err := d.db.Post.Query().
+		Where(inactive...).
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	locked := []post.ID{}
+	for _, id := range ids {
+		n, err := d.db.Post.Update().
+			Where(append(inactive, ent_post.ID(id))...).
+			SetLockedAt(time.Now()).
+			SetLockReason(reason).
+			Save(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+		}
+		if n == 1 {
+			locked = append(locked, post.ID(id))
+		}
+	}
+
+	return locked, nil
+}
+
 func (d *Writer) Delete(ctx context.Context, id post.ID) error {
 	err := d.db.Post.
 		UpdateOneID(xid.ID(id)).
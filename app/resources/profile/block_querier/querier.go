@@ -0,0 +1,72 @@
+package block_querier
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db}
+}
+
+// List returns the profiles an account has blocked, most recent first.
+func (q *Querier) List(ctx context.Context, accountID account.AccountID) ([]*profile.Ref, error) {
+	r, err := q.db.AccountBlock.Query().
+		Where(accountblock.AccountID(xid.ID(accountID))).
+		Order(ent.Desc(accountblock.FieldCreatedAt)).
+		WithBlocked().
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	profiles, err := dt.MapErr(r, func(in *ent.AccountBlock) (*profile.Ref, error) {
+		return profile.MapRef(in.Edges.Blocked)
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return profiles, nil
+}
+
+// Between reports whether a block exists in either direction between an
+// account and any of the others.
+func (q *Querier) Between(ctx context.Context, accountID account.AccountID, others ...account.AccountID) (bool, error) {
+	if len(others) == 0 {
+		return false, nil
+	}
+
+	ids := dt.Map(others, func(a account.AccountID) xid.ID { return xid.ID(a) })
+
+	exists, err := q.db.AccountBlock.Query().
+		Where(accountblock.Or(
+			accountblock.And(
+				accountblock.AccountID(xid.ID(accountID)),
+				accountblock.BlockedAccountIDIn(ids...),
+			),
+			accountblock.And(
+				accountblock.AccountIDIn(ids...),
+				accountblock.BlockedAccountID(xid.ID(accountID)),
+			),
+		)).
+		Exist(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,50 @@
+package block_writer
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db}
+}
+
+func (w *Writer) Block(ctx context.Context, accountID, blockedID account.AccountID) error {
+	err := w.db.AccountBlock.Create().
+		SetAccountID(xid.ID(accountID)).
+		SetBlockedAccountID(xid.ID(blockedID)).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil
+		}
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (w *Writer) Unblock(ctx context.Context, accountID, blockedID account.AccountID) error {
+	_, err := w.db.AccountBlock.Delete().
+		Where(
+			accountblock.AccountID(xid.ID(accountID)),
+			accountblock.BlockedAccountID(xid.ID(blockedID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
@@ -27,6 +27,7 @@ var writePermissions = []Permission{
 	PermissionCreateReaction,
 	PermissionManagePosts,
 	PermissionManageCategories,
+	PermissionManageTags,
 	PermissionCreateInvitation,
 	PermissionManageLibrary,
 	PermissionSubmitLibraryNode,
@@ -17,6 +17,7 @@ var (
 	PermissionCreateReaction        = Permission{`CREATE_REACTION`}
 	PermissionManagePosts           = Permission{`MANAGE_POSTS`}
 	PermissionManageCategories      = Permission{`MANAGE_CATEGORIES`}
+	PermissionManageTags            = Permission{`MANAGE_TAGS`}
 	PermissionCreateInvitation      = Permission{`CREATE_INVITATION`}
 	PermissionReadPublishedLibrary  = Permission{`READ_PUBLISHED_LIBRARY`}
 	PermissionManageLibrary         = Permission{`MANAGE_LIBRARY`}
@@ -36,6 +37,7 @@ var (
 	PermissionManageRoles           = Permission{`MANAGE_ROLES`}
 	PermissionManageReports         = Permission{`MANAGE_REPORTS`}
 	PermissionViewAccounts          = Permission{`VIEW_ACCOUNTS`}
+	PermissionBypassRateLimits      = Permission{`BYPASS_RATE_LIMITS`}
 	PermissionAdministrator         = Permission{`ADMINISTRATOR`}
 )
 
@@ -86,6 +88,8 @@ func NewPermission(__iNpUt__ string) (Permission, error) {
 		return PermissionManagePosts, nil
 	case string(`MANAGE_CATEGORIES`):
 		return PermissionManageCategories, nil
+	case string(`MANAGE_TAGS`):
+		return PermissionManageTags, nil
 	case string(`CREATE_INVITATION`):
 		return PermissionCreateInvitation, nil
 	case string(`READ_PUBLISHED_LIBRARY`):
@@ -124,6 +128,8 @@ func NewPermission(__iNpUt__ string) (Permission, error) {
 		return PermissionManageReports, nil
 	case string(`VIEW_ACCOUNTS`):
 		return PermissionViewAccounts, nil
+	case string(`BYPASS_RATE_LIMITS`):
+		return PermissionBypassRateLimits, nil
 	case string(`ADMINISTRATOR`):
 		return PermissionAdministrator, nil
 	default:
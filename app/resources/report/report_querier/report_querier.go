@@ -204,6 +204,23 @@ func (q *Querier) hydrateRefs(ctx context.Context, refs report.ReportRefs) (repo
 	return reports, nil
 }
 
+// HasOpen reports whether an item has been reported and the report is yet to
+// be resolved.
+func (q *Querier) HasOpen(ctx context.Context, targetID xid.ID, targetKind datagraph.Kind) (bool, error) {
+	open, err := q.db.Report.Query().
+		Where(
+			entreport.TargetID(targetID),
+			entreport.TargetKind(targetKind.String()),
+			entreport.StatusIn(report.StatusSubmitted.String(), report.StatusAcknowledged.String()),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return open, nil
+}
+
 func (q *Querier) Get(ctx context.Context, id report.ID) (*report.Report, error) {
 	r, err := q.db.Report.Query().
 		Where(entreport.ID(xid.ID(id))).
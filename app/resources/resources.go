@@ -3,13 +3,17 @@ package resources
 import (
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/resources/account/account_data"
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/account/account_writer"
 	"github.com/Southclaws/storyden/app/resources/account/authentication"
 	"github.com/Southclaws/storyden/app/resources/account/authentication/access_key"
+	"github.com/Southclaws/storyden/app/resources/account/calendar_token"
 	"github.com/Southclaws/storyden/app/resources/account/email"
 	"github.com/Southclaws/storyden/app/resources/account/invitation/invitation_querier"
 	"github.com/Southclaws/storyden/app/resources/account/invitation/invitation_writer"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_digest"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_preference"
 	"github.com/Southclaws/storyden/app/resources/account/notification/notify_querier"
 	"github.com/Southclaws/storyden/app/resources/account/notification/notify_writer"
 	"github.com/Southclaws/storyden/app/resources/account/role/role_assign"
@@ -19,9 +23,13 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/token"
 	"github.com/Southclaws/storyden/app/resources/asset/asset_querier"
 	"github.com/Southclaws/storyden/app/resources/asset/asset_writer"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_querier"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
 	collection_items "github.com/Southclaws/storyden/app/resources/collection/collection_item"
 	"github.com/Southclaws/storyden/app/resources/collection/collection_querier"
 	"github.com/Southclaws/storyden/app/resources/collection/collection_writer"
+	"github.com/Southclaws/storyden/app/resources/conversation/conversation_querier"
+	"github.com/Southclaws/storyden/app/resources/conversation/conversation_writer"
 	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
 	"github.com/Southclaws/storyden/app/resources/event/event_querier"
 	"github.com/Southclaws/storyden/app/resources/event/event_writer"
@@ -31,6 +39,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/library/node_children"
 	"github.com/Southclaws/storyden/app/resources/library/node_properties"
 	"github.com/Southclaws/storyden/app/resources/library/node_querier"
+	"github.com/Southclaws/storyden/app/resources/library/node_revision"
 	"github.com/Southclaws/storyden/app/resources/library/node_search"
 	"github.com/Southclaws/storyden/app/resources/library/node_traversal"
 	"github.com/Southclaws/storyden/app/resources/library/node_writer"
@@ -40,8 +49,11 @@ import (
 	"github.com/Southclaws/storyden/app/resources/link/link_writer"
 	"github.com/Southclaws/storyden/app/resources/post/category"
 	"github.com/Southclaws/storyden/app/resources/post/category_cache"
+	"github.com/Southclaws/storyden/app/resources/post/poll/poll_querier"
+	"github.com/Southclaws/storyden/app/resources/post/poll/poll_writer"
 	"github.com/Southclaws/storyden/app/resources/post/post_querier"
 	"github.com/Southclaws/storyden/app/resources/post/post_read_state"
+	"github.com/Southclaws/storyden/app/resources/post/post_revision"
 	"github.com/Southclaws/storyden/app/resources/post/post_search"
 	"github.com/Southclaws/storyden/app/resources/post/post_writer"
 	"github.com/Southclaws/storyden/app/resources/post/reaction"
@@ -50,6 +62,8 @@ import (
 	"github.com/Southclaws/storyden/app/resources/post/thread_cache"
 	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
 	"github.com/Southclaws/storyden/app/resources/post/thread_writer"
+	"github.com/Southclaws/storyden/app/resources/profile/block_querier"
+	"github.com/Southclaws/storyden/app/resources/profile/block_writer"
 	"github.com/Southclaws/storyden/app/resources/profile/follow_querier"
 	"github.com/Southclaws/storyden/app/resources/profile/follow_writer"
 	"github.com/Southclaws/storyden/app/resources/profile/profile_cache"
@@ -58,9 +72,12 @@ import (
 	"github.com/Southclaws/storyden/app/resources/question"
 	"github.com/Southclaws/storyden/app/resources/report/report_querier"
 	"github.com/Southclaws/storyden/app/resources/report/report_writer"
+	"github.com/Southclaws/storyden/app/resources/schedule/schedule_querier"
+	"github.com/Southclaws/storyden/app/resources/schedule/schedule_writer"
 	"github.com/Southclaws/storyden/app/resources/settings"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_querier"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_writer"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_repo"
 )
 
 func Build() fx.Option {
@@ -69,6 +86,8 @@ func Build() fx.Option {
 			settings.New,
 			account_querier.New,
 			account_writer.New,
+			account_data.NewCollector,
+			account_data.NewEraser,
 			access_key.New,
 			email.New,
 			role_assign.New,
@@ -84,6 +103,8 @@ func Build() fx.Option {
 			category_cache.New,
 			notify_querier.New,
 			notify_writer.New,
+			notify_preference.New,
+			notify_digest.New,
 			tag_querier.New,
 			tag_writer.New,
 			reply_querier.New,
@@ -92,12 +113,15 @@ func Build() fx.Option {
 			thread_querier.New,
 			thread_cache.New,
 			reaction.New,
+			poll_querier.New,
+			poll_writer.New,
 			like_querier.New,
 			like_writer.New,
 			post_querier.New,
 			post_search.New,
 			post_writer.New,
 			post_read_state.New,
+			post_revision.New,
 			collection_querier.New,
 			collection_writer.New,
 			collection_items.New,
@@ -108,6 +132,7 @@ func Build() fx.Option {
 			node_children.New,
 			node_search.New,
 			node_properties.New,
+			node_revision.New,
 			link_querier.New,
 			link_writer.New,
 			profile_search.New,
@@ -115,14 +140,24 @@ func Build() fx.Option {
 			profile_cache.New,
 			follow_writer.New,
 			follow_querier.New,
+			block_writer.New,
+			block_querier.New,
+			conversation_querier.New,
+			conversation_writer.New,
 			event_querier.New,
 			event_writer.New,
 			participant_querier.New,
 			participant_writer.New,
+			calendar_token.New,
 			hydrate.New,
 			question.New,
 			report_querier.New,
 			report_writer.New,
+			schedule_querier.New,
+			schedule_writer.New,
+			audit_querier.New,
+			audit_writer.New,
+			webhook_repo.New,
 		),
 		token.Build(),
 	)
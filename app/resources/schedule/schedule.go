@@ -0,0 +1,62 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type ScheduleID xid.ID
+
+func (id ScheduleID) String() string { return xid.ID(id).String() }
+
+// Schedule is a pending publish of a thread, library page or event. When the
+// publish time passes, the item is published as the account which scheduled it.
+// A schedule which failed too many times is kept with FailedAt and LastError
+// set and is not attempted again unless it's rescheduled.
+type Schedule struct {
+	ID        ScheduleID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	PublishAt time.Time
+	Target    datagraph.Ref
+	Account   profile.Ref
+	Attempts  int
+	LastError opt.Optional[string]
+	FailedAt  opt.Optional[time.Time]
+}
+
+func Map(in *ent.PublishSchedule) (*Schedule, error) {
+	accountEdge, err := in.Edges.AccountOrErr()
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	acc, err := profile.MapRef(accountEdge)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	kind, err := datagraph.NewKind(in.TargetKind)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Schedule{
+		ID:        ScheduleID(in.ID),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		PublishAt: in.PublishAt,
+		Target:    datagraph.Ref{ID: in.TargetID, Kind: kind},
+		Account:   *acc,
+		Attempts:  in.Attempts,
+		LastError: opt.NewPtr(in.LastError),
+		FailedAt:  opt.NewPtr(in.FailedAt),
+	}, nil
+}
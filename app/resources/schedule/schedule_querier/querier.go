@@ -0,0 +1,99 @@
+package schedule_querier
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/schedule"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_schedule "github.com/Southclaws/storyden/internal/ent/publishschedule"
+)
+
+type Querier struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Querier {
+	return &Querier{db: db}
+}
+
+type Filter func(*ent.PublishScheduleQuery)
+
+func WithAccount(id account.AccountID) Filter {
+	return func(q *ent.PublishScheduleQuery) {
+		q.Where(ent_schedule.AccountID(xid.ID(id)))
+	}
+}
+
+// WithDueBefore only includes schedules whose publish time has passed and which
+// are ready to be attempted, that is they haven't failed for good and aren't
+// waiting to be retried after a failed attempt.
+func WithDueBefore(t time.Time) Filter {
+	return func(q *ent.PublishScheduleQuery) {
+		q.Where(
+			ent_schedule.PublishAtLTE(t),
+			ent_schedule.FailedAtIsNil(),
+			ent_schedule.Or(
+				ent_schedule.NextAttemptAtIsNil(),
+				ent_schedule.NextAttemptAtLTE(t),
+			),
+		)
+	}
+}
+
+func WithLimit(n int) Filter {
+	return func(q *ent.PublishScheduleQuery) {
+		q.Limit(n)
+	}
+}
+
+// List returns pending schedules, soonest first.
+func (q *Querier) List(ctx context.Context, filters ...Filter) ([]*schedule.Schedule, error) {
+	query := q.db.PublishSchedule.Query().
+		WithAccount().
+		Order(ent.Asc(ent_schedule.FieldPublishAt))
+
+	for _, fn := range filters {
+		fn(query)
+	}
+
+	result, err := query.All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	list, err := dt.MapErr(result, schedule.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return list, nil
+}
+
+func (q *Querier) Get(ctx context.Context, id schedule.ScheduleID) (*schedule.Schedule, error) {
+	result, err := q.db.PublishSchedule.Query().
+		Where(ent_schedule.ID(xid.ID(id))).
+		WithAccount().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	s, err := schedule.Map(result)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s, nil
+}
@@ -0,0 +1,107 @@
+package schedule_writer
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/schedule"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_schedule "github.com/Southclaws/storyden/internal/ent/publishschedule"
+)
+
+type Writer struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Writer {
+	return &Writer{db: db}
+}
+
+// Set schedules the target to be published at the given time. An item has at
+// most one pending schedule so scheduling it again replaces the previous one,
+// including any record of failed attempts.
+func (w *Writer) Set(ctx context.Context, target datagraph.Ref, publishAt time.Time, accountID account.AccountID) (schedule.ScheduleID, error) {
+	id, err := w.db.PublishSchedule.Create().
+		SetTargetID(target.ID).
+		SetTargetKind(target.Kind.String()).
+		SetPublishAt(publishAt).
+		SetAccountID(xid.ID(accountID)).
+		OnConflictColumns(ent_schedule.FieldTargetID).
+		UpdateNewValues().
+		Update(func(u *ent.PublishScheduleUpsert) {
+			u.SetAttempts(0)
+			u.ClearNextAttemptAt()
+			u.ClearLastError()
+			u.ClearFailedAt()
+		}).
+		ID(ctx)
+	if err != nil {
+		return schedule.ScheduleID{}, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return schedule.ScheduleID(id), nil
+}
+
+func (w *Writer) Delete(ctx context.Context, id schedule.ScheduleID) error {
+	err := w.db.PublishSchedule.DeleteOneID(xid.ID(id)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return nil
+}
+
+// Claim records an attempt at publishing the schedule and holds off any other
+// attempt until retryAt. The attempt count must match the one the schedule was
+// listed with, otherwise another instance has claimed it first and false is
+// returned.
+func (w *Writer) Claim(ctx context.Context, s *schedule.Schedule, retryAt time.Time) (bool, error) {
+	n, err := w.db.PublishSchedule.Update().
+		Where(
+			ent_schedule.ID(xid.ID(s.ID)),
+			ent_schedule.Attempts(s.Attempts),
+			ent_schedule.FailedAtIsNil(),
+		).
+		AddAttempts(1).
+		SetNextAttemptAt(retryAt).
+		Save(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return n == 1, nil
+}
+
+// Failed records why an attempt failed. When failedAt is set the schedule has
+// failed for good and won't be attempted again.
+func (w *Writer) Failed(ctx context.Context, id schedule.ScheduleID, reason string, failedAt opt.Optional[time.Time]) error {
+	update := w.db.PublishSchedule.UpdateOneID(xid.ID(id)).
+		SetLastError(reason)
+
+	if t, ok := failedAt.Get(); ok {
+		update.SetFailedAt(t)
+	}
+
+	err := update.Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return nil
+}
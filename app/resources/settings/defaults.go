@@ -19,6 +19,8 @@ const (
 )
 const DefaultColour = "hsl(157, 65%, 44%)"
 
+const DefaultDeletionGracePeriodDays = 14
+
 // skip error check, we know it's correct, it's literally above ^^
 var defaultContent, _ = datagraph.NewRichText(DefaultContent)
 
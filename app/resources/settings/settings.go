@@ -36,6 +36,18 @@ type Settings struct {
 	// are exposed to members during the frontend registration and login flows.
 	AuthenticationMode opt.Optional[authentication.Mode]
 
+	// InviteOnly closes registration to anyone without a valid invitation. The
+	// very first account of a fresh installation is always allowed through.
+	InviteOnly opt.Optional[bool]
+
+	// TwoFactorRequired forces members whose roles can administrate or manage
+	// posts to complete a TOTP second factor when signing in with a password or
+	// email. Members without an authenticator are made to enrol one on sign in.
+	TwoFactorRequired opt.Optional[bool]
+
+	// AccountDeletion controls what happens when members delete their account.
+	AccountDeletion opt.Optional[AccountDeletionSettings]
+
 	Services opt.Optional[ServiceSettings]
 
 	// Metadata is an arbitrary object which can be used by frontends/clients to
@@ -43,6 +55,32 @@ type Settings struct {
 	Metadata opt.Optional[map[string]any]
 }
 
+type AccountDeletionSettings struct {
+	// GracePeriodDays is how long members have to change their mind after
+	// asking for their account to be deleted, DefaultDeletionGracePeriodDays
+	// applies when unset.
+	GracePeriodDays opt.Optional[int]
+
+	// Content decides what happens to everything the member wrote, which is
+	// anonymised when unset.
+	Content opt.Optional[DeletedContent]
+}
+
+// DeletedContent is what happens to the threads, replies and library pages of
+// a member when their account is deleted.
+type DeletedContent string
+
+const (
+	// DeletedContentAnonymise keeps everything the member wrote but removes
+	// everything identifying them from their account, leaving an anonymous
+	// placeholder as the author.
+	DeletedContentAnonymise DeletedContent = "anonymise"
+
+	// DeletedContentRemove deletes everything the member wrote along with
+	// their account. Replies by others in the member's threads go too.
+	DeletedContentRemove DeletedContent = "remove"
+)
+
 type ServiceSettings struct {
 	Moderation opt.Optional[ModerationServiceSettings]
 }
@@ -52,6 +90,10 @@ type ModerationServiceSettings struct {
 	ReplyBodyLengthMax  opt.Optional[int]
 	WordBlockList       opt.Optional[[]string]
 	WordReportList      opt.Optional[[]string]
+
+	// ThreadAutoLockDays locks threads which have had no replies for this many
+	// days, zero or unset disables automatic locking.
+	ThreadAutoLockDays opt.Optional[int]
 }
 
 // Merge will combine "updated" into "s" while overwriting any new values.
@@ -7,8 +7,12 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/jmoiron/sqlx"
+	"github.com/rs/xid"
 
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/tag"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
 	"github.com/Southclaws/storyden/internal/ent"
@@ -39,6 +43,23 @@ group by
   t.id
 `
 
+const tagItemsCountQuery = `select
+  t.id tag_id,
+  (
+    select count(*) from tag_posts tp
+    inner join posts p on p.id = tp.post_id and p.visibility = 'published' and p.deleted_at is null
+    where tp.tag_id = t.id
+  ) + (
+    select count(*) from tag_nodes tn
+    inner join nodes n on n.id = tn.node_id and n.visibility = 'published' and n.deleted_at is null
+    where tn.tag_id = t.id
+  ) items
+from
+  tags t
+where
+  t.id = $1
+`
+
 func (q *Querier) List(ctx context.Context) (tag_ref.Tags, error) {
 	r, err := q.db.Tag.Query().All(ctx)
 	if err != nil {
@@ -79,6 +100,74 @@ func (q *Querier) Search(ctx context.Context, query string) (tag_ref.Tags, error
 	return tags, nil
 }
 
+// Probe looks up a tag by name without loading any of its items.
+func (q *Querier) Probe(ctx context.Context, name tag_ref.Name) (*tag_ref.Tag, error) {
+	r, err := q.db.Tag.Query().
+		Where(ent_tag.Name(name.String())).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	var counts tag_ref.TagItemsResults
+	err = q.raw.SelectContext(ctx, &counts, tagItemsCountQuery, r.ID.String())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return tag_ref.Map(counts)(r), nil
+}
+
+// TaggedItems identifies the published threads and library pages carrying a
+// set of tags, these are the items whose indexes change with the tags.
+type TaggedItems struct {
+	Threads []post.ID
+	Nodes   []TaggedNode
+}
+
+type TaggedNode struct {
+	ID   library.NodeID
+	Slug string
+}
+
+func (q *Querier) Items(ctx context.Context, ids ...tag_ref.ID) (*TaggedItems, error) {
+	tagFilter := ent_tag.IDIn(dt.Map(ids, func(id tag_ref.ID) xid.ID { return xid.ID(id) })...)
+
+	threads, err := q.db.Post.Query().
+		Where(
+			ent_post.HasTagsWith(tagFilter),
+			ent_post.RootPostIDIsNil(),
+			ent_post.VisibilityEQ(ent_post.VisibilityPublished),
+			ent_post.DeletedAtIsNil(),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	nodes, err := q.db.Node.Query().
+		Where(
+			ent_node.HasTagsWith(tagFilter),
+			ent_node.VisibilityEQ(ent_node.VisibilityPublished),
+			ent_node.DeletedAtIsNil(),
+		).
+		Select(ent_node.FieldID, ent_node.FieldSlug).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return &TaggedItems{
+		Threads: dt.Map(threads, func(id xid.ID) post.ID { return post.ID(id) }),
+		Nodes: dt.Map(nodes, func(n *ent.Node) TaggedNode {
+			return TaggedNode{ID: library.NodeID(n.ID), Slug: n.Slug}
+		}),
+	}, nil
+}
+
 func (q *Querier) Get(ctx context.Context, name tag_ref.Name) (*tag.Tag, error) {
 	r, err := q.db.Tag.Query().
 		Where(ent_tag.Name(name.String())).
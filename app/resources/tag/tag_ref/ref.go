@@ -2,6 +2,7 @@ package tag_ref
 
 import (
 	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
 	"github.com/mazznoer/csscolorparser"
 	"github.com/rs/xid"
 	"github.com/samber/lo"
@@ -37,10 +38,11 @@ func (n Names) Strings() []string {
 }
 
 type Tag struct {
-	ID        ID
-	Name      Name
-	Colour    string
-	ItemCount int
+	ID          ID
+	Name        Name
+	Description opt.Optional[string]
+	Colour      string
+	ItemCount   int
 }
 
 type Tags []*Tag
@@ -57,11 +59,16 @@ func (t Tags) Names() []Name {
 
 func Map(counts TagItemsResults) func(in *ent.Tag) *Tag {
 	return func(in *ent.Tag) *Tag {
+		colour := opt.NewPtr(in.Colour).OrCall(func() string {
+			return deriveTagColour(in.Name)
+		})
+
 		return &Tag{
-			ID:        ID(in.ID),
-			Name:      NewName(in.Name),
-			Colour:    deriveTagColour(in.Name),
-			ItemCount: counts.Get(in.ID),
+			ID:          ID(in.ID),
+			Name:        NewName(in.Name),
+			Description: opt.NewPtr(in.Description),
+			Colour:      colour,
+			ItemCount:   counts.Get(in.ID),
 		}
 	}
 }
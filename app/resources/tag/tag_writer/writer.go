@@ -6,9 +6,16 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
 
 	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
 	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_node "github.com/Southclaws/storyden/internal/ent/node"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
 	ent_tag "github.com/Southclaws/storyden/internal/ent/tag"
 )
 
@@ -20,6 +27,26 @@ func New(db *ent.Client) *Writer {
 	return &Writer{db}
 }
 
+type Option func(*ent.TagMutation)
+
+func WithName(name tag_ref.Name) Option {
+	return func(m *ent.TagMutation) {
+		m.SetName(name.String())
+	}
+}
+
+func WithDescription(v string) Option {
+	return func(m *ent.TagMutation) {
+		m.SetDescription(v)
+	}
+}
+
+func WithColour(v string) Option {
+	return func(m *ent.TagMutation) {
+		m.SetColour(v)
+	}
+}
+
 func (w *Writer) Add(ctx context.Context, names ...tag_ref.Name) ([]*tag_ref.Tag, error) {
 	nameStrings := tag_ref.Names(names).Strings()
 
@@ -47,6 +74,111 @@ func (w *Writer) Add(ctx context.Context, names ...tag_ref.Name) ([]*tag_ref.Tag
 	return tags, nil
 }
 
+func (w *Writer) Update(ctx context.Context, id tag_ref.ID, opts ...Option) (*tag_ref.Tag, error) {
+	update := w.db.Tag.UpdateOneID(xid.ID(id))
+	for _, fn := range opts {
+		fn(update.Mutation())
+	}
+
+	r, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		if ent.IsConstraintError(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.AlreadyExists),
+				fmsg.WithDesc("tag exists", "A tag with this name already exists, merge the tags instead."))
+		}
+
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return tag_ref.Map(nil)(r), nil
+}
+
+// Merge moves every post, page and member interest from the source tags onto
+// the target tag then deletes the source tags, all within one transaction.
+func (w *Writer) Merge(ctx context.Context, into tag_ref.ID, from ...tag_ref.ID) error {
+	sourceIDs := lo.Without(dt.Map(from, func(id tag_ref.ID) xid.ID { return xid.ID(id) }), xid.ID(into))
+	if len(sourceIDs) == 0 {
+		return nil
+	}
+
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+	defer tx.Rollback()
+
+	target, err := tx.Tag.Query().
+		Where(ent_tag.ID(xid.ID(into))).
+		WithPosts(func(pq *ent.PostQuery) { pq.Select(ent_post.FieldID) }).
+		WithNodes(func(nq *ent.NodeQuery) { nq.Select(ent_node.FieldID) }).
+		WithAccounts(func(aq *ent.AccountQuery) { aq.Select(ent_account.FieldID) }).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	sourceFilter := ent_tag.IDIn(sourceIDs...)
+
+	posts, err := tx.Post.Query().Where(ent_post.HasTagsWith(sourceFilter)).IDs(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	nodes, err := tx.Node.Query().Where(ent_node.HasTagsWith(sourceFilter)).IDs(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	accounts, err := tx.Account.Query().Where(ent_account.HasTagsWith(sourceFilter)).IDs(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	// Items already carrying the target tag would violate the edge tables'
+	// primary keys if added again.
+	existingPosts := dt.Map(target.Edges.Posts, func(p *ent.Post) xid.ID { return p.ID })
+	existingNodes := dt.Map(target.Edges.Nodes, func(n *ent.Node) xid.ID { return n.ID })
+	existingAccounts := dt.Map(target.Edges.Accounts, func(a *ent.Account) xid.ID { return a.ID })
+
+	err = tx.Tag.UpdateOneID(target.ID).
+		AddPostIDs(lo.Without(posts, existingPosts...)...).
+		AddNodeIDs(lo.Without(nodes, existingNodes...)...).
+		AddAccountIDs(lo.Without(accounts, existingAccounts...)...).
+		Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	_, err = tx.Tag.Delete().Where(sourceFilter).Exec(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return nil
+}
+
+func (w *Writer) Delete(ctx context.Context, id tag_ref.ID) error {
+	err := w.db.Tag.DeleteOneID(xid.ID(id)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+		return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.Internal))
+	}
+
+	return nil
+}
+
 func (w *Writer) Remove(ctx context.Context, names ...tag_ref.Name) error {
 	nameStrings := tag_ref.Names(names).Strings()
 
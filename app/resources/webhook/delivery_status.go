@@ -0,0 +1,11 @@
+package webhook
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type deliveryStatusEnum string
+
+const (
+	deliveryStatusPending   deliveryStatusEnum = "pending"
+	deliveryStatusSucceeded deliveryStatusEnum = "succeeded"
+	deliveryStatusFailed    deliveryStatusEnum = "failed"
+)
@@ -0,0 +1,23 @@
+package webhook
+
+//go:generate go run github.com/Southclaws/enumerator
+
+type eventTypeEnum string
+
+const (
+	eventTypePing              eventTypeEnum = "ping"
+	eventTypeThreadPublished   eventTypeEnum = "thread.published"
+	eventTypeThreadUpdated     eventTypeEnum = "thread.updated"
+	eventTypeThreadUnpublished eventTypeEnum = "thread.unpublished"
+	eventTypeThreadDeleted     eventTypeEnum = "thread.deleted"
+	eventTypeReplyCreated      eventTypeEnum = "reply.created"
+	eventTypeReplyUpdated      eventTypeEnum = "reply.updated"
+	eventTypeReplyDeleted      eventTypeEnum = "reply.deleted"
+	eventTypeNodePublished     eventTypeEnum = "node.published"
+	eventTypeNodeUpdated       eventTypeEnum = "node.updated"
+	eventTypeNodeUnpublished   eventTypeEnum = "node.unpublished"
+	eventTypeNodeDeleted       eventTypeEnum = "node.deleted"
+	eventTypeReportCreated     eventTypeEnum = "report.created"
+	eventTypeReportUpdated     eventTypeEnum = "report.updated"
+	eventTypeAccountCreated    eventTypeEnum = "account.created"
+)
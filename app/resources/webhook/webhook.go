@@ -0,0 +1,109 @@
+// Package webhook describes outbound HTTP endpoints which receive a signed copy
+// of selected events from the message bus, along with a log of each delivery.
+package webhook
+
+import (
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/internal/ent"
+)
+
+type ID xid.ID
+
+func (i ID) String() string { return xid.ID(i).String() }
+
+type Webhook struct {
+	ID         ID
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Name       string
+	URL        string
+	Secret     string
+	EventTypes []EventType
+	Enabled    bool
+}
+
+// Subscribed reports whether the webhook wants to receive the given event. The
+// ping event is always sent when requested, regardless of the subscription.
+func (w *Webhook) Subscribed(et EventType) bool {
+	if et == EventTypePing {
+		return true
+	}
+
+	for _, t := range w.EventTypes {
+		if t == et {
+			return true
+		}
+	}
+
+	return false
+}
+
+type Webhooks []*Webhook
+
+func Map(in *ent.Webhook) (*Webhook, error) {
+	eventTypes, err := dt.MapErr(in.EventTypes, NewEventType)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Webhook{
+		ID:         ID(in.ID),
+		CreatedAt:  in.CreatedAt,
+		UpdatedAt:  in.UpdatedAt,
+		Name:       in.Name,
+		URL:        in.URL,
+		Secret:     in.Secret,
+		EventTypes: eventTypes,
+		Enabled:    in.Enabled,
+	}, nil
+}
+
+type DeliveryID xid.ID
+
+func (i DeliveryID) String() string { return xid.ID(i).String() }
+
+type Delivery struct {
+	ID             DeliveryID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	WebhookID      ID
+	EventType      EventType
+	Payload        []byte
+	Status         DeliveryStatus
+	Attempts       int
+	ResponseStatus opt.Optional[int]
+	Error          opt.Optional[string]
+}
+
+type Deliveries []*Delivery
+
+func MapDelivery(in *ent.WebhookDelivery) (*Delivery, error) {
+	eventType, err := NewEventType(in.EventType)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	status, err := NewDeliveryStatus(in.Status)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Delivery{
+		ID:             DeliveryID(in.ID),
+		CreatedAt:      in.CreatedAt,
+		UpdatedAt:      in.UpdatedAt,
+		WebhookID:      ID(in.WebhookID),
+		EventType:      eventType,
+		Payload:        []byte(in.Payload),
+		Status:         status,
+		Attempts:       in.Attempts,
+		ResponseStatus: opt.NewPtr(in.ResponseStatus),
+		Error:          opt.NewPtr(in.Error),
+	}, nil
+}
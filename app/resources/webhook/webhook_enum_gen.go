@@ -0,0 +1,160 @@
+// Code generated by enumerator. DO NOT EDIT.
+
+package webhook
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+type DeliveryStatus struct {
+	v deliveryStatusEnum
+}
+
+var (
+	DeliveryStatusPending   = DeliveryStatus{deliveryStatusPending}
+	DeliveryStatusSucceeded = DeliveryStatus{deliveryStatusSucceeded}
+	DeliveryStatusFailed    = DeliveryStatus{deliveryStatusFailed}
+)
+
+func (r DeliveryStatus) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		fmt.Fprint(f, r.v)
+	case 'q':
+		fmt.Fprintf(f, "%q", r.String())
+	default:
+		fmt.Fprint(f, r.v)
+	}
+}
+func (r DeliveryStatus) String() string {
+	return string(r.v)
+}
+func (r DeliveryStatus) MarshalText() ([]byte, error) {
+	return []byte(r.v), nil
+}
+func (r *DeliveryStatus) UnmarshalText(__iNpUt__ []byte) error {
+	s, err := NewDeliveryStatus(string(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func (r DeliveryStatus) Value() (driver.Value, error) {
+	return r.v, nil
+}
+func (r *DeliveryStatus) Scan(__iNpUt__ any) error {
+	s, err := NewDeliveryStatus(fmt.Sprint(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func NewDeliveryStatus(__iNpUt__ string) (DeliveryStatus, error) {
+	switch __iNpUt__ {
+	case string(deliveryStatusPending):
+		return DeliveryStatusPending, nil
+	case string(deliveryStatusSucceeded):
+		return DeliveryStatusSucceeded, nil
+	case string(deliveryStatusFailed):
+		return DeliveryStatusFailed, nil
+	default:
+		return DeliveryStatus{}, fmt.Errorf("invalid value for type 'DeliveryStatus': '%s'", __iNpUt__)
+	}
+}
+
+type EventType struct {
+	v eventTypeEnum
+}
+
+var (
+	EventTypePing              = EventType{eventTypePing}
+	EventTypeThreadPublished   = EventType{eventTypeThreadPublished}
+	EventTypeThreadUpdated     = EventType{eventTypeThreadUpdated}
+	EventTypeThreadUnpublished = EventType{eventTypeThreadUnpublished}
+	EventTypeThreadDeleted     = EventType{eventTypeThreadDeleted}
+	EventTypeReplyCreated      = EventType{eventTypeReplyCreated}
+	EventTypeReplyUpdated      = EventType{eventTypeReplyUpdated}
+	EventTypeReplyDeleted      = EventType{eventTypeReplyDeleted}
+	EventTypeNodePublished     = EventType{eventTypeNodePublished}
+	EventTypeNodeUpdated       = EventType{eventTypeNodeUpdated}
+	EventTypeNodeUnpublished   = EventType{eventTypeNodeUnpublished}
+	EventTypeNodeDeleted       = EventType{eventTypeNodeDeleted}
+	EventTypeReportCreated     = EventType{eventTypeReportCreated}
+	EventTypeReportUpdated     = EventType{eventTypeReportUpdated}
+	EventTypeAccountCreated    = EventType{eventTypeAccountCreated}
+)
+
+func (r EventType) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		fmt.Fprint(f, r.v)
+	case 'q':
+		fmt.Fprintf(f, "%q", r.String())
+	default:
+		fmt.Fprint(f, r.v)
+	}
+}
+func (r EventType) String() string {
+	return string(r.v)
+}
+func (r EventType) MarshalText() ([]byte, error) {
+	return []byte(r.v), nil
+}
+func (r *EventType) UnmarshalText(__iNpUt__ []byte) error {
+	s, err := NewEventType(string(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func (r EventType) Value() (driver.Value, error) {
+	return r.v, nil
+}
+func (r *EventType) Scan(__iNpUt__ any) error {
+	s, err := NewEventType(fmt.Sprint(__iNpUt__))
+	if err != nil {
+		return err
+	}
+	*r = s
+	return nil
+}
+func NewEventType(__iNpUt__ string) (EventType, error) {
+	switch __iNpUt__ {
+	case string(eventTypePing):
+		return EventTypePing, nil
+	case string(eventTypeThreadPublished):
+		return EventTypeThreadPublished, nil
+	case string(eventTypeThreadUpdated):
+		return EventTypeThreadUpdated, nil
+	case string(eventTypeThreadUnpublished):
+		return EventTypeThreadUnpublished, nil
+	case string(eventTypeThreadDeleted):
+		return EventTypeThreadDeleted, nil
+	case string(eventTypeReplyCreated):
+		return EventTypeReplyCreated, nil
+	case string(eventTypeReplyUpdated):
+		return EventTypeReplyUpdated, nil
+	case string(eventTypeReplyDeleted):
+		return EventTypeReplyDeleted, nil
+	case string(eventTypeNodePublished):
+		return EventTypeNodePublished, nil
+	case string(eventTypeNodeUpdated):
+		return EventTypeNodeUpdated, nil
+	case string(eventTypeNodeUnpublished):
+		return EventTypeNodeUnpublished, nil
+	case string(eventTypeNodeDeleted):
+		return EventTypeNodeDeleted, nil
+	case string(eventTypeReportCreated):
+		return EventTypeReportCreated, nil
+	case string(eventTypeReportUpdated):
+		return EventTypeReportUpdated, nil
+	case string(eventTypeAccountCreated):
+		return EventTypeAccountCreated, nil
+	default:
+		return EventType{}, fmt.Errorf("invalid value for type 'EventType': '%s'", __iNpUt__)
+	}
+}
@@ -0,0 +1,243 @@
+package webhook_repo
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_webhook "github.com/Southclaws/storyden/internal/ent/webhook"
+	"github.com/Southclaws/storyden/internal/ent/webhookdelivery"
+)
+
+type Repository struct {
+	db *ent.Client
+}
+
+func New(db *ent.Client) *Repository {
+	return &Repository{db: db}
+}
+
+type Option func(*ent.WebhookMutation)
+
+func WithName(v string) Option {
+	return func(m *ent.WebhookMutation) {
+		m.SetName(v)
+	}
+}
+
+func WithURL(v string) Option {
+	return func(m *ent.WebhookMutation) {
+		m.SetURL(v)
+	}
+}
+
+func WithSecret(v string) Option {
+	return func(m *ent.WebhookMutation) {
+		m.SetSecret(v)
+	}
+}
+
+func WithEventTypes(v []webhook.EventType) Option {
+	return func(m *ent.WebhookMutation) {
+		m.SetEventTypes(dt.Map(v, func(t webhook.EventType) string { return t.String() }))
+	}
+}
+
+func WithEnabled(v bool) Option {
+	return func(m *ent.WebhookMutation) {
+		m.SetEnabled(v)
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, name string, url string, secret string, eventTypes []webhook.EventType, opts ...Option) (*webhook.Webhook, error) {
+	create := r.db.Webhook.Create()
+	mutation := create.Mutation()
+
+	WithName(name)(mutation)
+	WithURL(url)(mutation)
+	WithSecret(secret)(mutation)
+	WithEventTypes(eventTypes)(mutation)
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	w, err := create.Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return webhook.Map(w)
+}
+
+func (r *Repository) Get(ctx context.Context, id webhook.ID) (*webhook.Webhook, error) {
+	w, err := r.db.Webhook.Get(ctx, xid.ID(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return webhook.Map(w)
+}
+
+func (r *Repository) List(ctx context.Context) (webhook.Webhooks, error) {
+	ws, err := r.db.Webhook.Query().
+		Order(ent.Asc(ent_webhook.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.MapErr(ws, webhook.Map)
+}
+
+// ListSubscribed returns all enabled webhooks which are subscribed to the given
+// event type. Event filters are stored as JSON so filtering happens in memory,
+// the number of configured webhooks on an instance is expected to be small.
+func (r *Repository) ListSubscribed(ctx context.Context, et webhook.EventType) (webhook.Webhooks, error) {
+	ws, err := r.db.Webhook.Query().
+		Where(ent_webhook.Enabled(true)).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	mapped, err := dt.MapErr(ws, webhook.Map)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Filter(mapped, func(w *webhook.Webhook) bool { return w.Subscribed(et) }), nil
+}
+
+func (r *Repository) Update(ctx context.Context, id webhook.ID, opts ...Option) (*webhook.Webhook, error) {
+	update := r.db.Webhook.UpdateOneID(xid.ID(id))
+	mutation := update.Mutation()
+
+	for _, fn := range opts {
+		fn(mutation)
+	}
+
+	w, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return webhook.Map(w)
+}
+
+func (r *Repository) Delete(ctx context.Context, id webhook.ID) error {
+	err := r.db.Webhook.DeleteOneID(xid.ID(id)).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateDelivery(ctx context.Context, id webhook.ID, et webhook.EventType, payload []byte) (*webhook.Delivery, error) {
+	d, err := r.db.WebhookDelivery.Create().
+		SetWebhookID(xid.ID(id)).
+		SetEventType(et.String()).
+		SetPayload(string(payload)).
+		SetStatus(webhook.DeliveryStatusPending.String()).
+		Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return webhook.MapDelivery(d)
+}
+
+func (r *Repository) GetDelivery(ctx context.Context, id webhook.DeliveryID) (*webhook.Delivery, error) {
+	d, err := r.db.WebhookDelivery.Get(ctx, xid.ID(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return webhook.MapDelivery(d)
+}
+
+func (r *Repository) ListDeliveries(ctx context.Context, id webhook.ID, page pagination.Parameters) (*pagination.Result[*webhook.Delivery], error) {
+	query := r.db.WebhookDelivery.Query().
+		Where(webhookdelivery.WebhookID(xid.ID(id)))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ds, err := query.
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt), ent.Desc(webhookdelivery.FieldID)).
+		Limit(page.Limit()).
+		Offset(page.Offset()).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	mapped, err := dt.MapErr(ds, webhook.MapDelivery)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result := pagination.NewPageResult(page, total, mapped)
+	return &result, nil
+}
+
+// RecordAttempt stores the outcome of a single delivery attempt. The attempt
+// counter is incremented and the response status and error are overwritten so
+// the delivery always reflects the most recent attempt.
+func (r *Repository) RecordAttempt(
+	ctx context.Context,
+	id webhook.DeliveryID,
+	status webhook.DeliveryStatus,
+	responseStatus opt.Optional[int],
+	deliveryErr opt.Optional[string],
+) (*webhook.Delivery, error) {
+	update := r.db.WebhookDelivery.UpdateOneID(xid.ID(id)).
+		SetStatus(status.String()).
+		AddAttempts(1)
+
+	if v, ok := responseStatus.Get(); ok {
+		update.SetResponseStatus(v)
+	} else {
+		update.ClearResponseStatus()
+	}
+
+	if v, ok := deliveryErr.Get(); ok {
+		update.SetError(v)
+	} else {
+		update.ClearError()
+	}
+
+	d, err := update.Save(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return webhook.MapDelivery(d)
+}
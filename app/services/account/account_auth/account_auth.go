@@ -6,26 +6,31 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
 	"github.com/samber/lo"
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/authentication"
 	authentication_repo "github.com/Southclaws/storyden/app/resources/account/authentication"
 	authentication_service "github.com/Southclaws/storyden/app/services/authentication"
+	"github.com/Southclaws/storyden/app/services/authentication/provider/totp"
 )
 
 type Manager struct {
 	authService *authentication_service.Manager
 	authRepo    authentication_repo.Repository
+	totp        *totp.Provider
 }
 
 func New(
 	authService *authentication_service.Manager,
 	authRepo authentication_repo.Repository,
+	totp *totp.Provider,
 ) *Manager {
 	return &Manager{
 		authService: authService,
 		authRepo:    authRepo,
+		totp:        totp,
 	}
 }
 
@@ -73,7 +78,28 @@ func (m *Manager) GetAuthMethods(ctx context.Context, id account.AccountID) ([]*
 }
 
 func (m *Manager) DeleteAuthMethod(ctx context.Context, id account.AccountID, aid authentication_repo.ID) error {
-	_, err := m.authRepo.DeleteByID(ctx, id, aid)
+	active, err := m.authRepo.GetAuthMethods(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Members who are required to use a second factor cannot remove it, an
+	// admin must first remove the privileged role or disable the requirement.
+	target, found := lo.Find(active, func(a *authentication.Authentication) bool { return a.ID == aid })
+	if found && target.Service == authentication.ServiceTOTP && !target.Disabled {
+		required, err := m.totp.Required(ctx, id)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if required {
+			return fault.Wrap(totp.ErrRequired,
+				fctx.With(ctx),
+				fmsg.WithDesc("two-factor required", "Your role requires two-factor authentication so your authenticator app cannot be removed."))
+		}
+	}
+
+	_, err = m.authRepo.DeleteByID(ctx, id, aid)
 	if err != nil {
 		return fault.Wrap(err, fctx.With(ctx))
 	}
@@ -0,0 +1,40 @@
+package account_deletion
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/infrastructure/schedule"
+)
+
+const (
+	// deleteInterval is how often accounts past their grace period are deleted.
+	deleteInterval = 10 * time.Minute
+
+	// deleteRetryAfter is how long a claimed deletion is held before another
+	// attempt is made, in case the instance which claimed it didn't finish.
+	deleteRetryAfter = time.Hour
+)
+
+func runDeleter(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	m *Manager,
+) {
+	schedule.Every(ctx, lc, logger, "account_deletion", deleteInterval, func(ctx context.Context) error {
+		n, err := m.DeleteDue(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		if n > 0 {
+			logger.Info("deleted accounts past their grace period", slog.Int("count", n))
+		}
+		return nil
+	})
+}
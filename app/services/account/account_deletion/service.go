@@ -0,0 +1,322 @@
+// Package account_deletion lets members delete their own account. Deletion is
+// scheduled rather than immediate so members have a grace period to change
+// their mind, after which the account is either anonymised or removed along
+// with everything the member wrote, depending on the instance's settings.
+package account_deletion
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_data"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/token"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/account/account_export"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/avatar"
+	"github.com/Southclaws/storyden/app/services/comms/mailqueue"
+	"github.com/Southclaws/storyden/app/services/notification/notify_mail"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var (
+	errAdmin  = fault.New("admin accounts cannot be deleted", ftag.With(ftag.PermissionDenied))
+	errReauth = fault.New("account deletion requires a recent sign in", ftag.With(ftag.PermissionDenied))
+)
+
+// reauthWindow is how recently the member must have signed in to schedule or
+// cancel a deletion. A session which has been around for longer, or one taken
+// from another device, has to prove it's still the member by signing in again.
+const reauthWindow = 10 * time.Minute
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(runDeleter),
+	)
+}
+
+type Manager struct {
+	logger        *slog.Logger
+	accountQuery  *account_querier.Querier
+	accountWriter *account_writer.Writer
+	tokens        token.Repository
+	eraser        *account_data.Eraser
+	settings      *settings.SettingsRepository
+	avatar        avatar.Service
+	store         object.Storer
+	bus           *pubsub.Bus
+	mailqueue     *mailqueue.Queuer
+}
+
+func New(
+	logger *slog.Logger,
+	accountQuery *account_querier.Querier,
+	accountWriter *account_writer.Writer,
+	tokens token.Repository,
+	eraser *account_data.Eraser,
+	settings *settings.SettingsRepository,
+	avatar avatar.Service,
+	store object.Storer,
+	bus *pubsub.Bus,
+	mailqueue *mailqueue.Queuer,
+) *Manager {
+	return &Manager{
+		logger:        logger.With(slog.String("service", "account_deletion")),
+		accountQuery:  accountQuery,
+		accountWriter: accountWriter,
+		tokens:        tokens,
+		eraser:        eraser,
+		settings:      settings,
+		avatar:        avatar,
+		store:         store,
+		bus:           bus,
+		mailqueue:     mailqueue,
+	}
+}
+
+// Request schedules the account for deletion once the grace period is over.
+// Asking again while a deletion is already scheduled doesn't push it back.
+func (m *Manager) Request(ctx context.Context, id account.AccountID) (*account.AccountWithEdges, error) {
+	if err := m.reauthenticated(ctx); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := m.accountQuery.GetByID(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, ok := acc.DeletionScheduledAt.Get(); ok {
+		return acc, nil
+	}
+
+	// Otherwise the instance could be left with nobody to administer it.
+	if acc.Admin {
+		return nil, fault.Wrap(errAdmin, fctx.With(ctx),
+			fmsg.WithDesc("admin", "Admin accounts can't be deleted, ask another admin to remove your admin status first."))
+	}
+
+	set, err := m.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	days := settings.DefaultDeletionGracePeriodDays
+	if s, ok := set.AccountDeletion.Get(); ok {
+		days = s.GracePeriodDays.Or(days)
+	}
+	at := time.Now().AddDate(0, 0, days)
+
+	acc, err = m.accountWriter.Update(ctx, id, account_writer.SetDeletionScheduled(opt.New(at)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// The deletion is scheduled regardless of whether the member could be told.
+	if err := m.notify(ctx, acc, set, at); err != nil {
+		m.logger.Warn("failed to notify member of scheduled deletion",
+			slog.String("account_id", id.String()),
+			slog.String("error", err.Error()))
+	}
+
+	return acc, nil
+}
+
+// Cancel stops a scheduled deletion, it's a no-op if none is scheduled.
+func (m *Manager) Cancel(ctx context.Context, id account.AccountID) (*account.AccountWithEdges, error) {
+	if err := m.reauthenticated(ctx); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := m.accountWriter.Update(ctx, id, account_writer.SetDeletionScheduled(opt.NewEmpty[time.Time]()))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return acc, nil
+}
+
+// reauthenticated checks the request was made with a browser session issued
+// within the re-authentication window. Access keys are never accepted.
+func (m *Manager) reauthenticated(ctx context.Context) error {
+	raw, ok := session.GetSessionToken(ctx).Get()
+	if !ok {
+		return fault.Wrap(errReauth, fctx.With(ctx),
+			fmsg.WithDesc("session", "Account deletion can only be managed from a signed in browser."))
+	}
+
+	t, err := token.FromString(raw)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s, err := m.tokens.Validate(ctx, t)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if time.Since(s.CreatedAt) > reauthWindow {
+		return fault.Wrap(errReauth, fctx.With(ctx),
+			fmsg.WithDesc("stale", "Please sign in again to confirm it's you before managing the deletion of your account."))
+	}
+
+	return nil
+}
+
+// DeleteDue deletes every account whose grace period is over. Each deletion is
+// claimed first, so when several instances run this at once an account is only
+// deleted by one of them and a deletion cancelled at the last moment stands.
+func (m *Manager) DeleteDue(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	due, err := m.accountQuery.ListDueDeletions(ctx, now)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	deleted := 0
+	for _, acc := range due {
+		scheduledAt, ok := acc.DeletionScheduledAt.Get()
+		if !ok {
+			continue
+		}
+
+		claimed, err := m.accountWriter.ClaimDeletion(ctx, acc.ID, scheduledAt, now.Add(deleteRetryAfter))
+		if err != nil {
+			return deleted, fault.Wrap(err, fctx.With(ctx))
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := m.Delete(ctx, acc.ID); err != nil {
+			m.logger.Warn("failed to delete account after grace period",
+				slog.String("account_id", acc.ID.String()),
+				slog.String("error", err.Error()))
+			continue
+		}
+
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+// Delete erases the account immediately, anonymising or removing the member's
+// content as the instance's settings say.
+func (m *Manager) Delete(ctx context.Context, id account.AccountID) error {
+	set, err := m.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	content := settings.DeletedContentAnonymise
+	if s, ok := set.AccountDeletion.Get(); ok {
+		content = s.Content.Or(content)
+	}
+
+	var erased *account_data.Erased
+	switch content {
+	case settings.DeletedContentRemove:
+		erased, err = m.eraser.Remove(ctx, id)
+	default:
+		erased, err = m.eraser.Anonymise(ctx, id)
+	}
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.reindex(ctx, erased, content == settings.DeletedContentRemove)
+
+	objects := append(erased.Objects, account_export.Path(id))
+	for _, p := range objects {
+		if err := m.store.Delete(ctx, p); err != nil {
+			m.logger.Warn("failed to delete file of deleted account",
+				slog.String("account_id", id.String()),
+				slog.String("path", p),
+				slog.String("error", err.Error()))
+		}
+	}
+
+	if err := m.avatar.Delete(ctx, id); err != nil {
+		m.logger.Warn("failed to delete avatar of deleted account",
+			slog.String("account_id", id.String()),
+			slog.String("error", err.Error()))
+	}
+
+	m.bus.Publish(ctx, &message.EventAccountDeleted{ID: id})
+
+	m.logger.Info("deleted account",
+		slog.String("account_id", id.String()),
+		slog.String("content", string(content)))
+
+	return nil
+}
+
+// reindex removes deleted content from the search index, or updates content
+// that was kept so it's no longer attributed to the member.
+func (m *Manager) reindex(ctx context.Context, erased *account_data.Erased, removed bool) {
+	commands := []any{}
+
+	for _, id := range erased.Threads {
+		if removed {
+			commands = append(commands, &message.CommandThreadDeindex{ID: id})
+		} else {
+			commands = append(commands, &message.CommandThreadIndex{ID: id})
+		}
+	}
+
+	for _, id := range erased.Replies {
+		if removed {
+			commands = append(commands, &message.CommandReplyDeindex{ID: id})
+		} else {
+			commands = append(commands, &message.CommandReplyIndex{ID: id})
+		}
+	}
+
+	for _, id := range erased.Nodes {
+		if removed {
+			commands = append(commands, &message.CommandNodeDeindex{ID: id})
+		} else {
+			commands = append(commands, &message.CommandNodeIndex{ID: id})
+		}
+	}
+
+	for _, c := range commands {
+		if err := m.bus.SendCommand(ctx, c); err != nil {
+			m.logger.Warn("failed to update search index for deleted account", slog.String("error", err.Error()))
+		}
+	}
+}
+
+func (m *Manager) notify(ctx context.Context, acc *account.AccountWithEdges, set *settings.Settings, at time.Time) error {
+	address, ok := notify_mail.Recipient(acc)
+	if !ok {
+		return nil
+	}
+
+	instanceTitle := set.Title.Or(settings.DefaultTitle)
+	subject := fmt.Sprintf("Your %s account will be deleted", instanceTitle)
+
+	intros := []string{
+		subject + ".",
+		fmt.Sprintf("Your account will be deleted on %s. If you change your mind, sign in and cancel the deletion from your account settings before then.", at.UTC().Format(time.RFC1123)),
+	}
+
+	return m.mailqueue.Queue(ctx, address, acc.Name, subject, intros, nil)
+}
@@ -0,0 +1,31 @@
+package account_export
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func runExportConsumer(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	bus *pubsub.Bus,
+	exporter *Exporter,
+) {
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		_, err := pubsub.SubscribeCommand(ctx, bus, "account_export.export", func(ctx context.Context, cmd *message.CommandAccountExport) error {
+			if err := exporter.Export(ctx, cmd.ID); err != nil {
+				logger.Error("failed to export account data", slog.String("account_id", cmd.ID.String()), slog.String("error", err.Error()))
+				return err
+			}
+			return nil
+		})
+
+		return err
+	}))
+}
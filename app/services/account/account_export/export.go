@@ -0,0 +1,288 @@
+// Package account_export builds a downloadable archive of everything stored
+// about a member. Archives are built in the background, the member is told by
+// notification and email once theirs is ready to download.
+package account_export
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+	"path"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/matcornic/hermes/v2"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_data"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/avatar"
+	"github.com/Southclaws/storyden/app/services/comms/mailqueue"
+	"github.com/Southclaws/storyden/app/services/comms/mailtemplate"
+	"github.com/Southclaws/storyden/app/services/notification/notify"
+	"github.com/Southclaws/storyden/app/services/notification/notify_mail"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+// exportsDirectory holds the latest archive for each member, it's replaced by
+// each new export and removed when the account is deleted.
+const exportsDirectory = "exports"
+
+func Path(id account.AccountID) string {
+	return path.Join(exportsDirectory, id.String()+".zip")
+}
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(runExportConsumer),
+	)
+}
+
+type Exporter struct {
+	logger       *slog.Logger
+	collector    *account_data.Collector
+	accountQuery *account_querier.Querier
+	settings     *settings.SettingsRepository
+	avatar       avatar.Service
+	store        object.Storer
+	bus          *pubsub.Bus
+	notifier     *notify.Notifier
+	mailqueue    *mailqueue.Queuer
+	apiAddress   url.URL
+}
+
+func New(
+	cfg config.Config,
+	logger *slog.Logger,
+	collector *account_data.Collector,
+	accountQuery *account_querier.Querier,
+	settings *settings.SettingsRepository,
+	avatar avatar.Service,
+	store object.Storer,
+	bus *pubsub.Bus,
+	notifier *notify.Notifier,
+	mailqueue *mailqueue.Queuer,
+) *Exporter {
+	return &Exporter{
+		logger:       logger.With(slog.String("service", "account_export")),
+		collector:    collector,
+		accountQuery: accountQuery,
+		settings:     settings,
+		avatar:       avatar,
+		store:        store,
+		bus:          bus,
+		notifier:     notifier,
+		mailqueue:    mailqueue,
+		apiAddress:   cfg.PublicAPIAddress,
+	}
+}
+
+// Request queues an export of the account, the member is notified when the
+// archive is ready.
+func (e *Exporter) Request(ctx context.Context, id account.AccountID) error {
+	if err := e.bus.SendCommand(ctx, &message.CommandAccountExport{ID: id}); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Get opens the most recent export of the account.
+func (e *Exporter) Get(ctx context.Context, id account.AccountID) (io.Reader, int64, error) {
+	exists, err := e.store.Exists(ctx, Path(id))
+	if err != nil {
+		return nil, 0, fault.Wrap(err, fctx.With(ctx))
+	}
+	if !exists {
+		return nil, 0, fault.New("no data export", fctx.With(ctx), ftag.With(ftag.NotFound),
+			fmsg.WithDesc("no export", "There's no export of your data yet, request one first."))
+	}
+
+	r, size, err := e.store.Read(ctx, Path(id))
+	if err != nil {
+		return nil, 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r, size, nil
+}
+
+// Export builds the archive, replacing any previous export, and lets the
+// member know it's ready.
+func (e *Exporter) Export(ctx context.Context, id account.AccountID) error {
+	data, err := e.collector.Collect(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Uploads can be large so the archive is spooled to disk, not memory.
+	f, err := os.CreateTemp("", "storyden-export-*.zip")
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := e.write(ctx, f, id, data); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := e.store.Write(ctx, Path(id), f, size); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := e.notifier.Send(ctx, id, opt.NewEmpty[account.AccountID](), notification.EventDataExportReady, nil); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// The export is ready regardless of whether the member could be emailed.
+	if err := e.mail(ctx, id); err != nil {
+		e.logger.Warn("failed to email member about their data export",
+			slog.String("account_id", id.String()),
+			slog.String("error", err.Error()))
+	}
+
+	return nil
+}
+
+// write lays out the archive as one JSON file for each kind of data along with
+// every file the member uploaded and their avatar.
+func (e *Exporter) write(ctx context.Context, w io.Writer, id account.AccountID, data *account_data.Data) error {
+	zw := zip.NewWriter(w)
+
+	files := []struct {
+		name string
+		v    any
+	}{
+		{"profile.json", data.Profile},
+		{"authentication.json", data.Authentication},
+		{"posts.json", data.Posts},
+		{"reacts.json", data.Reacts},
+		{"likes.json", data.Likes},
+		{"collections.json", data.Collections},
+		{"nodes.json", data.Nodes},
+		{"notifications.json", data.Notifications},
+		{"messages.json", data.Messages},
+		{"assets.json", data.Assets},
+	}
+
+	for _, f := range files {
+		fw, err := zw.Create(f.name)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		enc := json.NewEncoder(fw)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(f.v); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	for _, a := range data.Assets {
+		r, _, err := e.store.Read(ctx, a.Path)
+		if err != nil {
+			// The record outlived its file, the metadata is still exported.
+			e.logger.Warn("failed to read asset for data export",
+				slog.String("asset_id", a.ID),
+				slog.String("error", err.Error()))
+			continue
+		}
+
+		if err := copyFile(zw, path.Join("assets", a.Filename), r); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if e.avatar.Exists(ctx, id) {
+		r, _, err := e.avatar.Get(ctx, id)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if err := copyFile(zw, "avatar.png", r); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func copyFile(zw *zip.Writer, name string, r io.Reader) error {
+	if c, ok := r.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	fw, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+	if err != nil {
+		return fault.Wrap(err)
+	}
+
+	if _, err := io.Copy(fw, r); err != nil {
+		return fault.Wrap(err)
+	}
+
+	return nil
+}
+
+func (e *Exporter) mail(ctx context.Context, id account.AccountID) error {
+	acc, err := e.accountQuery.GetByID(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	address, ok := notify_mail.Recipient(acc)
+	if !ok {
+		return nil
+	}
+
+	set, err := e.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	instanceTitle := set.Title.Or(settings.DefaultTitle)
+	subject := fmt.Sprintf("Your %s data export is ready", instanceTitle)
+
+	intros := []string{
+		subject + ".",
+		"It contains your profile, everything you've posted and every file you've uploaded. You'll need to be signed in to download it.",
+	}
+	actions := []mailtemplate.Action{
+		{
+			Instructions: "To download it, click here:",
+			Button: hermes.Button{
+				Text: "Download",
+				Link: e.apiAddress.JoinPath("/api/accounts/self/data-export").String(),
+			},
+		},
+	}
+
+	return e.mailqueue.Queue(ctx, address, acc.Name, subject, intros, actions)
+}
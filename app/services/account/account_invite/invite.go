@@ -0,0 +1,137 @@
+package account_invite
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account/invitation"
+	"github.com/Southclaws/storyden/app/resources/account/invitation/invitation_querier"
+	"github.com/Southclaws/storyden/app/resources/account/invitation/invitation_writer"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/account/role/role_querier"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+)
+
+var (
+	errDefaultRole      = fault.New("default roles cannot be granted by invitation")
+	errInvalidMaxUses   = fault.New("max uses must be at least one")
+	errInvalidExpiry    = fault.New("expiry must be in the future")
+	errNotInviteCreator = fault.New("not the creator of this invitation")
+)
+
+type Manager struct {
+	roleQuerier *role_querier.Querier
+	invQuerier  *invitation_querier.Querier
+	invWriter   *invitation_writer.Writer
+}
+
+func New(
+	roleQuerier *role_querier.Querier,
+	invQuerier *invitation_querier.Querier,
+	invWriter *invitation_writer.Writer,
+) *Manager {
+	return &Manager{
+		roleQuerier: roleQuerier,
+		invQuerier:  invQuerier,
+		invWriter:   invWriter,
+	}
+}
+
+type Params struct {
+	Message   opt.Optional[string]
+	ExpiresAt opt.Optional[time.Time]
+	MaxUses   opt.Optional[int]
+	Roles     []role.RoleID
+}
+
+// Create issues an invitation from the session account. Only members who can
+// manage roles may create invitations which grant roles on registration.
+func (m *Manager) Create(ctx context.Context, p Params) (*invitation.Invitation, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	opts := []invitation_writer.Option{}
+
+	if n, ok := p.MaxUses.Get(); ok {
+		if n < 1 {
+			return nil, fault.Wrap(errInvalidMaxUses, fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+				fmsg.WithDesc("invalid max uses", "An invitation must allow at least one use."))
+		}
+		opts = append(opts, invitation_writer.WithMaxUses(n))
+	}
+
+	if t, ok := p.ExpiresAt.Get(); ok {
+		if !t.After(time.Now()) {
+			return nil, fault.Wrap(errInvalidExpiry, fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+				fmsg.WithDesc("invalid expiry", "An invitation's expiry time must be in the future."))
+		}
+		opts = append(opts, invitation_writer.WithExpiresAt(t))
+	}
+
+	if len(p.Roles) > 0 {
+		if err := session.Authorise(ctx, nil, rbac.PermissionManageRoles); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		for _, id := range p.Roles {
+			if id == role.DefaultRoleGuestID || id == role.DefaultRoleMemberID || id == role.DefaultRoleAdminID {
+				return nil, fault.Wrap(errDefaultRole, fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+					fmsg.WithDesc("default role", "Built-in roles cannot be granted by an invitation."))
+			}
+
+			if _, err := m.roleQuerier.Get(ctx, id); err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx))
+			}
+		}
+
+		opts = append(opts, invitation_writer.WithRoles(p.Roles...))
+	}
+
+	inv, err := m.invWriter.Create(ctx, accountID, p.Message, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return inv, nil
+}
+
+// Revoke stops an invitation from being used for any further registrations.
+// Only the invitation's creator or an administrator may revoke it.
+func (m *Manager) Revoke(ctx context.Context, id xid.ID) (*invitation.Invitation, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	inv, err := m.invQuerier.GetByID(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = session.Authorise(ctx, func() error {
+		if inv.Creator.ID == accountID {
+			return nil
+		}
+		return fault.Wrap(errNotInviteCreator, fctx.With(ctx), ftag.With(ftag.PermissionDenied))
+	}, rbac.PermissionAdministrator)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	inv, err = m.invWriter.Revoke(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return inv, nil
+}
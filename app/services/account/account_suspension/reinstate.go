@@ -0,0 +1,35 @@
+package account_suspension
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/infrastructure/schedule"
+)
+
+// reinstateInterval is how often expired suspensions are lifted, so a member
+// may wait at most this long past their suspension's end to sign in again.
+const reinstateInterval = time.Minute
+
+func runReinstater(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	svc Service,
+) {
+	schedule.Every(ctx, lc, logger, "account_reinstate", reinstateInterval, func(ctx context.Context) error {
+		n, err := svc.ReinstateExpired(ctx)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		if n > 0 {
+			logger.Info("reinstated accounts with expired suspensions", slog.Int("count", n))
+		}
+		return nil
+	})
+}
@@ -2,62 +2,233 @@ package account_suspension
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
 	"go.uber.org/fx"
 
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/account/account_writer"
 	authentication_repo "github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/settings"
 	"github.com/Southclaws/storyden/app/services/authentication"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/comms/mailqueue"
+	"github.com/Southclaws/storyden/app/services/notification/notify_mail"
 )
 
+var errExpiryInPast = fault.New("suspension expiry is in the past", ftag.With(ftag.InvalidArgument))
+
 type Service interface {
-	Suspend(ctx context.Context, id account.AccountID) (*account.AccountWithEdges, error)
+	Suspend(ctx context.Context, id account.AccountID, by account.AccountID, opts SuspendOptions) (*account.AccountWithEdges, error)
 	Reinstate(ctx context.Context, id account.AccountID) (*account.AccountWithEdges, error)
+
+	// ReinstateExpired lifts every suspension which has passed its expiry.
+	ReinstateExpired(ctx context.Context) (int, error)
+}
+
+// SuspendOptions are the optional details of a suspension. Without an expiry,
+// the suspension lasts until a member of staff reinstates the account.
+type SuspendOptions struct {
+	Reason opt.Optional[string]
+	Until  opt.Optional[time.Time]
 }
 
 func Build() fx.Option {
-	return fx.Provide(New)
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(runReinstater),
+	)
 }
 
 type service struct {
+	logger         *slog.Logger
 	auth_repo      authentication_repo.Repository
+	account_query  *account_querier.Querier
 	account_writer *account_writer.Writer
+	audit_writer   *audit_writer.Writer
+	settings       *settings.SettingsRepository
+	mailqueue      *mailqueue.Queuer
 
 	auth_svc *authentication.Manager
 }
 
 func New(
+	logger *slog.Logger,
 	auth_repo authentication_repo.Repository,
+	account_query *account_querier.Querier,
 	account_writer *account_writer.Writer,
+	audit_writer *audit_writer.Writer,
+	settings *settings.SettingsRepository,
+	mailqueue *mailqueue.Queuer,
 
 	auth_svc *authentication.Manager,
 ) Service {
 	return &service{
+		logger:         logger.With(slog.String("service", "account_suspension")),
 		auth_repo:      auth_repo,
+		account_query:  account_query,
 		account_writer: account_writer,
+		audit_writer:   audit_writer,
+		settings:       settings,
+		mailqueue:      mailqueue,
 		auth_svc:       auth_svc,
 	}
 }
 
-func (s *service) Suspend(ctx context.Context, id account.AccountID) (*account.AccountWithEdges, error) {
-	acc, err := s.account_writer.Update(ctx, id, account_writer.SetDeleted(opt.New(time.Now())))
+func (s *service) Suspend(ctx context.Context, id account.AccountID, by account.AccountID, opts SuspendOptions) (*account.AccountWithEdges, error) {
+	now := time.Now()
+
+	if until, ok := opts.Until.Get(); ok && !until.After(now) {
+		return nil, fault.Wrap(errExpiryInPast, fctx.With(ctx), fmsg.WithDesc("expiry in past", "The suspension must end at some point in the future."))
+	}
+
+	acc, err := s.account_writer.Update(ctx, id,
+		account_writer.SetDeleted(opt.New(now)),
+		account_writer.SetSuspension(account.Suspension{
+			Reason:      opts.Reason,
+			Until:       opts.Until,
+			SuspendedBy: opt.New(by),
+		}),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = s.audit_writer.Record(ctx, opt.New(by), audit.ActionAccountSuspended,
+		audit_writer.WithTarget(profileRef(id)),
+		audit_writer.WithAfter(summarise(opts)),
+	)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	// The suspension stands regardless of whether the member could be told.
+	if err := s.notify(ctx, acc, opts); err != nil {
+		s.logger.Warn("failed to notify suspended member",
+			slog.String("account_id", id.String()),
+			slog.String("error", err.Error()))
+	}
+
 	return acc, nil
 }
 
 func (s *service) Reinstate(ctx context.Context, id account.AccountID) (*account.AccountWithEdges, error) {
-	acc, err := s.account_writer.Update(ctx, id, account_writer.SetDeleted(opt.NewEmpty[time.Time]()))
+	acc, err := s.account_writer.Update(ctx, id,
+		account_writer.SetDeleted(opt.NewEmpty[time.Time]()),
+		account_writer.ClearSuspension(),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// When reinstated automatically after expiry, there's no session.
+	err = s.audit_writer.Record(ctx, session.GetOptAccountID(ctx), audit.ActionAccountReinstated,
+		audit_writer.WithTarget(profileRef(id)),
+	)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
 	return acc, nil
 }
+
+// ReinstateExpired is safe to run on several instances at once, each account
+// is only reinstated, and audited, by whichever instance lifts it first.
+func (s *service) ReinstateExpired(ctx context.Context) (int, error) {
+	expired, err := s.account_query.ListExpiredSuspensions(ctx, time.Now())
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	reinstated := 0
+	for _, acc := range expired {
+		suspension, ok := acc.Suspension.Get()
+		if !ok {
+			continue
+		}
+		until, ok := suspension.Until.Get()
+		if !ok {
+			continue
+		}
+
+		lifted, err := s.account_writer.ReinstateExpired(ctx, acc.ID, until)
+		if err != nil {
+			s.logger.Warn("failed to reinstate account after suspension expired",
+				slog.String("account_id", acc.ID.String()),
+				slog.String("error", err.Error()))
+			continue
+		}
+		if !lifted {
+			continue
+		}
+
+		err = s.audit_writer.Record(ctx, opt.NewEmpty[account.AccountID](), audit.ActionAccountReinstated,
+			audit_writer.WithTarget(profileRef(acc.ID)),
+		)
+		if err != nil {
+			return reinstated, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		reinstated++
+	}
+
+	return reinstated, nil
+}
+
+func profileRef(id account.AccountID) datagraph.Ref {
+	return datagraph.Ref{ID: xid.ID(id), Kind: datagraph.KindProfile}
+}
+
+func summarise(opts SuspendOptions) string {
+	summary := "until: indefinite"
+	if until, ok := opts.Until.Get(); ok {
+		summary = "until: " + until.UTC().Format(time.RFC3339)
+	}
+
+	if reason, ok := opts.Reason.Get(); ok {
+		summary = "reason: " + reason + ", " + summary
+	}
+
+	return summary
+}
+
+func (s *service) notify(ctx context.Context, acc *account.AccountWithEdges, opts SuspendOptions) error {
+	address, ok := notify_mail.Recipient(acc)
+	if !ok {
+		return nil
+	}
+
+	set, err := s.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	instanceTitle := set.Title.Or(settings.DefaultTitle)
+	subject := fmt.Sprintf("Your %s account has been suspended", instanceTitle)
+
+	intros := []string{subject + "."}
+
+	if reason, ok := opts.Reason.Get(); ok {
+		intros = append(intros, "Reason: "+reason)
+	}
+
+	if until, ok := opts.Until.Get(); ok {
+		intros = append(intros, fmt.Sprintf("The suspension ends on %s, after which you will be able to sign in again.", until.UTC().Format(time.RFC1123)))
+	} else {
+		intros = append(intros, "The suspension has no end date.")
+	}
+
+	return s.mailqueue.Queue(ctx, address, acc.Name, subject, intros, nil)
+}
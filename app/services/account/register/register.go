@@ -5,11 +5,14 @@ import (
 	"log/slog"
 	"net/mail"
 
+	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
 	petname "github.com/dustinkirkland/golang-petname"
+	"github.com/rs/xid"
 	"github.com/samber/lo"
 
 	"github.com/Southclaws/storyden/app/resources/account"
@@ -17,8 +20,13 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/account_writer"
 	"github.com/Southclaws/storyden/app/resources/account/authentication"
 	"github.com/Southclaws/storyden/app/resources/account/email"
+	"github.com/Southclaws/storyden/app/resources/account/invitation/invitation_querier"
+	"github.com/Southclaws/storyden/app/resources/account/invitation/invitation_writer"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/account/role/role_assign"
 	"github.com/Southclaws/storyden/app/resources/mark"
 	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/settings"
 	"github.com/Southclaws/storyden/app/services/authentication/email_verify"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/services/onboarding"
@@ -31,6 +39,7 @@ var (
 	errAccountMismatch         = fault.New("account mismatch")
 	errEmailNotVerified        = fault.New("email not verified")
 	errAuthMethodAlreadyLinked = fault.New("authentication method already linked to another account")
+	errInvitationRequired      = fault.New("registration requires an invitation")
 )
 
 type Registrar struct {
@@ -41,6 +50,10 @@ type Registrar struct {
 	emailVerify    *email_verify.Verifier
 	authRepo       authentication.Repository
 	onboarding     onboarding.Service
+	settings       *settings.SettingsRepository
+	invQuerier     *invitation_querier.Querier
+	invWriter      *invitation_writer.Writer
+	roleAssign     *role_assign.Assignment
 	bus            *pubsub.Bus
 }
 
@@ -52,6 +65,10 @@ func New(
 	emailVerify *email_verify.Verifier,
 	authRepo authentication.Repository,
 	onboarding onboarding.Service,
+	settings *settings.SettingsRepository,
+	invQuerier *invitation_querier.Querier,
+	invWriter *invitation_writer.Writer,
+	roleAssign *role_assign.Assignment,
 	bus *pubsub.Bus,
 ) *Registrar {
 	return &Registrar{
@@ -62,11 +79,23 @@ func New(
 		emailVerify:    emailVerify,
 		authRepo:       authRepo,
 		onboarding:     onboarding,
+		settings:       settings,
+		invQuerier:     invQuerier,
+		invWriter:      invWriter,
+		roleAssign:     roleAssign,
 		bus:            bus,
 	}
 }
 
 func (s *Registrar) Create(ctx context.Context, handle opt.Optional[string], opts ...account_writer.Option) (*account.Account, error) {
+	return s.CreateWithInvitation(ctx, handle, opt.NewEmpty[xid.ID](), opts...)
+}
+
+// CreateWithInvitation registers a new account, optionally via an invitation.
+// Using an invitation consumes one of its uses and grants the account any roles
+// the invitation was created with. When the instance is invite-only, accounts
+// may only be created with a valid invitation.
+func (s *Registrar) CreateWithInvitation(ctx context.Context, handle opt.Optional[string], invitationID opt.Optional[xid.ID], opts ...account_writer.Option) (*account.Account, error) {
 	status, err := s.onboarding.GetOnboardingStatus(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err,
@@ -77,6 +106,18 @@ func (s *Registrar) Create(ctx context.Context, handle opt.Optional[string], opt
 	if status == &onboarding.StatusRequiresFirstAccount {
 		// If we're doing first-time-setup then set the first account to admin.
 		opts = append(opts, account_writer.WithAdmin(true))
+	} else if !invitationID.Ok() {
+		settings, err := s.settings.Get(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if settings.InviteOnly.OrZero() {
+			return nil, fault.Wrap(errInvitationRequired,
+				fctx.With(ctx),
+				ftag.With(ftag.PermissionDenied),
+				fmsg.WithDesc("invite only", "Registration is by invitation only."))
+		}
 	}
 
 	// If no handle was given, generate one using adjective-animal.
@@ -86,13 +127,36 @@ func (s *Registrar) Create(ctx context.Context, handle opt.Optional[string], opt
 		return nil, err
 	}
 
+	if id, ok := invitationID.Get(); ok {
+		if err := s.invWriter.Claim(ctx, id); err != nil {
+			return nil, fault.Wrap(err,
+				fctx.With(ctx),
+				fmsg.WithDesc("invitation unavailable", "This invitation has expired, been revoked or has already been used."))
+		}
+
+		opts = append(opts, account_writer.WithInvitedBy(id))
+	}
+
 	acc, err := s.accountWriter.Create(ctx, handleOrGenerated, opts...)
 	if err != nil {
+		invitationID.Call(func(id xid.ID) {
+			if err := s.invWriter.Release(ctx, id); err != nil {
+				s.logger.Error("failed to release invitation use", slog.String("error", err.Error()))
+			}
+		})
+
 		return nil, fault.Wrap(err,
 			fctx.With(ctx),
 			fmsg.WithDesc("failed to create account", "Unable to create your account."))
 	}
 
+	if id, ok := invitationID.Get(); ok {
+		acc, err = s.grantInvitationRoles(ctx, id, acc)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	s.bus.Publish(ctx, &message.EventAccountCreated{
 		ID: acc.Account.ID,
 	})
@@ -100,6 +164,26 @@ func (s *Registrar) Create(ctx context.Context, handle opt.Optional[string], opt
 	return &acc.Account, nil
 }
 
+func (s *Registrar) grantInvitationRoles(ctx context.Context, id xid.ID, acc *account.AccountWithEdges) (*account.AccountWithEdges, error) {
+	inv, err := s.invQuerier.GetByID(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(inv.Roles) == 0 {
+		return acc, nil
+	}
+
+	mutations := dt.Map(inv.Roles, func(r *role.Role) role_assign.Mutation { return role_assign.Add(r.ID) })
+
+	updated, err := s.roleAssign.UpdateRoles(ctx, opt.NewEmpty[account.AccountID](), acc.ID, mutations...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return updated, nil
+}
+
 // GetOrCreateViaEmail is intended to be used for just OAuth2 providers. It will
 // cover cases for existing auth records, existing emails and ensure accounts
 // are linked correctly if necessary and also ensure mismatches are handled.
@@ -2,10 +2,16 @@ package settings_manager
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/rbac"
 	"github.com/Southclaws/storyden/app/resources/settings"
@@ -14,14 +20,16 @@ import (
 )
 
 type Manager struct {
-	repo *settings.SettingsRepository
-	bus  *pubsub.Bus
+	repo        *settings.SettingsRepository
+	auditWriter *audit_writer.Writer
+	bus         *pubsub.Bus
 }
 
-func New(repo *settings.SettingsRepository, bus *pubsub.Bus) *Manager {
+func New(repo *settings.SettingsRepository, auditWriter *audit_writer.Writer, bus *pubsub.Bus) *Manager {
 	return &Manager{
-		repo: repo,
-		bus:  bus,
+		repo:        repo,
+		auditWriter: auditWriter,
+		bus:         bus,
 	}
 }
 
@@ -39,14 +47,101 @@ func (m *Manager) Set(ctx context.Context, s settings.Settings) (*settings.Setti
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	current, err := m.repo.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Summarise before writing, the repository merges into the cached value.
+	before := summarise(current, s)
+
 	updated, err := m.repo.Set(ctx, s)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	after := summarise(updated, s)
+
+	if b, a, changed := diff(before, after); changed {
+		err = m.auditWriter.Record(ctx, session.GetOptAccountID(ctx), audit.ActionSettingsUpdated,
+			audit_writer.WithBefore(b),
+			audit_writer.WithAfter(a),
+		)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	m.bus.Publish(ctx, &message.EventSettingsUpdated{
 		Settings: updated,
 	})
 
 	return updated, nil
 }
+
+// summarise renders the fields of s which are present in the partial update.
+func summarise(s *settings.Settings, partial settings.Settings) map[string]string {
+	out := map[string]string{}
+
+	if partial.Title.Ok() {
+		out["title"] = s.Title.OrZero()
+	}
+	if partial.Description.Ok() {
+		out["description"] = s.Description.OrZero()
+	}
+	if partial.Content.Ok() {
+		if c, ok := s.Content.Get(); ok {
+			out["content"] = c.Short()
+		} else {
+			out["content"] = ""
+		}
+	}
+	if partial.AccentColour.Ok() {
+		out["accent_colour"] = s.AccentColour.OrZero()
+	}
+	if partial.Public.Ok() {
+		out["public"] = fmt.Sprint(s.Public.OrZero())
+	}
+	if partial.AuthenticationMode.Ok() {
+		out["authentication_mode"] = s.AuthenticationMode.OrZero().String()
+	}
+	if partial.InviteOnly.Ok() {
+		out["invite_only"] = fmt.Sprint(s.InviteOnly.OrZero())
+	}
+	if partial.TwoFactorRequired.Ok() {
+		out["two_factor_required"] = fmt.Sprint(s.TwoFactorRequired.OrZero())
+	}
+	if partial.AccountDeletion.Ok() {
+		b, _ := json.Marshal(s.AccountDeletion)
+		out["account_deletion"] = string(b)
+	}
+	if partial.Services.Ok() {
+		b, _ := json.Marshal(s.Services)
+		out["services"] = string(b)
+	}
+	if partial.Metadata.Ok() {
+		b, _ := json.Marshal(s.Metadata)
+		out["metadata"] = string(b)
+	}
+
+	return out
+}
+
+func diff(before, after map[string]string) (string, string, bool) {
+	keys := []string{}
+	for k := range after {
+		if before[k] != after[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	b := make([]string, 0, len(keys))
+	a := make([]string, 0, len(keys))
+	for _, k := range keys {
+		b = append(b, k+": "+before[k])
+		a = append(a, k+": "+after[k])
+	}
+
+	return strings.Join(b, "\n"), strings.Join(a, "\n"), len(keys) > 0
+}
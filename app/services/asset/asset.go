@@ -6,12 +6,14 @@ import (
 	"github.com/Southclaws/storyden/app/services/asset/analyse"
 	"github.com/Southclaws/storyden/app/services/asset/analyse_job"
 	"github.com/Southclaws/storyden/app/services/asset/asset_download"
+	"github.com/Southclaws/storyden/app/services/asset/asset_gc"
 	"github.com/Southclaws/storyden/app/services/asset/asset_upload"
 )
 
 func Build() fx.Option {
 	return fx.Options(
 		analyse_job.Build(),
+		asset_gc.Build(),
 		fx.Provide(
 			analyse.New,
 			asset_upload.New,
@@ -0,0 +1,163 @@
+// Package asset_gc removes files from object storage which are no longer used.
+// There are two kinds of garbage: asset records which nothing references any
+// more and stored files which have no asset record at all. Both are only
+// collected once they are older than the configured grace period so uploads
+// which are yet to be attached to a post or page are left alone.
+package asset_gc
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/asset/asset_querier"
+	"github.com/Southclaws/storyden/app/resources/asset/asset_writer"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+)
+
+type Collector struct {
+	logger  *slog.Logger
+	grace   time.Duration
+	querier *asset_querier.Querier
+	writer  *asset_writer.Writer
+	objects object.Storer
+}
+
+func New(
+	cfg config.Config,
+	logger *slog.Logger,
+	querier *asset_querier.Querier,
+	writer *asset_writer.Writer,
+	objects object.Storer,
+) *Collector {
+	return &Collector{
+		logger:  logger.With(slog.String("service", "asset_gc")),
+		grace:   cfg.AssetGCGracePeriod,
+		querier: querier,
+		writer:  writer,
+		objects: objects,
+	}
+}
+
+// Report describes what a collection removed or, for a dry run, would remove.
+type Report struct {
+	DryRun  bool
+	Assets  []*asset.Asset
+	Objects []object.Object
+	Bytes   int64
+}
+
+func (c *Collector) Collect(ctx context.Context, dryRun bool) (*Report, error) {
+	cutoff := time.Now().Add(-c.grace)
+
+	report := &Report{DryRun: dryRun}
+
+	assets, err := c.querier.ListUnreferenced(ctx, cutoff)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	collected := map[string]bool{}
+	for _, a := range assets {
+		if !dryRun {
+			if err := c.removeAsset(ctx, a); err != nil {
+				if ftag.Get(err) == ftag.NotFound {
+					// Another instance collected it first.
+					continue
+				}
+				c.logger.Warn("failed to remove orphaned asset",
+					slog.String("asset_id", a.ID.String()),
+					slog.String("error", err.Error()))
+				continue
+			}
+		}
+
+		collected[asset.BuildAssetPath(a.Name)] = true
+		report.Assets = append(report.Assets, a)
+		report.Bytes += int64(a.Size)
+	}
+
+	objects, err := c.listOrphanedObjects(ctx, cutoff)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, o := range objects {
+		// In a dry run, the files of unreferenced assets are still present and
+		// would otherwise be reported twice.
+		if collected[o.Path] {
+			continue
+		}
+
+		if !dryRun {
+			if err := c.objects.Delete(ctx, o.Path); err != nil {
+				c.logger.Warn("failed to remove orphaned object",
+					slog.String("path", o.Path),
+					slog.String("error", err.Error()))
+				continue
+			}
+		}
+
+		report.Objects = append(report.Objects, o)
+		report.Bytes += o.Size
+	}
+
+	c.logger.Info("asset garbage collection complete",
+		slog.Bool("dry_run", dryRun),
+		slog.Int("assets", len(report.Assets)),
+		slog.Int("objects", len(report.Objects)),
+		slog.Int64("bytes", report.Bytes),
+	)
+
+	return report, nil
+}
+
+func (c *Collector) removeAsset(ctx context.Context, a *asset.Asset) error {
+	// The record goes first so a failure to delete the file leaves an object
+	// without a record, which the next collection will pick up.
+	if err := c.writer.Delete(ctx, a.ID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := c.objects.Delete(ctx, asset.BuildAssetPath(a.Name)); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// listOrphanedObjects returns stored asset files older than the cutoff which
+// have no corresponding asset record.
+func (c *Collector) listOrphanedObjects(ctx context.Context, cutoff time.Time) ([]object.Object, error) {
+	stored, err := c.objects.List(ctx, asset.AssetsSubdirectory+"/")
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	stored = dt.Filter(stored, func(o object.Object) bool {
+		return o.Modified.Before(cutoff)
+	})
+
+	filenames := dt.Map(stored, objectFilename)
+
+	exists, err := c.querier.FilenamesExist(ctx, filenames)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return dt.Filter(stored, func(o object.Object) bool {
+		return !exists[objectFilename(o)]
+	}), nil
+}
+
+func objectFilename(o object.Object) string {
+	return strings.TrimPrefix(o.Path, asset.AssetsSubdirectory+"/")
+}
@@ -0,0 +1,39 @@
+package asset_gc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/schedule"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(runPeriodically),
+	)
+}
+
+// runPeriodically runs a collection every ASSET_GC_INTERVAL for the lifetime
+// of the process. Running on multiple instances at once is safe, an asset is
+// only collected by whichever instance deletes its record first and deleting a
+// file which is already gone is not an error.
+func runPeriodically(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	cfg config.Config,
+	logger *slog.Logger,
+	c *Collector,
+) {
+	schedule.Every(ctx, lc, logger, "asset_gc", cfg.AssetGCInterval, func(ctx context.Context) error {
+		if _, err := c.Collect(ctx, false); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		return nil
+	})
+}
@@ -21,6 +21,12 @@ type Provider interface {
 	Token() authentication.TokenType
 }
 
+// SecondFactorProvider is implemented by providers which can only be used in
+// addition to another provider, such as TOTP, and so are not sign in options.
+type SecondFactorProvider interface {
+	SecondFactor()
+}
+
 type OAuthProvider interface {
 	// Link will, for providers that support it, provide a URL to a third-party
 	// authenticator. OAuth providers use this to start the authentication flow.
@@ -119,9 +119,8 @@ func (p *Provider) Register(ctx context.Context, email mail.Address, handle opt.
 	}
 
 	opts := []account_writer.Option{}
-	inviteCode.Call(func(id xid.ID) { opts = append(opts, account_writer.WithInvitedBy(id)) })
 
-	account, err := p.register.Create(ctx, handle, opts...)
+	account, err := p.register.CreateWithInvitation(ctx, handle, inviteCode, opts...)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to create account"))
 	}
@@ -42,9 +42,8 @@ func (p *Provider) RegisterWithHandle(ctx context.Context, handle string, passwo
 	}
 
 	opts := []account_writer.Option{}
-	inviteCode.Call(func(id xid.ID) { opts = append(opts, account_writer.WithInvitedBy(id)) })
 
-	account, err := p.register.Create(ctx, opt.New(handle), opts...)
+	account, err := p.register.CreateWithInvitation(ctx, opt.New(handle), inviteCode, opts...)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to create account"))
 	}
@@ -0,0 +1,221 @@
+package totp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+)
+
+var ErrChallengeInvalid = fault.New("two-factor challenge invalid or expired", ftag.With(ftag.Unauthenticated))
+
+const (
+	challengeTTL         = 10 * time.Minute
+	challengeMaxAttempts = 5
+	challengeKeyPrefix   = "totp:challenge:"
+)
+
+// Challenge is handed to the client after a successful first factor in place
+// of a session. The token is then exchanged, along with a code, for a session.
+type Challenge struct {
+	Token             string
+	Expires           time.Time
+	EnrolmentRequired bool
+}
+
+type pendingChallenge struct {
+	AccountID string    `json:"a"`
+	Expires   time.Time `json:"e"`
+	Attempts  int       `json:"n"`
+}
+
+// Verified is the result of a successfully met challenge. If the challenge
+// also completed an enrolment, the new recovery codes are included.
+type Verified struct {
+	AccountID     account.AccountID
+	RecoveryCodes opt.Optional[[]string]
+}
+
+// Challenge decides whether a sign-in for the account must be completed with
+// a second factor. When it must, a challenge is returned and the caller must
+// not issue a session. Accounts which are required to use a second factor but
+// have not yet set one up are challenged to enrol one as part of signing in.
+func (p *Provider) Challenge(ctx context.Context, accountID account.AccountID) (opt.Optional[Challenge], error) {
+	enrolled, err := p.Enrolled(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !enrolled {
+		required, err := p.Required(ctx, accountID)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if !required {
+			return opt.NewEmpty[Challenge](), nil
+		}
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ch := Challenge{
+		Token:             base64.RawURLEncoding.EncodeToString(b),
+		Expires:           time.Now().Add(challengeTTL),
+		EnrolmentRequired: !enrolled,
+	}
+
+	err = p.storeChallenge(ctx, ch.Token, pendingChallenge{
+		AccountID: accountID.String(),
+		Expires:   ch.Expires,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return opt.New(ch), nil
+}
+
+// EnrolWithChallenge starts an enrolment for an account which was challenged
+// to enrol during sign-in and therefore does not yet have a session.
+func (p *Provider) EnrolWithChallenge(ctx context.Context, token string) (*Enrolment, error) {
+	pc, err := p.getChallenge(ctx, token)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	accountID, err := xid.FromString(pc.AccountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	e, err := p.Enrol(ctx, account.AccountID(accountID))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return e, nil
+}
+
+// Verify meets a challenge with either a code from the authenticator app or a
+// recovery code. If the challenge was an enrolment, the code also confirms the
+// enrolment. A challenge is discarded once met or after too many attempts.
+func (p *Provider) Verify(ctx context.Context, token string, code string) (*Verified, error) {
+	pc, err := p.getChallenge(ctx, token)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	id, err := xid.FromString(pc.AccountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	accountID := account.AccountID(id)
+
+	rec, exists, err := p.lookup(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !exists {
+		return nil, fault.Wrap(ErrNotEnrolled,
+			fctx.With(ctx),
+			fmsg.WithDesc("not enrolled", "Set up an authenticator app before entering a code."))
+	}
+
+	result := Verified{AccountID: accountID}
+
+	if rec.Disabled {
+		codes, err := p.activate(ctx, rec, code)
+		if err != nil {
+			return nil, p.fail(ctx, token, pc, err)
+		}
+
+		result.RecoveryCodes = opt.New(codes)
+	} else {
+		ok, err := p.verify(ctx, rec, code)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if !ok {
+			return nil, p.fail(ctx, token, pc, fault.Wrap(ErrCodeMismatch, fctx.With(ctx)))
+		}
+	}
+
+	if err := p.store.Delete(ctx, challengeKeyPrefix+token); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &result, nil
+}
+
+// fail records a failed attempt against the challenge, discarding it entirely
+// once the limit is reached so the first factor must be provided again.
+func (p *Provider) fail(ctx context.Context, token string, pc *pendingChallenge, cause error) error {
+	pc.Attempts++
+
+	var err error
+	if pc.Attempts >= challengeMaxAttempts {
+		err = p.store.Delete(ctx, challengeKeyPrefix+token)
+	} else {
+		err = p.storeChallenge(ctx, token, *pc)
+	}
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return cause
+}
+
+func (p *Provider) storeChallenge(ctx context.Context, token string, pc pendingChallenge) error {
+	b, err := json.Marshal(pc)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ttl := time.Until(pc.Expires)
+	if ttl <= 0 {
+		return fault.Wrap(ErrChallengeInvalid, fctx.With(ctx))
+	}
+
+	err = p.store.Set(ctx, challengeKeyPrefix+token, string(b), ttl)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (p *Provider) getChallenge(ctx context.Context, token string) (*pendingChallenge, error) {
+	raw, err := p.store.Get(ctx, challengeKeyPrefix+token)
+	if err != nil || raw == "" {
+		return nil, fault.Wrap(ErrChallengeInvalid,
+			fctx.With(ctx),
+			fmsg.WithDesc("challenge expired", "The sign in attempt has expired, please sign in again."))
+	}
+
+	var pc pendingChallenge
+	if err := json.Unmarshal([]byte(raw), &pc); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if time.Now().After(pc.Expires) {
+		return nil, fault.Wrap(ErrChallengeInvalid, fctx.With(ctx))
+	}
+
+	return &pc, nil
+}
@@ -0,0 +1,77 @@
+package totp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+
+	"github.com/Southclaws/fault"
+)
+
+const recoveryCodeCount = 10
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// generateRecoveryCodes creates a set of single-use codes formatted for humans
+// as "xxxxx-xxxxx" alongside their hashes, which is all that's stored. Codes
+// carry 50 bits of entropy so a fast hash is fine, unlike for passwords.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+
+	for i := range codes {
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, fault.Wrap(err)
+		}
+
+		raw := strings.ToLower(recoveryEncoding.EncodeToString(b))[:10]
+
+		codes[i] = raw[:5] + "-" + raw[5:]
+		hashes[i] = hashRecoveryCode(raw)
+	}
+
+	return codes, hashes, nil
+}
+
+func hashRecoveryCode(code string) string {
+	normalised := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(normalised))
+	return hex.EncodeToString(sum[:])
+}
+
+// recoveryCodeHashes reads the hashes back out of the record's metadata which,
+// having been through a JSON round trip, is a list of untyped values.
+func recoveryCodeHashes(v any) []string {
+	switch list := v.(type) {
+	case []string:
+		return list
+	case []any:
+		hashes := make([]string, 0, len(list))
+		for _, h := range list {
+			if s, ok := h.(string); ok {
+				hashes = append(hashes, s)
+			}
+		}
+		return hashes
+	}
+
+	return nil
+}
+
+// consumeRecoveryCode returns the remaining hashes if the code matches one.
+func consumeRecoveryCode(hashes []string, code string) ([]string, bool) {
+	hash := hashRecoveryCode(code)
+
+	for i, h := range hashes {
+		if subtle.ConstantTimeCompare([]byte(h), []byte(hash)) == 1 {
+			remaining := append([]string{}, hashes[:i]...)
+			return append(remaining, hashes[i+1:]...), true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,267 @@
+// Package totp provides time-based one-time password second factor support.
+// Unlike the other providers, TOTP cannot be used to sign in on its own. It's
+// enrolled by an already authenticated member and from then on, any password
+// or email sign-in for that account results in a challenge which must be met
+// with a code from the authenticator app (or a recovery code) before the
+// session is issued.
+package totp
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/authentication"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/internal/infrastructure/cache"
+	"github.com/Southclaws/storyden/internal/otp"
+)
+
+var (
+	ErrAlreadyEnrolled = fault.New("authenticator already enrolled", ftag.With(ftag.AlreadyExists))
+	ErrNotEnrolled     = fault.New("no authenticator enrolment in progress", ftag.With(ftag.InvalidArgument))
+	ErrCodeMismatch    = fault.New("two-factor code mismatch", ftag.With(ftag.PermissionDenied))
+	ErrRequired        = fault.New("two-factor authentication is required", ftag.With(ftag.PermissionDenied))
+)
+
+var (
+	service   = authentication.ServiceTOTP
+	tokenType = authentication.TokenTypeTOTP
+)
+
+// Each account may only have one authenticator, so the identifier is fixed.
+const (
+	identifier = "totp"
+	name       = "Authenticator app"
+)
+
+// Metadata keys stored on the authentication record.
+const (
+	metaRecoveryCodes = "recovery_codes"
+	metaLastStep      = "last_step"
+)
+
+type Provider struct {
+	logger       *slog.Logger
+	settings     *settings.SettingsRepository
+	auth         authentication.Repository
+	accountQuery *account_querier.Querier
+	store        cache.Store
+}
+
+func New(
+	logger *slog.Logger,
+	settings *settings.SettingsRepository,
+	auth authentication.Repository,
+	accountQuery *account_querier.Querier,
+	store cache.Store,
+) *Provider {
+	return &Provider{
+		logger:       logger,
+		settings:     settings,
+		auth:         auth,
+		accountQuery: accountQuery,
+		store:        store,
+	}
+}
+
+func (p *Provider) Service() authentication.Service { return service }
+func (p *Provider) Token() authentication.TokenType { return tokenType }
+
+func (p *Provider) Enabled(ctx context.Context) (bool, error) {
+	return true, nil
+}
+
+// SecondFactor marks this provider as unusable for signing in on its own.
+func (p *Provider) SecondFactor() {}
+
+type Enrolment struct {
+	Secret string
+	URI    string
+}
+
+// Enrol generates a new secret for the account and stores it as a disabled
+// authentication record. It only becomes active once Confirm is called with a
+// code generated from the secret, proving the authenticator app is set up.
+// Calling Enrol again before confirming replaces the pending secret.
+func (p *Provider) Enrol(ctx context.Context, accountID account.AccountID) (*Enrolment, error) {
+	acc, err := p.accountQuery.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	existing, exists, err := p.lookup(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if exists && !existing.Disabled {
+		return nil, fault.Wrap(ErrAlreadyEnrolled,
+			fctx.With(ctx),
+			fmsg.WithDesc("already enrolled", "An authenticator app is already set up for this account, remove it first to set up a new one."))
+	}
+
+	secret, err := otp.GenerateSecret()
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if exists {
+		_, err = p.auth.Update(ctx, existing.ID, authentication.WithToken(secret))
+	} else {
+		_, err = p.auth.Create(ctx, accountID, service, tokenType, identifier, secret, nil,
+			authentication.WithName(name),
+			authentication.WithDisabled(true),
+		)
+	}
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s, err := p.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	issuer := s.Title.Or(settings.DefaultTitle)
+
+	return &Enrolment{
+		Secret: secret,
+		URI:    otp.TOTPURI(issuer, acc.Handle, secret),
+	}, nil
+}
+
+// Confirm activates a pending enrolment given a valid code from the secret and
+// returns a fresh set of recovery codes. These are only ever shown once.
+func (p *Provider) Confirm(ctx context.Context, accountID account.AccountID, code string) ([]string, error) {
+	existing, exists, err := p.lookup(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !exists {
+		return nil, fault.Wrap(ErrNotEnrolled, fctx.With(ctx))
+	}
+
+	if !existing.Disabled {
+		return nil, fault.Wrap(ErrAlreadyEnrolled, fctx.With(ctx))
+	}
+
+	codes, err := p.activate(ctx, existing, code)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return codes, nil
+}
+
+func (p *Provider) activate(ctx context.Context, rec *authentication.Authentication, code string) ([]string, error) {
+	step, ok := otp.ValidateTOTP(rec.Token, code, time.Now())
+	if !ok {
+		return nil, fault.Wrap(ErrCodeMismatch, fctx.With(ctx))
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	_, err = p.auth.Update(ctx, rec.ID,
+		authentication.WithDisabled(false),
+		authentication.WithMetadata(map[string]any{
+			metaRecoveryCodes: hashes,
+			metaLastStep:      step,
+		}),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return codes, nil
+}
+
+// Enrolled reports whether the account has a confirmed authenticator.
+func (p *Provider) Enrolled(ctx context.Context, accountID account.AccountID) (bool, error) {
+	existing, exists, err := p.lookup(ctx, accountID)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return exists && !existing.Disabled, nil
+}
+
+// Required reports whether the instance requires the account to use a second
+// factor, which applies to any account holding a role that can administrate
+// the instance or manage other members' posts when the setting is turned on.
+func (p *Provider) Required(ctx context.Context, accountID account.AccountID) (bool, error) {
+	s, err := p.settings.Get(ctx)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !s.TwoFactorRequired.OrZero() {
+		return false, nil
+	}
+
+	acc, err := p.accountQuery.GetByID(ctx, accountID)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	perms := acc.Roles.Roles().Permissions()
+
+	return perms.HasAny(rbac.PermissionAdministrator, rbac.PermissionManagePosts), nil
+}
+
+// verify checks a code against the record's secret and if that fails, against
+// the remaining recovery codes. Used TOTP steps and recovery codes are written
+// back so neither can be replayed.
+func (p *Provider) verify(ctx context.Context, rec *authentication.Authentication, code string) (bool, error) {
+	m, _ := rec.Metadata.(map[string]any)
+	if m == nil {
+		m = map[string]any{}
+	}
+
+	lastStep, _ := m[metaLastStep].(float64)
+
+	if step, ok := otp.ValidateTOTP(rec.Token, code, time.Now()); ok {
+		if step <= int64(lastStep) {
+			return false, nil
+		}
+
+		m[metaLastStep] = step
+	} else {
+		hashes := recoveryCodeHashes(m[metaRecoveryCodes])
+
+		remaining, ok := consumeRecoveryCode(hashes, code)
+		if !ok {
+			return false, nil
+		}
+
+		m[metaRecoveryCodes] = remaining
+	}
+
+	_, err := p.auth.Update(ctx, rec.ID, authentication.WithMetadata(m))
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return true, nil
+}
+
+func (p *Provider) lookup(ctx context.Context, accountID account.AccountID) (*authentication.Authentication, bool, error) {
+	rec, exists, err := p.auth.LookupByTokenType(ctx, accountID, tokenType, identifier)
+	if err != nil {
+		return nil, false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return rec, exists, nil
+}
@@ -90,9 +90,8 @@ func (p *Provider) register(ctx context.Context, handle string, credential *weba
 	}
 
 	opts := []account_writer.Option{}
-	inviteCode.Call(func(id xid.ID) { opts = append(opts, account_writer.WithInvitedBy(id)) })
 
-	acc, err := p.reg.Create(ctx, opt.New(handle), opts...)
+	acc, err := p.reg.CreateWithInvitation(ctx, opt.New(handle), inviteCode, opts...)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/token"
+	"github.com/Southclaws/storyden/app/services/reqinfo"
 )
 
 type Issuer struct {
@@ -21,7 +22,10 @@ func NewIssuer(tokenRepo token.Repository) *Issuer {
 }
 
 func (s *Issuer) Issue(ctx context.Context, accountID account.AccountID) (*token.Token, error) {
-	t, err := s.tokenRepo.Issue(ctx, accountID)
+	t, err := s.tokenRepo.Issue(ctx, accountID, token.Device{
+		UserAgent: reqinfo.GetUserAgent(ctx),
+		IPAddress: reqinfo.GetClientAddress(ctx),
+	})
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
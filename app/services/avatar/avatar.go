@@ -58,3 +58,11 @@ func (s *service) Get(ctx context.Context, accountID account.AccountID) (io.Read
 
 	return stream, size, nil
 }
+
+func (s *service) Delete(ctx context.Context, accountID account.AccountID) error {
+	if err := s.storage.Delete(ctx, avatarPath(accountID)); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
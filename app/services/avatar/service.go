@@ -16,6 +16,7 @@ type Service interface {
 	Exists(ctx context.Context, accountID account.AccountID) bool
 	Set(ctx context.Context, accountID account.AccountID, stream io.Reader, size int64) error
 	Get(ctx context.Context, accountID account.AccountID) (io.Reader, int64, error)
+	Delete(ctx context.Context, accountID account.AccountID) error
 }
 
 func Build() fx.Option {
@@ -33,6 +33,7 @@ type Partial struct {
 	Slug              opt.Optional[string]
 	Description       opt.Optional[string]
 	Colour            opt.Optional[string]
+	QAMode            opt.Optional[bool]
 	Parent            opt.Optional[category.CategoryID]
 	CoverImageAssetID deletable.Value[*xid.ID]
 	Meta              opt.Optional[map[string]any]
@@ -86,6 +87,10 @@ func (s *service) Create(ctx context.Context, partial Partial) (*category.Catego
 		opts = append(opts, category.WithCoverImageAssetID(v))
 	}
 
+	if v, ok := partial.QAMode.Get(); ok {
+		opts = append(opts, category.WithQAMode(v))
+	}
+
 	if v, ok := partial.Meta.Get(); ok {
 		opts = append(opts, category.WithMeta(v))
 	}
@@ -134,6 +139,9 @@ func (s *service) Update(ctx context.Context, slug string, partial Partial) (*ca
 	if v, ok := partial.Colour.Get(); ok {
 		opts = append(opts, category.WithColour(v))
 	}
+	if v, ok := partial.QAMode.Get(); ok {
+		opts = append(opts, category.WithQAMode(v))
+	}
 	coverImageOpt, shouldDelete := partial.CoverImageAssetID.Get()
 	if shouldDelete {
 		opts = append(opts, category.WithCoverImageAssetID(nil))
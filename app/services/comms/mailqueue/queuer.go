@@ -66,17 +66,54 @@ func Build() fx.Option {
 	)
 }
 
-func (q *Queuer) Queue(ctx context.Context, address mail.Address, name string, subject string, intros []string, actions []mailtemplate.Action) error {
+type options struct {
+	outros   []string
+	headers  map[string]string
+	category string
+}
+
+type Option func(*options)
+
+// WithUnsubscribe adds an unsubscribe link below the email's content as well as
+// the RFC 8058 one-click unsubscribe headers, which mail clients may show as a
+// button. The one-click URL must accept a POST request with no other input.
+func WithUnsubscribe(link string, oneClick string) Option {
+	return func(o *options) {
+		o.outros = append(o.outros, "To stop receiving these emails, unsubscribe here: "+link)
+		o.headers["List-Unsubscribe"] = "<" + oneClick + ">"
+		o.headers["List-Unsubscribe-Post"] = "List-Unsubscribe=One-Click"
+	}
+}
+
+// WithCategory rate limits the email separately from others, so that frequent
+// emails such as notifications never prevent an important email being sent.
+func WithCategory(category string) Option {
+	return func(o *options) {
+		o.category = category
+	}
+}
+
+func (q *Queuer) Queue(ctx context.Context, address mail.Address, name string, subject string, intros []string, actions []mailtemplate.Action, opts ...Option) error {
 	if q.sender == nil {
 		return fault.New("email sending is not enabled")
 	}
 
-	err := q.limiter.Check(ctx, address.Address, 1)
+	o := options{headers: map[string]string{}}
+	for _, fn := range opts {
+		fn(&o)
+	}
+
+	key := address.Address
+	if o.category != "" {
+		key = o.category + ":" + key
+	}
+
+	err := q.limiter.Check(ctx, key, 1)
 	if err != nil {
 		return fault.Wrap(err, fctx.With(ctx))
 	}
 
-	content, err := q.templates.Build(ctx, name, intros, actions)
+	content, err := q.templates.Build(ctx, name, intros, actions, o.outros)
 	if err != nil {
 		return fault.Wrap(err, fctx.With(ctx))
 	}
@@ -86,6 +123,10 @@ func (q *Queuer) Queue(ctx context.Context, address mail.Address, name string, s
 		return fault.Wrap(err, fctx.With(ctx))
 	}
 
+	if len(o.headers) > 0 {
+		msg.Headers = o.headers
+	}
+
 	if err := q.bus.SendCommand(ctx, &message.CommandSendEmail{
 		Message: *msg,
 	}); err != nil {
@@ -31,7 +31,7 @@ func New(
 	}, nil
 }
 
-func (b *Builder) Build(ctx context.Context, name string, intros []string, actions []hermes.Action) (*mailer.Content, error) {
+func (b *Builder) Build(ctx context.Context, name string, intros []string, actions []hermes.Action, outros []string) (*mailer.Content, error) {
 	s, err := b.settings.Get(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -53,7 +53,7 @@ func (b *Builder) Build(ctx context.Context, name string, intros []string, actio
 			Name:      name,
 			Intros:    intros,
 			Actions:   actions,
-			Outros:    []string{},
+			Outros:    outros,
 			Signature: "Thanks",
 		},
 	}
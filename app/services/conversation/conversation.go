@@ -0,0 +1,354 @@
+// Package conversation provides private messaging between members. Only the
+// participants of a conversation may read or send to it, blocks between
+// members prevent new messages and administrators may only inspect a
+// conversation through an audited path, starting from a reported message.
+package conversation
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
+	"github.com/Southclaws/storyden/app/resources/conversation"
+	"github.com/Southclaws/storyden/app/resources/conversation/conversation_querier"
+	"github.com/Southclaws/storyden/app/resources/conversation/conversation_writer"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/profile/block_querier"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/report/report_querier"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/moderation"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+// MaxParticipants includes the member who starts the conversation.
+const MaxParticipants = 10
+
+var (
+	ErrNoRecipients   = fault.New("no recipients")
+	ErrTooManyMembers = fault.New("too many participants")
+	ErrNotParticipant = fault.New("not a participant")
+	ErrBlocked        = fault.New("blocked")
+	ErrEmptyMessage   = fault.New("empty message")
+	ErrNotReported    = fault.New("message not reported")
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(runNotifications),
+	)
+}
+
+type Manager struct {
+	querier      *conversation_querier.Querier
+	writer       *conversation_writer.Writer
+	blockQuerier *block_querier.Querier
+	accountQuery *account_querier.Querier
+	cpm          *moderation.Manager
+	auditWriter  *audit_writer.Writer
+	reports      *report_querier.Querier
+	bus          *pubsub.Bus
+}
+
+func New(
+	querier *conversation_querier.Querier,
+	writer *conversation_writer.Writer,
+	blockQuerier *block_querier.Querier,
+	accountQuery *account_querier.Querier,
+	cpm *moderation.Manager,
+	auditWriter *audit_writer.Writer,
+	reports *report_querier.Querier,
+	bus *pubsub.Bus,
+) *Manager {
+	return &Manager{
+		querier:      querier,
+		writer:       writer,
+		blockQuerier: blockQuerier,
+		accountQuery: accountQuery,
+		cpm:          cpm,
+		auditWriter:  auditWriter,
+		reports:      reports,
+		bus:          bus,
+	}
+}
+
+type StartParams struct {
+	Recipients []account.AccountID
+	Title      opt.Optional[string]
+	Content    datagraph.Content
+}
+
+// Start begins a conversation with the first message. Starting an untitled
+// conversation with a single member who already has one with the sender adds
+// the message to the existing conversation instead of creating another.
+func (m *Manager) Start(ctx context.Context, p StartParams) (*conversation.Conversation, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	recipients := dt.Filter(lo.Uniq(p.Recipients), func(a account.AccountID) bool { return a != accountID })
+	if len(recipients) == 0 {
+		return nil, fault.Wrap(ErrNoRecipients,
+			fctx.With(ctx),
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("no recipients", "A conversation needs at least one other member."),
+		)
+	}
+	if len(recipients)+1 > MaxParticipants {
+		return nil, fault.Wrap(ErrTooManyMembers,
+			fctx.With(ctx),
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("too many participants", "Conversations are limited to a small group of members."),
+		)
+	}
+
+	for _, r := range recipients {
+		if _, err := m.accountQuery.GetByID(ctx, r); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if err := m.checkBlocks(ctx, accountID, recipients); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// The opening message is checked before anything is written so rejected
+	// content doesn't leave an empty conversation behind.
+	messageID, err := m.check(ctx, p.Content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	var existing *conversation.Conversation
+	if len(recipients) == 1 && !p.Title.Ok() {
+		c, ok, err := m.querier.FindDirect(ctx, accountID, recipients[0])
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if ok {
+			existing = c
+		}
+	}
+
+	if existing == nil {
+		id, err := m.writer.Create(ctx, accountID, recipients, p.Title)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		existing, err = m.querier.Probe(ctx, id)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if _, err := m.deliver(ctx, existing, messageID, accountID, p.Content); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	c, err := m.querier.Probe(ctx, existing.ID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return c, nil
+}
+
+func (m *Manager) List(ctx context.Context, page pagination.Parameters) (pagination.Result[*conversation.Summary], error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return pagination.Result[*conversation.Summary]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	list, err := m.querier.List(ctx, accountID, page)
+	if err != nil {
+		return pagination.Result[*conversation.Summary]{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return list, nil
+}
+
+// Get reads a conversation and marks it as read for the requesting member.
+func (m *Manager) Get(ctx context.Context, id conversation.ConversationID, page pagination.Parameters) (*conversation.WithMessages, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	c, err := m.querier.Get(ctx, id, page)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, ok := c.Participant(accountID); !ok {
+		return nil, notParticipant(ctx)
+	}
+
+	if err := m.writer.MarkRead(ctx, id, accountID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return c, nil
+}
+
+func (m *Manager) Send(ctx context.Context, id conversation.ConversationID, content datagraph.Content) (*conversation.Message, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	c, err := m.querier.Probe(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, ok := c.Participant(accountID); !ok {
+		return nil, notParticipant(ctx)
+	}
+
+	others := m.others(c, accountID)
+	if err := m.checkBlocks(ctx, accountID, others); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	messageID, err := m.check(ctx, content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	msg, err := m.deliver(ctx, c, messageID, accountID, content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return msg, nil
+}
+
+// check runs the content checks against a message before it's written, using
+// a pre-generated ID so any report filed by a checker refers to the message.
+func (m *Manager) check(ctx context.Context, content datagraph.Content) (conversation.MessageID, error) {
+	if content.IsEmpty() {
+		return conversation.MessageID{}, fault.Wrap(ErrEmptyMessage, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	messageID := conversation.MessageID(xid.New())
+
+	// A report result files a system report against the message but, unlike
+	// public posts, there's no visibility to hold a message back with so it's
+	// still delivered. Rejections stop the message entirely.
+	_, err := m.cpm.CheckContent(ctx, xid.ID(messageID), datagraph.KindMessage, "", content)
+	if err != nil {
+		return conversation.MessageID{}, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return messageID, nil
+}
+
+func (m *Manager) deliver(ctx context.Context, c *conversation.Conversation, messageID conversation.MessageID, accountID account.AccountID, content datagraph.Content) (*conversation.Message, error) {
+	msg, err := m.writer.Send(ctx, c.ID, messageID, accountID, content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.bus.Publish(ctx, &message.EventConversationMessageSent{
+		ConversationID: c.ID,
+		MessageID:      msg.ID,
+		AuthorID:       accountID,
+		RecipientIDs:   m.others(c, accountID),
+	})
+
+	return msg, nil
+}
+
+// Inspect gives an administrator access to the conversation a message belongs
+// to while handling a report about it. Messages without an open report can't
+// be used to read a conversation and every access is audited.
+func (m *Manager) Inspect(ctx context.Context, messageID conversation.MessageID, page pagination.Parameters) (*conversation.WithMessages, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := session.Authorise(ctx, nil, rbac.PermissionAdministrator); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	reported, err := m.reports.HasOpen(ctx, xid.ID(messageID), datagraph.KindMessage)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if !reported {
+		return nil, fault.Wrap(ErrNotReported,
+			fctx.With(ctx),
+			ftag.With(ftag.PermissionDenied),
+			fmsg.WithDesc("not reported", "Conversations can only be inspected while handling an open report about one of their messages."),
+		)
+	}
+
+	msg, err := m.querier.GetMessage(ctx, messageID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	c, err := m.querier.Get(ctx, msg.ConversationID, page)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = m.auditWriter.Record(ctx, opt.New(accountID), audit.ActionConversationAccessed,
+		audit_writer.WithTarget(datagraph.Ref{ID: xid.ID(messageID), Kind: datagraph.KindMessage}),
+		audit_writer.WithAfter("conversation: "+c.ID.String()),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return c, nil
+}
+
+func (m *Manager) checkBlocks(ctx context.Context, accountID account.AccountID, others []account.AccountID) error {
+	blocked, err := m.blockQuerier.Between(ctx, accountID, others...)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if blocked {
+		return fault.Wrap(ErrBlocked,
+			fctx.With(ctx),
+			ftag.With(ftag.PermissionDenied),
+			fmsg.WithDesc("blocked", "You cannot message this member."),
+		)
+	}
+
+	return nil
+}
+
+func (m *Manager) others(c *conversation.Conversation, accountID account.AccountID) []account.AccountID {
+	return slices.DeleteFunc(c.ParticipantIDs(), func(a account.AccountID) bool { return a == accountID })
+}
+
+// Conversations are reported as missing rather than forbidden to members who
+// aren't in them so their existence isn't revealed.
+func notParticipant(ctx context.Context) error {
+	return fault.Wrap(ErrNotParticipant,
+		fctx.With(ctx),
+		ftag.With(ftag.NotFound),
+	)
+}
@@ -0,0 +1,43 @@
+package conversation
+
+import (
+	"context"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/services/notification/notify"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func runNotifications(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	bus *pubsub.Bus,
+	notifier *notify.Notifier,
+) {
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		_, err := pubsub.Subscribe(ctx, bus, "conversation.message_sent", func(ctx context.Context, evt *message.EventConversationMessageSent) error {
+			for _, r := range evt.RecipientIDs {
+				err := notifier.Send(ctx,
+					r,
+					opt.New(evt.AuthorID),
+					notification.EventDirectMessage,
+					&datagraph.Ref{
+						ID:   xid.ID(evt.MessageID),
+						Kind: datagraph.KindMessage,
+					},
+				)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		return err
+	}))
+}
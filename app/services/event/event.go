@@ -3,6 +3,7 @@ package event
 import (
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/services/event/event_calendar"
 	"github.com/Southclaws/storyden/app/services/event/event_job"
 	"github.com/Southclaws/storyden/app/services/event/event_management"
 	"github.com/Southclaws/storyden/app/services/event/event_participation"
@@ -12,6 +13,7 @@ func Build() fx.Option {
 	return fx.Options(
 		fx.Provide(event_management.New),
 		fx.Provide(event_participation.New),
+		event_calendar.Build(),
 		event_job.Build(),
 	)
 }
@@ -0,0 +1,212 @@
+// Package event_calendar publishes events as iCalendar documents so members
+// can add single events, or subscribe to all of them, in their calendar app.
+package event_calendar
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/calendar_token"
+	"github.com/Southclaws/storyden/app/resources/event/event_querier"
+	"github.com/Southclaws/storyden/app/resources/event/event_ref"
+	"github.com/Southclaws/storyden/app/resources/event/participation/participant_querier"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+var (
+	errInvalidToken = fault.New("invalid calendar token", ftag.With(ftag.PermissionDenied))
+	errNotPublished = fault.New("event is not published", ftag.With(ftag.NotFound))
+)
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+// Calendar is a set of events to render as a single iCalendar document.
+type Calendar struct {
+	Name    string
+	Updated time.Time
+	Events  []*event_ref.Event
+
+	// host qualifies event IDs into globally unique UIDs.
+	host string
+}
+
+// Version identifies what the calendar contains. The most recent update alone
+// misses events leaving it, such as when one is unpublished or a member stops
+// attending, so conditional requests compare the events and their revisions.
+func (c *Calendar) Version() string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%d\n", c.Name, len(c.Events))
+	for _, e := range c.Events {
+		fmt.Fprintf(h, "%s %d\n", e.ID, e.Sequence)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// Subscription is the location of an account's personal calendar feed.
+type Subscription struct {
+	Token string
+	URL   string
+}
+
+type Builder struct {
+	apiAddress         url.URL
+	settings           *settings.SettingsRepository
+	eventQuerier       *event_querier.Querier
+	participantQuerier *participant_querier.Querier
+	tokens             *calendar_token.Repository
+}
+
+func New(
+	cfg config.Config,
+	settings *settings.SettingsRepository,
+	eventQuerier *event_querier.Querier,
+	participantQuerier *participant_querier.Querier,
+	tokens *calendar_token.Repository,
+) *Builder {
+	return &Builder{
+		apiAddress:         cfg.PublicAPIAddress,
+		settings:           settings,
+		eventQuerier:       eventQuerier,
+		participantQuerier: participantQuerier,
+		tokens:             tokens,
+	}
+}
+
+// Published builds the public calendar of every published event. Deleted
+// events remain in the calendar, marked as cancelled, so subscribers see the
+// cancellation rather than the event silently disappearing.
+func (b *Builder) Published(ctx context.Context) (*Calendar, error) {
+	evts, err := b.eventQuerier.List(ctx, event_querier.WithVisibility(visibility.VisibilityPublished))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return b.newCalendar(ctx, "Events", evts)
+}
+
+// Attending builds the personal calendar of events the token's account is
+// attending. The token stands in for a session as calendar apps can't sign in.
+func (b *Builder) Attending(ctx context.Context, token string) (*Calendar, error) {
+	if token == "" {
+		return nil, fault.Wrap(errInvalidToken, fctx.With(ctx))
+	}
+
+	accountID, ok, err := b.tokens.Lookup(ctx, token)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if !ok {
+		return nil, fault.Wrap(errInvalidToken, fctx.With(ctx),
+			fmsg.WithDesc("invalid token", "This calendar link is invalid or has been reset."))
+	}
+
+	evts, err := b.participantQuerier.Attending(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return b.newCalendar(ctx, "My events", evts)
+}
+
+// Event builds a calendar containing a single event for download. Events
+// which aren't published are only available to their participants.
+func (b *Builder) Event(ctx context.Context, mk event_ref.QueryKey) (*Calendar, error) {
+	evt, err := b.eventQuerier.Get(ctx, mk)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if evt.Visibility != visibility.VisibilityPublished {
+		accountID, err := session.GetAccountID(ctx)
+		if err != nil {
+			return nil, fault.Wrap(errNotPublished, fctx.With(ctx))
+		}
+
+		_, participant := evt.Participants.Find(accountID)
+		if !participant {
+			return nil, fault.Wrap(errNotPublished, fctx.With(ctx))
+		}
+	}
+
+	return b.newCalendar(ctx, evt.Name, []*event_ref.Event{&evt.Event})
+}
+
+// Subscription returns the session account's personal calendar feed.
+func (b *Builder) Subscription(ctx context.Context) (*Subscription, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	token, err := b.tokens.Get(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return b.subscription(token), nil
+}
+
+// ResetSubscription issues a new personal calendar feed URL for the session
+// account, the previous URL stops working immediately.
+func (b *Builder) ResetSubscription(ctx context.Context) (*Subscription, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	token, err := b.tokens.Reset(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return b.subscription(token), nil
+}
+
+func (b *Builder) subscription(token string) *Subscription {
+	u := b.apiAddress.JoinPath("/api/feeds/events/attending")
+	u.RawQuery = url.Values{"token": []string{token}}.Encode()
+
+	return &Subscription{
+		Token: token,
+		URL:   u.String(),
+	}
+}
+
+func (b *Builder) newCalendar(ctx context.Context, name string, evts []*event_ref.Event) (*Calendar, error) {
+	s, err := b.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// As with syndication feeds, the calendar is as fresh as its most recently
+	// changed event and the zero time means there's nothing to compare with.
+	var updated time.Time
+	for _, e := range evts {
+		if e.UpdatedAt.After(updated) {
+			updated = e.UpdatedAt
+		}
+	}
+
+	return &Calendar{
+		Name:    s.Title.Or(settings.DefaultTitle) + ": " + name,
+		Updated: updated,
+		Events:  evts,
+		host:    b.apiAddress.Hostname(),
+	}, nil
+}
@@ -0,0 +1,162 @@
+package event_calendar
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Southclaws/storyden/app/resources/event/event_ref"
+	"github.com/Southclaws/storyden/app/resources/event/location"
+)
+
+// -
+// iCalendar: https://datatracker.ietf.org/doc/html/rfc5545
+// -
+
+const (
+	ContentType = "text/calendar; charset=utf-8"
+
+	productID = "-//Storyden//Events//EN"
+
+	// Content lines longer than this many octets must be folded.
+	maxLineLength = 75
+
+	utcDateTime = "20060102T150405Z"
+)
+
+// Render encodes the calendar as an iCalendar document.
+func (c *Calendar) Render() []byte {
+	w := &icsWriter{}
+
+	w.prop("BEGIN", "VCALENDAR")
+	w.prop("VERSION", "2.0")
+	w.prop("PRODID", productID)
+	w.prop("CALSCALE", "GREGORIAN")
+	w.prop("METHOD", "PUBLISH")
+	w.text("X-WR-CALNAME", c.Name)
+
+	for _, e := range c.Events {
+		c.event(w, e)
+	}
+
+	w.prop("END", "VCALENDAR")
+
+	return w.buf.Bytes()
+}
+
+func (c *Calendar) event(w *icsWriter, e *event_ref.Event) {
+	w.prop("BEGIN", "VEVENT")
+
+	// The UID and sequence are what allow calendar clients to recognise a
+	// changed event as a new revision of one they already have.
+	w.text("UID", e.ID.String()+"@"+c.host)
+	w.prop("SEQUENCE", fmt.Sprint(e.Sequence))
+	w.time("DTSTAMP", e.UpdatedAt)
+	w.time("CREATED", e.CreatedAt)
+	w.time("LAST-MODIFIED", e.UpdatedAt)
+	w.time("DTSTART", e.TimeRange.Start)
+	w.time("DTEND", e.TimeRange.End)
+	w.text("SUMMARY", e.Name)
+
+	if d, ok := e.Description.Get(); ok && d != "" {
+		w.text("DESCRIPTION", d)
+	}
+
+	switch l := e.Location.(type) {
+	case *location.Physical:
+		parts := []string{}
+		if l.Name != "" {
+			parts = append(parts, l.Name)
+		}
+		if a, ok := l.Address.Get(); ok && a != "" {
+			parts = append(parts, a)
+		}
+		if len(parts) > 0 {
+			w.text("LOCATION", strings.Join(parts, ", "))
+		}
+
+		lat, hasLat := l.Latitude.Get()
+		lon, hasLon := l.Longitude.Get()
+		if hasLat && hasLon {
+			w.prop("GEO", fmt.Sprintf("%f;%f", lat, lon))
+		}
+
+		if u, ok := l.URL.Get(); ok {
+			w.prop("URL", u.String())
+		}
+
+	case *location.Virtual:
+		u, hasURL := l.URL.Get()
+		switch {
+		case l.Name != "":
+			w.text("LOCATION", l.Name)
+		case hasURL:
+			w.text("LOCATION", u.String())
+		}
+
+		if hasURL {
+			w.prop("URL", u.String())
+		}
+	}
+
+	if e.DeletedAt.Ok() {
+		w.prop("STATUS", "CANCELLED")
+	} else {
+		w.prop("STATUS", "CONFIRMED")
+	}
+
+	w.prop("END", "VEVENT")
+}
+
+type icsWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *icsWriter) prop(name, value string) {
+	w.line(name + ":" + value)
+}
+
+func (w *icsWriter) text(name, value string) {
+	w.prop(name, escapeText(value))
+}
+
+func (w *icsWriter) time(name string, t time.Time) {
+	w.prop(name, t.UTC().Format(utcDateTime))
+}
+
+// line writes a content line, folding it onto continuation lines which begin
+// with a space without splitting any multi-byte characters.
+func (w *icsWriter) line(s string) {
+	limit := maxLineLength
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+
+		w.buf.WriteString(s[:cut])
+		w.buf.WriteString("\r\n ")
+		s = s[cut:]
+
+		// Continuation lines lose one octet to the leading space.
+		limit = maxLineLength - 1
+	}
+
+	w.buf.WriteString(s)
+	w.buf.WriteString("\r\n")
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`;`, `\;`,
+	`,`, `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", ``,
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
@@ -0,0 +1,38 @@
+package event_calendar
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLineFolding(t *testing.T) {
+	a := assert.New(t)
+
+	w := &icsWriter{}
+	value := strings.Repeat("Þórsdagr ", 30)
+	w.text("SUMMARY", value)
+
+	lines := strings.Split(strings.TrimSuffix(w.buf.String(), "\r\n"), "\r\n")
+	a.Greater(len(lines), 1)
+
+	for i, l := range lines {
+		a.LessOrEqual(len(l), maxLineLength)
+		a.True(utf8.ValidString(l), "line %d splits a character", i)
+		if i > 0 {
+			a.True(strings.HasPrefix(l, " "))
+		}
+	}
+
+	unfolded := strings.ReplaceAll(strings.TrimSuffix(w.buf.String(), "\r\n"), "\r\n ", "")
+	a.Equal("SUMMARY:"+value, unfolded)
+}
+
+func TestEscapeText(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(`a\; b\, c\\d\ne`, escapeText("a; b, c\\d\r\ne"))
+	a.Equal(`one\ntwo`, escapeText("one\ntwo"))
+}
@@ -47,7 +47,7 @@ func (m *Manager) updateSelf(ctx context.Context, acc *account.Account, mk event
 	selfParticipation, isAttending := lo.Find(evt.Participants, func(p *participation.EventParticipant) bool { return p.Account.ID == acc.ID })
 
 	// Is the requesting account a host of this event?
-	isHost := selfParticipation.Role == participation.RoleHost
+	isHost := isAttending && selfParticipation.Role == participation.RoleHost
 
 	logger := m.logger.With(
 		slog.String("event_id", evt.ID.String()),
@@ -74,7 +74,9 @@ func (m *Manager) updateSelf(ctx context.Context, acc *account.Account, mk event
 			}
 		})...)
 	} else {
-		err := m.writer.Add(ctx, mk, acc.ID, participant_writer.WithStatus(status))
+		err := m.writer.Add(ctx, mk, acc.ID,
+			participant_writer.WithRole(participation.RoleAttendee),
+			participant_writer.WithStatus(status))
 		if err != nil {
 			return nil, fault.Wrap(err, fctx.With(ctx))
 		}
@@ -0,0 +1,266 @@
+// Package feed builds syndication feeds of recently published content which can
+// be rendered as either RSS 2.0 or Atom for feed readers.
+package feed
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/library/node_querier"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+// feedSize is the number of entries included in a feed, feed readers poll and
+// remember what they've seen so there's no need for pagination.
+const feedSize = 50
+
+var errAuthorNotFound = fault.New("author not found", ftag.With(ftag.NotFound))
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+type Feed struct {
+	Title       string
+	Description string
+	Link        string // The web page the feed represents.
+	Self        string // The URL of the feed document itself.
+	Updated     time.Time
+	Items       []Item
+}
+
+type Item struct {
+	ID         string
+	Title      string
+	Link       string
+	Summary    string
+	Content    string
+	Author     string
+	Categories []string
+	Published  time.Time
+	Updated    time.Time
+}
+
+// ThreadFilter narrows a thread feed down, all fields are optional.
+type ThreadFilter struct {
+	Category opt.Optional[string]
+	Tag      opt.Optional[string]
+	Author   opt.Optional[string]
+}
+
+type Builder struct {
+	webAddress     url.URL
+	apiAddress     url.URL
+	settings       *settings.SettingsRepository
+	threadQuerier  *thread_querier.Querier
+	nodeQuerier    *node_querier.Querier
+	categoryRepo   *category.Repository
+	profileQuerier *profile_querier.Querier
+}
+
+func New(
+	cfg config.Config,
+	settings *settings.SettingsRepository,
+	threadQuerier *thread_querier.Querier,
+	nodeQuerier *node_querier.Querier,
+	categoryRepo *category.Repository,
+	profileQuerier *profile_querier.Querier,
+) *Builder {
+	return &Builder{
+		webAddress:     cfg.PublicWebAddress,
+		apiAddress:     cfg.PublicAPIAddress,
+		settings:       settings,
+		threadQuerier:  threadQuerier,
+		nodeQuerier:    nodeQuerier,
+		categoryRepo:   categoryRepo,
+		profileQuerier: profileQuerier,
+	}
+}
+
+func (b *Builder) Threads(ctx context.Context, f ThreadFilter) (*Feed, error) {
+	s, err := b.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	title := s.Title.Or(settings.DefaultTitle)
+	description := s.Description.Or("")
+	link := b.webAddress.String()
+	self := b.apiAddress.JoinPath("/api/feeds/threads")
+	selfQuery := url.Values{}
+
+	q := []thread_querier.Query{
+		thread_querier.HasNotBeenDeleted(),
+		thread_querier.HasStatus(visibility.VisibilityPublished),
+		thread_querier.OrderByNewest(),
+	}
+
+	if slug, ok := f.Category.Get(); ok {
+		cat, err := b.categoryRepo.Get(ctx, slug)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		q = append(q, thread_querier.HasCategories(thread_querier.CategoryFilter{Slugs: []string{cat.Slug}}))
+		title = title + ": " + cat.Name
+		description = cat.Description
+		link = b.webAddress.JoinPath("d", cat.Slug).String()
+		selfQuery.Set("category", cat.Slug)
+	}
+
+	if name, ok := f.Tag.Get(); ok {
+		q = append(q, thread_querier.HasTagNames(name))
+		title = title + ": #" + name
+		link = b.webAddress.JoinPath("tags", name).String()
+		selfQuery.Set("tag", name)
+	}
+
+	if handle, ok := f.Author.Get(); ok {
+		p, exists, err := b.profileQuerier.LookupByHandle(ctx, handle)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if !exists {
+			return nil, fault.Wrap(errAuthorNotFound, fctx.With(ctx), fmsg.WithDesc("not found", "No member exists with that handle."))
+		}
+
+		q = append(q, thread_querier.HasAuthor(p.ID))
+		title = title + ": " + p.Name
+		link = b.webAddress.JoinPath("m", p.Handle).String()
+		selfQuery.Set("author", p.Handle)
+	}
+
+	result, err := b.threadQuerier.List(ctx, 0, feedSize, opt.NewEmpty[account.AccountID](), q...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	self.RawQuery = selfQuery.Encode()
+
+	return newFeed(title, description, link, self.String(), dt.Map(result.Threads, b.threadItem)), nil
+}
+
+func (b *Builder) Library(ctx context.Context) (*Feed, error) {
+	s, err := b.settings.Get(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodes, err := b.nodeQuerier.ListRecentlyPublished(ctx, feedSize)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	title := s.Title.Or(settings.DefaultTitle) + ": Library"
+	link := b.webAddress.JoinPath("l").String()
+	self := b.apiAddress.JoinPath("/api/feeds/library").String()
+
+	return newFeed(title, s.Description.Or(""), link, self, dt.Map(nodes, b.nodeItem)), nil
+}
+
+func newFeed(title, description, link, self string, items []Item) *Feed {
+	// The feed is as fresh as its most recently changed entry. When there are
+	// no entries at all, the zero time tells the caller there's nothing to
+	// compare against for conditional requests.
+	var updated time.Time
+	for _, i := range items {
+		if i.Updated.After(updated) {
+			updated = i.Updated
+		}
+	}
+
+	return &Feed{
+		Title:       title,
+		Description: description,
+		Link:        link,
+		Self:        self,
+		Updated:     updated,
+		Items:       items,
+	}
+}
+
+// Version identifies what the feed contains and how it was requested: the
+// filters, which are part of its self link, the format and the entries. Two
+// feeds with the same most recent update can still differ, for example when an
+// entry has been deleted, and this tells them apart for conditional requests.
+func (f *Feed) Version(format Format) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%d\n", f.Self, format, len(f.Items))
+	for _, i := range f.Items {
+		fmt.Fprintln(h, i.ID)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+func (b *Builder) threadItem(t *thread.Thread) Item {
+	categories := []string{}
+	if c, ok := t.Category.Get(); ok {
+		categories = append(categories, c.Name)
+	}
+	for _, tag := range t.Tags {
+		categories = append(categories, tag.Name.String())
+	}
+
+	link := b.webAddress.JoinPath("t", t.Slug).String()
+
+	return Item{
+		ID:         link,
+		Title:      t.Title,
+		Link:       link,
+		Summary:    t.Content.Short(),
+		Content:    t.Content.HTML(),
+		Author:     t.Author.Name,
+		Categories: categories,
+		Published:  t.CreatedAt,
+		Updated:    t.UpdatedAt,
+	}
+}
+
+func (b *Builder) nodeItem(n *library.Node) Item {
+	categories := dt.Map(n.Tags, func(t *tag_ref.Tag) string { return t.Name.String() })
+
+	link := b.webAddress.JoinPath("l", n.Mark.Slug()).String()
+
+	summary := n.Description.Or("")
+	content := ""
+	if c, ok := n.Content.Get(); ok {
+		content = c.HTML()
+		if summary == "" {
+			summary = c.Short()
+		}
+	}
+
+	return Item{
+		ID:         link,
+		Title:      n.Name,
+		Link:       link,
+		Summary:    summary,
+		Content:    content,
+		Author:     n.Owner.Name,
+		Categories: categories,
+		Published:  n.CreatedAt,
+		Updated:    n.UpdatedAt,
+	}
+}
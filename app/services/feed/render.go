@@ -0,0 +1,211 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"time"
+
+	"github.com/Southclaws/dt"
+)
+
+type Format string
+
+const (
+	FormatRSS  Format = "rss"
+	FormatAtom Format = "atom"
+)
+
+func (f Format) ContentType() string {
+	if f == FormatAtom {
+		return "application/atom+xml"
+	}
+	return "application/rss+xml"
+}
+
+// Render encodes the feed as an XML document in the given format.
+func (f *Feed) Render(format Format) ([]byte, error) {
+	var doc any
+	if format == FormatAtom {
+		doc = f.atom()
+	} else {
+		doc = f.rss()
+	}
+
+	buf := bytes.NewBufferString(xml.Header)
+
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// -
+// RSS 2.0: https://www.rssboard.org/rss-specification
+// -
+
+type rssDocument struct {
+	XMLName   xml.Name   `xml:"rss"`
+	Version   string     `xml:"version,attr"`
+	AtomNS    string     `xml:"xmlns:atom,attr"`
+	ContentNS string     `xml:"xmlns:content,attr"`
+	DCNS      string     `xml:"xmlns:dc,attr"`
+	Channel   rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	AtomLink      rssSelf   `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssSelf struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	Description string   `xml:"description"`
+	Content     *cdata   `xml:"content:encoded,omitempty"`
+	Creator     string   `xml:"dc:creator,omitempty"`
+	Categories  []string `xml:"category"`
+	PubDate     string   `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type cdata struct {
+	Value string `xml:",cdata"`
+}
+
+func (f *Feed) rss() rssDocument {
+	lastBuild := ""
+	if !f.Updated.IsZero() {
+		lastBuild = f.Updated.UTC().Format(time.RFC1123Z)
+	}
+
+	return rssDocument{
+		Version:   "2.0",
+		AtomNS:    "http://www.w3.org/2005/Atom",
+		ContentNS: "http://purl.org/rss/1.0/modules/content/",
+		DCNS:      "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:         f.Title,
+			Link:          f.Link,
+			Description:   f.Description,
+			AtomLink:      rssSelf{Href: f.Self, Rel: "self", Type: FormatRSS.ContentType()},
+			LastBuildDate: lastBuild,
+			Items: dt.Map(f.Items, func(i Item) rssItem {
+				var content *cdata
+				if i.Content != "" {
+					content = &cdata{i.Content}
+				}
+
+				return rssItem{
+					Title:       i.Title,
+					Link:        i.Link,
+					GUID:        rssGUID{IsPermaLink: true, Value: i.ID},
+					Description: i.Summary,
+					Content:     content,
+					Creator:     i.Author,
+					Categories:  i.Categories,
+					PubDate:     i.Published.UTC().Format(time.RFC1123Z),
+				}
+			}),
+		},
+	}
+}
+
+// -
+// Atom: https://www.rfc-editor.org/rfc/rfc4287
+// -
+
+type atomDocument struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     atomAuthor     `xml:"author"`
+	Categories []atomCategory `xml:"category"`
+	Summary    string         `xml:"summary,omitempty"`
+	Content    *atomContent   `xml:"content,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func (f *Feed) atom() atomDocument {
+	// Atom requires an updated timestamp on the feed even when it's empty.
+	updated := f.Updated
+	if updated.IsZero() {
+		updated = time.Unix(0, 0)
+	}
+
+	return atomDocument{
+		ID:       f.Self,
+		Title:    f.Title,
+		Subtitle: f.Description,
+		Updated:  updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: f.Link, Rel: "alternate", Type: "text/html"},
+			{Href: f.Self, Rel: "self", Type: FormatAtom.ContentType()},
+		},
+		Entries: dt.Map(f.Items, func(i Item) atomEntry {
+			var content *atomContent
+			if i.Content != "" {
+				content = &atomContent{Type: "html", Value: i.Content}
+			}
+
+			return atomEntry{
+				ID:         i.ID,
+				Title:      i.Title,
+				Link:       atomLink{Href: i.Link, Rel: "alternate", Type: "text/html"},
+				Published:  i.Published.UTC().Format(time.RFC3339),
+				Updated:    i.Updated.UTC().Format(time.RFC3339),
+				Author:     atomAuthor{Name: i.Author},
+				Categories: dt.Map(i.Categories, func(c string) atomCategory { return atomCategory{Term: c} }),
+				Summary:    i.Summary,
+				Content:    content,
+			}
+		}),
+	}
+}
@@ -3,6 +3,7 @@ package library
 import (
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/services/library/node_export"
 	"github.com/Southclaws/storyden/app/services/library/node_mutate"
 	"github.com/Southclaws/storyden/app/services/library/node_property_schema"
 	"github.com/Southclaws/storyden/app/services/library/node_read"
@@ -12,6 +13,6 @@ import (
 
 func Build() fx.Option {
 	return fx.Options(
-		fx.Provide(node_read.New, node_mutate.New, nodetree.New, node_visibility.New, node_property_schema.New),
+		fx.Provide(node_read.New, node_mutate.New, nodetree.New, node_visibility.New, node_property_schema.New, node_export.New),
 	)
 }
@@ -0,0 +1,460 @@
+// Package node_export writes a library page and every page beneath it as a zip
+// of Markdown files laid out in the same shape as the tree. It's intended for
+// offline copies of a knowledge base and for keeping a mirror in a git repo so
+// the output is stable: each page is a directory named after its slug with an
+// index.md inside, and every uploaded file lives in a single assets directory.
+package node_export
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"maps"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	htmltomarkdown "github.com/JohannesKaufmann/html-to-markdown/v2"
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/library/node_querier"
+	"github.com/Southclaws/storyden/app/resources/library/node_traversal"
+	"github.com/Southclaws/storyden/app/resources/link/link_ref"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+)
+
+const (
+	pageFilename    = "index.md"
+	assetsDirectory = "assets"
+)
+
+type Exporter struct {
+	logger       *slog.Logger
+	accountQuery *account_querier.Querier
+	nodeQuerier  *node_querier.Querier
+	traversal    node_traversal.Repository
+	store        object.Storer
+	webAddress   url.URL
+}
+
+func New(
+	cfg config.Config,
+	logger *slog.Logger,
+	accountQuery *account_querier.Querier,
+	nodeQuerier *node_querier.Querier,
+	traversal node_traversal.Repository,
+	store object.Storer,
+) *Exporter {
+	return &Exporter{
+		logger:       logger.With(slog.String("service", "node_export")),
+		accountQuery: accountQuery,
+		nodeQuerier:  nodeQuerier,
+		traversal:    traversal,
+		store:        store,
+		webAddress:   cfg.PublicWebAddress,
+	}
+}
+
+// page is a single rendered Markdown file in the archive.
+type page struct {
+	path    string
+	content []byte
+}
+
+// export holds everything gathered before the archive is written.
+type export struct {
+	dirs   map[library.NodeID]string
+	pages  []page
+	assets map[string]string // archive path to object storage path
+}
+
+// addAsset includes the file in the archive and returns its path there.
+func (ex *export) addAsset(a asset.Asset) string {
+	p := path.Join(assetsDirectory, a.Name.String())
+	ex.assets[p] = asset.BuildAssetPath(a.Name)
+	return p
+}
+
+// Export renders the node identified by qk and its subtree and returns a
+// reader for the zip archive. Only pages the requesting member can see in the
+// library are included. Everything is loaded before the archive is streamed so
+// errors are surfaced before any of the response has been written.
+func (e *Exporter) Export(ctx context.Context, qk library.QueryKey) (io.ReadCloser, error) {
+	acc, err := e.requestingAccount(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	accountID := opt.Map(acc, func(a account.AccountWithEdges) account.AccountID { return a.ID })
+
+	root, err := e.nodeQuerier.Get(ctx, qk, node_querier.WithVisibilityRulesApplied(accountID.Ptr()))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	filters := []node_traversal.Filter{}
+	if acc.Ok() {
+		filters = append(filters, node_traversal.WithVisibility(acc,
+			visibility.VisibilityDraft,
+			visibility.VisibilityUnlisted,
+			visibility.VisibilityReview,
+			visibility.VisibilityPublished,
+		))
+	}
+
+	tree, err := e.traversal.Subtree(ctx, opt.New(library.NodeID(root.Mark.ID())), false, filters...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ex := &export{
+		dirs:   map[library.NodeID]string{},
+		assets: map[string]string{},
+	}
+
+	// Directories and assets are assigned for the whole tree first so links
+	// between pages resolve regardless of the order pages are rendered in.
+	var layout func(nodes []*library.Node, parent string)
+	layout = func(nodes []*library.Node, parent string) {
+		for _, n := range nodes {
+			dir := path.Join(parent, n.Mark.Slug())
+			ex.dirs[library.NodeID(n.Mark.ID())] = dir
+
+			for _, a := range n.Assets {
+				ex.addAsset(*a)
+			}
+			n.PrimaryImage.Call(func(a asset.Asset) { ex.addAsset(a) })
+
+			layout(n.Nodes, dir)
+		}
+	}
+	layout(tree, "")
+
+	var render func(nodes []*library.Node) error
+	render = func(nodes []*library.Node) error {
+		for _, n := range nodes {
+			// The traversal doesn't hydrate properties or tags so each page
+			// is read in full, visibility has already been applied above.
+			full, err := e.nodeQuerier.Get(ctx, library.NewID(n.Mark.ID()))
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+
+			p, err := e.render(ex, full)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+
+			ex.pages = append(ex.pages, *p)
+
+			if err := render(n.Nodes); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+	if err := render(tree); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		pw.CloseWithError(e.write(ctx, pw, ex))
+	}()
+
+	return pr, nil
+}
+
+func (e *Exporter) requestingAccount(ctx context.Context) (opt.Optional[account.AccountWithEdges], error) {
+	id, ok := session.GetOptAccountID(ctx).Get()
+	if !ok {
+		return opt.NewEmpty[account.AccountWithEdges](), nil
+	}
+
+	acc, err := e.accountQuery.GetByID(ctx, id)
+	if err != nil {
+		return opt.NewEmpty[account.AccountWithEdges](), fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return opt.New(*acc), nil
+}
+
+type frontMatter struct {
+	ID           string     `yaml:"id"`
+	Title        string     `yaml:"title"`
+	Slug         string     `yaml:"slug"`
+	Description  string     `yaml:"description,omitempty"`
+	Author       string     `yaml:"author"`
+	Link         string     `yaml:"link,omitempty"`
+	PrimaryImage string     `yaml:"primary_image,omitempty"`
+	Assets       []string   `yaml:"assets,omitempty"`
+	Tags         []string   `yaml:"tags,omitempty"`
+	Properties   *yaml.Node `yaml:"properties,omitempty"`
+	Created      time.Time  `yaml:"created"`
+	Updated      time.Time  `yaml:"updated"`
+}
+
+func (e *Exporter) render(ex *export, n *library.Node) (*page, error) {
+	dir := ex.dirs[library.NodeID(n.Mark.ID())]
+
+	addAsset := func(a asset.Asset) string {
+		return relative(dir, ex.addAsset(a))
+	}
+
+	fm := frontMatter{
+		ID:          n.Mark.ID().String(),
+		Title:       n.Name,
+		Slug:        n.Mark.Slug(),
+		Description: n.Description.OrZero(),
+		Author:      n.Owner.Handle,
+		Link:        opt.Map(n.WebLink, func(l link_ref.LinkRef) string { return l.URL }).OrZero(),
+		Assets:      dt.Map(n.Assets, func(a *asset.Asset) string { return addAsset(*a) }),
+		Tags:        dt.Map(n.Tags, func(t *tag_ref.Tag) string { return t.Name.String() }),
+		Created:     n.CreatedAt.UTC(),
+		Updated:     n.UpdatedAt.UTC(),
+	}
+
+	if pi, ok := n.PrimaryImage.Get(); ok {
+		fm.PrimaryImage = addAsset(pi)
+	}
+
+	if pt, ok := n.Properties.Get(); ok && len(pt.Properties) > 0 {
+		props, err := properties(pt.Properties)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		fm.Properties = props
+	}
+
+	header, err := yaml.Marshal(fm)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString("---\n")
+	buf.Write(header)
+	buf.WriteString("---\n")
+
+	if c, ok := n.Content.Get(); ok && !c.IsEmpty() {
+		tree := c.HTMLTree()
+		e.rewriteLinks(ex, dir, tree)
+
+		md, err := htmltomarkdown.ConvertNode(tree)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+
+		buf.WriteString("\n")
+		buf.Write(md)
+		buf.WriteString("\n")
+	}
+
+	return &page{
+		path:    path.Join(dir, pageFilename),
+		content: buf.Bytes(),
+	}, nil
+}
+
+// properties keeps the schema's field order and writes numbers, booleans and
+// timestamps as native YAML values so static site generators can use them.
+func properties(ps library.Properties) (*yaml.Node, error) {
+	m := &yaml.Node{Kind: yaml.MappingNode}
+
+	for _, p := range ps {
+		key := &yaml.Node{}
+		if err := key.Encode(p.Field.Name); err != nil {
+			return nil, fault.Wrap(err)
+		}
+
+		value := &yaml.Node{}
+		if err := value.Encode(propertyValue(p)); err != nil {
+			return nil, fault.Wrap(err)
+		}
+
+		m.Content = append(m.Content, key, value)
+	}
+
+	return m, nil
+}
+
+func propertyValue(p *library.Property) any {
+	v, ok := p.Value.Get()
+	if !ok {
+		return nil
+	}
+
+	switch p.Field.Type {
+	case library.PropertyTypeEnumNumber:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	case library.PropertyTypeEnumBoolean:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	case library.PropertyTypeEnumTimestamp:
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t
+		}
+	}
+
+	return v
+}
+
+// rewriteLinks points sdr: references and embedded uploads at their place in
+// the archive. References to pages outside the export, or to threads, point to
+// the page on the site instead. Anything else is left as-is.
+func (e *Exporter) rewriteLinks(ex *export, dir string, n *html.Node) {
+	attr := ""
+	switch n.DataAtom {
+	case atom.A:
+		attr = "href"
+	case atom.Img:
+		attr = "src"
+	}
+
+	for i, a := range n.Attr {
+		if attr == "" || strings.ToLower(a.Key) != attr {
+			continue
+		}
+
+		u, err := url.Parse(a.Val)
+		if err != nil {
+			continue
+		}
+
+		if rewritten, ok := e.resolve(ex, dir, u); ok {
+			n.Attr[i].Val = rewritten
+		}
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		e.rewriteLinks(ex, dir, c)
+	}
+}
+
+func (e *Exporter) resolve(ex *export, dir string, u *url.URL) (string, bool) {
+	if u.Scheme == datagraph.RefScheme {
+		ref, err := datagraph.NewRefFromSDR(*u)
+		if err != nil {
+			return "", false
+		}
+
+		switch ref.Kind {
+		case datagraph.KindNode:
+			if target, ok := ex.dirs[library.NodeID(ref.ID)]; ok {
+				return relative(dir, path.Join(target, pageFilename)), true
+			}
+			return e.webAddress.JoinPath("l", ref.ID.String()).String(), true
+
+		case datagraph.KindThread:
+			return e.webAddress.JoinPath("t", ref.ID.String()).String(), true
+		}
+
+		return "", false
+	}
+
+	if name, ok := strings.CutPrefix(u.Path, "/api/assets/"); ok {
+		p := path.Join(assetsDirectory, name)
+		if _, exported := ex.assets[p]; exported {
+			return relative(dir, p), true
+		}
+	}
+
+	return "", false
+}
+
+// relative returns the path to target, relative to the directory dir. Both are
+// slash-separated paths from the root of the archive.
+func relative(dir, target string) string {
+	from := strings.Split(dir, "/")
+	to := strings.Split(target, "/")
+
+	common := 0
+	for common < len(from) && common < len(to)-1 && from[common] == to[common] {
+		common++
+	}
+
+	up := strings.Repeat("../", len(from)-common)
+
+	return up + strings.Join(to[common:], "/")
+}
+
+func (e *Exporter) write(ctx context.Context, w io.Writer, ex *export) error {
+	zw := zip.NewWriter(w)
+
+	for _, p := range ex.pages {
+		fw, err := zw.Create(p.path)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if _, err := fw.Write(p.content); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	// Sorted so repeated exports of an unchanged tree are identical.
+	for _, archivePath := range slices.Sorted(maps.Keys(ex.assets)) {
+		objectPath := ex.assets[archivePath]
+
+		r, _, err := e.store.Read(ctx, objectPath)
+		if err != nil {
+			// A missing file shouldn't stop the rest of the export.
+			e.logger.Warn("failed to read asset for library export",
+				slog.String("path", objectPath),
+				slog.String("error", err.Error()))
+			continue
+		}
+
+		if err := copyFile(zw, archivePath, r); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func copyFile(zw *zip.Writer, name string, r io.Reader) error {
+	if c, ok := r.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	fw, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+	if err != nil {
+		return fault.Wrap(err)
+	}
+
+	if _, err := io.Copy(fw, r); err != nil {
+		return fault.Wrap(err)
+	}
+
+	return nil
+}
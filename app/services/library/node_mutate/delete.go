@@ -7,6 +7,9 @@ import (
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/opt"
 
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/library"
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
@@ -54,6 +57,16 @@ func (s *Manager) Delete(ctx context.Context, qk library.QueryKey, d DeleteOptio
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	if n.Owner.ID != accountID {
+		err = s.auditWriter.Record(ctx, opt.New(accountID), audit.ActionNodeDeleted,
+			audit_writer.WithTarget(*datagraph.NewRef(n)),
+			audit_writer.WithBefore("name: "+n.Name),
+		)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	s.bus.Publish(ctx, &message.EventNodeDeleted{
 		ID:   library.NodeID(n.GetID()),
 		Slug: n.GetSlug(),
@@ -7,12 +7,14 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/library"
 	"github.com/Southclaws/storyden/app/resources/library/node_cache"
 	"github.com/Southclaws/storyden/app/resources/library/node_children"
 	"github.com/Southclaws/storyden/app/resources/library/node_properties"
 	"github.com/Southclaws/storyden/app/resources/library/node_querier"
+	"github.com/Southclaws/storyden/app/resources/library/node_revision"
 	"github.com/Southclaws/storyden/app/resources/library/node_writer"
 	"github.com/Southclaws/storyden/app/resources/mark"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
@@ -57,6 +59,8 @@ type Manager struct {
 	summariser   generative.Summariser
 	cache        *node_cache.Cache
 	bus          *pubsub.Bus
+	revisions    *node_revision.Repository
+	auditWriter  *audit_writer.Writer
 }
 
 func New(
@@ -73,6 +77,8 @@ func New(
 	summariser generative.Summariser,
 	cache *node_cache.Cache,
 	bus *pubsub.Bus,
+	revisions *node_revision.Repository,
+	auditWriter *audit_writer.Writer,
 ) *Manager {
 	return &Manager{
 		accountQuery: accountQuery,
@@ -88,5 +94,7 @@ func New(
 		summariser:   summariser,
 		cache:        cache,
 		bus:          bus,
+		revisions:    revisions,
+		auditWriter:  auditWriter,
 	}
 }
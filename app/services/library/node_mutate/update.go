@@ -39,6 +39,8 @@ func (s *Manager) Update(ctx context.Context, qk library.QueryKey, p Partial) (*
 	}
 
 	oldVisibility := n.Visibility
+	oldName := n.Name
+	oldContent := n.Content
 
 	pre, err := s.preMutation(ctx, p, opt.NewPtr(n))
 	if err != nil {
@@ -50,6 +52,14 @@ func (s *Manager) Update(ctx context.Context, qk library.QueryKey, p Partial) (*
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	// Content may also be changed by fill rules during pre-mutation, so the
+	// comparison is done against the written node rather than the partial.
+	if oldName != n.Name || oldContent.OrZero().HTML() != n.Content.OrZero().HTML() {
+		if _, err := s.revisions.Create(ctx, library.NodeID(n.Mark.ID()), accountID, oldName, oldContent); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	if props, ok := p.Properties.Get(); ok {
 		updatedProperties, err := s.applyPropertyMutations(ctx, n, props)
 		if err != nil {
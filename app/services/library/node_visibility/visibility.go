@@ -6,8 +6,12 @@ import (
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
 
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/library"
 	"github.com/Southclaws/storyden/app/resources/library/node_children"
 	"github.com/Southclaws/storyden/app/resources/library/node_querier"
@@ -26,6 +30,7 @@ type Controller struct {
 	nodeQuerier  *node_querier.Querier
 	nodeWriter   *node_writer.Writer
 	nc           *node_children.Writer
+	auditWriter  *audit_writer.Writer
 	bus          *pubsub.Bus
 }
 
@@ -34,6 +39,7 @@ func New(
 	nodeQuerier *node_querier.Querier,
 	nodeWriter *node_writer.Writer,
 	nc *node_children.Writer,
+	auditWriter *audit_writer.Writer,
 	bus *pubsub.Bus,
 ) *Controller {
 	return &Controller{
@@ -41,6 +47,7 @@ func New(
 		nodeQuerier:  nodeQuerier,
 		nodeWriter:   nodeWriter,
 		nc:           nc,
+		auditWriter:  auditWriter,
 		bus:          bus,
 	}
 }
@@ -77,6 +84,18 @@ func (m *Controller) ChangeVisibility(ctx context.Context, qk library.QueryKey,
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	// Only a moderator changing someone else's page is worth auditing.
+	if oldVisibility != vis && n.Owner.ID != accountID {
+		err = m.auditWriter.Record(ctx, opt.New(accountID), audit.ActionVisibilityChanged,
+			audit_writer.WithTarget(*datagraph.NewRef(n)),
+			audit_writer.WithBefore("visibility: "+oldVisibility.String()),
+			audit_writer.WithAfter("visibility: "+vis.String()),
+		)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	// Emit visibility transition events
 	// NOTE: If this changes, remove the node_visibility service and consolidate
 	if oldVisibility != vis {
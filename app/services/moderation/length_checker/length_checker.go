@@ -101,7 +101,8 @@ func (l *LengthChecker) Check(ctx context.Context, targetID xid.ID, targetKind d
 	switch targetKind {
 	case datagraph.KindThread:
 		maxLength = l.threadBodyLengthMax
-	case datagraph.KindReply:
+	case datagraph.KindReply, datagraph.KindMessage:
+		// Direct messages share the reply limit, they're conversational too.
 		maxLength = l.replyBodyLengthMax
 	default:
 		return nil, fault.Wrap(
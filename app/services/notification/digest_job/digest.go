@@ -0,0 +1,316 @@
+// Package digest_job periodically emails members who have opted in to the
+// digest a summary of their unread notifications, new threads in categories
+// they take part in and the most popular threads since their previous digest.
+package digest_job
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/matcornic/hermes/v2"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_digest"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_preference"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/comms/mailqueue"
+	"github.com/Southclaws/storyden/app/services/comms/mailtemplate"
+	"github.com/Southclaws/storyden/app/services/notification/notify_mail"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/schedule"
+)
+
+const (
+	// Digests are sent at most once per period, so checking for due digests
+	// more often than this only affects how close to the period they arrive.
+	checkInterval = 15 * time.Minute
+
+	// batchSize bounds how many due digests are loaded at once.
+	batchSize = 100
+
+	maxNotifications = 10
+	maxNewThreads    = 5
+	maxTopThreads    = 5
+
+	category = "digest"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(runPeriodically),
+	)
+}
+
+type Sender struct {
+	logger       *slog.Logger
+	accountQuery *account_querier.Querier
+	preferences  *notify_preference.Repository
+	digests      *notify_digest.Repository
+	settings     *settings.SettingsRepository
+	mailqueue    *mailqueue.Queuer
+	mailer       *notify_mail.Mailer
+	webAddress   url.URL
+}
+
+func New(
+	cfg config.Config,
+	logger *slog.Logger,
+	accountQuery *account_querier.Querier,
+	preferences *notify_preference.Repository,
+	digests *notify_digest.Repository,
+	settings *settings.SettingsRepository,
+	mailqueue *mailqueue.Queuer,
+	mailer *notify_mail.Mailer,
+) *Sender {
+	return &Sender{
+		logger:       logger,
+		accountQuery: accountQuery,
+		preferences:  preferences,
+		digests:      digests,
+		settings:     settings,
+		mailqueue:    mailqueue,
+		mailer:       mailer,
+		webAddress:   cfg.PublicWebAddress,
+	}
+}
+
+func runPeriodically(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	s *Sender,
+) {
+	schedule.Every(ctx, lc, logger, "notification_digest", checkInterval, func(ctx context.Context) error {
+		n, err := s.SendDue(ctx, time.Now())
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		if n > 0 {
+			logger.Info("sent digest emails", slog.Int("count", n))
+		}
+		return nil
+	})
+}
+
+// SendDue sends every digest whose period has elapsed and returns how many
+// emails were sent. A failure for one member does not stop the others. Each
+// digest is claimed before it's sent so when multiple instances run this at
+// once every member still only receives one email.
+func (s *Sender) SendDue(ctx context.Context, now time.Time) (int, error) {
+	sent := 0
+	for {
+		due, err := s.digests.ListDue(ctx, now, batchSize)
+		if err != nil {
+			return sent, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		claimed := 0
+		for _, d := range due {
+			ok, err := s.digests.Claim(ctx, d, now)
+			if err != nil {
+				return sent, fault.Wrap(err, fctx.With(ctx))
+			}
+			if !ok {
+				continue
+			}
+			claimed++
+
+			ok, err = s.send(ctx, d, now)
+			if err != nil {
+				s.logger.Error("failed to send digest",
+					slog.String("error", err.Error()),
+					slog.String("account_id", d.AccountID.String()))
+				continue
+			}
+			if ok {
+				sent++
+			}
+		}
+
+		// Claimed digests are no longer due so the next batch is always new
+		// ones, unless nothing could be claimed which would loop forever.
+		if len(due) < batchSize || claimed == 0 {
+			return sent, nil
+		}
+	}
+}
+
+type contents struct {
+	notifications notification.NotificationRefs
+	unread        int
+	newThreads    []*notify_digest.Thread
+	topThreads    []*notify_digest.Thread
+}
+
+func (c contents) empty() bool {
+	return len(c.notifications) == 0 && len(c.newThreads) == 0 && len(c.topThreads) == 0
+}
+
+func (c contents) items() []notify_digest.Item {
+	items := []notify_digest.Item{}
+	for _, n := range c.notifications {
+		items = append(items, notify_digest.Item{Kind: notify_digest.ItemKindNotification, ID: n.ID})
+	}
+	for _, t := range append(c.newThreads, c.topThreads...) {
+		items = append(items, notify_digest.Item{Kind: notify_digest.ItemKindThread, ID: xid.ID(t.ID)})
+	}
+	return items
+}
+
+func (s *Sender) send(ctx context.Context, d *notify_digest.Digest, now time.Time) (bool, error) {
+	since := d.LastSent.Or(now.Add(-d.Frequency.Period()))
+
+	c, err := s.collect(ctx, d, since)
+	if err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if c.empty() {
+		return false, nil
+	}
+
+	if err := s.email(ctx, d, c); err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := s.digests.Record(ctx, d.ID, c.items()); err != nil {
+		return false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return true, nil
+}
+
+func (s *Sender) collect(ctx context.Context, d *notify_digest.Digest, since time.Time) (*contents, error) {
+	prefs, err := s.preferences.Get(ctx, d.AccountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Notifications which were already emailed individually are left out.
+	emailed := dt.Filter(notification.Events, func(e notification.Event) bool { return prefs.For(e).Email() })
+
+	c := contents{}
+
+	c.notifications, c.unread, err = s.digests.Unread(ctx, d, emailed, maxNotifications)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	newThreads, err := s.digests.NewThreads(ctx, d.AccountID, since, maxNewThreads)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	topThreads, err := s.digests.TopThreads(ctx, d.AccountID, since, maxNewThreads+maxTopThreads)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	threadID := func(t *notify_digest.Thread) xid.ID { return xid.ID(t.ID) }
+	sentThreads, err := s.digests.Sent(ctx, d.ID, notify_digest.ItemKindThread, append(dt.Map(newThreads, threadID), dt.Map(topThreads, threadID)...))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, t := range newThreads {
+		if !sentThreads[xid.ID(t.ID)] {
+			sentThreads[xid.ID(t.ID)] = true
+			c.newThreads = append(c.newThreads, t)
+		}
+	}
+
+	for _, t := range topThreads {
+		if !sentThreads[xid.ID(t.ID)] && len(c.topThreads) < maxTopThreads {
+			sentThreads[xid.ID(t.ID)] = true
+			c.topThreads = append(c.topThreads, t)
+		}
+	}
+
+	return &c, nil
+}
+
+func (s *Sender) email(ctx context.Context, d *notify_digest.Digest, c *contents) error {
+	acc, err := s.accountQuery.GetByID(ctx, d.AccountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	address, ok := notify_mail.Recipient(acc)
+	if !ok {
+		return nil
+	}
+
+	set, err := s.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	instanceTitle := set.Title.Or(settings.DefaultTitle)
+
+	subject := fmt.Sprintf("Your %s digest from %s", d.Frequency, instanceTitle)
+
+	intros := []string{fmt.Sprintf("Here's what's been happening on %s.", instanceTitle)}
+
+	if c.unread > 0 {
+		intros = append(intros, fmt.Sprintf("You have %d unread notifications:", c.unread))
+		for _, n := range c.notifications {
+			source := opt.Map(n.Source, func(p profile.Ref) string { return p.Name }).Or("Someone")
+			intros = append(intros, "- "+notify_mail.Describe(n.Event, source))
+		}
+	}
+
+	if len(c.newThreads) > 0 {
+		intros = append(intros, "New threads in categories you've posted in:")
+		for _, t := range c.newThreads {
+			intros = append(intros, fmt.Sprintf("- %s: %s", t.Title, s.threadLink(t)))
+		}
+	}
+
+	if len(c.topThreads) > 0 {
+		intros = append(intros, "Popular threads:")
+		for _, t := range c.topThreads {
+			intros = append(intros, fmt.Sprintf("- %s (%d replies, %d likes): %s", t.Title, t.Replies, t.Likes, s.threadLink(t)))
+		}
+	}
+
+	actions := []mailtemplate.Action{
+		{
+			Instructions: "To catch up, click here:",
+			Button: hermes.Button{
+				Text: "Visit " + instanceTitle,
+				Link: s.webAddress.String(),
+			},
+		},
+	}
+
+	unsubscribe, err := s.mailer.UnsubscribeOption(ctx, d.AccountID, opt.NewEmpty[notification.Event]())
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = s.mailqueue.Queue(ctx, address, acc.Name, subject, intros, actions,
+		mailqueue.WithCategory(category),
+		unsubscribe,
+	)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (s *Sender) threadLink(t *notify_digest.Thread) string {
+	return s.webAddress.JoinPath("t", t.Slug).String()
+}
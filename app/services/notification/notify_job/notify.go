@@ -2,25 +2,42 @@ package notify_job
 
 import (
 	"context"
+	"log/slog"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/opt"
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_preference"
 	"github.com/Southclaws/storyden/app/resources/account/notification/notify_writer"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/services/notification/notify_mail"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
 
 type notifyConsumer struct {
+	logger       *slog.Logger
 	notifyWriter *notify_writer.Writer
+	preferences  *notify_preference.Repository
+	mailer       *notify_mail.Mailer
+	bus          *pubsub.Bus
 }
 
 func newNotifyConsumer(
+	logger *slog.Logger,
 	notifyWriter *notify_writer.Writer,
+	preferences *notify_preference.Repository,
+	mailer *notify_mail.Mailer,
+	bus *pubsub.Bus,
 ) *notifyConsumer {
 	return &notifyConsumer{
+		logger:       logger,
 		notifyWriter: notifyWriter,
+		preferences:  preferences,
+		mailer:       mailer,
+		bus:          bus,
 	}
 }
 
@@ -30,14 +47,41 @@ func (s *notifyConsumer) notify(ctx context.Context,
 	event notification.Event,
 	item *datagraph.Ref,
 ) error {
-	itemref := opt.Map(opt.NewPtr(item), func(i datagraph.Ref) datagraph.ItemRef {
-		return &i
-	})
-
-	_, err := s.notifyWriter.Notification(ctx, targetID, event, itemref, sourceID)
+	prefs, err := s.preferences.Get(ctx, targetID)
 	if err != nil {
 		return fault.Wrap(err, fctx.With(ctx))
 	}
 
+	delivery := prefs.For(event)
+
+	if delivery.InApp() {
+		itemref := opt.Map(opt.NewPtr(item), func(i datagraph.Ref) datagraph.ItemRef {
+			return &i
+		})
+
+		n, err := s.notifyWriter.Notification(ctx, targetID, event, itemref, sourceID)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		s.bus.Publish(ctx, &message.EventNotificationCreated{
+			ID:       n.ID,
+			TargetID: targetID,
+		})
+	}
+
+	// The in-app notification has already been written at this point so a
+	// failed email is logged rather than failing the command, which would cause
+	// the notification to be written twice when the command is retried.
+	if delivery.Email() {
+		err := s.mailer.Send(ctx, targetID, sourceID, event, opt.NewPtr(item))
+		if err != nil {
+			s.logger.Error("failed to email notification",
+				slog.String("error", err.Error()),
+				slog.String("account_id", targetID.String()),
+				slog.String("event", event.String()))
+		}
+	}
+
 	return nil
 }
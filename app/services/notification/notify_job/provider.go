@@ -4,6 +4,7 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/Southclaws/storyden/app/services/notification/notify"
+	"github.com/Southclaws/storyden/app/services/notification/notify_mail"
 )
 
 func Build() fx.Option {
@@ -12,5 +13,6 @@ func Build() fx.Option {
 		fx.Invoke(runNotifyConsumer),
 
 		fx.Provide(notify.New),
+		fx.Provide(notify_mail.New),
 	)
 }
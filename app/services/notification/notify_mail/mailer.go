@@ -0,0 +1,221 @@
+// Package notify_mail delivers notifications by email to members who have
+// chosen email delivery for an event, with a signed one-click unsubscribe link.
+package notify_mail
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"net/url"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/matcornic/hermes/v2"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_digest"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_preference"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/post_search"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/comms/mailqueue"
+	"github.com/Southclaws/storyden/app/services/comms/mailtemplate"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/endec"
+)
+
+// category keeps notification emails in their own rate limit bucket so a busy
+// thread never stops a password reset from being sent.
+const category = "notification"
+
+type Mailer struct {
+	logger       *slog.Logger
+	accountQuery *account_querier.Querier
+	preferences  *notify_preference.Repository
+	digests      *notify_digest.Repository
+	postSearch   post_search.Repository
+	settings     *settings.SettingsRepository
+	mailqueue    *mailqueue.Queuer
+	endec        endec.EncrypterDecrypter
+	webAddress   url.URL
+	apiAddress   url.URL
+}
+
+func New(
+	cfg config.Config,
+	logger *slog.Logger,
+	accountQuery *account_querier.Querier,
+	preferences *notify_preference.Repository,
+	digests *notify_digest.Repository,
+	postSearch post_search.Repository,
+	settings *settings.SettingsRepository,
+	mailqueue *mailqueue.Queuer,
+	endec endec.EncrypterDecrypter,
+) *Mailer {
+	return &Mailer{
+		logger:       logger,
+		accountQuery: accountQuery,
+		preferences:  preferences,
+		digests:      digests,
+		postSearch:   postSearch,
+		settings:     settings,
+		mailqueue:    mailqueue,
+		endec:        endec,
+		webAddress:   cfg.PublicWebAddress,
+		apiAddress:   cfg.PublicAPIAddress,
+	}
+}
+
+// Send emails a single notification to the target account. Accounts without
+// an email address on file are skipped silently.
+func (m *Mailer) Send(ctx context.Context,
+	targetID account.AccountID,
+	sourceID opt.Optional[account.AccountID],
+	event notification.Event,
+	item opt.Optional[datagraph.Ref],
+) error {
+	acc, err := m.accountQuery.GetByID(ctx, targetID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	address, ok := Recipient(acc)
+	if !ok {
+		return nil
+	}
+
+	set, err := m.settings.Get(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	instanceTitle := set.Title.Or(settings.DefaultTitle)
+
+	source := "Someone"
+	if id, ok := sourceID.Get(); ok {
+		src, err := m.accountQuery.GetByID(ctx, id)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		source = src.Name
+	}
+
+	subject := fmt.Sprintf("%s on %s", Describe(event, source), instanceTitle)
+
+	link, err := m.link(ctx, item)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	unsubscribe, err := m.UnsubscribeOption(ctx, targetID, opt.New(event))
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	intros := []string{Describe(event, source) + "."}
+	actions := []mailtemplate.Action{
+		{
+			Instructions: "To see it, click here:",
+			Button: hermes.Button{
+				Text: "View",
+				Link: link,
+			},
+		},
+	}
+
+	err = m.mailqueue.Queue(ctx, address, acc.Name, subject, intros, actions,
+		mailqueue.WithCategory(category),
+		unsubscribe,
+	)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// link points at the item the notification is about, or the notification list
+// for items that have no page of their own.
+func (m *Mailer) link(ctx context.Context, item opt.Optional[datagraph.Ref]) (string, error) {
+	ref, ok := item.Get()
+	if !ok {
+		return m.webAddress.JoinPath("notifications").String(), nil
+	}
+
+	switch ref.Kind {
+	case datagraph.KindPost, datagraph.KindThread, datagraph.KindReply:
+		loc, err := m.postSearch.Locate(ctx, post.ID(ref.ID))
+		if err != nil {
+			return "", fault.Wrap(err, fctx.With(ctx))
+		}
+
+		slug, fragment, _ := strings.Cut(loc.Slug, "#")
+		u := m.webAddress.JoinPath("t", slug)
+		u.Fragment = fragment
+		return u.String(), nil
+
+	case datagraph.KindProfile:
+		acc, err := m.accountQuery.GetByID(ctx, account.AccountID(ref.ID))
+		if err != nil {
+			return "", fault.Wrap(err, fctx.With(ctx))
+		}
+		return m.webAddress.JoinPath("m", acc.Handle).String(), nil
+
+	default:
+		return m.webAddress.JoinPath("notifications").String(), nil
+	}
+}
+
+// Recipient picks the address to send notifications to. Only verified
+// addresses are used, an unverified address may belong to someone else.
+func Recipient(acc *account.AccountWithEdges) (mail.Address, bool) {
+	for _, e := range acc.EmailAddresses {
+		if e.Verified {
+			return e.Email, true
+		}
+	}
+
+	return mail.Address{}, false
+}
+
+// Describe summarises a notification in a short sentence for use in emails.
+func Describe(event notification.Event, source string) string {
+	switch event {
+	case notification.EventThreadReply:
+		return source + " replied to your thread"
+	case notification.EventReplyToReply:
+		return source + " replied to your post"
+	case notification.EventPostLike:
+		return source + " liked your post"
+	case notification.EventFollow:
+		return source + " followed you"
+	case notification.EventProfileMention:
+		return source + " mentioned you"
+	case notification.EventEventHostAdded:
+		return source + " added you as an event host"
+	case notification.EventMemberAttendingEvent:
+		return source + " is attending your event"
+	case notification.EventMemberDeclinedEvent:
+		return source + " declined your event"
+	case notification.EventAttendeeRemoved:
+		return "You were removed from an event"
+	case notification.EventReportSubmitted:
+		return source + " submitted a report"
+	case notification.EventReportUpdated:
+		return "A report you submitted was updated"
+	case notification.EventAnswerAccepted:
+		return source + " accepted your reply as the answer"
+	case notification.EventDirectMessage:
+		return source + " sent you a message"
+	case notification.EventDataExportReady:
+		return "Your data export is ready"
+	default:
+		return "You have a new notification"
+	}
+}
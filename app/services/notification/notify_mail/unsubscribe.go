@@ -0,0 +1,155 @@
+package notify_mail
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/services/comms/mailqueue"
+	"github.com/Southclaws/storyden/internal/infrastructure/endec"
+)
+
+var ErrInvalidToken = fault.New("invalid unsubscribe token", ftag.With(ftag.InvalidArgument))
+
+const (
+	accountIDKey = "account_id"
+	eventKey     = "unsubscribe_event"
+
+	// Unsubscribe links must keep working for as long as someone might dig an
+	// old email out of their inbox, so they're valid for a long time.
+	unsubscribeTokenLifespan = time.Hour * 24 * 365
+)
+
+// UnsubscribeToken signs a token which stops emails for the given event, or
+// for every event when none is specified, without needing to sign in.
+func (m *Mailer) UnsubscribeToken(ctx context.Context, accountID account.AccountID, event opt.Optional[notification.Event]) (string, error) {
+	claims := endec.Claims{
+		accountIDKey: accountID.String(),
+	}
+
+	if e, ok := event.Get(); ok {
+		claims[eventKey] = e.String()
+	}
+
+	token, err := m.endec.Encrypt(claims, unsubscribeTokenLifespan)
+	if err != nil {
+		return "", fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return token, nil
+}
+
+// CheckUnsubscribeToken validates the token without changing anything, so a
+// page can ask the member to confirm before they're unsubscribed.
+func (m *Mailer) CheckUnsubscribeToken(ctx context.Context, token string) error {
+	if _, _, err := m.parseUnsubscribeToken(ctx, token); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Unsubscribe validates the token and moves the event it was issued for back
+// to the in-app notification list only. Tokens issued for every event, such as
+// those in digest emails, also stop the digest.
+func (m *Mailer) Unsubscribe(ctx context.Context, token string) error {
+	accountID, event, err := m.parseUnsubscribeToken(ctx, token)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	current, err := m.preferences.Get(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	events := notification.Events
+	if e, ok := event.Get(); ok {
+		events = []notification.Event{e}
+	} else {
+		_, err := m.digests.SetFrequency(ctx, accountID, notification.DigestFrequencyOff)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	update := notification.Preferences{}
+	for _, e := range events {
+		d := current.For(e)
+		if d == notification.DeliveryEmail || d == notification.DeliveryDigest {
+			update[e] = notification.DeliveryInApp
+		}
+	}
+
+	_, err = m.preferences.Set(ctx, accountID, update)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (m *Mailer) parseUnsubscribeToken(ctx context.Context, token string) (account.AccountID, opt.Optional[notification.Event], error) {
+	none := opt.NewEmpty[notification.Event]()
+
+	claims, err := m.endec.Decrypt(token)
+	if err != nil {
+		return account.AccountID{}, none, fault.Wrap(ErrInvalidToken,
+			fctx.With(ctx),
+			fmsg.WithDesc("failed to decrypt token", "This unsubscribe link is invalid or has expired."))
+	}
+
+	rawID, _ := claims[accountIDKey].(string)
+	id, err := xid.FromString(rawID)
+	if err != nil {
+		return account.AccountID{}, none, fault.Wrap(ErrInvalidToken, fctx.With(ctx), fmsg.With("failed to parse account_id in token"))
+	}
+
+	raw, ok := claims[eventKey].(string)
+	if !ok {
+		return account.AccountID(id), none, nil
+	}
+
+	e, err := notification.NewEvent(raw)
+	if err != nil {
+		return account.AccountID{}, none, fault.Wrap(ErrInvalidToken, fctx.With(ctx), fmsg.With("failed to parse event in token"))
+	}
+
+	return account.AccountID(id), opt.New(e), nil
+}
+
+// UnsubscribeOption builds the unsubscribe link and headers for an email sent
+// to the account about the given event, or about all events if none is given.
+func (m *Mailer) UnsubscribeOption(ctx context.Context, accountID account.AccountID, event opt.Optional[notification.Event]) (mailqueue.Option, error) {
+	token, err := m.UnsubscribeToken(ctx, accountID, event)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// The link in the body is opened in a browser with a GET request, which
+	// only shows a page asking to confirm. Mail scanners and link prefetchers
+	// follow links too, so only the POST from that page or from the one-click
+	// header (RFC 8058) actually unsubscribes.
+	link := m.unsubscribeEndpoint(token)
+
+	return mailqueue.WithUnsubscribe(link, link), nil
+}
+
+// PreferencesLink is where members can review what they're emailed about.
+func (m *Mailer) PreferencesLink() string {
+	return m.webAddress.JoinPath("settings").String()
+}
+
+func (m *Mailer) unsubscribeEndpoint(token string) string {
+	u := m.apiAddress.JoinPath("/api/notifications/unsubscribe")
+	u.RawQuery = "token=" + token
+	return u.String()
+}
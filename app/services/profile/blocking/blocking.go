@@ -0,0 +1,61 @@
+package blocking
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/profile"
+	"github.com/Southclaws/storyden/app/resources/profile/block_querier"
+	"github.com/Southclaws/storyden/app/resources/profile/block_writer"
+)
+
+var errBlockSelf = fault.New("cannot block self")
+
+type BlockManager struct {
+	blockQuerier *block_querier.Querier
+	blockWriter  *block_writer.Writer
+}
+
+func New(blockQuerier *block_querier.Querier, blockWriter *block_writer.Writer) *BlockManager {
+	return &BlockManager{blockQuerier: blockQuerier, blockWriter: blockWriter}
+}
+
+func (b *BlockManager) Block(ctx context.Context, accountID, blockedID account.AccountID) error {
+	if accountID == blockedID {
+		return fault.Wrap(errBlockSelf,
+			fctx.With(ctx),
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("self", "You cannot block yourself."),
+		)
+	}
+
+	err := b.blockWriter.Block(ctx, accountID, blockedID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (b *BlockManager) Unblock(ctx context.Context, accountID, blockedID account.AccountID) error {
+	err := b.blockWriter.Unblock(ctx, accountID, blockedID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (b *BlockManager) List(ctx context.Context, accountID account.AccountID) ([]*profile.Ref, error) {
+	profiles, err := b.blockQuerier.List(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return profiles, nil
+}
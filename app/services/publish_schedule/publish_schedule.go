@@ -0,0 +1,205 @@
+// Package publish_schedule allows threads, library pages and events to be
+// queued for publishing at a future time.
+package publish_schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/event/event_querier"
+	"github.com/Southclaws/storyden/app/resources/event/event_ref"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/library/node_querier"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/schedule"
+	"github.com/Southclaws/storyden/app/resources/schedule/schedule_querier"
+	"github.com/Southclaws/storyden/app/resources/schedule/schedule_writer"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+)
+
+var (
+	errUnsupportedKind  = fault.New("only threads, library pages and events can be scheduled")
+	errPublishAtPast    = fault.New("publish time must be in the future")
+	errAlreadyPublished = fault.New("item is already published")
+	errNotOwner         = fault.New("not the owner of the scheduled item")
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New, newPublisher),
+		fx.Invoke(runPublisher),
+	)
+}
+
+type Manager struct {
+	accountQuery  *account_querier.Querier
+	threadQuerier *thread_querier.Querier
+	nodeQuerier   *node_querier.Querier
+	eventQuerier  *event_querier.Querier
+	querier       *schedule_querier.Querier
+	writer        *schedule_writer.Writer
+}
+
+func New(
+	accountQuery *account_querier.Querier,
+	threadQuerier *thread_querier.Querier,
+	nodeQuerier *node_querier.Querier,
+	eventQuerier *event_querier.Querier,
+	querier *schedule_querier.Querier,
+	writer *schedule_writer.Writer,
+) *Manager {
+	return &Manager{
+		accountQuery:  accountQuery,
+		threadQuerier: threadQuerier,
+		nodeQuerier:   nodeQuerier,
+		eventQuerier:  eventQuerier,
+		querier:       querier,
+		writer:        writer,
+	}
+}
+
+// Schedule queues an unpublished item to be published at the given time. The
+// session account must be allowed to publish the item, replacing any existing
+// schedule for it.
+func (m *Manager) Schedule(ctx context.Context, target datagraph.Ref, publishAt time.Time) (*schedule.Schedule, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !publishAt.After(time.Now()) {
+		return nil, fault.Wrap(errPublishAtPast, fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("publish time in past", "The publish time must be in the future."))
+	}
+
+	owner, vis, err := m.lookup(ctx, target)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := authorise(ctx, target.Kind, owner(accountID)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if vis == visibility.VisibilityPublished {
+		return nil, fault.Wrap(errAlreadyPublished, fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("already published", "This item has already been published."))
+	}
+
+	id, err := m.writer.Set(ctx, target, publishAt, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s, err := m.querier.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s, nil
+}
+
+// List returns pending schedules. Members who can manage posts or the library
+// see every pending schedule, everyone else only sees their own.
+func (m *Manager) List(ctx context.Context) ([]*schedule.Schedule, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	filters := []schedule_querier.Filter{}
+
+	if err := session.Authorise(ctx, nil, rbac.PermissionManagePosts, rbac.PermissionManageLibrary); err != nil {
+		filters = append(filters, schedule_querier.WithAccount(accountID))
+	}
+
+	list, err := m.querier.List(ctx, filters...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return list, nil
+}
+
+// Cancel removes a pending schedule, leaving the item unpublished.
+func (m *Manager) Cancel(ctx context.Context, id schedule.ScheduleID) error {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	s, err := m.querier.Get(ctx, id)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := authorise(ctx, s.Target.Kind, s.Account.ID == accountID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.writer.Delete(ctx, id); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// lookup resolves the target's current visibility and a predicate reporting
+// whether an account owns it, which permits publishing without moderation
+// permissions. Ownership follows the same rules as each item's update path.
+func (m *Manager) lookup(ctx context.Context, target datagraph.Ref) (func(account.AccountID) bool, visibility.Visibility, error) {
+	switch target.Kind {
+	case datagraph.KindThread:
+		thr, err := m.threadQuerier.Get(ctx, post.ID(target.ID), pagination.Parameters{}, opt.NewEmpty[account.AccountID]())
+		if err != nil {
+			return nil, visibility.Visibility{}, fault.Wrap(err, fctx.With(ctx))
+		}
+		return func(id account.AccountID) bool { return thr.Author.ID == id }, thr.Visibility, nil
+
+	case datagraph.KindNode:
+		n, err := m.nodeQuerier.Get(ctx, library.NewID(target.ID))
+		if err != nil {
+			return nil, visibility.Visibility{}, fault.Wrap(err, fctx.With(ctx))
+		}
+		return func(id account.AccountID) bool { return n.Owner.ID == id }, n.Visibility, nil
+
+	case datagraph.KindEvent:
+		evt, err := m.eventQuerier.Get(ctx, event_ref.NewID(target.ID))
+		if err != nil {
+			return nil, visibility.Visibility{}, fault.Wrap(err, fctx.With(ctx))
+		}
+		return evt.Participants.IsHost, evt.Visibility, nil
+
+	default:
+		return nil, visibility.Visibility{}, fault.Wrap(errUnsupportedKind, fctx.With(ctx), ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("unsupported kind", "Only threads, library pages and events can be scheduled for publishing."))
+	}
+}
+
+func authorise(ctx context.Context, kind datagraph.Kind, owner bool) error {
+	perm := rbac.PermissionManagePosts
+	if kind != datagraph.KindThread {
+		perm = rbac.PermissionManageLibrary
+	}
+
+	return session.Authorise(ctx, func() error {
+		if !owner {
+			return fault.Wrap(errNotOwner, fctx.With(ctx))
+		}
+		return nil
+	}, perm)
+}
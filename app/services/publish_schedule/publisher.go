@@ -0,0 +1,211 @@
+package publish_schedule
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/event/event_ref"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/schedule"
+	"github.com/Southclaws/storyden/app/resources/schedule/schedule_querier"
+	"github.com/Southclaws/storyden/app/resources/schedule/schedule_writer"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/event/event_management"
+	"github.com/Southclaws/storyden/app/services/library/node_visibility"
+	"github.com/Southclaws/storyden/app/services/thread"
+	scheduler "github.com/Southclaws/storyden/internal/infrastructure/schedule"
+)
+
+const (
+	// publishInterval is how often due schedules are checked for, so an item
+	// may be published up to this long after its scheduled time.
+	publishInterval = time.Minute
+
+	// maxAttempts is how many times publishing an item is tried before its
+	// schedule is marked as failed. The wait between attempts doubles each
+	// time, so the last attempt is around half an hour after the first.
+	maxAttempts = 5
+
+	// batchSize bounds how many due schedules are attempted per check.
+	batchSize = 100
+)
+
+// retryAfter is how long to wait after the given attempt before the next one.
+// It also holds off other instances while an attempt is in progress.
+func retryAfter(attempt int) time.Duration {
+	return publishInterval << attempt
+}
+
+type Publisher struct {
+	logger       *slog.Logger
+	accountQuery *account_querier.Querier
+	querier      *schedule_querier.Querier
+	writer       *schedule_writer.Writer
+	threads      thread.Service
+	nodes        *node_visibility.Controller
+	events       *event_management.Manager
+}
+
+func newPublisher(
+	logger *slog.Logger,
+	accountQuery *account_querier.Querier,
+	querier *schedule_querier.Querier,
+	writer *schedule_writer.Writer,
+	threads thread.Service,
+	nodes *node_visibility.Controller,
+	events *event_management.Manager,
+) *Publisher {
+	return &Publisher{
+		logger:       logger,
+		accountQuery: accountQuery,
+		querier:      querier,
+		writer:       writer,
+		threads:      threads,
+		nodes:        nodes,
+		events:       events,
+	}
+}
+
+// PublishDue publishes every item whose scheduled time has passed and returns
+// how many were published. Items are published through the same services as a
+// manual publish, acting as the scheduling account, so the usual published
+// events are emitted and permissions are checked at the time of publishing.
+//
+// Each schedule is claimed before it's attempted so when several instances run
+// this at once, only one of them publishes a given item.
+func (p *Publisher) PublishDue(ctx context.Context, now time.Time) (int, error) {
+	due, err := p.querier.List(ctx,
+		schedule_querier.WithDueBefore(now),
+		schedule_querier.WithLimit(batchSize),
+	)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	published := 0
+	for _, s := range due {
+		claimed, err := p.writer.Claim(ctx, s, now.Add(retryAfter(s.Attempts+1)))
+		if err != nil {
+			return published, fault.Wrap(err, fctx.With(ctx))
+		}
+		if !claimed {
+			continue
+		}
+
+		err = p.publish(ctx, s)
+		if err != nil {
+			p.logger.Error("failed to publish scheduled item",
+				slog.String("schedule_id", s.ID.String()),
+				slog.String("target_kind", s.Target.Kind.String()),
+				slog.String("target_id", s.Target.ID.String()),
+				slog.Int("attempt", s.Attempts+1),
+				slog.String("error", err.Error()))
+
+			// Retry later unless the item is gone or the account can no longer
+			// publish it, in which case the schedule can never succeed.
+			switch ftag.Get(err) {
+			case ftag.NotFound, ftag.PermissionDenied:
+			default:
+				if err := p.failed(ctx, s, err, now); err != nil {
+					return published, fault.Wrap(err, fctx.With(ctx))
+				}
+				continue
+			}
+		} else {
+			published++
+		}
+
+		if err := p.writer.Delete(ctx, s.ID); err != nil {
+			// Cancelled while it was being published.
+			if ftag.Get(err) == ftag.NotFound {
+				continue
+			}
+			return published, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return published, nil
+}
+
+// failed records a failed attempt, once there have been too many the schedule
+// is marked as failed and left for the member to reschedule or cancel.
+func (p *Publisher) failed(ctx context.Context, s *schedule.Schedule, cause error, now time.Time) error {
+	reason := string(fmsg.GetIssue(cause))
+	if reason == "" {
+		reason = "The item could not be published."
+	}
+
+	failedAt := opt.NewEmpty[time.Time]()
+	if s.Attempts+1 >= maxAttempts {
+		failedAt = opt.New(now)
+	}
+
+	err := p.writer.Failed(ctx, s.ID, reason, failedAt)
+	if err != nil && ftag.Get(err) != ftag.NotFound {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (p *Publisher) publish(ctx context.Context, s *schedule.Schedule) error {
+	acc, err := p.accountQuery.GetByID(ctx, s.Account.ID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ctx = session.WithAccount(ctx, acc.Account, acc.Roles.Roles())
+
+	switch s.Target.Kind {
+	case datagraph.KindThread:
+		_, err = p.threads.Update(ctx, post.ID(s.Target.ID), thread.Partial{
+			Visibility: opt.New(visibility.VisibilityPublished),
+		})
+
+	case datagraph.KindNode:
+		_, err = p.nodes.ChangeVisibility(ctx, library.NewID(s.Target.ID), visibility.VisibilityPublished)
+
+	case datagraph.KindEvent:
+		_, err = p.events.Update(ctx, event_ref.NewID(s.Target.ID), event_management.Partial{
+			Visibility: opt.New(visibility.VisibilityPublished),
+		})
+
+	default:
+		err = fault.Wrap(errUnsupportedKind, ftag.With(ftag.NotFound))
+	}
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func runPublisher(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	p *Publisher,
+) {
+	scheduler.Every(ctx, lc, logger, "publish_schedule", publishInterval, func(ctx context.Context) error {
+		n, err := p.PublishDue(ctx, time.Now())
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		if n > 0 {
+			logger.Info("published scheduled items", slog.Int("count", n))
+		}
+		return nil
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/post/reaction"
 	"github.com/Southclaws/storyden/app/resources/post/thread_cache"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/thread/thread_lock"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
 
@@ -25,6 +26,7 @@ type Reactor struct {
 	postQuerier    *post_querier.Querier
 	bus            *pubsub.Bus
 	cache          *thread_cache.Cache
+	lockGuard      *thread_lock.Guard
 }
 
 func New(
@@ -34,6 +36,7 @@ func New(
 	postQuerier *post_querier.Querier,
 	bus *pubsub.Bus,
 	cache *thread_cache.Cache,
+	lockGuard *thread_lock.Guard,
 ) *Reactor {
 	return &Reactor{
 		accountQuerier: accountQuerier,
@@ -42,6 +45,7 @@ func New(
 		postQuerier:    postQuerier,
 		bus:            bus,
 		cache:          cache,
+		lockGuard:      lockGuard,
 	}
 }
 
@@ -56,6 +60,10 @@ func (s *Reactor) Add(ctx context.Context, postID post.ID, emoji string) (*react
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	if err := s.lockGuard.Authorise(ctx, accountID, pref.Root); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	if err := s.cache.Invalidate(ctx, xid.ID(pref.Root)); err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
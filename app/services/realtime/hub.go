@@ -0,0 +1,192 @@
+// Package realtime fans out activity to clients holding a streaming connection
+// open to this instance. Events are received from the message bus using
+// broadcast subscriptions so every instance sees every event regardless of
+// which instance the activity originated from.
+package realtime
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+// How many events may be waiting for a slow client before new ones are
+// dropped. Clients are expected to re-fetch when they reconnect anyway.
+const subscriberBuffer = 32
+
+type EventKind string
+
+const (
+	EventNotification EventKind = "notification"
+	EventReplyCreated EventKind = "reply_created"
+	EventReplyUpdated EventKind = "reply_updated"
+	EventReplyDeleted EventKind = "reply_deleted"
+	EventReactAdded   EventKind = "react_added"
+	EventReactRemoved EventKind = "react_removed"
+)
+
+// Event is a minimal description of something that happened, clients use the
+// regular API to fetch the full resource so visibility rules are respected.
+type Event struct {
+	Kind           EventKind
+	NotificationID xid.ID
+	ThreadID       post.ID
+	PostID         post.ID
+}
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(runSubscriptions),
+	)
+}
+
+type Hub struct {
+	logger *slog.Logger
+
+	mu          sync.RWMutex
+	subscribers map[*Subscriber]struct{}
+}
+
+func New(logger *slog.Logger) *Hub {
+	return &Hub{
+		logger:      logger,
+		subscribers: map[*Subscriber]struct{}{},
+	}
+}
+
+// Subscriber receives notifications for one account and activity within the
+// threads it asked for. It must be closed once the connection goes away.
+type Subscriber struct {
+	hub       *Hub
+	accountID account.AccountID
+	threads   map[post.ID]struct{}
+	events    chan Event
+	once      sync.Once
+}
+
+func (h *Hub) Subscribe(accountID account.AccountID, threads []post.ID) *Subscriber {
+	s := &Subscriber{
+		hub:       h,
+		accountID: accountID,
+		threads:   make(map[post.ID]struct{}, len(threads)),
+		events:    make(chan Event, subscriberBuffer),
+	}
+	for _, t := range threads {
+		s.threads[t] = struct{}{}
+	}
+
+	h.mu.Lock()
+	h.subscribers[s] = struct{}{}
+	h.mu.Unlock()
+
+	return s
+}
+
+func (s *Subscriber) Events() <-chan Event {
+	return s.events
+}
+
+func (s *Subscriber) Close() {
+	s.once.Do(func() {
+		s.hub.mu.Lock()
+		delete(s.hub.subscribers, s)
+		s.hub.mu.Unlock()
+
+		close(s.events)
+	})
+}
+
+func (h *Hub) publishToAccount(accountID account.AccountID, e Event) {
+	h.publish(e, func(s *Subscriber) bool { return s.accountID == accountID })
+}
+
+func (h *Hub) publishToThread(threadID post.ID, e Event) {
+	h.publish(e, func(s *Subscriber) bool {
+		_, ok := s.threads[threadID]
+		return ok
+	})
+}
+
+func (h *Hub) publish(e Event, match func(s *Subscriber) bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for s := range h.subscribers {
+		if !match(s) {
+			continue
+		}
+
+		select {
+		case s.events <- e:
+		default:
+			h.logger.Warn("dropped realtime event for slow subscriber",
+				slog.String("account_id", s.accountID.String()),
+				slog.String("kind", string(e.Kind)))
+		}
+	}
+}
+
+func runSubscriptions(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	bus *pubsub.Bus,
+	h *Hub,
+) {
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		_, err := pubsub.SubscribeBroadcast(ctx, bus, "realtime.notification_created", func(ctx context.Context, evt *message.EventNotificationCreated) error {
+			h.publishToAccount(evt.TargetID, Event{Kind: EventNotification, NotificationID: evt.ID})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = pubsub.SubscribeBroadcast(ctx, bus, "realtime.reply_created", func(ctx context.Context, evt *message.EventThreadReplyCreated) error {
+			h.publishToThread(evt.ThreadID, Event{Kind: EventReplyCreated, ThreadID: evt.ThreadID, PostID: evt.ReplyID})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = pubsub.SubscribeBroadcast(ctx, bus, "realtime.reply_updated", func(ctx context.Context, evt *message.EventThreadReplyUpdated) error {
+			h.publishToThread(evt.ThreadID, Event{Kind: EventReplyUpdated, ThreadID: evt.ThreadID, PostID: evt.ReplyID})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = pubsub.SubscribeBroadcast(ctx, bus, "realtime.reply_deleted", func(ctx context.Context, evt *message.EventThreadReplyDeleted) error {
+			h.publishToThread(evt.ThreadID, Event{Kind: EventReplyDeleted, ThreadID: evt.ThreadID, PostID: evt.ReplyID})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = pubsub.SubscribeBroadcast(ctx, bus, "realtime.post_reacted", func(ctx context.Context, evt *message.EventPostReacted) error {
+			h.publishToThread(evt.RootPostID, Event{Kind: EventReactAdded, ThreadID: evt.RootPostID, PostID: evt.PostID})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = pubsub.SubscribeBroadcast(ctx, bus, "realtime.post_unreacted", func(ctx context.Context, evt *message.EventPostUnreacted) error {
+			h.publishToThread(evt.RootPostID, Event{Kind: EventReactRemoved, ThreadID: evt.RootPostID, PostID: evt.PostID})
+			return nil
+		})
+
+		return err
+	}))
+}
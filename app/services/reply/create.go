@@ -25,6 +25,10 @@ func (s *Mutator) Create(
 	parentID post.ID,
 	partial Partial,
 ) (*reply.Reply, error) {
+	if err := s.lockGuard.Authorise(ctx, authorID, parentID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	opts := partial.Opts()
 	opts = append(opts, reply_writer.WithVisibility(visibility.VisibilityPublished))
 
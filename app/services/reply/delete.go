@@ -6,8 +6,12 @@ import (
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
 
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/post"
 	"github.com/Southclaws/storyden/app/resources/rbac"
@@ -50,11 +54,30 @@ func (s *Mutator) Delete(ctx context.Context, postID post.ID) error {
 		return fault.Wrap(err, fctx.With(ctx))
 	}
 
-	err = s.replyWriter.Delete(ctx, postID)
+	wasAnswer, err := s.replyWriter.Delete(ctx, postID)
 	if err != nil {
 		return fault.Wrap(err, fctx.With(ctx))
 	}
 
+	if wasAnswer {
+		// The thread is now unanswered, which is both cached and indexed.
+		if err := s.cache.Invalidate(ctx, xid.ID(pref.RootPostID)); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		s.bus.Publish(ctx, &message.EventThreadUpdated{ID: p.RootPostID})
+	}
+
+	if p.Author.ID != aid {
+		err = s.auditWriter.Record(ctx, opt.New(aid), audit.ActionReplyDeleted,
+			audit_writer.WithTarget(*datagraph.NewRef(p)),
+			audit_writer.WithBefore(p.Content.Short()),
+		)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	s.bus.Publish(ctx, &message.EventThreadReplyDeleted{
 		ThreadID: p.RootPostID,
 		ReplyID:  p.ID,
@@ -6,8 +6,10 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/post_revision"
 	"github.com/Southclaws/storyden/app/resources/post/reply_querier"
 	"github.com/Southclaws/storyden/app/resources/post/reply_writer"
 	"github.com/Southclaws/storyden/app/resources/post/thread_cache"
@@ -16,6 +18,7 @@ import (
 	"github.com/Southclaws/storyden/app/services/moderation"
 	"github.com/Southclaws/storyden/app/services/reply/reply_notify"
 	"github.com/Southclaws/storyden/app/services/report/system_report"
+	"github.com/Southclaws/storyden/app/services/thread/thread_lock"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
 
@@ -42,14 +45,17 @@ func Build() fx.Option {
 }
 
 type Mutator struct {
-	accountQuery  *account_querier.Querier
-	replyQuerier  *reply_querier.Querier
-	replyWriter   *reply_writer.Writer
-	fetcher       *fetcher.Fetcher
-	bus           *pubsub.Bus
-	cpm           *moderation.Manager
-	cache         *thread_cache.Cache
+	accountQuery   *account_querier.Querier
+	replyQuerier   *reply_querier.Querier
+	replyWriter    *reply_writer.Writer
+	fetcher        *fetcher.Fetcher
+	bus            *pubsub.Bus
+	cpm            *moderation.Manager
+	cache          *thread_cache.Cache
 	systemReporter *system_report.Manager
+	revisions      *post_revision.Repository
+	auditWriter    *audit_writer.Writer
+	lockGuard      *thread_lock.Guard
 }
 
 func New(
@@ -61,6 +67,9 @@ func New(
 	cpm *moderation.Manager,
 	cache *thread_cache.Cache,
 	systemReporter *system_report.Manager,
+	revisions *post_revision.Repository,
+	auditWriter *audit_writer.Writer,
+	lockGuard *thread_lock.Guard,
 ) *Mutator {
 	return &Mutator{
 		accountQuery:   accountQuery,
@@ -71,5 +80,8 @@ func New(
 		cpm:            cpm,
 		cache:          cache,
 		systemReporter: systemReporter,
+		revisions:      revisions,
+		auditWriter:    auditWriter,
+		lockGuard:      lockGuard,
 	}
 }
@@ -55,6 +55,7 @@ func (s *Mutator) Update(ctx context.Context, replyID post.ID, partial Partial)
 	}
 
 	oldVisibility := p.Visibility
+	oldContent := p.Content
 	opts := partial.Opts()
 
 	if content, ok := partial.Content.Get(); ok && !userSetVisibility {
@@ -82,6 +83,14 @@ func (s *Mutator) Update(ctx context.Context, replyID post.ID, partial Partial)
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	// As with threads, the previous version is only kept once the edit has
+	// been written so a failed update doesn't leave a phantom revision.
+	if oldContent.HTML() != p.Content.HTML() {
+		if _, err := s.revisions.Create(ctx, replyID, aid, "", oldContent); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	s.bus.Publish(ctx, &message.EventThreadReplyUpdated{
 		ThreadID: p.RootPostID,
 		ReplyID:  p.ID,
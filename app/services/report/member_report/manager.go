@@ -10,6 +10,8 @@ import (
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/conversation"
+	"github.com/Southclaws/storyden/app/resources/conversation/conversation_querier"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/pagination"
@@ -21,20 +23,23 @@ import (
 )
 
 type Manager struct {
-	reportQuerier *report_querier.Querier
-	reportWriter  *report_writer.Writer
-	bus           *pubsub.Bus
+	reportQuerier       *report_querier.Querier
+	reportWriter        *report_writer.Writer
+	conversationQuerier *conversation_querier.Querier
+	bus                 *pubsub.Bus
 }
 
 func New(
 	reportQuerier *report_querier.Querier,
 	reportWriter *report_writer.Writer,
+	conversationQuerier *conversation_querier.Querier,
 	bus *pubsub.Bus,
 ) *Manager {
 	return &Manager{
-		reportQuerier: reportQuerier,
-		reportWriter:  reportWriter,
-		bus:           bus,
+		reportQuerier:       reportQuerier,
+		reportWriter:        reportWriter,
+		conversationQuerier: conversationQuerier,
+		bus:                 bus,
 	}
 }
 
@@ -49,6 +54,12 @@ func (m *Manager) Submit(
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	if targetKind == datagraph.KindMessage {
+		if err := m.canSeeMessage(ctx, acc.ID, conversation.MessageID(targetID)); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	rep, err := m.reportWriter.Create(ctx, targetID, targetKind, opt.New(acc.ID), comment)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -68,6 +79,26 @@ func (m *Manager) Submit(
 	return rep, nil
 }
 
+// canSeeMessage prevents reporting messages from conversations the reporter is
+// not part of, private messages are otherwise invisible to other members.
+func (m *Manager) canSeeMessage(ctx context.Context, accountID account.AccountID, messageID conversation.MessageID) error {
+	msg, err := m.conversationQuerier.GetMessage(ctx, messageID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	c, err := m.conversationQuerier.Probe(ctx, msg.ConversationID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, ok := c.Participant(accountID); !ok {
+		return fault.Wrap(fault.New("message not found"), fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	return nil
+}
+
 func (m *Manager) List(
 	ctx context.Context,
 	page pagination.Parameters,
@@ -8,6 +8,8 @@ import (
 	"github.com/Southclaws/opt"
 
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/pagination"
@@ -21,17 +23,20 @@ import (
 type Manager struct {
 	reportQuerier *report_querier.Querier
 	reportWriter  *report_writer.Writer
+	auditWriter   *audit_writer.Writer
 	bus           *pubsub.Bus
 }
 
 func New(
 	reportQuerier *report_querier.Querier,
 	reportWriter *report_writer.Writer,
+	auditWriter *audit_writer.Writer,
 	bus *pubsub.Bus,
 ) *Manager {
 	return &Manager{
 		reportQuerier: reportQuerier,
 		reportWriter:  reportWriter,
+		auditWriter:   auditWriter,
 		bus:           bus,
 	}
 }
@@ -97,11 +102,27 @@ func (m *Manager) Update(
 		handledBy = opt.New(acc.ID)
 	}
 
+	previous, err := m.reportQuerier.Get(ctx, reportID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
 	rep, err := m.reportWriter.Update(ctx, reportID, opts.Status, handledBy)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	if rep.Status != previous.Status {
+		err = m.auditWriter.Record(ctx, opt.New(acc.ID), audit.ActionReportUpdated,
+			audit_writer.WithTarget(datagraph.Ref{ID: rep.TargetItemID, Kind: rep.TargetItemKind}),
+			audit_writer.WithBefore("status: "+previous.Status.String()),
+			audit_writer.WithAfter("status: "+rep.Status.String()),
+		)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	var targetRef *datagraph.Ref
 	if rep.TargetItem != nil {
 		targetRef = datagraph.NewRef(rep.TargetItem)
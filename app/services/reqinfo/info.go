@@ -3,6 +3,7 @@ package reqinfo
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"time"
 
@@ -13,10 +14,15 @@ import (
 )
 
 type Info struct {
-	UserAgent  useragent.UserAgent
-	CacheQuery cachecontrol.Query
+	UserAgent     useragent.UserAgent
+	ClientAddress string
+	CacheQuery    cachecontrol.Query
 }
 
+// clientAddressHeaders are set by proxies and CDNs in front of Storyden to the
+// address of the original client, in order of preference.
+var clientAddressHeaders = []string{"CF-Connecting-IP", "X-Real-IP", "True-Client-IP"}
+
 type infoKey struct{}
 
 func WithRequestInfo(ctx context.Context, r *http.Request) context.Context {
@@ -33,8 +39,9 @@ func WithRequestInfo(ctx context.Context, r *http.Request) context.Context {
 	}
 
 	info := Info{
-		UserAgent:  ua,
-		CacheQuery: cachecontrol.NewQuery(ifNoneMatch, ifModifiedSince),
+		UserAgent:     ua,
+		ClientAddress: clientAddress(r),
+		CacheQuery:    cachecontrol.NewQuery(ifNoneMatch, ifModifiedSince),
 	}
 
 	return context.WithValue(ctx, infoKey{}, info)
@@ -47,11 +54,41 @@ func GetDeviceName(ctx context.Context) string {
 		return "Unknown"
 	}
 
-	ua := i.UserAgent
+	return deviceName(i.UserAgent)
+}
+
+// DeviceName produces a readable name for a device from a raw user agent, for
+// when the request in question isn't the current one.
+func DeviceName(userAgent string) string {
+	return deviceName(useragent.Parse(userAgent))
+}
 
+func deviceName(ua useragent.UserAgent) string {
 	return fmt.Sprintf("%s (%s)", ua.Name, ua.OS)
 }
 
+// GetUserAgent returns the raw User-Agent header of the request, if any.
+func GetUserAgent(ctx context.Context) opt.Optional[string] {
+	v := ctx.Value(infoKey{})
+	i, ok := v.(Info)
+	if !ok {
+		return opt.NewEmpty[string]()
+	}
+
+	return opt.NewIf(i.UserAgent.String, notEmpty)
+}
+
+// GetClientAddress returns the IP address of the client making the request.
+func GetClientAddress(ctx context.Context) opt.Optional[string] {
+	v := ctx.Value(infoKey{})
+	i, ok := v.(Info)
+	if !ok {
+		return opt.NewEmpty[string]()
+	}
+
+	return opt.NewIf(i.ClientAddress, notEmpty)
+}
+
 func GetCacheQuery(ctx context.Context) cachecontrol.Query {
 	v := ctx.Value(infoKey{})
 	i, ok := v.(Info)
@@ -62,6 +99,21 @@ func GetCacheQuery(ctx context.Context) cachecontrol.Query {
 	return i.CacheQuery
 }
 
+func clientAddress(r *http.Request) string {
+	for _, h := range clientAddressHeaders {
+		if v := r.Header.Get(h); v != "" {
+			return v
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return ""
+	}
+
+	return host
+}
+
 func notEmpty(s string) bool {
 	return s != ""
 }
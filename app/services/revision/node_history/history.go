@@ -0,0 +1,148 @@
+// Package node_history provides access to the edit history of library pages,
+// including comparing and restoring old versions.
+package node_history
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/library/node_querier"
+	"github.com/Southclaws/storyden/app/resources/library/node_revision"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/services/library/node_mutate"
+	"github.com/Southclaws/storyden/app/services/revision/revision_diff"
+)
+
+const currentLabel = "current"
+
+type Manager struct {
+	revisions   *node_revision.Repository
+	nodeQuerier *node_querier.Querier
+	nodeMutator *node_mutate.Manager
+}
+
+func New(
+	revisions *node_revision.Repository,
+	nodeQuerier *node_querier.Querier,
+	nodeMutator *node_mutate.Manager,
+) *Manager {
+	return &Manager{
+		revisions:   revisions,
+		nodeQuerier: nodeQuerier,
+		nodeMutator: nodeMutator,
+	}
+}
+
+func (m *Manager) List(ctx context.Context, qk library.QueryKey, page pagination.Parameters) (*pagination.Result[*node_revision.Revision], error) {
+	n, err := m.nodeQuerier.Get(ctx, qk)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, err := m.revisions.List(ctx, library.NodeID(n.Mark.ID()), page)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r, nil
+}
+
+func (m *Manager) Get(ctx context.Context, qk library.QueryKey, id node_revision.ID) (*node_revision.Revision, error) {
+	n, err := m.nodeQuerier.Get(ctx, qk)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, err := m.revisions.Get(ctx, library.NodeID(n.Mark.ID()), id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r, nil
+}
+
+// Diff compares a revision against either another revision of the same node or,
+// if no other revision is specified, the current live version of the node.
+func (m *Manager) Diff(ctx context.Context, qk library.QueryKey, id node_revision.ID, against opt.Optional[node_revision.ID]) (*revision_diff.Diff, error) {
+	n, err := m.nodeQuerier.Get(ctx, qk)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	nodeID := library.NodeID(n.Mark.ID())
+
+	rev, err := m.revisions.Get(ctx, nodeID, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	from := revision_diff.Version{
+		Label:   rev.ID.String(),
+		Title:   rev.Name,
+		Content: rev.Content.OrZero(),
+	}
+
+	to := revision_diff.Version{
+		Label:   currentLabel,
+		Title:   n.Name,
+		Content: n.Content.OrZero(),
+	}
+
+	if againstID, ok := against.Get(); ok {
+		other, err := m.revisions.Get(ctx, nodeID, againstID)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		to = revision_diff.Version{
+			Label:   other.ID.String(),
+			Title:   other.Name,
+			Content: other.Content.OrZero(),
+		}
+	}
+
+	d, err := revision_diff.Compute(from, to)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return d, nil
+}
+
+// Restore writes the name and content of a revision back to the node. This uses
+// the regular node update path so the version being replaced is also kept as a
+// revision and the same authorisation rules and events apply.
+func (m *Manager) Restore(ctx context.Context, qk library.QueryKey, id node_revision.ID) (*library.Node, error) {
+	n, err := m.nodeQuerier.Get(ctx, qk)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	rev, err := m.revisions.Get(ctx, library.NodeID(n.Mark.ID()), id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	content, ok := rev.Content.Get()
+	if !ok {
+		content, err = datagraph.NewRichText("")
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	n, err = m.nodeMutator.Update(ctx, library.NewID(n.Mark.ID()), node_mutate.Partial{
+		Name:    opt.New(rev.Name),
+		Content: opt.New(content),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return n, nil
+}
@@ -0,0 +1,184 @@
+// Package post_history provides access to the edit history of threads and
+// replies for moderators, including comparing and restoring old versions.
+package post_history
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/post_querier"
+	"github.com/Southclaws/storyden/app/resources/post/post_revision"
+	"github.com/Southclaws/storyden/app/resources/post/post_search"
+	"github.com/Southclaws/storyden/app/services/reply"
+	"github.com/Southclaws/storyden/app/services/revision/revision_diff"
+	"github.com/Southclaws/storyden/app/services/thread"
+)
+
+const currentLabel = "current"
+
+type Manager struct {
+	revisions    *post_revision.Repository
+	postQuerier  *post_querier.Querier
+	postSearcher post_search.Repository
+	threadSvc    thread.Service
+	replyMutator *reply.Mutator
+}
+
+func New(
+	revisions *post_revision.Repository,
+	postQuerier *post_querier.Querier,
+	postSearcher post_search.Repository,
+	threadSvc thread.Service,
+	replyMutator *reply.Mutator,
+) *Manager {
+	return &Manager{
+		revisions:    revisions,
+		postQuerier:  postQuerier,
+		postSearcher: postSearcher,
+		threadSvc:    threadSvc,
+		replyMutator: replyMutator,
+	}
+}
+
+func (m *Manager) List(ctx context.Context, postID post.ID, page pagination.Parameters) (*pagination.Result[*post_revision.Revision], error) {
+	if _, err := m.postQuerier.Probe(ctx, postID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	r, err := m.revisions.List(ctx, postID, page)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r, nil
+}
+
+func (m *Manager) Get(ctx context.Context, postID post.ID, id post_revision.ID) (*post_revision.Revision, error) {
+	r, err := m.revisions.Get(ctx, postID, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r, nil
+}
+
+// Diff compares a revision against either another revision of the same post or,
+// if no other revision is specified, the current live version of the post.
+func (m *Manager) Diff(ctx context.Context, postID post.ID, id post_revision.ID, against opt.Optional[post_revision.ID]) (*revision_diff.Diff, error) {
+	rev, err := m.revisions.Get(ctx, postID, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	from := revision_diff.Version{
+		Label:   rev.ID.String(),
+		Title:   rev.Title,
+		Content: rev.Content,
+	}
+
+	var to revision_diff.Version
+	if againstID, ok := against.Get(); ok {
+		other, err := m.revisions.Get(ctx, postID, againstID)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		to = revision_diff.Version{
+			Label:   other.ID.String(),
+			Title:   other.Title,
+			Content: other.Content,
+		}
+	} else {
+		current, err := m.getCurrent(ctx, postID)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		to = revision_diff.Version{
+			Label:   currentLabel,
+			Title:   titleOf(current),
+			Content: current.Content,
+		}
+	}
+
+	d, err := revision_diff.Compute(from, to)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return d, nil
+}
+
+// Restore writes the title and content of a revision back to the post. This
+// goes through the regular update path, so the version being replaced is also
+// kept as a revision and the usual moderation checks and events are applied.
+func (m *Manager) Restore(ctx context.Context, postID post.ID, id post_revision.ID) (*post.Post, error) {
+	rev, err := m.revisions.Get(ctx, postID, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	ref, err := m.postQuerier.Probe(ctx, postID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	if ref.IsThread() {
+		partial := thread.Partial{
+			Content: opt.New(rev.Content),
+		}
+		if rev.Title != "" {
+			partial.Title = opt.New(rev.Title)
+		}
+
+		if _, err := m.threadSvc.Update(ctx, postID, partial); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	} else {
+		if _, err := m.replyMutator.Update(ctx, postID, reply.Partial{
+			Content: opt.New(rev.Content),
+		}); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	p, err := m.getCurrent(ctx, postID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return p, nil
+}
+
+func (m *Manager) getCurrent(ctx context.Context, postID post.ID) (*post.Post, error) {
+	posts, err := m.postSearcher.GetMany(ctx, postID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(posts) == 0 {
+		return nil, fault.New("post not found",
+			fctx.With(ctx),
+			ftag.With(ftag.NotFound),
+			fmsg.WithDesc("not found", "The post this revision belongs to no longer exists."),
+		)
+	}
+
+	return posts[0], nil
+}
+
+// titleOf yields the title of a post only if it's a thread. Replies inherit the
+// title of their thread when mapped, but revisions of replies have no title.
+func titleOf(p *post.Post) string {
+	if p.ID != p.Root {
+		return ""
+	}
+	return p.Title
+}
@@ -0,0 +1,17 @@
+// Package revision provides moderation tooling for reviewing the edit history
+// of threads, replies and library pages.
+package revision
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/services/revision/node_history"
+	"github.com/Southclaws/storyden/app/services/revision/post_history"
+)
+
+func Build() fx.Option {
+	return fx.Provide(
+		post_history.New,
+		node_history.New,
+	)
+}
@@ -0,0 +1,62 @@
+// Package revision_diff produces human readable differences between two
+// versions of a piece of content, used for reviewing edits to posts and nodes.
+package revision_diff
+
+import (
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+)
+
+// Version is one side of a comparison. Label is used in the unified diff file
+// headers and is usually a revision ID or "current" for the live version.
+type Version struct {
+	Label   string
+	Title   string
+	Content datagraph.Content
+}
+
+type Diff struct {
+	From         Version
+	To           Version
+	TitleChanged bool
+	Changes      string
+}
+
+// Compute produces a unified diff of the plain text of two content versions.
+// Content is split on block elements (paragraphs, headings, quotes, etc.) so
+// each line of the diff corresponds to a logical block of the rendered post.
+func Compute(from, to Version) (*Diff, error) {
+	changes, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        lines(from.Content),
+		B:        lines(to.Content),
+		FromFile: from.Label,
+		ToFile:   to.Label,
+		Context:  3,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Diff{
+		From:         from,
+		To:           to,
+		TitleChanged: from.Title != to.Title,
+		Changes:      changes,
+	}, nil
+}
+
+func lines(c datagraph.Content) []string {
+	blocks := c.Split()
+	out := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		b = strings.TrimSpace(b)
+		if b == "" {
+			continue
+		}
+		out = append(out, b+"\n")
+	}
+	return out
+}
@@ -38,6 +38,7 @@ type Document struct {
 	AuthorID    string   `json:"author_id"`
 	CategoryID  string   `json:"category_id"`
 	Tags        []string `json:"tags"`
+	Answered    string   `json:"answered"`
 }
 
 type BleveSearcher struct {
@@ -209,6 +210,12 @@ func (s *BleveSearcher) buildSearchQuery(q string, opts searcher.Options) query.
 		filters = append(filters, bleve.NewConjunctionQuery(tagQueries...))
 	}
 
+	if answered, ok := opts.Answered.Get(); ok {
+		aq := bleve.NewTermQuery(searcher.AnswerStatus(answered))
+		aq.SetField("answered")
+		filters = append(filters, aq)
+	}
+
 	if len(filters) > 0 {
 		allQueries := append([]query.Query{textQuery}, filters...)
 		return bleve.NewConjunctionQuery(allQueries...)
@@ -271,6 +278,12 @@ func (s *BleveSearcher) buildMatchQuery(q string, opts searcher.Options) query.Q
 		filters = append(filters, bleve.NewConjunctionQuery(tagQueries...))
 	}
 
+	if answered, ok := opts.Answered.Get(); ok {
+		aq := bleve.NewMatchQuery(searcher.AnswerStatus(answered))
+		aq.SetField("answered")
+		filters = append(filters, aq)
+	}
+
 	if len(filters) > 0 {
 		allQueries := append([]query.Query{textQuery}, filters...)
 		return bleve.NewConjunctionQuery(allQueries...)
@@ -343,6 +356,12 @@ func (s *BleveSearcher) Index(ctx context.Context, item datagraph.Item) error {
 		doc.Tags = v.GetTags()
 	}
 
+	if v, ok := item.(datagraph.WithAnswered); ok {
+		if answered, ok := v.GetAnswered().Get(); ok {
+			doc.Answered = searcher.AnswerStatus(answered)
+		}
+	}
+
 	err := s.client.Index(item.GetID().String(), doc)
 	if err != nil {
 		return fault.Wrap(err, fctx.With(ctx), fmsg.With(fmt.Sprintf("failed to index document in bleve %s", item.GetID())))
@@ -447,6 +466,12 @@ func createIndexMapping() mapping.IndexMapping {
 	tagsFieldMapping.Analyzer = "keyword"
 	docMapping.AddFieldMappingsAt("tags", tagsFieldMapping)
 
+	answeredFieldMapping := bleve.NewTextFieldMapping()
+	answeredFieldMapping.Store = false
+	answeredFieldMapping.Index = true
+	answeredFieldMapping.Analyzer = "keyword"
+	docMapping.AddFieldMappingsAt("answered", answeredFieldMapping)
+
 	indexMapping.DefaultMapping = docMapping
 
 	return indexMapping
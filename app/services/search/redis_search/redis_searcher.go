@@ -37,6 +37,7 @@ type Document struct {
 	AuthorID    string
 	CategoryID  string
 	Tags        []string
+	Answered    string
 }
 
 type SearchResult struct {
@@ -137,6 +138,7 @@ func (c *RedisSearcher) createIndex(ctx context.Context) error {
 		FieldName("author_id").Tag().
 		FieldName("category_id").Tag().
 		FieldName("tags").Tag().Separator(",").
+		FieldName("answered").Tag().
 		Build()
 
 	err := c.client.Do(ctx, cmd).Error()
@@ -281,6 +283,12 @@ func (s *RedisSearcher) buildDocument(item datagraph.Item) Document {
 		doc.Tags = v.GetTags()
 	}
 
+	if v, ok := item.(datagraph.WithAnswered); ok {
+		if answered, ok := v.GetAnswered().Get(); ok {
+			doc.Answered = searcher.AnswerStatus(answered)
+		}
+	}
+
 	return doc
 }
 
@@ -311,6 +319,10 @@ func (s *RedisSearcher) Index(ctx context.Context, item datagraph.Item) error {
 		builder = builder.FieldValue("tags", strings.Join(doc.Tags, ","))
 	}
 
+	if doc.Answered != "" {
+		builder = builder.FieldValue("answered", doc.Answered)
+	}
+
 	cmd := builder.Build()
 
 	err := s.client.Do(ctx, cmd).Error()
@@ -355,6 +367,10 @@ func (s *RedisSearcher) buildQuery(q string, opts searcher.Options) string {
 		}
 	}
 
+	if answered, ok := opts.Answered.Get(); ok {
+		filters = append(filters, fmt.Sprintf("@answered:{%s}", searcher.AnswerStatus(answered)))
+	}
+
 	if len(filters) > 0 {
 		return fmt.Sprintf("(%s) %s", escapedQuery, strings.Join(filters, " "))
 	}
@@ -422,6 +438,10 @@ func (s *RedisSearcher) buildPrefixQuery(q string, opts searcher.Options) string
 		}
 	}
 
+	if answered, ok := opts.Answered.Get(); ok {
+		filters = append(filters, fmt.Sprintf("@answered:{%s}", searcher.AnswerStatus(answered)))
+	}
+
 	if len(filters) > 0 {
 		return fmt.Sprintf("%s %s", nameQuery, strings.Join(filters, " "))
 	}
@@ -69,6 +69,7 @@ func (idx *Indexer) reindexThreads(ctx context.Context) (int, error) {
 				},
 			).
 			WithTags().
+			WithCategory().
 			Order(ent_post.ByUpdatedAt(), ent_post.ByID()).
 			Limit(idx.chunkSize).
 			All(ctx)
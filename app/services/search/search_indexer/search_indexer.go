@@ -247,6 +247,13 @@ func newIndexer(
 			return err
 		}
 
+		_, err = pubsub.Subscribe(ctx, idx.bus, "search_indexer.account_deleted", func(ctx context.Context, evt *message.EventAccountDeleted) error {
+			return idx.deindexProfile(ctx, evt.ID)
+		})
+		if err != nil {
+			return err
+		}
+
 		_, err = pubsub.SubscribeCommand(ctx, idx.bus, "search_indexer.index_profile", func(ctx context.Context, cmd *message.CommandProfileIndex) error {
 			return idx.indexProfile(ctx, cmd.ID)
 		})
@@ -396,3 +403,13 @@ func (idx *Indexer) indexProfile(ctx context.Context, id account.AccountID) erro
 	idx.logger.Debug("indexed profile", slog.String("id", id.String()))
 	return nil
 }
+
+func (idx *Indexer) deindexProfile(ctx context.Context, id account.AccountID) error {
+	if _, err := idx.semdexMutator.Delete(ctx, xid.ID(id)); err != nil {
+		idx.logger.Error("failed to desemdex profile", slog.String("id", id.String()), slog.String("error", err.Error()))
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	idx.logger.Debug("deindexed profile", slog.String("id", id.String()))
+	return nil
+}
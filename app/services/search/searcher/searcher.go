@@ -19,6 +19,19 @@ type Options struct {
 	Authors    opt.Optional[[]account.AccountID]
 	Categories opt.Optional[[]category.CategoryID]
 	Tags       opt.Optional[[]tag_ref.Name]
+
+	// Answered restricts results to questions (threads in Q&A categories)
+	// which either have an accepted answer or are still awaiting one.
+	Answered opt.Optional[bool]
+}
+
+// AnswerStatus is the keyword indexed by search providers for questions, items
+// which aren't questions are indexed without any answer status at all.
+func AnswerStatus(answered bool) string {
+	if answered {
+		return "answered"
+	}
+	return "unanswered"
 }
 
 var ErrFastMatchesUnavailable = fault.New("datagraph matches are not enabled", ftag.With(ftag.InvalidArgument))
@@ -21,6 +21,12 @@ type nodeSearcher struct {
 }
 
 func (s *nodeSearcher) Search(ctx context.Context, query string, p pagination.Parameters, opts searcher.Options) (*pagination.Result[datagraph.Item], error) {
+	// Library pages are never questions so can't be answered or unanswered.
+	if opts.Answered.Ok() {
+		result := pagination.NewPageResult(p, 0, []datagraph.Item{})
+		return &result, nil
+	}
+
 	o := []node_search.Option{
 		node_search.WithNameContains(query),
 		node_search.WithContentContains(query),
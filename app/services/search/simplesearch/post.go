@@ -54,6 +54,10 @@ func (s *postSearcher) Search(ctx context.Context, query string, p pagination.Pa
 		o = append(o, post_search.WithTags(value...))
 	})
 
+	opts.Answered.Call(func(value bool) {
+		o = append(o, post_search.WithAnswered(value))
+	})
+
 	rs, err := s.post_search.Search(ctx, p, o...)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -5,7 +5,9 @@ import (
 
 	"github.com/Southclaws/storyden/app/services/account"
 	"github.com/Southclaws/storyden/app/services/account/account_auth"
+	"github.com/Southclaws/storyden/app/services/account/account_deletion"
 	"github.com/Southclaws/storyden/app/services/account/account_email"
+	"github.com/Southclaws/storyden/app/services/account/account_export"
 	"github.com/Southclaws/storyden/app/services/account/account_suspension"
 	"github.com/Southclaws/storyden/app/services/account/register"
 	"github.com/Southclaws/storyden/app/services/admin/settings_manager"
@@ -18,19 +20,26 @@ import (
 	"github.com/Southclaws/storyden/app/services/category"
 	"github.com/Southclaws/storyden/app/services/collection"
 	"github.com/Southclaws/storyden/app/services/comms"
+	"github.com/Southclaws/storyden/app/services/conversation"
 	"github.com/Southclaws/storyden/app/services/event"
+	"github.com/Southclaws/storyden/app/services/feed"
 	"github.com/Southclaws/storyden/app/services/generative"
 	"github.com/Southclaws/storyden/app/services/library"
 	"github.com/Southclaws/storyden/app/services/like/post_liker"
 	"github.com/Southclaws/storyden/app/services/link"
 	"github.com/Southclaws/storyden/app/services/mention/mention_job"
 	"github.com/Southclaws/storyden/app/services/moderation"
+	"github.com/Southclaws/storyden/app/services/notification/digest_job"
 	"github.com/Southclaws/storyden/app/services/notification/notify_job"
 	"github.com/Southclaws/storyden/app/services/onboarding"
+	"github.com/Southclaws/storyden/app/services/profile/blocking"
 	"github.com/Southclaws/storyden/app/services/profile/following"
+	"github.com/Southclaws/storyden/app/services/publish_schedule"
 	"github.com/Southclaws/storyden/app/services/react_manager"
+	"github.com/Southclaws/storyden/app/services/realtime"
 	"github.com/Southclaws/storyden/app/services/reply"
 	"github.com/Southclaws/storyden/app/services/report"
+	"github.com/Southclaws/storyden/app/services/revision"
 	"github.com/Southclaws/storyden/app/services/search"
 	"github.com/Southclaws/storyden/app/services/search/bleve_search"
 	"github.com/Southclaws/storyden/app/services/search/redis_search"
@@ -38,8 +47,10 @@ import (
 	"github.com/Southclaws/storyden/app/services/semdex/semdexer"
 	"github.com/Southclaws/storyden/app/services/system/instance_info"
 	"github.com/Southclaws/storyden/app/services/tag/autotagger"
+	"github.com/Southclaws/storyden/app/services/tag/tag_manager"
 	"github.com/Southclaws/storyden/app/services/thread"
 	"github.com/Southclaws/storyden/app/services/thread_mark"
+	"github.com/Southclaws/storyden/app/services/webhook"
 )
 
 func Build() fx.Option {
@@ -49,6 +60,8 @@ func Build() fx.Option {
 		branding.Build(),
 		onboarding.Build(),
 		account_suspension.Build(),
+		account_export.Build(),
+		account_deletion.Build(),
 		authentication.Build(),
 		category.Build(),
 		thread.Build(),
@@ -68,15 +81,24 @@ func Build() fx.Option {
 		comms.Build(),
 		link.Build(),
 		notify_job.Build(),
+		digest_job.Build(),
+		realtime.Build(),
 		mention_job.Build(),
 		beacon_listener.Build(),
 		generative.Build(),
 		semdexer.Build(),
 		event.Build(),
 		moderation.Build(),
+		revision.Build(),
+		webhook.Build(),
+		feed.Build(),
+		conversation.Build(),
+		publish_schedule.Build(),
 		fx.Provide(avatar_gen.New),
 		fx.Provide(following.New),
+		fx.Provide(blocking.New),
 		fx.Provide(autotagger.New),
+		fx.Provide(tag_manager.New),
 		fx.Provide(instance_info.New),
 		fx.Provide(account_auth.New, account_email.New),
 		fx.Provide(settings_manager.New),
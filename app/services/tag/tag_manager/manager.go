@@ -0,0 +1,256 @@
+// Package tag_manager provides administrative operations for cleaning up tags
+// created by members, such as merging near-duplicates into a single tag.
+package tag_manager
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/mazznoer/csscolorparser"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
+	"github.com/Southclaws/storyden/app/resources/library/node_cache"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/post/thread_cache"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_querier"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_writer"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var (
+	errEmptyName    = fault.New("tag name is empty", ftag.With(ftag.InvalidArgument))
+	errInvalidColor = fault.New("invalid tag colour", ftag.With(ftag.InvalidArgument))
+	errNoSources    = fault.New("no tags to merge", ftag.With(ftag.InvalidArgument))
+)
+
+type Partial struct {
+	Name        opt.Optional[string]
+	Description opt.Optional[string]
+	Colour      opt.Optional[string]
+}
+
+type Manager struct {
+	logger      *slog.Logger
+	querier     *tag_querier.Querier
+	writer      *tag_writer.Writer
+	auditWriter *audit_writer.Writer
+	threadCache *thread_cache.Cache
+	nodeCache   *node_cache.Cache
+	bus         *pubsub.Bus
+}
+
+func New(
+	logger *slog.Logger,
+	querier *tag_querier.Querier,
+	writer *tag_writer.Writer,
+	auditWriter *audit_writer.Writer,
+	threadCache *thread_cache.Cache,
+	nodeCache *node_cache.Cache,
+	bus *pubsub.Bus,
+) *Manager {
+	return &Manager{
+		logger:      logger,
+		querier:     querier,
+		writer:      writer,
+		auditWriter: auditWriter,
+		threadCache: threadCache,
+		nodeCache:   nodeCache,
+		bus:         bus,
+	}
+}
+
+// Update renames a tag or changes its description and colour. Renaming to the
+// name of another tag is rejected, those tags should be merged instead.
+func (m *Manager) Update(ctx context.Context, name tag_ref.Name, p Partial) (*tag_ref.Tag, error) {
+	current, err := m.querier.Probe(ctx, name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	opts := []tag_writer.Option{}
+
+	renamed := false
+	if v, ok := p.Name.Get(); ok {
+		newName := tag_ref.NewName(v)
+		if newName.String() == "" {
+			return nil, fault.Wrap(errEmptyName, fctx.With(ctx),
+				fmsg.WithDesc("empty name", "A tag name must contain at least one letter or number."))
+		}
+
+		if newName != current.Name {
+			renamed = true
+			opts = append(opts, tag_writer.WithName(newName))
+		}
+	}
+
+	if v, ok := p.Description.Get(); ok {
+		opts = append(opts, tag_writer.WithDescription(strings.TrimSpace(v)))
+	}
+
+	if v, ok := p.Colour.Get(); ok {
+		c, err := csscolorparser.Parse(v)
+		if err != nil {
+			return nil, fault.Wrap(errInvalidColor, fctx.With(ctx),
+				fmsg.WithDesc("invalid colour", "The tag colour must be a valid CSS colour such as #4ec9b0."))
+		}
+		opts = append(opts, tag_writer.WithColour(c.HexString()))
+	}
+
+	updated, err := m.writer.Update(ctx, current.ID, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if renamed {
+		if err := m.reindex(ctx, updated.ID); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	err = m.auditWriter.Record(ctx, session.GetOptAccountID(ctx), audit.ActionTagUpdated,
+		audit_writer.WithBefore(summarise(current)),
+		audit_writer.WithAfter(summarise(updated)),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return updated, nil
+}
+
+// Merge moves all items from the source tags onto the target tag and deletes
+// the source tags. Every affected item is reindexed so searches for the
+// source tags' names no longer match and the target's name does.
+func (m *Manager) Merge(ctx context.Context, into tag_ref.Name, from []tag_ref.Name) (*tag_ref.Tag, error) {
+	target, err := m.querier.Probe(ctx, into)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	sources := []*tag_ref.Tag{}
+	for _, name := range from {
+		if name == target.Name {
+			continue
+		}
+
+		t, err := m.querier.Probe(ctx, name)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		sources = append(sources, t)
+	}
+
+	if len(sources) == 0 {
+		return nil, fault.Wrap(errNoSources, fctx.With(ctx),
+			fmsg.WithDesc("no sources", "At least one tag other than the target must be given to merge."))
+	}
+
+	sourceIDs := dt.Map(sources, func(t *tag_ref.Tag) tag_ref.ID { return t.ID })
+
+	if err := m.writer.Merge(ctx, target.ID, sourceIDs...); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.reindex(ctx, target.ID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = m.auditWriter.Record(ctx, session.GetOptAccountID(ctx), audit.ActionTagsMerged,
+		audit_writer.WithBefore("tags: "+strings.Join(tag_ref.Names(tag_ref.Tags(sources).Names()).Strings(), ", ")),
+		audit_writer.WithAfter("tag: "+target.Name.String()),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	merged, err := m.querier.Probe(ctx, target.Name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return merged, nil
+}
+
+// Delete removes a tag from every item it's applied to.
+func (m *Manager) Delete(ctx context.Context, name tag_ref.Name) error {
+	current, err := m.querier.Probe(ctx, name)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// The affected items must be found before the tag and its edges are gone.
+	items, err := m.querier.Items(ctx, current.ID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.writer.Delete(ctx, current.ID); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.reindexItems(ctx, items)
+
+	err = m.auditWriter.Record(ctx, session.GetOptAccountID(ctx), audit.ActionTagDeleted,
+		audit_writer.WithBefore(summarise(current)),
+	)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (m *Manager) reindex(ctx context.Context, ids ...tag_ref.ID) error {
+	items, err := m.querier.Items(ctx, ids...)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.reindexItems(ctx, items)
+
+	return nil
+}
+
+// reindexItems refreshes search indexes and cached responses for items whose
+// tags changed. Failures are logged rather than returned as the tag change
+// itself has already been committed.
+func (m *Manager) reindexItems(ctx context.Context, items *tag_querier.TaggedItems) {
+	for _, id := range items.Threads {
+		if err := m.threadCache.Invalidate(ctx, xid.ID(id)); err != nil {
+			m.logger.Warn("failed to invalidate thread cache", slog.String("thread_id", id.String()), slog.String("error", err.Error()))
+		}
+
+		if err := m.bus.SendCommand(ctx, &message.CommandThreadIndex{ID: id}); err != nil {
+			m.logger.Error("failed to send thread index command", slog.String("thread_id", id.String()), slog.String("error", err.Error()))
+		}
+	}
+
+	for _, n := range items.Nodes {
+		if err := m.nodeCache.Invalidate(ctx, n.Slug); err != nil {
+			m.logger.Warn("failed to invalidate node cache", slog.String("node_id", n.ID.String()), slog.String("error", err.Error()))
+		}
+
+		if err := m.bus.SendCommand(ctx, &message.CommandNodeIndex{ID: n.ID}); err != nil {
+			m.logger.Error("failed to send node index command", slog.String("node_id", n.ID.String()), slog.String("error", err.Error()))
+		}
+	}
+}
+
+func summarise(t *tag_ref.Tag) string {
+	s := "name: " + t.Name.String() + ", colour: " + t.Colour
+	if d, ok := t.Description.Get(); ok && d != "" {
+		s += ", description: " + d
+	}
+	return s
+}
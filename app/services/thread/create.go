@@ -7,6 +7,7 @@ import (
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account"
@@ -26,6 +27,13 @@ func (s *service) Create(ctx context.Context,
 	meta map[string]any,
 	partial Partial,
 ) (*thread.Thread, error) {
+	// Validated up-front so an invalid poll doesn't leave a thread without it.
+	if p, ok := partial.Poll.Get(); ok {
+		if err := p.ValidateNew(ctx); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	opts := partial.Opts()
 	opts = append(opts,
 		thread_writer.WithMeta(meta),
@@ -65,6 +73,14 @@ func (s *service) Create(ctx context.Context,
 		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to create thread"))
 	}
 
+	if p, ok := partial.Poll.Get(); ok {
+		created, err := s.polls.Set(ctx, thr.ID, p)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		thr.Poll = opt.New(*created)
+	}
+
 	if content, ok := partial.Content.Get(); ok && thr.Visibility == visibility.VisibilityPublished {
 		result, err := s.cpm.CheckContent(ctx, xid.ID(thr.ID), datagraph.KindThread, title, content)
 		if err != nil {
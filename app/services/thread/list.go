@@ -25,6 +25,8 @@ type Params struct {
 	Visibility    opt.Optional[[]visibility.Visibility]
 	Tags          opt.Optional[[]xid.ID]
 	Categories    opt.Optional[thread_querier.CategoryFilter]
+	Locked        opt.Optional[bool]
+	Answered      opt.Optional[bool]
 }
 
 func (s *service) List(ctx context.Context,
@@ -44,6 +46,8 @@ func (s *service) List(ctx context.Context,
 	opts.AccountID.Call(func(a account.AccountID) { q = append(q, thread_querier.HasAuthor(a)) })
 	opts.Tags.Call(func(a []xid.ID) { q = append(q, thread_querier.HasTags(a)) })
 	opts.Categories.Call(func(cf thread_querier.CategoryFilter) { q = append(q, thread_querier.HasCategories(cf)) })
+	opts.Locked.Call(func(l bool) { q = append(q, thread_querier.HasLocked(l)) })
+	opts.Answered.Call(func(a bool) { q = append(q, thread_querier.HasAnswered(a)) })
 
 	vq := func() thread_querier.Query {
 		v, ok := opts.Visibility.Get()
@@ -12,9 +12,11 @@ import (
 
 	"github.com/Southclaws/storyden/app/resources/account"
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/pagination"
 	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/post_revision"
 	"github.com/Southclaws/storyden/app/resources/post/thread"
 	"github.com/Southclaws/storyden/app/resources/post/thread_cache"
 	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
@@ -27,6 +29,9 @@ import (
 	"github.com/Southclaws/storyden/app/services/moderation"
 	"github.com/Southclaws/storyden/app/services/report/system_report"
 	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/app/services/thread/thread_answer"
+	"github.com/Southclaws/storyden/app/services/thread/thread_lock"
+	"github.com/Southclaws/storyden/app/services/thread/thread_poll"
 	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/spanner"
 	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
@@ -67,6 +72,9 @@ type Partial struct {
 	Visibility opt.Optional[visibility.Visibility]
 	URL        opt.Optional[url.URL]
 	Meta       opt.Optional[map[string]any]
+	Locked     opt.Optional[bool]
+	LockReason opt.Optional[string]
+	Poll       opt.Optional[thread_poll.Params]
 }
 
 func (p Partial) Opts() (opts []thread_writer.Option) {
@@ -81,6 +89,9 @@ func (p Partial) Opts() (opts []thread_writer.Option) {
 func Build() fx.Option {
 	return fx.Options(
 		fx.Provide(New),
+		thread_lock.Build(),
+		thread_answer.Build(),
+		thread_poll.Build(),
 	)
 }
 
@@ -98,6 +109,9 @@ type service struct {
 	cpm            *moderation.Manager
 	cache          *thread_cache.Cache
 	systemReporter *system_report.Manager
+	revisions      *post_revision.Repository
+	auditWriter    *audit_writer.Writer
+	polls          *thread_poll.Manager
 }
 
 func New(
@@ -114,6 +128,9 @@ func New(
 	cpm *moderation.Manager,
 	cache *thread_cache.Cache,
 	systemReporter *system_report.Manager,
+	revisions *post_revision.Repository,
+	auditWriter *audit_writer.Writer,
+	polls *thread_poll.Manager,
 ) Service {
 	return &service{
 		ins: ins.Build(),
@@ -129,5 +146,8 @@ func New(
 		cpm:            cpm,
 		cache:          cache,
 		systemReporter: systemReporter,
+		revisions:      revisions,
+		auditWriter:    auditWriter,
+		polls:          polls,
 	}
 }
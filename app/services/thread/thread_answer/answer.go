@@ -0,0 +1,192 @@
+// Package thread_answer allows the author of a question, a thread posted in a
+// Q&A mode category, or a moderator to mark one of its replies as the answer.
+package thread_answer
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/reply_querier"
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/post/thread_cache"
+	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
+	"github.com/Southclaws/storyden/app/resources/post/thread_writer"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var (
+	ErrNotQuestion  = fault.New("thread is not in a Q&A category", ftag.With(ftag.InvalidArgument))
+	ErrNotInThread  = fault.New("reply does not belong to thread", ftag.With(ftag.InvalidArgument))
+	ErrNotPublished = fault.New("reply is not published", ftag.With(ftag.InvalidArgument))
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(runNotifications),
+	)
+}
+
+type Manager struct {
+	accountQuery  *account_querier.Querier
+	threadQuerier *thread_querier.Querier
+	replyQuerier  *reply_querier.Querier
+	threadWriter  *thread_writer.Writer
+	cache         *thread_cache.Cache
+	bus           *pubsub.Bus
+}
+
+func New(
+	accountQuery *account_querier.Querier,
+	threadQuerier *thread_querier.Querier,
+	replyQuerier *reply_querier.Querier,
+	threadWriter *thread_writer.Writer,
+	cache *thread_cache.Cache,
+	bus *pubsub.Bus,
+) *Manager {
+	return &Manager{
+		accountQuery:  accountQuery,
+		threadQuerier: threadQuerier,
+		replyQuerier:  replyQuerier,
+		threadWriter:  threadWriter,
+		cache:         cache,
+		bus:           bus,
+	}
+}
+
+// Accept marks a reply as the answer to the thread, replacing any answer that
+// was previously accepted.
+func (m *Manager) Accept(ctx context.Context, threadID post.ID, replyID post.ID) (*thread.Thread, error) {
+	accountID, thr, err := m.authorise(ctx, threadID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	rep, err := m.replyQuerier.Get(ctx, replyID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if rep.RootPostID != threadID || rep.DeletedAt.Ok() {
+		return nil, fault.Wrap(ErrNotInThread,
+			fctx.With(ctx),
+			fmsg.WithDesc("not in thread", "The answer must be a reply within the same thread."),
+		)
+	}
+
+	if rep.Visibility != visibility.VisibilityPublished {
+		return nil, fault.Wrap(ErrNotPublished,
+			fctx.With(ctx),
+			fmsg.WithDesc("not published", "Only published replies may be accepted as the answer."),
+		)
+	}
+
+	if current, ok := thr.AcceptedAnswer.Get(); ok && current == replyID {
+		return thr, nil
+	}
+
+	thr, err = m.update(ctx, threadID, thread_writer.WithAcceptedAnswer(replyID))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.bus.Publish(ctx, &message.EventThreadAnswerAccepted{
+		ThreadID:      threadID,
+		ReplyID:       replyID,
+		ReplyAuthorID: rep.Author.ID,
+		AcceptedByID:  accountID,
+	})
+
+	return thr, nil
+}
+
+// Remove clears the accepted answer, returning the thread to unanswered.
+func (m *Manager) Remove(ctx context.Context, threadID post.ID) (*thread.Thread, error) {
+	_, thr, err := m.authorise(ctx, threadID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !thr.AcceptedAnswer.Ok() {
+		return thr, nil
+	}
+
+	thr, err = m.update(ctx, threadID, thread_writer.WithoutAcceptedAnswer())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return thr, nil
+}
+
+// authorise ensures the thread is a question and the member is either its
+// author or has the Manage Posts permission.
+func (m *Manager) authorise(ctx context.Context, threadID post.ID) (account.AccountID, *thread.Thread, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return account.AccountID{}, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := m.accountQuery.GetByID(ctx, accountID)
+	if err != nil {
+		return account.AccountID{}, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	thr, err := m.threadQuerier.Get(ctx, threadID, pagination.Parameters{}, opt.NewEmpty[account.AccountID]())
+	if err != nil {
+		return account.AccountID{}, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !thr.GetAnswered().Ok() {
+		return account.AccountID{}, nil, fault.Wrap(ErrNotQuestion,
+			fctx.With(ctx),
+			fmsg.WithDesc("not a question", "Answers may only be accepted on threads in a Q&A category."),
+		)
+	}
+
+	err = acc.Roles.Permissions().Authorise(ctx, func() error {
+		if thr.Author.ID != accountID {
+			return fault.Wrap(rbac.ErrPermissions,
+				fctx.With(ctx),
+				fmsg.WithDesc("not author", "You are not the author of the thread and do not have the Manage Posts permission."),
+			)
+		}
+		return nil
+	}, rbac.PermissionManagePosts)
+	if err != nil {
+		return account.AccountID{}, nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return accountID, thr, nil
+}
+
+func (m *Manager) update(ctx context.Context, threadID post.ID, opts ...thread_writer.Option) (*thread.Thread, error) {
+	if err := m.cache.Invalidate(ctx, xid.ID(threadID)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	thr, err := m.threadWriter.Update(ctx, threadID, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Answered status is part of the search index so the thread is reindexed.
+	m.bus.Publish(ctx, &message.EventThreadUpdated{ID: threadID})
+
+	return thr, nil
+}
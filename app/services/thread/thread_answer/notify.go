@@ -0,0 +1,42 @@
+package thread_answer
+
+import (
+	"context"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/services/notification/notify"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+func runNotifications(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	bus *pubsub.Bus,
+	notifier *notify.Notifier,
+) {
+	lc.Append(fx.StartHook(func(hctx context.Context) error {
+		_, err := pubsub.Subscribe(ctx, bus, "thread_answer.answer_accepted", func(ctx context.Context, evt *message.EventThreadAnswerAccepted) error {
+			// No need to tell someone they accepted their own answer.
+			if evt.ReplyAuthorID == evt.AcceptedByID {
+				return nil
+			}
+
+			return notifier.Send(ctx,
+				evt.ReplyAuthorID,
+				opt.New(evt.AcceptedByID),
+				notification.EventAnswerAccepted,
+				&datagraph.Ref{
+					ID:   xid.ID(evt.ReplyID),
+					Kind: datagraph.KindPost,
+				},
+			)
+		})
+		return err
+	}))
+}
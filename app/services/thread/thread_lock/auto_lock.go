@@ -0,0 +1,107 @@
+package thread_lock
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/post/thread_cache"
+	"github.com/Southclaws/storyden/app/resources/post/thread_writer"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/internal/infrastructure/schedule"
+)
+
+// autoLockInterval is how often inactive threads are checked for, the lock
+// period is measured in days so there's no need to check any more often.
+const autoLockInterval = time.Hour
+
+type AutoLocker struct {
+	settings     *settings.SettingsRepository
+	threadWriter *thread_writer.Writer
+	cache        *thread_cache.Cache
+	auditWriter  *audit_writer.Writer
+}
+
+func newAutoLocker(
+	settings *settings.SettingsRepository,
+	threadWriter *thread_writer.Writer,
+	cache *thread_cache.Cache,
+	auditWriter *audit_writer.Writer,
+) *AutoLocker {
+	return &AutoLocker{
+		settings:     settings,
+		threadWriter: threadWriter,
+		cache:        cache,
+		auditWriter:  auditWriter,
+	}
+}
+
+// LockInactive locks every thread without a reply within the number of days
+// configured in the moderation settings and returns how many were locked. When
+// several instances run this at once, each thread is only locked and audited
+// by one of them.
+func (l *AutoLocker) LockInactive(ctx context.Context, now time.Time) (int, error) {
+	set, err := l.settings.Get(ctx)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	days := opt.Map(set.Services.OrZero().Moderation, func(m settings.ModerationServiceSettings) int {
+		return m.ThreadAutoLockDays.OrZero()
+	}).OrZero()
+	if days <= 0 {
+		return 0, nil
+	}
+
+	reason := fmt.Sprintf("Automatically locked after %d days without replies.", days)
+
+	ids, err := l.threadWriter.LockInactive(ctx, now.AddDate(0, 0, -days), reason)
+	if err != nil {
+		return 0, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, id := range ids {
+		if err := l.cache.Invalidate(ctx, xid.ID(id)); err != nil {
+			return 0, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		err = l.auditWriter.Record(ctx, opt.NewEmpty[account.AccountID](), audit.ActionThreadLocked,
+			audit_writer.WithTarget(datagraph.Ref{ID: xid.ID(id), Kind: datagraph.KindThread}),
+			audit_writer.WithAfter("reason: "+reason),
+		)
+		if err != nil {
+			return 0, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return len(ids), nil
+}
+
+func runAutoLocker(
+	ctx context.Context,
+	lc fx.Lifecycle,
+	logger *slog.Logger,
+	l *AutoLocker,
+) {
+	schedule.Every(ctx, lc, logger, "thread_auto_lock", autoLockInterval, func(ctx context.Context) error {
+		n, err := l.LockInactive(ctx, time.Now())
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		if n > 0 {
+			logger.Info("locked inactive threads", slog.Int("count", n))
+		}
+		return nil
+	})
+}
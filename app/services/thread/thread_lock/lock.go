@@ -0,0 +1,70 @@
+// Package thread_lock enforces thread locks on participation such as replies
+// and reactions and periodically locks threads which have gone quiet.
+package thread_lock
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/post_querier"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+)
+
+var ErrLocked = fault.New("thread is locked", ftag.With(ftag.PermissionDenied))
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New, newAutoLocker),
+		fx.Invoke(runAutoLocker),
+	)
+}
+
+type Guard struct {
+	accountQuery *account_querier.Querier
+	postQuerier  *post_querier.Querier
+}
+
+func New(
+	accountQuery *account_querier.Querier,
+	postQuerier *post_querier.Querier,
+) *Guard {
+	return &Guard{
+		accountQuery: accountQuery,
+		postQuerier:  postQuerier,
+	}
+}
+
+// Authorise returns ErrLocked if the thread is locked and the account does not
+// have the Manage Posts permission which allows moderators to keep posting.
+func (g *Guard) Authorise(ctx context.Context, accountID account.AccountID, threadID post.ID) error {
+	locked, err := g.postQuerier.Locked(ctx, threadID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !locked {
+		return nil
+	}
+
+	acc, err := g.accountQuery.GetByID(ctx, accountID)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if acc.Roles.Permissions().HasAny(rbac.PermissionManagePosts, rbac.PermissionAdministrator) {
+		return nil
+	}
+
+	return fault.Wrap(ErrLocked,
+		fctx.With(ctx),
+		fmsg.WithDesc("thread locked", "This thread is locked and no longer accepts replies or reactions."),
+	)
+}
@@ -0,0 +1,289 @@
+// Package thread_poll manages polls attached to threads. Polls are created and
+// changed alongside their thread by its author and voted on by members.
+package thread_poll
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/poll"
+	"github.com/Southclaws/storyden/app/resources/post/poll/poll_querier"
+	"github.com/Southclaws/storyden/app/resources/post/poll/poll_writer"
+	"github.com/Southclaws/storyden/app/resources/post/thread_cache"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/thread/thread_lock"
+)
+
+const (
+	minOptions = 2
+	maxOptions = 20
+)
+
+var (
+	ErrInvalidOptions = fault.New("invalid poll options", ftag.With(ftag.InvalidArgument))
+	ErrMaxChoices     = fault.New("invalid poll max choices", ftag.With(ftag.InvalidArgument))
+	ErrClosesAt       = fault.New("poll close time is in the past", ftag.With(ftag.InvalidArgument))
+	ErrHasVotes       = fault.New("poll already has votes", ftag.With(ftag.InvalidArgument))
+	ErrNoPoll         = fault.New("thread has no poll", ftag.With(ftag.NotFound))
+	ErrClosed         = fault.New("poll is closed", ftag.With(ftag.InvalidArgument))
+	ErrInvalidVote    = fault.New("invalid poll vote", ftag.With(ftag.InvalidArgument))
+)
+
+func Build() fx.Option {
+	return fx.Provide(New)
+}
+
+// Params describes a new poll or changes to an existing one.
+type Params struct {
+	Options    opt.Optional[[]string]
+	MaxChoices opt.Optional[int]
+	Anonymous  opt.Optional[bool]
+	ClosesAt   opt.Optional[time.Time]
+}
+
+// ValidateNew checks the parameters describe a complete poll, this is done by
+// thread creation before the thread is written so a bad poll doesn't result in
+// a thread being created without it.
+func (p Params) ValidateNew(ctx context.Context) error {
+	options, ok := p.Options.Get()
+	if !ok {
+		return fault.Wrap(ErrInvalidOptions,
+			fctx.With(ctx),
+			fmsg.WithDesc("no options", "A poll needs at least two options."),
+		)
+	}
+
+	if err := p.validate(ctx, len(options)); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if closesAt, ok := p.ClosesAt.Get(); ok && !closesAt.After(time.Now()) {
+		return fault.Wrap(ErrClosesAt,
+			fctx.With(ctx),
+			fmsg.WithDesc("closes in past", "A new poll must close some time in the future."),
+		)
+	}
+
+	return nil
+}
+
+func (p Params) validate(ctx context.Context, optionCount int) error {
+	if options, ok := p.Options.Get(); ok {
+		if len(options) < minOptions || len(options) > maxOptions {
+			return fault.Wrap(ErrInvalidOptions,
+				fctx.With(ctx),
+				fmsg.WithDesc("option count", "A poll must have between 2 and 20 options."),
+			)
+		}
+
+		for _, o := range options {
+			if strings.TrimSpace(o) == "" {
+				return fault.Wrap(ErrInvalidOptions,
+					fctx.With(ctx),
+					fmsg.WithDesc("empty option", "Poll options cannot be empty."),
+				)
+			}
+		}
+
+		if len(lo.Uniq(options)) != len(options) {
+			return fault.Wrap(ErrInvalidOptions,
+				fctx.With(ctx),
+				fmsg.WithDesc("duplicate option", "Poll options must all be different."),
+			)
+		}
+	}
+
+	if n, ok := p.MaxChoices.Get(); ok && (n < 1 || n > optionCount) {
+		return fault.Wrap(ErrMaxChoices,
+			fctx.With(ctx),
+			fmsg.WithDesc("max choices", "The maximum number of choices must be between 1 and the number of options."),
+		)
+	}
+
+	return nil
+}
+
+func (p Params) opts() (opts []poll_writer.Option) {
+	p.MaxChoices.Call(func(v int) { opts = append(opts, poll_writer.WithMaxChoices(v)) })
+	p.Anonymous.Call(func(v bool) { opts = append(opts, poll_writer.WithAnonymous(v)) })
+	p.ClosesAt.Call(func(v time.Time) { opts = append(opts, poll_writer.WithClosesAt(v)) })
+	return
+}
+
+type Manager struct {
+	pollQuerier *poll_querier.Querier
+	pollWriter  *poll_writer.Writer
+	lockGuard   *thread_lock.Guard
+	cache       *thread_cache.Cache
+}
+
+func New(
+	pollQuerier *poll_querier.Querier,
+	pollWriter *poll_writer.Writer,
+	lockGuard *thread_lock.Guard,
+	cache *thread_cache.Cache,
+) *Manager {
+	return &Manager{
+		pollQuerier: pollQuerier,
+		pollWriter:  pollWriter,
+		lockGuard:   lockGuard,
+		cache:       cache,
+	}
+}
+
+// Set creates a poll on the thread or applies changes to its existing poll.
+// Callers are responsible for authorising changes to the thread itself.
+func (m *Manager) Set(ctx context.Context, threadID post.ID, p Params) (*poll.Poll, error) {
+	accountID := session.GetOptAccountID(ctx)
+
+	existing, exists, err := m.pollQuerier.Lookup(ctx, threadID, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !exists {
+		if err := p.ValidateNew(ctx); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if _, err := m.pollWriter.Create(ctx, threadID, p.Options.OrZero(), p.opts()...); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return m.get(ctx, threadID, accountID)
+	}
+
+	optionCount := len(existing.Options)
+	if options, ok := p.Options.Get(); ok {
+		optionCount = len(options)
+	}
+
+	if err := p.validate(ctx, optionCount); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// The close time may always be changed but anything affecting the meaning
+	// of votes that have already been cast may not.
+	if existing.HasVotes() && changesVoting(existing, p) {
+		return nil, fault.Wrap(ErrHasVotes,
+			fctx.With(ctx),
+			fmsg.WithDesc("has votes", "The options, choices and anonymity of a poll cannot be changed after people have voted."),
+		)
+	}
+
+	if err := m.cache.Invalidate(ctx, xid.ID(threadID)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.pollWriter.Update(ctx, existing.ID, p.opts()...); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if options, ok := p.Options.Get(); ok {
+		if err := m.pollWriter.SetOptions(ctx, existing.ID, options); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return m.get(ctx, threadID, accountID)
+}
+
+func changesVoting(existing *poll.Poll, p Params) bool {
+	if options, ok := p.Options.Get(); ok {
+		current := lo.Map(existing.Options, func(o *poll.Option, _ int) string { return o.Text })
+		if !slices.Equal(current, options) {
+			return true
+		}
+	}
+
+	if n, ok := p.MaxChoices.Get(); ok && n != existing.MaxChoices {
+		return true
+	}
+
+	if v, ok := p.Anonymous.Get(); ok && v != existing.Anonymous {
+		return true
+	}
+
+	return false
+}
+
+// Vote replaces the requesting account's votes on the thread's poll.
+func (m *Manager) Vote(ctx context.Context, threadID post.ID, options []poll.OptionID) (*poll.Poll, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.lockGuard.Authorise(ctx, accountID, threadID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	p, err := m.get(ctx, threadID, opt.New(accountID))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if p.Closed {
+		return nil, fault.Wrap(ErrClosed,
+			fctx.With(ctx),
+			fmsg.WithDesc("closed", "This poll has closed and no longer accepts votes."),
+		)
+	}
+
+	options = lo.Uniq(options)
+
+	if len(options) > p.MaxChoices {
+		return nil, fault.Wrap(ErrInvalidVote,
+			fctx.With(ctx),
+			fmsg.WithDesc("too many choices", "You have chosen more options than this poll allows."),
+		)
+	}
+
+	for _, id := range options {
+		if _, ok := p.Option(id); !ok {
+			return nil, fault.Wrap(ErrInvalidVote,
+				fctx.With(ctx),
+				fmsg.WithDesc("unknown option", "One of the chosen options is not part of this poll."),
+			)
+		}
+	}
+
+	if err := m.cache.Invalidate(ctx, xid.ID(threadID)); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := m.pollWriter.Vote(ctx, p.ID, accountID, options); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return m.get(ctx, threadID, opt.New(accountID))
+}
+
+func (m *Manager) get(ctx context.Context, threadID post.ID, accountID opt.Optional[account.AccountID]) (*poll.Poll, error) {
+	p, exists, err := m.pollQuerier.Lookup(ctx, threadID, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !exists {
+		return nil, fault.Wrap(ErrNoPoll,
+			fctx.With(ctx),
+			fmsg.WithDesc("no poll", "This thread does not have a poll."),
+		)
+	}
+
+	return p, nil
+}
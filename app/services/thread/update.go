@@ -12,10 +12,13 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/message"
 	"github.com/Southclaws/storyden/app/resources/pagination"
 	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/poll"
 	"github.com/Southclaws/storyden/app/resources/post/thread"
 	"github.com/Southclaws/storyden/app/resources/post/thread_writer"
 	"github.com/Southclaws/storyden/app/resources/rbac"
@@ -47,8 +50,34 @@ func (s *service) Update(ctx context.Context, threadID post.ID, partial Partial)
 	}
 
 	oldVisibility := thr.Visibility
+	oldTitle := thr.Title
+	oldContent := thr.Content
 	opts := partial.Opts()
 
+	// Locking is a moderation action, authors cannot lock their own threads.
+	locked, lockChanged := partial.Locked.Get()
+	lockChanged = lockChanged && locked != thr.Lock.Ok()
+	if lockChanged {
+		if err := acc.Roles.Permissions().Authorise(ctx, nil, rbac.PermissionManagePosts); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if locked {
+			opts = append(opts, thread_writer.WithLock(aid, partial.LockReason))
+		} else {
+			opts = append(opts, thread_writer.WithUnlock())
+		}
+	}
+
+	var updatedPoll opt.Optional[poll.Poll]
+	if p, ok := partial.Poll.Get(); ok {
+		up, err := s.polls.Set(ctx, threadID, p)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		updatedPoll = opt.New(*up)
+	}
+
 	newContent, contentChanged := partial.Content.Get()
 	newTitle, titleChanged := partial.Title.Get()
 	if contentChanged || titleChanged {
@@ -100,13 +129,50 @@ func (s *service) Update(ctx context.Context, threadID post.ID, partial Partial)
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	thr.Poll = updatedPoll
+
+	// The revision is only kept once the edit it precedes has been written,
+	// otherwise a failed update would leave a copy of the current version.
+	if oldTitle != thr.Title || oldContent.HTML() != thr.Content.HTML() {
+		if _, err := s.revisions.Create(ctx, threadID, aid, oldTitle, oldContent); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	// Always emit a general update event
 	s.bus.Publish(ctx, &message.EventThreadUpdated{
 		ID: thr.ID,
 	})
 
+	if lockChanged {
+		auditOpts := []audit_writer.Option{audit_writer.WithTarget(*datagraph.NewRef(thr))}
+		action := audit.ActionThreadUnlocked
+		if locked {
+			action = audit.ActionThreadLocked
+			if reason, ok := partial.LockReason.Get(); ok {
+				auditOpts = append(auditOpts, audit_writer.WithAfter("reason: "+reason))
+			}
+		}
+
+		if err := s.auditWriter.Record(ctx, opt.New(aid), action, auditOpts...); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
 	// Emit visibility-specific events when visibility changes
 	if oldVisibility != thr.Visibility {
+		// Only a moderator changing someone else's thread is worth auditing.
+		if thr.Author.ID != aid {
+			err = s.auditWriter.Record(ctx, opt.New(aid), audit.ActionVisibilityChanged,
+				audit_writer.WithTarget(*datagraph.NewRef(thr)),
+				audit_writer.WithBefore("visibility: "+oldVisibility.String()),
+				audit_writer.WithAfter("visibility: "+thr.Visibility.String()),
+			)
+			if err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx))
+			}
+		}
+
 		if thr.Visibility == visibility.VisibilityPublished {
 			s.bus.Publish(ctx, &message.EventThreadPublished{
 				ID: thr.ID,
@@ -0,0 +1,19 @@
+package webhook
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_dispatch"
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_manager"
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_sender"
+)
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			webhook_sender.New,
+			webhook_manager.New,
+		),
+		webhook_dispatch.Build(),
+	)
+}
@@ -0,0 +1,135 @@
+// Package webhook_dispatch subscribes to events on the message bus and fans them
+// out to every webhook subscribed to the event as a queued delivery.
+package webhook_dispatch
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_repo"
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_sender"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+var errDeliveryPending = fault.New("webhook delivery failed, retrying")
+
+type Dispatcher struct {
+	logger *slog.Logger
+	repo   *webhook_repo.Repository
+	sender *webhook_sender.Sender
+	bus    *pubsub.Bus
+}
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(New),
+		fx.Invoke(func(
+			ctx context.Context,
+			lc fx.Lifecycle,
+			bus *pubsub.Bus,
+			d *Dispatcher,
+		) {
+			lc.Append(fx.StartHook(func(hctx context.Context) error {
+				if _, err := pubsub.SubscribeCommand(ctx, bus, "webhook_dispatch.deliver", func(ctx context.Context, cmd *message.CommandDeliverWebhook) error {
+					return d.deliver(ctx, cmd.ID)
+				}); err != nil {
+					return err
+				}
+
+				return subscribeEvents(ctx, bus, d)
+			}))
+		}),
+	)
+}
+
+func New(
+	logger *slog.Logger,
+	repo *webhook_repo.Repository,
+	sender *webhook_sender.Sender,
+	bus *pubsub.Bus,
+) *Dispatcher {
+	return &Dispatcher{
+		logger: logger,
+		repo:   repo,
+		sender: sender,
+		bus:    bus,
+	}
+}
+
+// Dispatch queues a delivery of the event data to every enabled webhook which
+// is subscribed to the event type. Delivery happens asynchronously.
+func (d *Dispatcher) Dispatch(ctx context.Context, et webhook.EventType, data any) error {
+	hooks, err := d.repo.ListSubscribed(ctx, et)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// A failure only skips the webhook it happened on. Failing the whole event
+	// would have the bus dispatch it again and every webhook queued before the
+	// failure would receive it twice.
+	for _, wh := range hooks {
+		if err := d.queue(ctx, wh.ID, et, payload); err != nil {
+			d.logger.Error("failed to queue webhook delivery",
+				slog.String("webhook_id", wh.ID.String()),
+				slog.String("event_type", et.String()),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) queue(ctx context.Context, id webhook.ID, et webhook.EventType, payload []byte) error {
+	del, err := d.repo.CreateDelivery(ctx, id, et, payload)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := d.bus.SendCommand(ctx, &message.CommandDeliverWebhook{ID: del.ID}); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// deliver makes one attempt at sending a delivery. If the attempt fails and
+// the delivery has attempts remaining, an error is returned so the message
+// queue's retry middleware re-runs the delivery with exponential backoff.
+func (d *Dispatcher) deliver(ctx context.Context, id webhook.DeliveryID) error {
+	del, err := d.sender.Send(ctx, id, true)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	switch del.Status {
+	case webhook.DeliveryStatusPending:
+		return fault.Wrap(errDeliveryPending, fctx.With(ctx))
+
+	case webhook.DeliveryStatusFailed:
+		d.logger.Warn("webhook delivery failed",
+			slog.String("delivery_id", del.ID.String()),
+			slog.String("webhook_id", del.WebhookID.String()),
+			slog.Int("attempts", del.Attempts),
+			slog.String("error", del.Error.OrZero()),
+		)
+	}
+
+	return nil
+}
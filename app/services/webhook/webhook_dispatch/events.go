@@ -0,0 +1,113 @@
+package webhook_dispatch
+
+import (
+	"context"
+
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+)
+
+// The payloads below are intentionally minimal references to the resource that
+// changed. Receivers are expected to fetch the full resource from the API which
+// ensures visibility rules are always respected for the data being accessed.
+
+type threadPayload struct {
+	ID string `json:"id"`
+}
+
+type replyPayload struct {
+	ThreadID string `json:"thread_id"`
+	ReplyID  string `json:"reply_id"`
+}
+
+type nodePayload struct {
+	ID   string `json:"id"`
+	Slug string `json:"slug"`
+}
+
+type reportPayload struct {
+	ID         string  `json:"id"`
+	TargetKind *string `json:"target_kind,omitempty"`
+	TargetID   *string `json:"target_id,omitempty"`
+	Status     *string `json:"status,omitempty"`
+}
+
+type accountPayload struct {
+	ID string `json:"id"`
+}
+
+func subscribeEvents(ctx context.Context, bus *pubsub.Bus, d *Dispatcher) error {
+	subscriptions := []func() error{
+		on(ctx, bus, d, webhook.EventTypeThreadPublished, func(e *message.EventThreadPublished) any {
+			return threadPayload{ID: e.ID.String()}
+		}),
+		on(ctx, bus, d, webhook.EventTypeThreadUpdated, func(e *message.EventThreadUpdated) any {
+			return threadPayload{ID: e.ID.String()}
+		}),
+		on(ctx, bus, d, webhook.EventTypeThreadUnpublished, func(e *message.EventThreadUnpublished) any {
+			return threadPayload{ID: e.ID.String()}
+		}),
+		on(ctx, bus, d, webhook.EventTypeThreadDeleted, func(e *message.EventThreadDeleted) any {
+			return threadPayload{ID: e.ID.String()}
+		}),
+		on(ctx, bus, d, webhook.EventTypeReplyCreated, func(e *message.EventThreadReplyCreated) any {
+			return replyPayload{ThreadID: e.ThreadID.String(), ReplyID: e.ReplyID.String()}
+		}),
+		on(ctx, bus, d, webhook.EventTypeReplyUpdated, func(e *message.EventThreadReplyUpdated) any {
+			return replyPayload{ThreadID: e.ThreadID.String(), ReplyID: e.ReplyID.String()}
+		}),
+		on(ctx, bus, d, webhook.EventTypeReplyDeleted, func(e *message.EventThreadReplyDeleted) any {
+			return replyPayload{ThreadID: e.ThreadID.String(), ReplyID: e.ReplyID.String()}
+		}),
+		on(ctx, bus, d, webhook.EventTypeNodePublished, func(e *message.EventNodePublished) any {
+			return nodePayload{ID: e.ID.String(), Slug: e.Slug}
+		}),
+		on(ctx, bus, d, webhook.EventTypeNodeUpdated, func(e *message.EventNodeUpdated) any {
+			return nodePayload{ID: e.ID.String(), Slug: e.Slug}
+		}),
+		on(ctx, bus, d, webhook.EventTypeNodeUnpublished, func(e *message.EventNodeUnpublished) any {
+			return nodePayload{ID: e.ID.String(), Slug: e.Slug}
+		}),
+		on(ctx, bus, d, webhook.EventTypeNodeDeleted, func(e *message.EventNodeDeleted) any {
+			return nodePayload{ID: e.ID.String(), Slug: e.Slug}
+		}),
+		on(ctx, bus, d, webhook.EventTypeReportCreated, func(e *message.EventReportCreated) any {
+			p := reportPayload{ID: e.ID.String()}
+			if e.Target != nil {
+				kind, id := e.Target.Kind.String(), e.Target.ID.String()
+				p.TargetKind, p.TargetID = &kind, &id
+			}
+			return p
+		}),
+		on(ctx, bus, d, webhook.EventTypeReportUpdated, func(e *message.EventReportUpdated) any {
+			status := e.Status.String()
+			p := reportPayload{ID: e.ID.String(), Status: &status}
+			if e.Target != nil {
+				kind, id := e.Target.Kind.String(), e.Target.ID.String()
+				p.TargetKind, p.TargetID = &kind, &id
+			}
+			return p
+		}),
+		on(ctx, bus, d, webhook.EventTypeAccountCreated, func(e *message.EventAccountCreated) any {
+			return accountPayload{ID: e.ID.String()}
+		}),
+	}
+
+	for _, subscribe := range subscriptions {
+		if err := subscribe(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func on[T any](ctx context.Context, bus *pubsub.Bus, d *Dispatcher, et webhook.EventType, payload func(*T) any) func() error {
+	return func() error {
+		_, err := pubsub.Subscribe(ctx, bus, "webhook_dispatch."+et.String(), func(ctx context.Context, evt *T) error {
+			return d.Dispatch(ctx, et, payload(evt))
+		})
+		return err
+	}
+}
@@ -0,0 +1,190 @@
+// Package webhook_manager provides administration of webhook endpoints.
+package webhook_manager
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_repo"
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_sender"
+)
+
+// secretPrefix is a visual cue for where the value came from, similar to the
+// prefixes used for access keys. It has no meaning to the signature scheme.
+const secretPrefix = "sdwhsec_"
+
+var errInvalidURL = fault.New("webhook URL must be an absolute http or https URL")
+
+type Partial struct {
+	Name       opt.Optional[string]
+	URL        opt.Optional[string]
+	EventTypes opt.Optional[[]webhook.EventType]
+	Enabled    opt.Optional[bool]
+}
+
+type Manager struct {
+	repo   *webhook_repo.Repository
+	sender *webhook_sender.Sender
+}
+
+func New(repo *webhook_repo.Repository, sender *webhook_sender.Sender) *Manager {
+	return &Manager{repo: repo, sender: sender}
+}
+
+func (m *Manager) Create(ctx context.Context, name string, endpoint string, eventTypes []webhook.EventType, enabled opt.Optional[bool]) (*webhook.Webhook, error) {
+	if err := validateURL(endpoint); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	opts := []webhook_repo.Option{}
+	enabled.Call(func(v bool) { opts = append(opts, webhook_repo.WithEnabled(v)) })
+
+	wh, err := m.repo.Create(ctx, name, endpoint, secret, eventTypes, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return wh, nil
+}
+
+func (m *Manager) List(ctx context.Context) (webhook.Webhooks, error) {
+	ws, err := m.repo.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return ws, nil
+}
+
+func (m *Manager) Get(ctx context.Context, id webhook.ID) (*webhook.Webhook, error) {
+	wh, err := m.repo.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return wh, nil
+}
+
+func (m *Manager) Update(ctx context.Context, id webhook.ID, p Partial) (*webhook.Webhook, error) {
+	opts := []webhook_repo.Option{}
+
+	if v, ok := p.URL.Get(); ok {
+		if err := validateURL(v); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		opts = append(opts, webhook_repo.WithURL(v))
+	}
+
+	p.Name.Call(func(v string) { opts = append(opts, webhook_repo.WithName(v)) })
+	p.EventTypes.Call(func(v []webhook.EventType) { opts = append(opts, webhook_repo.WithEventTypes(v)) })
+	p.Enabled.Call(func(v bool) { opts = append(opts, webhook_repo.WithEnabled(v)) })
+
+	wh, err := m.repo.Update(ctx, id, opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return wh, nil
+}
+
+// RotateSecret replaces the signing secret of a webhook. The new secret is only
+// available on the returned webhook, the receiver must be updated to match.
+func (m *Manager) RotateSecret(ctx context.Context, id webhook.ID) (*webhook.Webhook, error) {
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	wh, err := m.repo.Update(ctx, id, webhook_repo.WithSecret(secret))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return wh, nil
+}
+
+func (m *Manager) Delete(ctx context.Context, id webhook.ID) error {
+	if err := m.repo.Delete(ctx, id); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Test sends a ping event to the webhook immediately and returns the outcome.
+// This bypasses the queue and does not retry so the result can be displayed to
+// the administrator straight away. It works on disabled webhooks too, so they
+// can be verified before being enabled.
+func (m *Manager) Test(ctx context.Context, id webhook.ID) (*webhook.Delivery, error) {
+	wh, err := m.repo.Get(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	payload, err := json.Marshal(map[string]string{"webhook_id": wh.ID.String()})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	d, err := m.repo.CreateDelivery(ctx, wh.ID, webhook.EventTypePing, payload)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	d, err = m.sender.Send(ctx, d.ID, false)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return d, nil
+}
+
+func (m *Manager) ListDeliveries(ctx context.Context, id webhook.ID, page pagination.Parameters) (*pagination.Result[*webhook.Delivery], error) {
+	if _, err := m.repo.Get(ctx, id); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, err := m.repo.ListDeliveries(ctx, id, page)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return r, nil
+}
+
+func validateURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil || !u.IsAbs() || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fault.Wrap(errInvalidURL,
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("invalid url", "The webhook URL must be a full URL starting with http:// or https://"),
+		)
+	}
+
+	return nil
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return secretPrefix + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,136 @@
+// Package webhook_sender performs individual HTTP delivery attempts for webhook
+// deliveries and records the outcome of each attempt in the delivery log.
+package webhook_sender
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/app/resources/webhook/webhook_repo"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+const (
+	HeaderEvent     = "X-Storyden-Event"
+	HeaderDelivery  = "X-Storyden-Delivery"
+	HeaderTimestamp = "X-Storyden-Timestamp"
+	HeaderSignature = "X-Storyden-Signature"
+
+	requestTimeout = 10 * time.Second
+
+	// Only the start of a failed response body is kept in the delivery log.
+	maxErrorBodySize = 1024
+)
+
+// Envelope is the JSON body sent to webhook endpoints. The data field holds the
+// event specific payload, the shape of which depends on the event type.
+type Envelope struct {
+	ID        string          `json:"id"`
+	Event     string          `json:"event"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+type Sender struct {
+	repo        *webhook_repo.Repository
+	client      *http.Client
+	maxAttempts int
+}
+
+func New(cfg config.Config, repo *webhook_repo.Repository) *Sender {
+	return &Sender{
+		repo:        repo,
+		client:      &http.Client{Timeout: requestTimeout},
+		maxAttempts: cfg.QueueMaxRetries + 1,
+	}
+}
+
+// Send performs a single delivery attempt. When retry is true and the attempt
+// fails, the delivery is left in the pending state as long as it has attempts
+// remaining so the caller can try again. Otherwise a failure is final.
+func (s *Sender) Send(ctx context.Context, id webhook.DeliveryID, retry bool) (*webhook.Delivery, error) {
+	d, err := s.repo.GetDelivery(ctx, id)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	wh, err := s.repo.Get(ctx, d.WebhookID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	responseStatus, sendErr := s.post(ctx, wh, d)
+	if sendErr == nil {
+		return s.repo.RecordAttempt(ctx, id, webhook.DeliveryStatusSucceeded, responseStatus, opt.NewEmpty[string]())
+	}
+
+	status := webhook.DeliveryStatusFailed
+	if retry && d.Attempts+1 < s.maxAttempts {
+		status = webhook.DeliveryStatusPending
+	}
+
+	return s.repo.RecordAttempt(ctx, id, status, responseStatus, opt.New(sendErr.Error()))
+}
+
+func (s *Sender) post(ctx context.Context, wh *webhook.Webhook, d *webhook.Delivery) (opt.Optional[int], error) {
+	body, err := json.Marshal(Envelope{
+		ID:        d.ID.String(),
+		Event:     d.EventType.String(),
+		CreatedAt: d.CreatedAt,
+		Data:      d.Payload,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Storyden-Webhooks")
+	req.Header.Set(HeaderEvent, d.EventType.String())
+	req.Header.Set(HeaderDelivery, d.ID.String())
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, "sha256="+Sign(wh.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return opt.New(resp.StatusCode), fault.Newf("endpoint responded with %s: %s", resp.Status, excerpt)
+	}
+
+	return opt.New(resp.StatusCode), nil
+}
+
+// Sign produces the hex encoded HMAC-SHA256 signature for a delivery. The
+// timestamp is included in the signed content so receivers can reject replays
+// by checking that the timestamp header is recent.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%s.", timestamp)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
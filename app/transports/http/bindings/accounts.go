@@ -23,7 +23,9 @@ import (
 	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
 	"github.com/Southclaws/storyden/app/resources/rbac"
 	"github.com/Southclaws/storyden/app/services/account/account_auth"
+	"github.com/Southclaws/storyden/app/services/account/account_deletion"
 	"github.com/Southclaws/storyden/app/services/account/account_email"
+	"github.com/Southclaws/storyden/app/services/account/account_export"
 	"github.com/Southclaws/storyden/app/services/account/account_manage"
 	"github.com/Southclaws/storyden/app/services/account/account_update"
 	"github.com/Southclaws/storyden/app/services/authentication"
@@ -44,6 +46,8 @@ type Accounts struct {
 	accountAuth   *account_auth.Manager
 	accountEmail  *account_email.Manager
 	accountManage *account_manage.Manager
+	accountExport *account_export.Exporter
+	accountDelete *account_deletion.Manager
 	roleAssign    *role_assign.Assignment
 	roleBadge     *role_badge.Writer
 	webAddress    url.URL
@@ -60,6 +64,8 @@ func NewAccounts(
 	accountAuth *account_auth.Manager,
 	accountEmail *account_email.Manager,
 	accountManage *account_manage.Manager,
+	accountExport *account_export.Exporter,
+	accountDelete *account_deletion.Manager,
 	roleAssign *role_assign.Assignment,
 	roleBadge *role_badge.Writer,
 ) Accounts {
@@ -73,6 +79,8 @@ func NewAccounts(
 		accountAuth:   accountAuth,
 		accountEmail:  accountEmail,
 		accountManage: accountManage,
+		accountExport: accountExport,
+		accountDelete: accountDelete,
 		roleAssign:    roleAssign,
 		roleBadge:     roleBadge,
 		webAddress:    cfg.PublicWebAddress,
@@ -304,6 +312,70 @@ func (i *Accounts) AccountSetAvatar(ctx context.Context, request openapi.Account
 	return openapi.AccountSetAvatar200Response{}, nil
 }
 
+func (i *Accounts) AccountDataExportGet(ctx context.Context, request openapi.AccountDataExportGetRequestObject) (openapi.AccountDataExportGetResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, size, err := i.accountExport.Get(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountDataExportGet200ApplicationzipResponse{
+		AccountDataExportGetOKApplicationzipResponse: openapi.AccountDataExportGetOKApplicationzipResponse{
+			Body:          r,
+			ContentLength: size,
+		},
+	}, nil
+}
+
+func (i *Accounts) AccountDataExportRequest(ctx context.Context, request openapi.AccountDataExportRequestRequestObject) (openapi.AccountDataExportRequestResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := i.accountExport.Request(ctx, accountID); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountDataExportRequest202Response{}, nil
+}
+
+func (i *Accounts) AccountDeletionRequest(ctx context.Context, request openapi.AccountDeletionRequestRequestObject) (openapi.AccountDeletionRequestResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := i.accountDelete.Request(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountDeletionRequest200JSONResponse{
+		AccountUpdateOKJSONResponse: openapi.AccountUpdateOKJSONResponse(serialiseAccount(acc)),
+	}, nil
+}
+
+func (i *Accounts) AccountDeletionCancel(ctx context.Context, request openapi.AccountDeletionCancelRequestObject) (openapi.AccountDeletionCancelResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := i.accountDelete.Cancel(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountDeletionCancel200JSONResponse{
+		AccountUpdateOKJSONResponse: openapi.AccountUpdateOKJSONResponse(serialiseAccount(acc)),
+	}, nil
+}
+
 func (h *Accounts) AccountRemoveRole(ctx context.Context, request openapi.AccountRemoveRoleRequestObject) (openapi.AccountRemoveRoleResponseObject, error) {
 	accountID, err := session.GetAccountID(ctx)
 	if err != nil {
@@ -331,7 +403,7 @@ func (h *Accounts) AccountRemoveRole(ctx context.Context, request openapi.Accoun
 		return nil, fault.Wrap(ErrEveryoneRole, fctx.With(ctx))
 	}
 
-	acc, err = h.roleAssign.UpdateRoles(ctx, acc.ID, role_assign.Remove(roleID))
+	acc, err = h.roleAssign.UpdateRoles(ctx, opt.New(accountID), acc.ID, role_assign.Remove(roleID))
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -368,7 +440,7 @@ func (h *Accounts) AccountAddRole(ctx context.Context, request openapi.AccountAd
 		return nil, fault.Wrap(ErrEveryoneRole, fctx.With(ctx))
 	}
 
-	acc, err = h.roleAssign.UpdateRoles(ctx, acc.ID, role_assign.Add(roleID))
+	acc, err = h.roleAssign.UpdateRoles(ctx, opt.New(accountID), acc.ID, role_assign.Add(roleID))
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
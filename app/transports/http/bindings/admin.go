@@ -8,10 +8,14 @@ import (
 	"github.com/Southclaws/fault/fctx"
 	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/account/authentication"
 	"github.com/Southclaws/storyden/app/resources/account/authentication/access_key"
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_querier"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
 	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
 	"github.com/Southclaws/storyden/app/resources/rbac"
@@ -30,6 +34,8 @@ type Admin struct {
 	as              account_suspension.Service
 	settingsManager *settings_manager.Manager
 	akr             *access_key.Repository
+	auditQuerier    *audit_querier.Querier
+	auditWriter     *audit_writer.Writer
 }
 
 func NewAdmin(
@@ -38,6 +44,8 @@ func NewAdmin(
 	as account_suspension.Service,
 	settingsManager *settings_manager.Manager,
 	akr *access_key.Repository,
+	auditQuerier *audit_querier.Querier,
+	auditWriter *audit_writer.Writer,
 ) Admin {
 	return Admin{
 		accountQuery:    accountQuery,
@@ -45,6 +53,8 @@ func NewAdmin(
 		as:              as,
 		settingsManager: settingsManager,
 		akr:             akr,
+		auditQuerier:    auditQuerier,
+		auditWriter:     auditWriter,
 	}
 }
 
@@ -79,16 +89,22 @@ func (a *Admin) AdminSettingsUpdate(ctx context.Context, request openapi.AdminSe
 				ReplyBodyLengthMax:  opt.NewPtr(moderation.ReplyBodyLengthMax),
 				WordBlockList:       opt.NewPtr(moderation.WordBlockList),
 				WordReportList:      opt.NewPtr(moderation.WordReportList),
+				ThreadAutoLockDays:  opt.NewPtr(moderation.ThreadAutoLockDays),
 			}),
 		})
 	}
 
+	accountDeletion := opt.NewPtrMap(request.Body.AccountDeletion, deserialiseAccountDeletionSettings)
+
 	settings, err := a.settingsManager.Set(ctx, settings.Settings{
 		Title:              opt.NewPtr(request.Body.Title),
 		Description:        opt.NewPtr(request.Body.Description),
 		Content:            content,
 		AccentColour:       opt.NewPtr(request.Body.AccentColour),
 		AuthenticationMode: authMode,
+		InviteOnly:         opt.NewPtr(request.Body.InviteOnly),
+		TwoFactorRequired:  opt.NewPtr(request.Body.TwoFactorRequired),
+		AccountDeletion:    accountDeletion,
 		Services:           services,
 		Metadata:           opt.NewPtr((*map[string]any)(request.Body.Metadata)),
 	})
@@ -121,7 +137,13 @@ func (i *Admin) AdminAccountBanCreate(ctx context.Context, request openapi.Admin
 		return nil, fault.Wrap(errNotAuthorised, fctx.With(ctx), ftag.With(ftag.PermissionDenied))
 	}
 
-	acc, err = i.as.Suspend(ctx, id)
+	opts := account_suspension.SuspendOptions{}
+	if request.Body != nil {
+		opts.Reason = opt.NewPtr(request.Body.Reason)
+		opts.Until = opt.NewPtr(request.Body.ExpiresAt)
+	}
+
+	acc, err = i.as.Suspend(ctx, id, accountID, opts)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -187,7 +209,20 @@ func (i *Admin) AdminAccessKeyDelete(ctx context.Context, request openapi.AdminA
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	_, err := i.akr.RevokeAsAdmin(ctx, deserialiseID(request.AccessKeyId))
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	key, err := i.akr.RevokeAsAdmin(ctx, deserialiseID(request.AccessKeyId))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = i.auditWriter.Record(ctx, opt.New(accountID), audit.ActionAccessKeyRevoked,
+		audit_writer.WithTarget(datagraph.Ref{ID: xid.ID(key.Account.ID), Kind: datagraph.KindProfile}),
+		audit_writer.WithBefore("access key: "+key.Name.Or(key.ID.String())),
+	)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -195,6 +230,75 @@ func (i *Admin) AdminAccessKeyDelete(ctx context.Context, request openapi.AdminA
 	return openapi.NoContentResponse{}, nil
 }
 
+func (i *Admin) AdminAuditLogList(ctx context.Context, request openapi.AdminAuditLogListRequestObject) (openapi.AdminAuditLogListResponseObject, error) {
+	actions, err := dt.MapErr(opt.NewPtr(request.Params.Action).OrZero(), func(a openapi.AuditLogAction) (audit.Action, error) {
+		return audit.NewAction(string(a))
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	opts := []audit_querier.Query{audit_querier.WithActions(actions...)}
+
+	if handle, ok := opt.NewPtr(request.Params.Actor).Get(); ok {
+		acc, found, err := i.accountQuery.LookupByHandle(ctx, handle)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if !found {
+			return nil, fault.New("actor not found", fctx.With(ctx), ftag.With(ftag.NotFound))
+		}
+
+		opts = append(opts, audit_querier.WithActor(acc.ID))
+	}
+
+	if id, ok := opt.NewPtr(request.Params.TargetId).Get(); ok {
+		opts = append(opts, audit_querier.WithTargetID(deserialiseID(id)))
+	}
+
+	if kind, ok := opt.NewPtr(request.Params.TargetKind).Get(); ok {
+		k, err := datagraph.NewKind(string(kind))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+
+		opts = append(opts, audit_querier.WithTargetKind(k))
+	}
+
+	r, err := i.auditQuerier.List(ctx, deserialisePageParams(request.Params.Page, 50), opts...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminAuditLogList200JSONResponse{
+		AdminAuditLogListOKJSONResponse: openapi.AdminAuditLogListOKJSONResponse{
+			CurrentPage: r.CurrentPage,
+			NextPage:    r.NextPage.Ptr(),
+			PageSize:    r.Size,
+			Results:     r.Results,
+			Entries:     dt.Map(r.Items, serialiseAuditLogEntry),
+			TotalPages:  r.TotalPages,
+		},
+	}, nil
+}
+
+func serialiseAuditLogEntry(in *audit.Entry) openapi.AuditLogEntry {
+	return openapi.AuditLogEntry{
+		Id:        openapi.Identifier(in.ID.String()),
+		CreatedAt: in.CreatedAt,
+		Actor:     opt.Map(in.Actor, serialiseProfileReferenceFromAccount).Ptr(),
+		Action:    openapi.AuditLogAction(in.Action.String()),
+		Target: opt.Map(in.Target, func(r datagraph.Ref) openapi.AuditLogTarget {
+			return openapi.AuditLogTarget{
+				Id:   openapi.Identifier(r.ID.String()),
+				Kind: openapi.DatagraphItemKind(r.Kind.String()),
+			}
+		}).Ptr(),
+		Before: in.Before.Ptr(),
+		After:  in.After.Ptr(),
+	}
+}
+
 func serialiseSettings(in *settings.Settings) openapi.AdminSettingsProps {
 	return openapi.AdminSettingsProps{
 		AccentColour:       in.AccentColour.OrZero(),
@@ -202,11 +306,32 @@ func serialiseSettings(in *settings.Settings) openapi.AdminSettingsProps {
 		Content:            in.Content.OrZero().HTML(),
 		Title:              in.Title.OrZero(),
 		AuthenticationMode: openapi.AuthMode(in.AuthenticationMode.Or(authentication.ModeHandle).String()),
+		InviteOnly:         in.InviteOnly.Ptr(),
+		TwoFactorRequired:  in.TwoFactorRequired.Ptr(),
+		AccountDeletion:    opt.Map(in.AccountDeletion, serialiseAccountDeletionSettings).Ptr(),
 		Services:           opt.Map(in.Services, serialiseServiceSettings).Ptr(),
 		Metadata:           (*openapi.Metadata)(in.Metadata.Ptr()),
 	}
 }
 
+func deserialiseAccountDeletionSettings(in openapi.AccountDeletionSettings) settings.AccountDeletionSettings {
+	return settings.AccountDeletionSettings{
+		GracePeriodDays: opt.NewPtr(in.GracePeriodDays),
+		Content: opt.NewPtrMap(in.Content, func(c openapi.AccountDeletionContent) settings.DeletedContent {
+			return settings.DeletedContent(c)
+		}),
+	}
+}
+
+func serialiseAccountDeletionSettings(in settings.AccountDeletionSettings) openapi.AccountDeletionSettings {
+	return openapi.AccountDeletionSettings{
+		GracePeriodDays: in.GracePeriodDays.Ptr(),
+		Content: opt.Map(in.Content, func(c settings.DeletedContent) openapi.AccountDeletionContent {
+			return openapi.AccountDeletionContent(c)
+		}).Ptr(),
+	}
+}
+
 func serialiseServiceSettings(in settings.ServiceSettings) openapi.AdminSettingsServiceProps {
 	return openapi.AdminSettingsServiceProps{
 		Moderation: opt.Map(in.Moderation, serialiseModerationSettings).Ptr(),
@@ -219,6 +344,7 @@ func serialiseModerationSettings(in settings.ModerationServiceSettings) openapi.
 		ReplyBodyLengthMax:  in.ReplyBodyLengthMax.Ptr(),
 		WordBlockList:       in.WordBlockList.Ptr(),
 		WordReportList:      in.WordReportList.Ptr(),
+		ThreadAutoLockDays:  in.ThreadAutoLockDays.Ptr(),
 	}
 }
 
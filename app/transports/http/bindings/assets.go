@@ -13,18 +13,21 @@ import (
 	"github.com/Southclaws/storyden/app/resources/asset"
 	"github.com/Southclaws/storyden/app/resources/rbac"
 	"github.com/Southclaws/storyden/app/services/asset/asset_download"
+	"github.com/Southclaws/storyden/app/services/asset/asset_gc"
 	"github.com/Southclaws/storyden/app/services/asset/asset_upload"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
 )
 
 type Assets struct {
 	uploader   *asset_upload.Uploader
 	downloader *asset_download.Downloader
+	collector  *asset_gc.Collector
 }
 
-func NewAssets(uploader *asset_upload.Uploader, downloader *asset_download.Downloader) Assets {
-	return Assets{uploader, downloader}
+func NewAssets(uploader *asset_upload.Uploader, downloader *asset_download.Downloader, collector *asset_gc.Collector) Assets {
+	return Assets{uploader, downloader, collector}
 }
 
 func (i *Assets) AssetGet(ctx context.Context, request openapi.AssetGetRequestObject) (openapi.AssetGetResponseObject, error) {
@@ -77,6 +80,30 @@ func (i *Assets) AssetUpload(ctx context.Context, request openapi.AssetUploadReq
 	}, nil
 }
 
+func (i *Assets) AdminAssetCleanup(ctx context.Context, request openapi.AdminAssetCleanupRequestObject) (openapi.AdminAssetCleanupResponseObject, error) {
+	report, err := i.collector.Collect(ctx, request.Body.DryRun)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminAssetCleanup200JSONResponse{
+		AdminAssetCleanupOKJSONResponse: openapi.AdminAssetCleanupOKJSONResponse{
+			DryRun:    report.DryRun,
+			Assets:    dt.Map(report.Assets, serialiseAssetPtr),
+			Objects:   dt.Map(report.Objects, serialiseStoredObject),
+			TotalSize: int(report.Bytes),
+		},
+	}, nil
+}
+
+func serialiseStoredObject(o object.Object) openapi.StoredObject {
+	return openapi.StoredObject{
+		Path:       o.Path,
+		Size:       int(o.Size),
+		ModifiedAt: o.Modified,
+	}
+}
+
 func serialiseAsset(a asset.Asset) openapi.Asset {
 	path := fmt.Sprintf(`/api/assets/%s`, a.Name.String())
 
@@ -24,6 +24,7 @@ import (
 	"github.com/Southclaws/storyden/app/services/authentication/provider/email_only"
 	"github.com/Southclaws/storyden/app/services/authentication/provider/oauth"
 	"github.com/Southclaws/storyden/app/services/authentication/provider/password"
+	"github.com/Southclaws/storyden/app/services/authentication/provider/totp"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/session_cookie"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
@@ -38,6 +39,7 @@ type Authentication struct {
 	settings                      *settings.SettingsRepository
 	passwordAuthProvider          *password.Provider
 	emailVerificationAuthProvider *email_only.Provider
+	totpAuthProvider              *totp.Provider
 	accountQuery                  *account_querier.Querier
 	emailRepo                     *email.Repository
 	authManager                   *auth_svc.Manager
@@ -55,6 +57,7 @@ func NewAuthentication(
 	settings *settings.SettingsRepository,
 	passwordAuthProvider *password.Provider,
 	emailVerificationAuthProvider *email_only.Provider,
+	totpAuthProvider *totp.Provider,
 	accountQuery *account_querier.Querier,
 	emailRepo *email.Repository,
 	authManager *auth_svc.Manager,
@@ -69,6 +72,7 @@ func NewAuthentication(
 		settings:                      settings,
 		passwordAuthProvider:          passwordAuthProvider,
 		emailVerificationAuthProvider: emailVerificationAuthProvider,
+		totpAuthProvider:              totpAuthProvider,
 		accountQuery:                  accountQuery,
 		emailRepo:                     emailRepo,
 		authManager:                   authManager,
@@ -89,6 +93,13 @@ func (o *Authentication) AuthProviderList(ctx context.Context, request openapi.A
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	// Second factors are not sign in options, they're only offered as part of
+	// a sign in that was started with one of the other providers.
+	providers = dt.Filter(providers, func(p auth_svc.Provider) bool {
+		_, secondFactor := p.(auth_svc.SecondFactorProvider)
+		return !secondFactor
+	})
+
 	list, err := dt.MapErr(providers, serialiseAuthProvider(buildRedirectURL(o.webAddress)))
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
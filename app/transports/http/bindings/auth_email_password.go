@@ -58,6 +58,15 @@ func (i *Authentication) AuthEmailPasswordSignin(ctx context.Context, request op
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	challenge, err := i.challenge(ctx, acc.ID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if ch, ok := challenge.Get(); ok {
+		return openapi.AuthEmailPasswordSignin202JSONResponse{AuthTwoFactorChallengeOKJSONResponse: ch}, nil
+	}
+
 	t, err := i.si.Issue(ctx, acc.ID)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
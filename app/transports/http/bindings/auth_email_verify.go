@@ -23,6 +23,15 @@ func (i *Authentication) AuthEmailVerify(ctx context.Context, request openapi.Au
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	challenge, err := i.challenge(ctx, acc.ID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if ch, ok := challenge.Get(); ok {
+		return openapi.AuthEmailVerify202JSONResponse{AuthTwoFactorChallengeOKJSONResponse: ch}, nil
+	}
+
 	t, err := i.si.Issue(ctx, acc.ID)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -33,6 +33,15 @@ func (o *Authentication) OAuthProviderCallback(ctx context.Context, request open
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	challenge, err := o.challenge(ctx, account.ID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if ch, ok := challenge.Get(); ok {
+		return openapi.OAuthProviderCallback202JSONResponse{AuthTwoFactorChallengeOKJSONResponse: ch}, nil
+	}
+
 	t, err := o.si.Issue(ctx, account.ID)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -16,6 +16,15 @@ func (i *Authentication) AuthPasswordSignin(ctx context.Context, request openapi
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	challenge, err := i.challenge(ctx, acc.ID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if ch, ok := challenge.Get(); ok {
+		return openapi.AuthPasswordSignin202JSONResponse{AuthTwoFactorChallengeOKJSONResponse: ch}, nil
+	}
+
 	t, err := i.si.Issue(ctx, acc.ID)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -115,6 +124,17 @@ func (i *Authentication) AuthPasswordReset(ctx context.Context, request openapi.
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	// A reset token proves access to the account's email, not possession of
+	// its second factor, so it can't be used to sign in without one.
+	challenge, err := i.challenge(ctx, acc.ID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if ch, ok := challenge.Get(); ok {
+		return openapi.AuthPasswordReset202JSONResponse{AuthTwoFactorChallengeOKJSONResponse: ch}, nil
+	}
+
 	t, err := i.si.Issue(ctx, acc.ID)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -7,6 +7,7 @@ import (
 	"github.com/Southclaws/fault/fctx"
 
 	"github.com/Southclaws/storyden/app/services/authentication/provider/phone"
+	"github.com/Southclaws/storyden/app/services/authentication/provider/totp"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/session_cookie"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
@@ -14,12 +15,13 @@ import (
 
 type PhoneAuth struct {
 	pp *phone.Provider
+	tp *totp.Provider
 	cj *session_cookie.Jar
 	si *session.Issuer
 }
 
-func NewPhoneAuth(pp *phone.Provider, cj *session_cookie.Jar, si *session.Issuer) PhoneAuth {
-	return PhoneAuth{pp, cj, si}
+func NewPhoneAuth(pp *phone.Provider, tp *totp.Provider, cj *session_cookie.Jar, si *session.Issuer) PhoneAuth {
+	return PhoneAuth{pp, tp, cj, si}
 }
 
 func (i *PhoneAuth) PhoneRequestCode(ctx context.Context, request openapi.PhoneRequestCodeRequestObject) (openapi.PhoneRequestCodeResponseObject, error) {
@@ -46,6 +48,15 @@ func (i *PhoneAuth) PhoneSubmitCode(ctx context.Context, request openapi.PhoneSu
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	challenge, err := challenge(ctx, i.tp, acc.ID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if ch, ok := challenge.Get(); ok {
+		return openapi.PhoneSubmitCode202JSONResponse{AuthTwoFactorChallengeOKJSONResponse: ch}, nil
+	}
+
 	t, err := i.si.Issue(ctx, acc.ID)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
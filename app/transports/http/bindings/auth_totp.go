@@ -0,0 +1,119 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/services/authentication/provider/totp"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+func (i *Authentication) AuthTOTPEnrol(ctx context.Context, request openapi.AuthTOTPEnrolRequestObject) (openapi.AuthTOTPEnrolResponseObject, error) {
+	var challenge opt.Optional[string]
+	if request.Body != nil {
+		challenge = opt.NewPtr(request.Body.Challenge)
+	}
+
+	var (
+		e   *totp.Enrolment
+		err error
+	)
+	if c, ok := challenge.Get(); ok {
+		e, err = i.totpAuthProvider.EnrolWithChallenge(ctx, c)
+	} else {
+		var accountID account.AccountID
+		accountID, err = session.GetAccountID(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		e, err = i.totpAuthProvider.Enrol(ctx, accountID)
+	}
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AuthTOTPEnrol200JSONResponse{
+		AuthTOTPEnrolOKJSONResponse: openapi.AuthTOTPEnrolOKJSONResponse{
+			Secret: e.Secret,
+			Uri:    e.URI,
+		},
+	}, nil
+}
+
+func (i *Authentication) AuthTOTPConfirm(ctx context.Context, request openapi.AuthTOTPConfirmRequestObject) (openapi.AuthTOTPConfirmResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	codes, err := i.totpAuthProvider.Confirm(ctx, accountID, request.Body.Code)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AuthTOTPConfirm200JSONResponse{
+		AuthTOTPConfirmOKJSONResponse: openapi.AuthTOTPConfirmOKJSONResponse{
+			RecoveryCodes: codes,
+		},
+	}, nil
+}
+
+func (i *Authentication) AuthTOTPVerify(ctx context.Context, request openapi.AuthTOTPVerifyRequestObject) (openapi.AuthTOTPVerifyResponseObject, error) {
+	v, err := i.totpAuthProvider.Verify(ctx, request.Body.Challenge, request.Body.Code)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	t, err := i.si.Issue(ctx, v.AccountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AuthTOTPVerify200JSONResponse{
+		AuthTOTPVerifyOKJSONResponse: openapi.AuthTOTPVerifyOKJSONResponse{
+			Body: openapi.AuthTOTPVerifySuccess{
+				Id:            v.AccountID.String(),
+				RecoveryCodes: v.RecoveryCodes.Ptr(),
+			},
+			Headers: openapi.AuthTOTPVerifyOKResponseHeaders{
+				SetCookie: i.cj.Create(*t).String(),
+			},
+		},
+	}, nil
+}
+
+// challenge must be called after a first factor succeeds and before a session
+// is issued. If it yields a challenge, the sign in must stop there and return
+// the challenge to the client instead of a session cookie. Requests already
+// holding a session for the same account, such as verifying an extra email
+// address while signed in, are never challenged.
+func (i *Authentication) challenge(ctx context.Context, accountID account.AccountID) (opt.Optional[openapi.AuthTwoFactorChallengeOKJSONResponse], error) {
+	return challenge(ctx, i.totpAuthProvider, accountID)
+}
+
+// challenge is shared by every binding which can sign in to an existing account
+// so that no first factor can be used to skip the second.
+func challenge(ctx context.Context, tp *totp.Provider, accountID account.AccountID) (opt.Optional[openapi.AuthTwoFactorChallengeOKJSONResponse], error) {
+	if current, ok := session.GetOptAccountID(ctx).Get(); ok && current == accountID {
+		return opt.NewEmpty[openapi.AuthTwoFactorChallengeOKJSONResponse](), nil
+	}
+
+	ch, err := tp.Challenge(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return opt.Map(ch, func(c totp.Challenge) openapi.AuthTwoFactorChallengeOKJSONResponse {
+		return openapi.AuthTwoFactorChallengeOKJSONResponse{
+			Challenge:         c.Token,
+			ExpiresAt:         c.Expires,
+			EnrolmentRequired: c.EnrolmentRequired,
+		}
+	}), nil
+}
@@ -72,6 +72,7 @@ type Bindings struct {
 	Accounts
 	Invitations
 	Notifications
+	Conversations
 	Reports
 	Profiles
 	Categories
@@ -84,9 +85,14 @@ type Bindings struct {
 	Likes
 	Collections
 	Nodes
+	Revisions
 	Links
 	Datagraph
 	Events
+	PublishSchedules
+	Webhooks
+	Sessions
+	Feeds
 }
 
 // bindingsProviders provides to the application the necessary implementations
@@ -106,6 +112,7 @@ func bindingsProviders() fx.Option {
 		NewAccounts,
 		NewInvitations,
 		NewNotifications,
+		NewConversations,
 		NewReports,
 		NewProfiles,
 		NewCategories,
@@ -118,9 +125,14 @@ func bindingsProviders() fx.Option {
 		NewLikes,
 		NewCollections,
 		NewNodes,
+		NewRevisions,
 		NewLinks,
 		NewDatagraph,
 		NewEvents,
+		NewPublishSchedules,
+		NewWebhooks,
+		NewSessions,
+		NewFeeds,
 	)
 }
 
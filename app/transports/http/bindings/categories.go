@@ -50,6 +50,7 @@ func (c Categories) CategoryCreate(ctx context.Context, request openapi.Category
 		Slug:              opt.NewPtr(request.Body.Slug),
 		Description:       opt.New(request.Body.Description),
 		Colour:            opt.New(request.Body.Colour),
+		QAMode:            opt.NewPtr(request.Body.QaMode),
 		Parent:            parentID,
 		CoverImageAssetID: coverImageAssetID,
 		Meta:              opt.NewPtr((*map[string]any)(request.Body.Meta)),
@@ -161,6 +162,7 @@ func (c Categories) CategoryUpdate(ctx context.Context, request openapi.Category
 		Slug:              opt.NewPtr(request.Body.Slug),
 		Description:       opt.NewPtr(request.Body.Description),
 		Colour:            opt.NewPtr(request.Body.Colour),
+		QAMode:            opt.NewPtr(request.Body.QaMode),
 		CoverImageAssetID: coverImageAssetID,
 		Meta:              opt.NewPtr((*map[string]any)(request.Body.Meta)),
 	})
@@ -203,6 +205,7 @@ func serialiseCategory(c *category.Category) openapi.Category {
 		Description: c.Description,
 		PostCount:   c.PostCount,
 		Sort:        c.Sort,
+		QaMode:      c.QAMode,
 		Parent:      parentID,
 		CoverImage:  opt.Map(c.CoverImage, serialiseAsset).Ptr(),
 		Children:    children,
@@ -226,6 +229,7 @@ func serialiseCategoryReference(c category.Category) openapi.CategoryReference {
 		Colour:      c.Colour,
 		Description: c.Description,
 		Sort:        c.Sort,
+		QaMode:      c.QAMode,
 		Parent:      parentID,
 		CoverImage:  opt.Map(c.CoverImage, serialiseAsset).Ptr(),
 		Children:    children,
@@ -0,0 +1,181 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/conversation"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	conversation_service "github.com/Southclaws/storyden/app/services/conversation"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Conversations struct {
+	conversationManager *conversation_service.Manager
+}
+
+func NewConversations(
+	conversationManager *conversation_service.Manager,
+) Conversations {
+	return Conversations{
+		conversationManager: conversationManager,
+	}
+}
+
+func (h *Conversations) ConversationList(ctx context.Context, request openapi.ConversationListRequestObject) (openapi.ConversationListResponseObject, error) {
+	r, err := h.conversationManager.List(ctx, deserialisePageParams(request.Params.Page, 50))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ConversationList200JSONResponse{
+		ConversationListOKJSONResponse: openapi.ConversationListOKJSONResponse{
+			CurrentPage:   r.CurrentPage,
+			NextPage:      r.NextPage.Ptr(),
+			PageSize:      r.Size,
+			Results:       r.Results,
+			TotalPages:    r.TotalPages,
+			Conversations: dt.Map(r.Items, serialiseConversationSummary),
+		},
+	}, nil
+}
+
+func (h *Conversations) ConversationCreate(ctx context.Context, request openapi.ConversationCreateRequestObject) (openapi.ConversationCreateResponseObject, error) {
+	content, err := datagraph.NewRichText(request.Body.Body)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	c, err := h.conversationManager.Start(ctx, conversation_service.StartParams{
+		Recipients: dt.Map(request.Body.Recipients, func(id openapi.Identifier) account.AccountID {
+			return account.AccountID(deserialiseID(id))
+		}),
+		Title:   opt.NewPtr(request.Body.Title),
+		Content: content,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ConversationCreate200JSONResponse{
+		ConversationCreateOKJSONResponse: openapi.ConversationCreateOKJSONResponse(serialiseConversation(c)),
+	}, nil
+}
+
+func (h *Conversations) ConversationGet(ctx context.Context, request openapi.ConversationGetRequestObject) (openapi.ConversationGetResponseObject, error) {
+	c, err := h.conversationManager.Get(ctx,
+		conversation.ConversationID(deserialiseID(request.ConversationId)),
+		deserialisePageParams(request.Params.Page, 50),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ConversationGet200JSONResponse{
+		ConversationGetOKJSONResponse: openapi.ConversationGetOKJSONResponse(serialiseConversationWithMessages(c)),
+	}, nil
+}
+
+func (h *Conversations) ConversationMessageSend(ctx context.Context, request openapi.ConversationMessageSendRequestObject) (openapi.ConversationMessageSendResponseObject, error) {
+	content, err := datagraph.NewRichText(request.Body.Body)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	msg, err := h.conversationManager.Send(ctx,
+		conversation.ConversationID(deserialiseID(request.ConversationId)),
+		content,
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ConversationMessageSend200JSONResponse{
+		ConversationMessageSendOKJSONResponse: openapi.ConversationMessageSendOKJSONResponse(serialiseConversationMessage(msg)),
+	}, nil
+}
+
+func (h *Conversations) AdminConversationInspect(ctx context.Context, request openapi.AdminConversationInspectRequestObject) (openapi.AdminConversationInspectResponseObject, error) {
+	c, err := h.conversationManager.Inspect(ctx,
+		conversation.MessageID(deserialiseID(request.MessageId)),
+		deserialisePageParams(request.Params.Page, 50),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AdminConversationInspect200JSONResponse{
+		ConversationGetOKJSONResponse: openapi.ConversationGetOKJSONResponse(serialiseConversationWithMessages(c)),
+	}, nil
+}
+
+func serialiseConversation(in *conversation.Conversation) openapi.Conversation {
+	return openapi.Conversation{
+		Id:            in.ID.String(),
+		CreatedAt:     in.CreatedAt,
+		UpdatedAt:     in.UpdatedAt,
+		Title:         in.Title.Ptr(),
+		LastMessageAt: in.LastMessageAt,
+		Participants:  dt.Map(in.Participants, serialiseConversationParticipant),
+	}
+}
+
+func serialiseConversationSummary(in *conversation.Summary) openapi.ConversationSummary {
+	return openapi.ConversationSummary{
+		Id:            in.ID.String(),
+		CreatedAt:     in.CreatedAt,
+		UpdatedAt:     in.UpdatedAt,
+		Title:         in.Title.Ptr(),
+		LastMessageAt: in.LastMessageAt,
+		Participants:  dt.Map(in.Participants, serialiseConversationParticipant),
+		Unread:        in.Unread,
+	}
+}
+
+func serialiseConversationWithMessages(in *conversation.WithMessages) openapi.ConversationWithMessages {
+	return openapi.ConversationWithMessages{
+		Id:            in.ID.String(),
+		CreatedAt:     in.CreatedAt,
+		UpdatedAt:     in.UpdatedAt,
+		Title:         in.Title.Ptr(),
+		LastMessageAt: in.LastMessageAt,
+		Participants:  dt.Map(in.Participants, serialiseConversationParticipant),
+		Messages: openapi.ConversationMessageListResult{
+			CurrentPage: in.Messages.CurrentPage,
+			NextPage:    in.Messages.NextPage.Ptr(),
+			PageSize:    in.Messages.Size,
+			Results:     in.Messages.Results,
+			TotalPages:  in.Messages.TotalPages,
+			Messages:    dt.Map(in.Messages.Items, serialiseConversationMessage),
+		},
+	}
+}
+
+func serialiseConversationParticipant(in *conversation.Participant) openapi.ConversationParticipant {
+	ref := serialiseProfileReference(in.Ref)
+	return openapi.ConversationParticipant{
+		Id:         ref.Id,
+		Joined:     ref.Joined,
+		Suspended:  ref.Suspended,
+		Handle:     ref.Handle,
+		Name:       ref.Name,
+		LastReadAt: in.LastReadAt.Ptr(),
+	}
+}
+
+func serialiseConversationMessage(in *conversation.Message) openapi.ConversationMessage {
+	return openapi.ConversationMessage{
+		Id:             in.ID.String(),
+		CreatedAt:      in.CreatedAt,
+		UpdatedAt:      in.UpdatedAt,
+		ConversationId: in.ConversationID.String(),
+		Author:         serialiseProfileReference(in.Author),
+		Body:           serialiseContentHTML(in.Content),
+	}
+}
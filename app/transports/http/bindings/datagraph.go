@@ -85,12 +85,7 @@ func NewDatagraph(
 				w.Header().Set("Content-Type", "text/event-stream; charset=utf-8")
 
 				// if fails to cast, do nothing, flusher nil, just guard clause.
-				flusher, ok := w.(http.Flusher)
-				if !ok {
-					if innerWriter := unwrapWriter(w); innerWriter != nil {
-						flusher, _ = innerWriter.(http.Flusher)
-					}
-				}
+				flusher := responseFlusher(w)
 
 				for chunk, err := range iter {
 					if err != nil {
@@ -144,6 +139,18 @@ func NewDatagraph(
 	return d
 }
 
+// responseFlusher returns the flusher for streaming responses, which may be the
+// writer itself or one it wraps. Nil if the writer cannot be flushed.
+func responseFlusher(w http.ResponseWriter) http.Flusher {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		if innerWriter := unwrapWriter(w); innerWriter != nil {
+			flusher, _ = innerWriter.(http.Flusher)
+		}
+	}
+	return flusher
+}
+
 func unwrapWriter(w http.ResponseWriter) http.ResponseWriter {
 	switch v := w.(type) {
 	case interface{ Unwrap() http.ResponseWriter }:
@@ -186,6 +193,7 @@ func (d Datagraph) DatagraphSearch(ctx context.Context, request openapi.Datagrap
 		Authors:    authorFilter,
 		Categories: categoryFilter,
 		Tags:       tagFilter,
+		Answered:   opt.NewPtr(request.Params.Answered),
 	}
 
 	r, err := d.searcher.Search(ctx, request.Params.Q, pp, opts)
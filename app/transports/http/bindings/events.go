@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"net/url"
+	"time"
 
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
@@ -17,22 +18,30 @@ import (
 	"github.com/Southclaws/storyden/app/resources/event/location"
 	"github.com/Southclaws/storyden/app/resources/event/participation"
 	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/services/event/event_calendar"
 	"github.com/Southclaws/storyden/app/services/event/event_management"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
 )
 
+// Unpublished events are only downloadable by participants so responses
+// can't be shared, but revalidation is cheap thanks to the ETag.
+const eventICSCacheControl = "private, no-cache"
+
 type Events struct {
-	eventQuerier *event_querier.Querier
-	eventManager *event_management.Manager
+	eventQuerier  *event_querier.Querier
+	eventManager  *event_management.Manager
+	eventCalendar *event_calendar.Builder
 }
 
 func NewEvents(
 	eventQuerier *event_querier.Querier,
 	eventManager *event_management.Manager,
+	eventCalendar *event_calendar.Builder,
 ) Events {
 	return Events{
-		eventQuerier: eventQuerier,
-		eventManager: eventManager,
+		eventQuerier:  eventQuerier,
+		eventManager:  eventManager,
+		eventCalendar: eventCalendar,
 	}
 }
 
@@ -121,6 +130,28 @@ func (h *Events) EventGet(ctx context.Context, request openapi.EventGetRequestOb
 	}, nil
 }
 
+func (h *Events) EventGetICS(ctx context.Context, request openapi.EventGetICSRequestObject) (openapi.EventGetICSResponseObject, error) {
+	cal, err := h.eventCalendar.Event(ctx, event_ref.NewKey(request.EventMark))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	etag, notModified := checkCalendar(ctx, cal)
+	if notModified {
+		return openapi.EventGetICS304Response{
+			Headers: openapi.NotModifiedResponseHeaders{
+				CacheControl: eventICSCacheControl,
+				LastModified: etag.Time.Format(time.RFC1123),
+				ETag:         etag.String(),
+			},
+		}, nil
+	}
+
+	return openapi.EventGetICS200TextcalendarResponse{
+		CalendarOKTextcalendarResponse: calendarResponse(cal, etag, eventICSCacheControl),
+	}, nil
+}
+
 func (h *Events) EventUpdate(ctx context.Context, request openapi.EventUpdateRequestObject) (openapi.EventUpdateResponseObject, error) {
 	// event_manager
 	return nil, nil
@@ -0,0 +1,240 @@
+package bindings
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/cachecontrol"
+	"github.com/Southclaws/storyden/app/services/event/event_calendar"
+	"github.com/Southclaws/storyden/app/services/feed"
+	"github.com/Southclaws/storyden/app/services/reqinfo"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+// Feed readers poll aggressively, a short max-age absorbs most of that while
+// conditional requests cover the rest.
+const feedCacheControl = "public, max-age=300"
+
+// Personal calendars are only for the token holder, shared caches must not
+// keep a copy.
+const privateFeedCacheControl = "private, max-age=300"
+
+type Feeds struct {
+	builder  *feed.Builder
+	calendar *event_calendar.Builder
+}
+
+func NewFeeds(builder *feed.Builder, calendar *event_calendar.Builder) Feeds {
+	return Feeds{builder: builder, calendar: calendar}
+}
+
+func (f Feeds) FeedThreads(ctx context.Context, request openapi.FeedThreadsRequestObject) (openapi.FeedThreadsResponseObject, error) {
+	fd, err := f.builder.Threads(ctx, feed.ThreadFilter{
+		Category: opt.NewPtr(request.Params.Category),
+		Tag:      opt.NewPtr((*string)(request.Params.Tag)),
+		Author:   opt.NewPtr((*string)(request.Params.Author)),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	format := feed.Format(opt.NewPtr(request.Params.Format).OrZero())
+
+	etag, notModified := checkFeed(ctx, fd, format)
+	if notModified {
+		return openapi.FeedThreads304Response{
+			Headers: openapi.NotModifiedResponseHeaders{
+				CacheControl: feedCacheControl,
+				LastModified: etag.Time.Format(time.RFC1123),
+				ETag:         etag.String(),
+			},
+		}, nil
+	}
+
+	b, err := fd.Render(format)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	resp := openapi.FeedOKApplicationrssXmlResponse{
+		Body:          bytes.NewReader(b),
+		ContentLength: int64(len(b)),
+		Headers:       feedHeaders(etag),
+	}
+
+	if format == feed.FormatAtom {
+		return openapi.FeedThreads200ApplicationatomXmlResponse{
+			FeedOKApplicationatomXmlResponse: openapi.FeedOKApplicationatomXmlResponse(resp),
+		}, nil
+	}
+
+	return openapi.FeedThreads200ApplicationrssXmlResponse{
+		FeedOKApplicationrssXmlResponse: resp,
+	}, nil
+}
+
+func (f Feeds) FeedLibrary(ctx context.Context, request openapi.FeedLibraryRequestObject) (openapi.FeedLibraryResponseObject, error) {
+	fd, err := f.builder.Library(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	format := feed.Format(opt.NewPtr(request.Params.Format).OrZero())
+
+	etag, notModified := checkFeed(ctx, fd, format)
+	if notModified {
+		return openapi.FeedLibrary304Response{
+			Headers: openapi.NotModifiedResponseHeaders{
+				CacheControl: feedCacheControl,
+				LastModified: etag.Time.Format(time.RFC1123),
+				ETag:         etag.String(),
+			},
+		}, nil
+	}
+
+	b, err := fd.Render(format)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	resp := openapi.FeedOKApplicationrssXmlResponse{
+		Body:          bytes.NewReader(b),
+		ContentLength: int64(len(b)),
+		Headers:       feedHeaders(etag),
+	}
+
+	if format == feed.FormatAtom {
+		return openapi.FeedLibrary200ApplicationatomXmlResponse{
+			FeedOKApplicationatomXmlResponse: openapi.FeedOKApplicationatomXmlResponse(resp),
+		}, nil
+	}
+
+	return openapi.FeedLibrary200ApplicationrssXmlResponse{
+		FeedOKApplicationrssXmlResponse: resp,
+	}, nil
+}
+
+func (f Feeds) FeedEvents(ctx context.Context, request openapi.FeedEventsRequestObject) (openapi.FeedEventsResponseObject, error) {
+	cal, err := f.calendar.Published(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	etag, notModified := checkCalendar(ctx, cal)
+	if notModified {
+		return openapi.FeedEvents304Response{
+			Headers: openapi.NotModifiedResponseHeaders{
+				CacheControl: feedCacheControl,
+				LastModified: etag.Time.Format(time.RFC1123),
+				ETag:         etag.String(),
+			},
+		}, nil
+	}
+
+	return openapi.FeedEvents200TextcalendarResponse{
+		CalendarOKTextcalendarResponse: calendarResponse(cal, etag, feedCacheControl),
+	}, nil
+}
+
+func (f Feeds) FeedEventsAttending(ctx context.Context, request openapi.FeedEventsAttendingRequestObject) (openapi.FeedEventsAttendingResponseObject, error) {
+	cal, err := f.calendar.Attending(ctx, request.Params.Token)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	etag, notModified := checkCalendar(ctx, cal)
+	if notModified {
+		return openapi.FeedEventsAttending304Response{
+			Headers: openapi.NotModifiedResponseHeaders{
+				CacheControl: privateFeedCacheControl,
+				LastModified: etag.Time.Format(time.RFC1123),
+				ETag:         etag.String(),
+			},
+		}, nil
+	}
+
+	return openapi.FeedEventsAttending200TextcalendarResponse{
+		CalendarOKTextcalendarResponse: calendarResponse(cal, etag, privateFeedCacheControl),
+	}, nil
+}
+
+func (f Feeds) AccountCalendarGet(ctx context.Context, request openapi.AccountCalendarGetRequestObject) (openapi.AccountCalendarGetResponseObject, error) {
+	sub, err := f.calendar.Subscription(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountCalendarGet200JSONResponse{
+		AccountCalendarOKJSONResponse: openapi.AccountCalendarOKJSONResponse(serialiseCalendarSubscription(sub)),
+	}, nil
+}
+
+func (f Feeds) AccountCalendarReset(ctx context.Context, request openapi.AccountCalendarResetRequestObject) (openapi.AccountCalendarResetResponseObject, error) {
+	sub, err := f.calendar.ResetSubscription(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountCalendarReset200JSONResponse{
+		AccountCalendarOKJSONResponse: openapi.AccountCalendarOKJSONResponse(serialiseCalendarSubscription(sub)),
+	}, nil
+}
+
+func serialiseCalendarSubscription(in *event_calendar.Subscription) openapi.AccountCalendar {
+	return openapi.AccountCalendar{
+		Url:   in.URL,
+		Token: in.Token,
+	}
+}
+
+func checkCalendar(ctx context.Context, cal *event_calendar.Calendar) (*cachecontrol.ETag, bool) {
+	return reqinfo.GetCacheQuery(ctx).CheckVersion(cal.Version(), func() *time.Time {
+		if cal.Updated.IsZero() {
+			return nil
+		}
+		return &cal.Updated
+	})
+}
+
+func calendarResponse(cal *event_calendar.Calendar, etag *cachecontrol.ETag, cacheControl string) openapi.CalendarOKTextcalendarResponse {
+	b := cal.Render()
+
+	headers := openapi.CalendarOKResponseHeaders{CacheControl: cacheControl}
+	if etag != nil {
+		headers.LastModified = etag.Time.Format(time.RFC1123)
+		headers.ETag = etag.String()
+	}
+
+	return openapi.CalendarOKTextcalendarResponse{
+		Body:          bytes.NewReader(b),
+		ContentLength: int64(len(b)),
+		Headers:       headers,
+	}
+}
+
+func checkFeed(ctx context.Context, fd *feed.Feed, format feed.Format) (*cachecontrol.ETag, bool) {
+	return reqinfo.GetCacheQuery(ctx).CheckVersion(fd.Version(format), func() *time.Time {
+		if fd.Updated.IsZero() {
+			return nil
+		}
+		return &fd.Updated
+	})
+}
+
+func feedHeaders(etag *cachecontrol.ETag) openapi.FeedOKResponseHeaders {
+	// An empty feed has nothing to validate against.
+	if etag == nil {
+		return openapi.FeedOKResponseHeaders{CacheControl: feedCacheControl}
+	}
+
+	return openapi.FeedOKResponseHeaders{
+		CacheControl: feedCacheControl,
+		LastModified: etag.Time.Format(time.RFC1123),
+		ETag:         etag.String(),
+	}
+}
@@ -101,6 +101,7 @@ func serialiseInfo(info *instance_info.Info) openapi.Info {
 		AccentColour:       info.Settings.AccentColour.OrZero(),
 		OnboardingStatus:   openapi.OnboardingStatus(info.OnboardingStatus.String()),
 		AuthenticationMode: openapi.AuthMode(info.Settings.AuthenticationMode.Or(authentication.ModeHandle).String()),
+		InviteOnly:         info.Settings.InviteOnly.Ptr(),
 		Capabilities:       serialiseCapabilitiesList(info.Capabilities),
 		Metadata:           (*openapi.Metadata)(info.Settings.Metadata.Ptr()),
 	}
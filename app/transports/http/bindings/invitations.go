@@ -14,7 +14,10 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/invitation"
 	"github.com/Southclaws/storyden/app/resources/account/invitation/invitation_querier"
 	"github.com/Southclaws/storyden/app/resources/account/invitation/invitation_writer"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/profile"
 	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/account/account_invite"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
 )
@@ -23,13 +26,15 @@ type Invitations struct {
 	accountQuerier *account_querier.Querier
 	invQuerier     *invitation_querier.Querier
 	invWriter      *invitation_writer.Writer
+	inviteManager  *account_invite.Manager
 }
 
-func NewInvitations(accountQuerier *account_querier.Querier, invQuerier *invitation_querier.Querier, invWriter *invitation_writer.Writer) Invitations {
+func NewInvitations(accountQuerier *account_querier.Querier, invQuerier *invitation_querier.Querier, invWriter *invitation_writer.Writer, inviteManager *account_invite.Manager) Invitations {
 	return Invitations{
 		accountQuerier: accountQuerier,
 		invQuerier:     invQuerier,
 		invWriter:      invWriter,
+		inviteManager:  inviteManager,
 	}
 }
 
@@ -44,7 +49,7 @@ func (h *Invitations) InvitationList(ctx context.Context, request openapi.Invita
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	opts := []invitation_querier.Filter{}
+	opts := []invitation_querier.Filter{invitation_querier.WithInvited()}
 
 	filterByAccountID := opt.Map(opt.NewPtr(request.Params.AccountId), openapi.GetAccountID)
 
@@ -77,17 +82,16 @@ func (h *Invitations) InvitationList(ctx context.Context, request openapi.Invita
 }
 
 func (h *Invitations) InvitationCreate(ctx context.Context, request openapi.InvitationCreateRequestObject) (openapi.InvitationCreateResponseObject, error) {
-	session, err := session.GetAccountID(ctx)
-	if err != nil {
-		return nil, fault.Wrap(err, fctx.With(ctx))
-	}
-
-	acc, err := h.accountQuerier.GetByID(ctx, session)
-	if err != nil {
-		return nil, fault.Wrap(err, fctx.With(ctx))
-	}
-
-	inv, err := h.invWriter.Create(ctx, account.AccountID(acc.ID), opt.NewPtr(request.Body.Message))
+	roles := dt.Map(opt.NewPtr(request.Body.Roles).OrZero(), func(id openapi.Identifier) role.RoleID {
+		return role.RoleID(openapi.ParseID(id))
+	})
+
+	inv, err := h.inviteManager.Create(ctx, account_invite.Params{
+		Message:   opt.NewPtr(request.Body.Message),
+		ExpiresAt: opt.NewPtr(request.Body.ExpiresAt),
+		MaxUses:   opt.NewPtr(request.Body.MaxUses),
+		Roles:     roles,
+	})
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -136,6 +140,22 @@ func (h *Invitations) InvitationDelete(ctx context.Context, request openapi.Invi
 	return openapi.InvitationDelete200Response{}, nil
 }
 
+func (h *Invitations) InvitationRevoke(ctx context.Context, request openapi.InvitationRevokeRequestObject) (openapi.InvitationRevokeResponseObject, error) {
+	invid, err := xid.FromString(request.InvitationId)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	inv, err := h.inviteManager.Revoke(ctx, invid)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.InvitationRevoke200JSONResponse{
+		InvitationRevokeOKJSONResponse: openapi.InvitationRevokeOKJSONResponse(serialiseInvitationPtr(inv)),
+	}, nil
+}
+
 func (h *Invitations) InvitationGet(ctx context.Context, request openapi.InvitationGetRequestObject) (openapi.InvitationGetResponseObject, error) {
 	invid, err := xid.FromString(request.InvitationId)
 	if err != nil {
@@ -160,6 +180,14 @@ func serialiseInvitationPtr(inv *invitation.Invitation) openapi.Invitation {
 		DeletedAt: inv.DeletedAt.Ptr(),
 		Creator:   serialiseProfileReferenceFromAccount(inv.Creator),
 		Message:   inv.Message.Ptr(),
+		ExpiresAt: inv.ExpiresAt.Ptr(),
+		MaxUses:   inv.MaxUses.Ptr(),
+		Uses:      inv.Uses,
+		RevokedAt: inv.RevokedAt.Ptr(),
+		Roles:     dt.Map(inv.Roles, serialiseRolePtr),
+		Invited: opt.Map(inv.Invited, func(refs []*profile.Ref) openapi.InvitationInvitedList {
+			return dt.Map(refs, serialiseProfileReferencePtr)
+		}).Ptr(),
 	}
 }
 
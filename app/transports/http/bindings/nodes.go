@@ -30,6 +30,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/visibility"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/services/generative"
+	"github.com/Southclaws/storyden/app/services/library/node_export"
 	"github.com/Southclaws/storyden/app/services/library/node_mutate"
 	"github.com/Southclaws/storyden/app/services/library/node_property_schema"
 	"github.com/Southclaws/storyden/app/services/library/node_read"
@@ -54,6 +55,7 @@ type Nodes struct {
 	ntr           node_traversal.Repository
 	schemaUpdater *node_property_schema.Updater
 	node_cache    *node_cache.Cache
+	nodeExporter  *node_export.Exporter
 }
 
 func NewNodes(
@@ -69,6 +71,7 @@ func NewNodes(
 	ntr node_traversal.Repository,
 	schemaUpdater *node_property_schema.Updater,
 	node_cache *node_cache.Cache,
+	nodeExporter *node_export.Exporter,
 ) Nodes {
 	return Nodes{
 		accountQuery:  accountQuery,
@@ -83,6 +86,7 @@ func NewNodes(
 		ntr:           ntr,
 		schemaUpdater: schemaUpdater,
 		node_cache:    node_cache,
+		nodeExporter:  nodeExporter,
 	}
 }
 
@@ -317,6 +321,19 @@ func (c *Nodes) NodeListChildren(ctx context.Context, request openapi.NodeListCh
 	}, nil
 }
 
+func (c *Nodes) NodeExport(ctx context.Context, request openapi.NodeExportRequestObject) (openapi.NodeExportResponseObject, error) {
+	r, err := c.nodeExporter.Export(ctx, deserialiseNodeMark(request.NodeSlug))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.NodeExport200ApplicationzipResponse{
+		NodeExportOKApplicationzipResponse: openapi.NodeExportOKApplicationzipResponse{
+			Body: r,
+		},
+	}, nil
+}
+
 func (c *Nodes) NodeUpdate(ctx context.Context, request openapi.NodeUpdateRequestObject) (openapi.NodeUpdateResponseObject, error) {
 	content, err := opt.MapErr(opt.NewPtr(request.Body.Content), datagraph.NewRichText)
 	if err != nil {
@@ -0,0 +1,172 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/labstack/echo/v4"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_querier"
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/realtime"
+	thread_service "github.com/Southclaws/storyden/app/services/thread"
+	"github.com/Southclaws/storyden/app/services/thread_mark"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+const (
+	notificationStreamPath = "/api/notifications/stream"
+
+	// Matches maxItems on the thread query parameter, this is checked by hand
+	// as the stream is served before the request validator runs.
+	notificationStreamMaxThreads = 20
+
+	// Proxies tend to close connections which are quiet for too long.
+	notificationStreamKeepAlive = 30 * time.Second
+)
+
+type notificationStream struct {
+	logger        *slog.Logger
+	notifyReader  *notify_querier.Querier
+	hub           *realtime.Hub
+	threadSvc     thread_service.Service
+	threadMarkSvc thread_mark.Service
+}
+
+func (s notificationStream) middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if c.Path() != notificationStreamPath {
+			return next(c)
+		}
+
+		return s.serve(c)
+	}
+}
+
+func (s notificationStream) serve(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	threads, err := s.threads(ctx, c.QueryParams()["thread"])
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	sub := s.hub.Subscribe(accountID, threads)
+	defer sub.Close()
+
+	w := c.Response().Writer
+	w.Header().Set("Content-Type", "text/event-stream; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	flusher := responseFlusher(w)
+	write := func(msg string) error {
+		if _, err := w.Write([]byte(msg)); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	}
+
+	// Sends the headers immediately so clients know the stream is open.
+	if err := write(": connected\n\n"); err != nil {
+		return nil
+	}
+
+	keepAlive := time.NewTicker(notificationStreamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-keepAlive.C:
+			if err := write(": keep-alive\n\n"); err != nil {
+				return nil
+			}
+
+		case e, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+
+			data, err := s.serialiseEvent(ctx, accountID, e)
+			if err != nil {
+				if ftag.Get(err) != ftag.NotFound {
+					s.logger.Error("failed to serialise stream event",
+						slog.String("error", err.Error()),
+						slog.String("kind", string(e.Kind)))
+				}
+				continue
+			}
+
+			if err := write(fmt.Sprintf("event: %s\ndata: %s\n\n", e.Kind, data)); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// threads resolves the requested thread marks and ensures the member is able
+// to read every one of them before any activity is streamed.
+func (s notificationStream) threads(ctx context.Context, marks []string) ([]post.ID, error) {
+	if len(marks) > notificationStreamMaxThreads {
+		return nil, fault.New("too many threads requested",
+			fctx.With(ctx),
+			ftag.With(ftag.InvalidArgument),
+			fmsg.WithDesc("too many threads", fmt.Sprintf("At most %d threads may be streamed at once.", notificationStreamMaxThreads)))
+	}
+
+	ids := make([]post.ID, 0, len(marks))
+	for _, mark := range marks {
+		id, err := s.threadMarkSvc.Lookup(ctx, mark)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		if _, err := s.threadSvc.Get(ctx, id, pagination.NewPageParams(1, 1)); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (s notificationStream) serialiseEvent(ctx context.Context, accountID account.AccountID, e realtime.Event) ([]byte, error) {
+	switch e.Kind {
+	case realtime.EventNotification:
+		n, err := s.notifyReader.Get(ctx, accountID, e.NotificationID)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		return json.Marshal(serialiseNotification(n))
+
+	default:
+		return json.Marshal(openapi.NotificationStreamThreadActivity{
+			ThreadId: e.ThreadID.String(),
+			PostId:   e.PostID.String(),
+		})
+	}
+}
@@ -1,32 +1,73 @@
 package bindings
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/url"
 
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
 	"github.com/Southclaws/opt"
+	"github.com/labstack/echo/v4"
+
 	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_digest"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_preference"
 	"github.com/Southclaws/storyden/app/resources/account/notification/notify_querier"
 	"github.com/Southclaws/storyden/app/resources/account/notification/notify_writer"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/notification/notify_mail"
+	"github.com/Southclaws/storyden/app/services/realtime"
+	thread_service "github.com/Southclaws/storyden/app/services/thread"
+	"github.com/Southclaws/storyden/app/services/thread_mark"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
 )
 
 type Notifications struct {
-	notifyReader *notify_querier.Querier
-	notifyWriter *notify_writer.Writer
+	notifyReader     *notify_querier.Querier
+	notifyWriter     *notify_writer.Writer
+	notifyPreference *notify_preference.Repository
+	notifyDigest     *notify_digest.Repository
+	notifyMail       *notify_mail.Mailer
+	stream           notificationStream
 }
 
 func NewNotifications(
+	logger *slog.Logger,
+	router *echo.Echo,
 	notifyReader *notify_querier.Querier,
 	notifyWriter *notify_writer.Writer,
+	notifyPreference *notify_preference.Repository,
+	notifyDigest *notify_digest.Repository,
+	notifyMail *notify_mail.Mailer,
+	hub *realtime.Hub,
+	threadSvc thread_service.Service,
+	threadMarkSvc thread_mark.Service,
 ) Notifications {
-	return Notifications{
-		notifyReader: notifyReader,
-		notifyWriter: notifyWriter,
+	n := Notifications{
+		notifyReader:     notifyReader,
+		notifyWriter:     notifyWriter,
+		notifyPreference: notifyPreference,
+		notifyDigest:     notifyDigest,
+		notifyMail:       notifyMail,
+		stream: notificationStream{
+			logger:        logger,
+			notifyReader:  notifyReader,
+			hub:           hub,
+			threadSvc:     threadSvc,
+			threadMarkSvc: threadMarkSvc,
+		},
 	}
+
+	// Like the datagraph ask endpoint, the stream needs the underlying writer.
+	router.Use(n.stream.middleware)
+
+	return n
 }
 
 func (h *Notifications) NotificationList(ctx context.Context, request openapi.NotificationListRequestObject) (openapi.NotificationListResponseObject, error) {
@@ -116,6 +157,203 @@ func (h *Notifications) NotificationUpdateMany(ctx context.Context, request open
 	}, nil
 }
 
+func (h *Notifications) AccountNotificationPreferencesGet(ctx context.Context, request openapi.AccountNotificationPreferencesGetRequestObject) (openapi.AccountNotificationPreferencesGetResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	prefs, err := h.notifyPreference.Get(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	digest, err := h.notifyDigest.Get(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountNotificationPreferencesGet200JSONResponse{
+		AccountNotificationPreferencesOKJSONResponse: openapi.AccountNotificationPreferencesOKJSONResponse(serialiseNotificationPreferences(prefs, digest)),
+	}, nil
+}
+
+func (h *Notifications) AccountNotificationPreferencesUpdate(ctx context.Context, request openapi.AccountNotificationPreferencesUpdateRequestObject) (openapi.AccountNotificationPreferencesUpdateResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	update, err := deserialiseNotificationPreferences(request.Body.Preferences)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	var frequency opt.Optional[notification.DigestFrequency]
+	if f := request.Body.DigestFrequency; f != nil {
+		v, err := notification.NewDigestFrequency(string(*f))
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+		}
+		frequency = opt.New(v)
+	}
+
+	prefs, err := h.notifyPreference.Set(ctx, accountID, update)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	var digest *notify_digest.Digest
+	if f, ok := frequency.Get(); ok {
+		digest, err = h.notifyDigest.SetFrequency(ctx, accountID, f)
+	} else {
+		digest, err = h.notifyDigest.Get(ctx, accountID)
+	}
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountNotificationPreferencesUpdate200JSONResponse{
+		AccountNotificationPreferencesOKJSONResponse: openapi.AccountNotificationPreferencesOKJSONResponse(serialiseNotificationPreferences(prefs, digest)),
+	}, nil
+}
+
+func (h *Notifications) NotificationUnsubscribe(ctx context.Context, request openapi.NotificationUnsubscribeRequestObject) (openapi.NotificationUnsubscribeResponseObject, error) {
+	err := h.notifyMail.Unsubscribe(ctx, request.Params.Token)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	b, err := renderUnsubscribePage(unsubscribePage{
+		Title:           "Unsubscribed",
+		Message:         "You will no longer receive these emails.",
+		PreferencesLink: h.notifyMail.PreferencesLink(),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.NotificationUnsubscribe200TexthtmlResponse{
+		Body:          b,
+		ContentLength: int64(b.Len()),
+	}, nil
+}
+
+type unsubscribePage struct {
+	Title           string
+	Message         string
+	Action          string // Where the confirmation form posts to, if shown.
+	PreferencesLink string
+}
+
+var unsubscribeTemplate = template.Must(template.New("unsubscribe").Parse(`<!doctype html>
+<html>
+  <head>
+    <title>{{ .Title }}</title>
+    <meta charset="utf-8" />
+    <meta
+      name="viewport"
+      content="width=device-width, initial-scale=1" />
+  </head>
+  <body>
+    <h1>{{ .Title }}</h1>
+    <p>{{ .Message }}</p>
+    {{- if .Action }}
+    <form method="post" action="{{ .Action }}">
+      <button type="submit">Unsubscribe</button>
+    </form>
+    {{- end }}
+    <p><a href="{{ .PreferencesLink }}">Manage your notification settings</a></p>
+  </body>
+</html>`))
+
+func renderUnsubscribePage(page unsubscribePage) (*bytes.Buffer, error) {
+	b := bytes.NewBuffer(nil)
+	if err := unsubscribeTemplate.Execute(b, page); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// NotificationUnsubscribeLink only asks the member to confirm, following the
+// link must not unsubscribe as mail scanners and prefetchers follow it too.
+func (h *Notifications) NotificationUnsubscribeLink(ctx context.Context, request openapi.NotificationUnsubscribeLinkRequestObject) (openapi.NotificationUnsubscribeLinkResponseObject, error) {
+	page := unsubscribePage{
+		Title:           "Unsubscribe",
+		Message:         "Stop receiving these emails? You can still see these notifications when you sign in.",
+		Action:          "?" + url.Values{"token": {request.Params.Token}}.Encode(),
+		PreferencesLink: h.notifyMail.PreferencesLink(),
+	}
+
+	err := h.notifyMail.CheckUnsubscribeToken(ctx, request.Params.Token)
+	invalid := errors.Is(err, notify_mail.ErrInvalidToken)
+	if err != nil && !invalid {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if invalid {
+		page.Title = "Invalid link"
+		page.Message = "This unsubscribe link is invalid or has expired, you can still unsubscribe from your notification settings."
+		page.Action = ""
+	}
+
+	b, err := renderUnsubscribePage(page)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if invalid {
+		return openapi.NotificationUnsubscribeLink400TexthtmlResponse{
+			Body:          b,
+			ContentLength: int64(b.Len()),
+		}, nil
+	}
+
+	return openapi.NotificationUnsubscribeLink200TexthtmlResponse{
+		Body:          b,
+		ContentLength: int64(b.Len()),
+	}, nil
+}
+
+func (h *Notifications) NotificationStream(ctx context.Context, request openapi.NotificationStreamRequestObject) (openapi.NotificationStreamResponseObject, error) {
+	// NOTE: Unused stub, see notificationStream's middleware.
+	return nil, nil
+}
+
+func serialiseNotificationPreferences(in notification.Preferences, digest *notify_digest.Digest) openapi.NotificationPreferences {
+	frequency := openapi.NotificationDigestFrequency(digest.Frequency.String())
+
+	return openapi.NotificationPreferences{
+		DigestFrequency: &frequency,
+		Preferences: dt.Map(notification.Events, func(e notification.Event) openapi.NotificationPreference {
+			return openapi.NotificationPreference{
+				Event:    openapi.NotificationEvent(e.String()),
+				Delivery: openapi.NotificationDelivery(in.For(e).String()),
+			}
+		}),
+	}
+}
+
+func deserialiseNotificationPreferences(in openapi.NotificationPreferenceList) (notification.Preferences, error) {
+	out := notification.Preferences{}
+	for _, p := range in {
+		event, err := notification.NewEvent(string(p.Event))
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+
+		delivery, err := notification.NewDelivery(string(p.Delivery))
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+
+		out[event] = delivery
+	}
+
+	return out, nil
+}
+
 func serialiseNotification(in *notification.Notification) openapi.Notification {
 	item := opt.Map(opt.NewSafe(in.Item, in.Item != nil), serialiseDatagraphItem)
 
@@ -62,10 +62,58 @@ func (m *Mapping) AdminAccessKeyDelete() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionAdministrator
 }
 
+func (m *Mapping) AdminAccountSessionsRevoke() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageSuspensions
+}
+
 func (m *Mapping) AdminAccountBanRemove() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionManageSuspensions
 }
 
+func (m *Mapping) WebhookList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) WebhookCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) WebhookGet() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) WebhookUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) WebhookDelete() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) WebhookSecretRotate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) WebhookTest() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) WebhookDeliveryList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminAssetCleanup() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminAuditLogList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
+func (m *Mapping) AdminConversationInspect() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionAdministrator
+}
+
 func (m *Mapping) RoleCreate() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionManageRoles
 }
@@ -178,6 +226,30 @@ func (m *Mapping) AuthProviderLogout() (bool, *rbac.Permission) {
 	return true, nil
 }
 
+func (m *Mapping) AuthTOTPEnrol() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AuthTOTPConfirm() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AuthTOTPVerify() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AuthSessionList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AuthSessionRevokeOthers() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AuthSessionRevoke() (bool, *rbac.Permission) {
+	return true, nil
+}
+
 func (m *Mapping) AccountGet() (bool, *rbac.Permission) {
 	return true, nil
 }
@@ -202,6 +274,42 @@ func (m *Mapping) AccountEmailAdd() (bool, *rbac.Permission) {
 	return true, nil
 }
 
+func (m *Mapping) AccountNotificationPreferencesGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountNotificationPreferencesUpdate() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountBlockList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountCalendarGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountCalendarReset() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountDataExportGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountDataExportRequest() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountDeletionRequest() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) AccountDeletionCancel() (bool, *rbac.Permission) {
+	return true, nil
+}
+
 func (m *Mapping) AccountEmailRemove() (bool, *rbac.Permission) {
 	return true, nil
 }
@@ -246,6 +354,10 @@ func (m *Mapping) InvitationDelete() (bool, *rbac.Permission) {
 	return true, nil
 }
 
+func (m *Mapping) InvitationRevoke() (bool, *rbac.Permission) {
+	return true, nil
+}
+
 func (m *Mapping) NotificationList() (bool, *rbac.Permission) {
 	return true, nil
 }
@@ -254,6 +366,34 @@ func (m *Mapping) NotificationUpdate() (bool, *rbac.Permission) {
 	return true, nil
 }
 
+func (m *Mapping) ConversationList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ConversationCreate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionCreatePost
+}
+
+func (m *Mapping) ConversationGet() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ConversationMessageSend() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionCreatePost
+}
+
+func (m *Mapping) NotificationUnsubscribe() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) NotificationUnsubscribeLink() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) NotificationStream() (bool, *rbac.Permission) {
+	return true, nil
+}
+
 func (m *Mapping) NotificationUpdateMany() (bool, *rbac.Permission) {
 	return true, nil
 }
@@ -294,6 +434,14 @@ func (m *Mapping) ProfileFollowingGet() (bool, *rbac.Permission) {
 	return false, nil
 }
 
+func (m *Mapping) ProfileBlockAdd() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) ProfileBlockRemove() (bool, *rbac.Permission) {
+	return true, nil
+}
+
 func (m *Mapping) CategoryCreate() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionManageCategories
 }
@@ -326,6 +474,18 @@ func (m *Mapping) TagGet() (bool, *rbac.Permission) {
 	return false, nil
 }
 
+func (m *Mapping) TagUpdate() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageTags
+}
+
+func (m *Mapping) TagDelete() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageTags
+}
+
+func (m *Mapping) TagMerge() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageTags
+}
+
 func (m *Mapping) ThreadCreate() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionCreatePost
 }
@@ -334,6 +494,23 @@ func (m *Mapping) ThreadList() (bool, *rbac.Permission) {
 	return false, &rbac.PermissionReadPublishedThreads
 }
 
+func (m *Mapping) FeedThreads() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadPublishedThreads
+}
+
+func (m *Mapping) FeedLibrary() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadPublishedLibrary
+}
+
+func (m *Mapping) FeedEvents() (bool, *rbac.Permission) {
+	return false, nil
+}
+
+func (m *Mapping) FeedEventsAttending() (bool, *rbac.Permission) {
+	// Authorised by the calendar token rather than a session.
+	return false, nil
+}
+
 func (m *Mapping) ThreadGet() (bool, *rbac.Permission) {
 	return false, &rbac.PermissionReadPublishedThreads
 }
@@ -346,6 +523,18 @@ func (m *Mapping) ThreadDelete() (bool, *rbac.Permission) {
 	return true, nil // See NOTE.
 }
 
+func (m *Mapping) ThreadAnswerAccept() (bool, *rbac.Permission) {
+	return true, nil // See NOTE.
+}
+
+func (m *Mapping) ThreadAnswerRemove() (bool, *rbac.Permission) {
+	return true, nil // See NOTE.
+}
+
+func (m *Mapping) ThreadPollVote() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionCreateReaction
+}
+
 func (m *Mapping) ReplyCreate() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionCreatePost
 }
@@ -374,6 +563,22 @@ func (m *Mapping) PostReactRemove() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionCreateReaction
 }
 
+func (m *Mapping) PostRevisionList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManagePosts
+}
+
+func (m *Mapping) PostRevisionGet() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManagePosts
+}
+
+func (m *Mapping) PostRevisionDiff() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManagePosts
+}
+
+func (m *Mapping) PostRevisionRestore() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManagePosts
+}
+
 func (m *Mapping) AssetUpload() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionUploadAsset
 }
@@ -450,6 +655,10 @@ func (m *Mapping) NodeListChildren() (bool, *rbac.Permission) {
 	return false, &rbac.PermissionReadPublishedLibrary
 }
 
+func (m *Mapping) NodeExport() (bool, *rbac.Permission) {
+	return false, &rbac.PermissionReadPublishedLibrary
+}
+
 func (m *Mapping) NodeUpdate() (bool, *rbac.Permission) {
 	return true, nil // See NOTE.
 }
@@ -506,6 +715,22 @@ func (m *Mapping) NodeUpdatePosition() (bool, *rbac.Permission) {
 	return true, nil // See NOTE.
 }
 
+func (m *Mapping) NodeRevisionList() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageLibrary
+}
+
+func (m *Mapping) NodeRevisionGet() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageLibrary
+}
+
+func (m *Mapping) NodeRevisionDiff() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageLibrary
+}
+
+func (m *Mapping) NodeRevisionRestore() (bool, *rbac.Permission) {
+	return true, &rbac.PermissionManageLibrary
+}
+
 func (m *Mapping) LinkCreate() (bool, *rbac.Permission) {
 	return true, nil
 }
@@ -542,6 +767,10 @@ func (m *Mapping) EventGet() (bool, *rbac.Permission) {
 	return false, nil
 }
 
+func (m *Mapping) EventGetICS() (bool, *rbac.Permission) {
+	return false, nil
+}
+
 func (m *Mapping) EventUpdate() (bool, *rbac.Permission) {
 	return true, &rbac.PermissionManageEvents
 }
@@ -559,3 +788,16 @@ func (m *Mapping) EventParticipantRemove() (bool, *rbac.Permission) {
 	// Requires PermissionManageEvents unless deleting self
 	return true, nil
 }
+
+func (m *Mapping) PublishScheduleList() (bool, *rbac.Permission) {
+	return true, nil
+}
+
+func (m *Mapping) PublishScheduleCreate() (bool, *rbac.Permission) {
+	// Requires the permission to publish the scheduled item unless owned
+	return true, nil
+}
+
+func (m *Mapping) PublishScheduleCancel() (bool, *rbac.Permission) {
+	return true, nil
+}
@@ -18,6 +18,18 @@ type OperationPermissions interface {
 	AdminAccountBanRemove() (bool, *rbac.Permission)
 	AdminAccessKeyList() (bool, *rbac.Permission)
 	AdminAccessKeyDelete() (bool, *rbac.Permission)
+	AdminAccountSessionsRevoke() (bool, *rbac.Permission)
+	WebhookList() (bool, *rbac.Permission)
+	WebhookCreate() (bool, *rbac.Permission)
+	WebhookGet() (bool, *rbac.Permission)
+	WebhookUpdate() (bool, *rbac.Permission)
+	WebhookDelete() (bool, *rbac.Permission)
+	WebhookSecretRotate() (bool, *rbac.Permission)
+	WebhookTest() (bool, *rbac.Permission)
+	WebhookDeliveryList() (bool, *rbac.Permission)
+	AdminAssetCleanup() (bool, *rbac.Permission)
+	AdminAuditLogList() (bool, *rbac.Permission)
+	AdminConversationInspect() (bool, *rbac.Permission)
 	RoleCreate() (bool, *rbac.Permission)
 	RoleList() (bool, *rbac.Permission)
 	RoleGet() (bool, *rbac.Permission)
@@ -46,6 +58,12 @@ type OperationPermissions interface {
 	AccessKeyCreate() (bool, *rbac.Permission)
 	AccessKeyDelete() (bool, *rbac.Permission)
 	AuthProviderLogout() (bool, *rbac.Permission)
+	AuthTOTPEnrol() (bool, *rbac.Permission)
+	AuthTOTPConfirm() (bool, *rbac.Permission)
+	AuthTOTPVerify() (bool, *rbac.Permission)
+	AuthSessionList() (bool, *rbac.Permission)
+	AuthSessionRevokeOthers() (bool, *rbac.Permission)
+	AuthSessionRevoke() (bool, *rbac.Permission)
 	AccountGet() (bool, *rbac.Permission)
 	AccountUpdate() (bool, *rbac.Permission)
 	AccountView() (bool, *rbac.Permission)
@@ -54,6 +72,15 @@ type OperationPermissions interface {
 	AccountEmailAdd() (bool, *rbac.Permission)
 	AccountEmailRemove() (bool, *rbac.Permission)
 	AccountSetAvatar() (bool, *rbac.Permission)
+	AccountNotificationPreferencesGet() (bool, *rbac.Permission)
+	AccountNotificationPreferencesUpdate() (bool, *rbac.Permission)
+	AccountBlockList() (bool, *rbac.Permission)
+	AccountCalendarGet() (bool, *rbac.Permission)
+	AccountCalendarReset() (bool, *rbac.Permission)
+	AccountDataExportGet() (bool, *rbac.Permission)
+	AccountDataExportRequest() (bool, *rbac.Permission)
+	AccountDeletionRequest() (bool, *rbac.Permission)
+	AccountDeletionCancel() (bool, *rbac.Permission)
 	AccountGetAvatar() (bool, *rbac.Permission)
 	AccountAddRole() (bool, *rbac.Permission)
 	AccountRemoveRole() (bool, *rbac.Permission)
@@ -63,9 +90,17 @@ type OperationPermissions interface {
 	InvitationCreate() (bool, *rbac.Permission)
 	InvitationGet() (bool, *rbac.Permission)
 	InvitationDelete() (bool, *rbac.Permission)
+	InvitationRevoke() (bool, *rbac.Permission)
 	NotificationList() (bool, *rbac.Permission)
 	NotificationUpdateMany() (bool, *rbac.Permission)
+	NotificationUnsubscribeLink() (bool, *rbac.Permission)
+	NotificationUnsubscribe() (bool, *rbac.Permission)
+	NotificationStream() (bool, *rbac.Permission)
 	NotificationUpdate() (bool, *rbac.Permission)
+	ConversationList() (bool, *rbac.Permission)
+	ConversationCreate() (bool, *rbac.Permission)
+	ConversationGet() (bool, *rbac.Permission)
+	ConversationMessageSend() (bool, *rbac.Permission)
 	ReportCreate() (bool, *rbac.Permission)
 	ReportList() (bool, *rbac.Permission)
 	ReportUpdate() (bool, *rbac.Permission)
@@ -75,6 +110,8 @@ type OperationPermissions interface {
 	ProfileFollowersAdd() (bool, *rbac.Permission)
 	ProfileFollowersRemove() (bool, *rbac.Permission)
 	ProfileFollowingGet() (bool, *rbac.Permission)
+	ProfileBlockAdd() (bool, *rbac.Permission)
+	ProfileBlockRemove() (bool, *rbac.Permission)
 	CategoryCreate() (bool, *rbac.Permission)
 	CategoryList() (bool, *rbac.Permission)
 	CategoryGet() (bool, *rbac.Permission)
@@ -83,16 +120,30 @@ type OperationPermissions interface {
 	CategoryUpdatePosition() (bool, *rbac.Permission)
 	TagList() (bool, *rbac.Permission)
 	TagGet() (bool, *rbac.Permission)
+	TagUpdate() (bool, *rbac.Permission)
+	TagDelete() (bool, *rbac.Permission)
+	TagMerge() (bool, *rbac.Permission)
 	ThreadCreate() (bool, *rbac.Permission)
 	ThreadList() (bool, *rbac.Permission)
+	FeedThreads() (bool, *rbac.Permission)
+	FeedLibrary() (bool, *rbac.Permission)
+	FeedEvents() (bool, *rbac.Permission)
+	FeedEventsAttending() (bool, *rbac.Permission)
 	ThreadGet() (bool, *rbac.Permission)
 	ThreadUpdate() (bool, *rbac.Permission)
 	ThreadDelete() (bool, *rbac.Permission)
+	ThreadAnswerRemove() (bool, *rbac.Permission)
+	ThreadAnswerAccept() (bool, *rbac.Permission)
+	ThreadPollVote() (bool, *rbac.Permission)
 	ReplyCreate() (bool, *rbac.Permission)
 	PostUpdate() (bool, *rbac.Permission)
 	PostDelete() (bool, *rbac.Permission)
 	PostReactAdd() (bool, *rbac.Permission)
 	PostReactRemove() (bool, *rbac.Permission)
+	PostRevisionList() (bool, *rbac.Permission)
+	PostRevisionGet() (bool, *rbac.Permission)
+	PostRevisionDiff() (bool, *rbac.Permission)
+	PostRevisionRestore() (bool, *rbac.Permission)
 	PostLocationGet() (bool, *rbac.Permission)
 	AssetUpload() (bool, *rbac.Permission)
 	AssetGet() (bool, *rbac.Permission)
@@ -114,6 +165,7 @@ type OperationPermissions interface {
 	NodeGet() (bool, *rbac.Permission)
 	NodeUpdate() (bool, *rbac.Permission)
 	NodeDelete() (bool, *rbac.Permission)
+	NodeExport() (bool, *rbac.Permission)
 	NodeGenerateTitle() (bool, *rbac.Permission)
 	NodeGenerateTags() (bool, *rbac.Permission)
 	NodeGenerateContent() (bool, *rbac.Permission)
@@ -127,6 +179,10 @@ type OperationPermissions interface {
 	NodeAddNode() (bool, *rbac.Permission)
 	NodeRemoveNode() (bool, *rbac.Permission)
 	NodeUpdatePosition() (bool, *rbac.Permission)
+	NodeRevisionList() (bool, *rbac.Permission)
+	NodeRevisionGet() (bool, *rbac.Permission)
+	NodeRevisionDiff() (bool, *rbac.Permission)
+	NodeRevisionRestore() (bool, *rbac.Permission)
 	LinkCreate() (bool, *rbac.Permission)
 	LinkList() (bool, *rbac.Permission)
 	LinkGet() (bool, *rbac.Permission)
@@ -138,8 +194,12 @@ type OperationPermissions interface {
 	EventGet() (bool, *rbac.Permission)
 	EventUpdate() (bool, *rbac.Permission)
 	EventDelete() (bool, *rbac.Permission)
+	EventGetICS() (bool, *rbac.Permission)
 	EventParticipantUpdate() (bool, *rbac.Permission)
 	EventParticipantRemove() (bool, *rbac.Permission)
+	PublishScheduleList() (bool, *rbac.Permission)
+	PublishScheduleCreate() (bool, *rbac.Permission)
+	PublishScheduleCancel() (bool, *rbac.Permission)
 }
 
 func GetOperationPermission(optable OperationPermissions, op string) (bool, *rbac.Permission) {
@@ -174,6 +234,30 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.AdminAccessKeyList()
 	case "AdminAccessKeyDelete":
 		return optable.AdminAccessKeyDelete()
+	case "AdminAccountSessionsRevoke":
+		return optable.AdminAccountSessionsRevoke()
+	case "WebhookList":
+		return optable.WebhookList()
+	case "WebhookCreate":
+		return optable.WebhookCreate()
+	case "WebhookGet":
+		return optable.WebhookGet()
+	case "WebhookUpdate":
+		return optable.WebhookUpdate()
+	case "WebhookDelete":
+		return optable.WebhookDelete()
+	case "WebhookSecretRotate":
+		return optable.WebhookSecretRotate()
+	case "WebhookTest":
+		return optable.WebhookTest()
+	case "WebhookDeliveryList":
+		return optable.WebhookDeliveryList()
+	case "AdminAssetCleanup":
+		return optable.AdminAssetCleanup()
+	case "AdminAuditLogList":
+		return optable.AdminAuditLogList()
+	case "AdminConversationInspect":
+		return optable.AdminConversationInspect()
 	case "RoleCreate":
 		return optable.RoleCreate()
 	case "RoleList":
@@ -230,6 +314,18 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.AccessKeyDelete()
 	case "AuthProviderLogout":
 		return optable.AuthProviderLogout()
+	case "AuthTOTPEnrol":
+		return optable.AuthTOTPEnrol()
+	case "AuthTOTPConfirm":
+		return optable.AuthTOTPConfirm()
+	case "AuthTOTPVerify":
+		return optable.AuthTOTPVerify()
+	case "AuthSessionList":
+		return optable.AuthSessionList()
+	case "AuthSessionRevokeOthers":
+		return optable.AuthSessionRevokeOthers()
+	case "AuthSessionRevoke":
+		return optable.AuthSessionRevoke()
 	case "AccountGet":
 		return optable.AccountGet()
 	case "AccountUpdate":
@@ -246,6 +342,24 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.AccountEmailRemove()
 	case "AccountSetAvatar":
 		return optable.AccountSetAvatar()
+	case "AccountNotificationPreferencesGet":
+		return optable.AccountNotificationPreferencesGet()
+	case "AccountNotificationPreferencesUpdate":
+		return optable.AccountNotificationPreferencesUpdate()
+	case "AccountBlockList":
+		return optable.AccountBlockList()
+	case "AccountCalendarGet":
+		return optable.AccountCalendarGet()
+	case "AccountCalendarReset":
+		return optable.AccountCalendarReset()
+	case "AccountDataExportGet":
+		return optable.AccountDataExportGet()
+	case "AccountDataExportRequest":
+		return optable.AccountDataExportRequest()
+	case "AccountDeletionRequest":
+		return optable.AccountDeletionRequest()
+	case "AccountDeletionCancel":
+		return optable.AccountDeletionCancel()
 	case "AccountGetAvatar":
 		return optable.AccountGetAvatar()
 	case "AccountAddRole":
@@ -264,12 +378,28 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.InvitationGet()
 	case "InvitationDelete":
 		return optable.InvitationDelete()
+	case "InvitationRevoke":
+		return optable.InvitationRevoke()
 	case "NotificationList":
 		return optable.NotificationList()
 	case "NotificationUpdateMany":
 		return optable.NotificationUpdateMany()
+	case "NotificationUnsubscribeLink":
+		return optable.NotificationUnsubscribeLink()
+	case "NotificationUnsubscribe":
+		return optable.NotificationUnsubscribe()
+	case "NotificationStream":
+		return optable.NotificationStream()
 	case "NotificationUpdate":
 		return optable.NotificationUpdate()
+	case "ConversationList":
+		return optable.ConversationList()
+	case "ConversationCreate":
+		return optable.ConversationCreate()
+	case "ConversationGet":
+		return optable.ConversationGet()
+	case "ConversationMessageSend":
+		return optable.ConversationMessageSend()
 	case "ReportCreate":
 		return optable.ReportCreate()
 	case "ReportList":
@@ -288,6 +418,10 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.ProfileFollowersRemove()
 	case "ProfileFollowingGet":
 		return optable.ProfileFollowingGet()
+	case "ProfileBlockAdd":
+		return optable.ProfileBlockAdd()
+	case "ProfileBlockRemove":
+		return optable.ProfileBlockRemove()
 	case "CategoryCreate":
 		return optable.CategoryCreate()
 	case "CategoryList":
@@ -304,16 +438,36 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.TagList()
 	case "TagGet":
 		return optable.TagGet()
+	case "TagUpdate":
+		return optable.TagUpdate()
+	case "TagDelete":
+		return optable.TagDelete()
+	case "TagMerge":
+		return optable.TagMerge()
 	case "ThreadCreate":
 		return optable.ThreadCreate()
 	case "ThreadList":
 		return optable.ThreadList()
+	case "FeedThreads":
+		return optable.FeedThreads()
+	case "FeedLibrary":
+		return optable.FeedLibrary()
+	case "FeedEvents":
+		return optable.FeedEvents()
+	case "FeedEventsAttending":
+		return optable.FeedEventsAttending()
 	case "ThreadGet":
 		return optable.ThreadGet()
 	case "ThreadUpdate":
 		return optable.ThreadUpdate()
 	case "ThreadDelete":
 		return optable.ThreadDelete()
+	case "ThreadAnswerRemove":
+		return optable.ThreadAnswerRemove()
+	case "ThreadAnswerAccept":
+		return optable.ThreadAnswerAccept()
+	case "ThreadPollVote":
+		return optable.ThreadPollVote()
 	case "ReplyCreate":
 		return optable.ReplyCreate()
 	case "PostUpdate":
@@ -324,6 +478,14 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.PostReactAdd()
 	case "PostReactRemove":
 		return optable.PostReactRemove()
+	case "PostRevisionList":
+		return optable.PostRevisionList()
+	case "PostRevisionGet":
+		return optable.PostRevisionGet()
+	case "PostRevisionDiff":
+		return optable.PostRevisionDiff()
+	case "PostRevisionRestore":
+		return optable.PostRevisionRestore()
 	case "PostLocationGet":
 		return optable.PostLocationGet()
 	case "AssetUpload":
@@ -366,6 +528,8 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.NodeUpdate()
 	case "NodeDelete":
 		return optable.NodeDelete()
+	case "NodeExport":
+		return optable.NodeExport()
 	case "NodeGenerateTitle":
 		return optable.NodeGenerateTitle()
 	case "NodeGenerateTags":
@@ -392,6 +556,14 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.NodeRemoveNode()
 	case "NodeUpdatePosition":
 		return optable.NodeUpdatePosition()
+	case "NodeRevisionList":
+		return optable.NodeRevisionList()
+	case "NodeRevisionGet":
+		return optable.NodeRevisionGet()
+	case "NodeRevisionDiff":
+		return optable.NodeRevisionDiff()
+	case "NodeRevisionRestore":
+		return optable.NodeRevisionRestore()
 	case "LinkCreate":
 		return optable.LinkCreate()
 	case "LinkList":
@@ -414,10 +586,18 @@ func GetOperationPermission(optable OperationPermissions, op string) (bool, *rba
 		return optable.EventUpdate()
 	case "EventDelete":
 		return optable.EventDelete()
+	case "EventGetICS":
+		return optable.EventGetICS()
 	case "EventParticipantUpdate":
 		return optable.EventParticipantUpdate()
 	case "EventParticipantRemove":
 		return optable.EventParticipantRemove()
+	case "PublishScheduleList":
+		return optable.PublishScheduleList()
+	case "PublishScheduleCreate":
+		return optable.PublishScheduleCreate()
+	case "PublishScheduleCancel":
+		return optable.PublishScheduleCancel()
 	default:
 		panic("unknown operation, must re-run rbacgen")
 	}
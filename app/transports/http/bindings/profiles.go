@@ -20,6 +20,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/profile/profile_querier"
 	"github.com/Southclaws/storyden/app/resources/profile/profile_search"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/profile/blocking"
 	"github.com/Southclaws/storyden/app/services/profile/following"
 	"github.com/Southclaws/storyden/app/services/reqinfo"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
@@ -33,6 +34,7 @@ type Profiles struct {
 	ps            profile_search.Repository
 	followQuerier *follow_querier.Querier
 	followManager *following.FollowManager
+	blockManager  *blocking.BlockManager
 }
 
 func NewProfiles(
@@ -42,6 +44,7 @@ func NewProfiles(
 	ps profile_search.Repository,
 	followQuerier *follow_querier.Querier,
 	followManager *following.FollowManager,
+	blockManager *blocking.BlockManager,
 ) Profiles {
 	return Profiles{
 		apiAddress:    cfg.PublicWebAddress,
@@ -50,6 +53,7 @@ func NewProfiles(
 		ps:            ps,
 		followQuerier: followQuerier,
 		followManager: followManager,
+		blockManager:  blockManager,
 	}
 }
 
@@ -277,6 +281,62 @@ func serialiseProfile(in *profile.Public) openapi.PublicProfile {
 	}
 }
 
+func (p *Profiles) ProfileBlockAdd(ctx context.Context, request openapi.ProfileBlockAddRequestObject) (openapi.ProfileBlockAddResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	targetID, err := openapi.ResolveHandle(ctx, p.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = p.blockManager.Block(ctx, accountID, targetID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ProfileBlockAdd200Response{}, nil
+}
+
+func (p *Profiles) ProfileBlockRemove(ctx context.Context, request openapi.ProfileBlockRemoveRequestObject) (openapi.ProfileBlockRemoveResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	targetID, err := openapi.ResolveHandle(ctx, p.profileQuery, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = p.blockManager.Unblock(ctx, accountID, targetID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ProfileBlockRemove200Response{}, nil
+}
+
+func (p *Profiles) AccountBlockList(ctx context.Context, request openapi.AccountBlockListRequestObject) (openapi.AccountBlockListResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	profiles, err := p.blockManager.List(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.AccountBlockList200JSONResponse{
+		AccountBlockListOKJSONResponse: openapi.AccountBlockListOKJSONResponse{
+			Profiles: dt.Map(profiles, serialiseProfileReferencePtr),
+		},
+	}, nil
+}
+
 func serialiseProfileReference(a profile.Ref) openapi.ProfileReference {
 	return openapi.ProfileReference{
 		Id:        *openapi.IdentifierFrom(xid.ID(a.ID)),
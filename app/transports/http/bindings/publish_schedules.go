@@ -0,0 +1,81 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/schedule"
+	"github.com/Southclaws/storyden/app/services/publish_schedule"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type PublishSchedules struct {
+	scheduleManager *publish_schedule.Manager
+}
+
+func NewPublishSchedules(scheduleManager *publish_schedule.Manager) PublishSchedules {
+	return PublishSchedules{scheduleManager: scheduleManager}
+}
+
+func (h *PublishSchedules) PublishScheduleList(ctx context.Context, request openapi.PublishScheduleListRequestObject) (openapi.PublishScheduleListResponseObject, error) {
+	list, err := h.scheduleManager.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PublishScheduleList200JSONResponse{
+		PublishScheduleListOKJSONResponse: openapi.PublishScheduleListOKJSONResponse{
+			Schedules: dt.Map(list, serialisePublishSchedule),
+		},
+	}, nil
+}
+
+func (h *PublishSchedules) PublishScheduleCreate(ctx context.Context, request openapi.PublishScheduleCreateRequestObject) (openapi.PublishScheduleCreateResponseObject, error) {
+	kind, err := datagraph.NewKind(string(request.Body.TargetKind))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	target := datagraph.Ref{
+		ID:   openapi.ParseID(request.Body.TargetId),
+		Kind: kind,
+	}
+
+	s, err := h.scheduleManager.Schedule(ctx, target, request.Body.PublishAt)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PublishScheduleCreate200JSONResponse{
+		PublishScheduleCreateOKJSONResponse: openapi.PublishScheduleCreateOKJSONResponse(serialisePublishSchedule(s)),
+	}, nil
+}
+
+func (h *PublishSchedules) PublishScheduleCancel(ctx context.Context, request openapi.PublishScheduleCancelRequestObject) (openapi.PublishScheduleCancelResponseObject, error) {
+	err := h.scheduleManager.Cancel(ctx, schedule.ScheduleID(openapi.ParseID(request.ScheduleId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PublishScheduleCancel200Response{}, nil
+}
+
+func serialisePublishSchedule(in *schedule.Schedule) openapi.PublishSchedule {
+	return openapi.PublishSchedule{
+		Id:         in.ID.String(),
+		CreatedAt:  in.CreatedAt,
+		UpdatedAt:  in.UpdatedAt,
+		TargetId:   in.Target.ID.String(),
+		TargetKind: openapi.DatagraphItemKind(in.Target.Kind.String()),
+		PublishAt:  in.PublishAt,
+		Account:    serialiseProfileReference(in.Account),
+		Attempts:   in.Attempts,
+		LastError:  in.LastError.Ptr(),
+		FailedAt:   in.FailedAt.Ptr(),
+	}
+}
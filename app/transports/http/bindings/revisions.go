@@ -0,0 +1,207 @@
+package bindings
+
+import (
+	"context"
+	"time"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/library/node_revision"
+	"github.com/Southclaws/storyden/app/resources/post/post_revision"
+	"github.com/Southclaws/storyden/app/services/revision/node_history"
+	"github.com/Southclaws/storyden/app/services/revision/post_history"
+	"github.com/Southclaws/storyden/app/services/revision/revision_diff"
+	"github.com/Southclaws/storyden/app/services/thread_mark"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Revisions struct {
+	thread_mark_svc thread_mark.Service
+	postHistory     *post_history.Manager
+	nodeHistory     *node_history.Manager
+}
+
+func NewRevisions(
+	thread_mark_svc thread_mark.Service,
+	postHistory *post_history.Manager,
+	nodeHistory *node_history.Manager,
+) Revisions {
+	return Revisions{
+		thread_mark_svc: thread_mark_svc,
+		postHistory:     postHistory,
+		nodeHistory:     nodeHistory,
+	}
+}
+
+func (h *Revisions) PostRevisionList(ctx context.Context, request openapi.PostRevisionListRequestObject) (openapi.PostRevisionListResponseObject, error) {
+	postID, err := h.thread_mark_svc.Lookup(ctx, string(request.PostId))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, err := h.postHistory.List(ctx, postID, deserialisePageParams(request.Params.Page, 50))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PostRevisionList200JSONResponse{
+		PostRevisionListOKJSONResponse: openapi.PostRevisionListOKJSONResponse{
+			CurrentPage: r.CurrentPage,
+			NextPage:    r.NextPage.Ptr(),
+			PageSize:    r.Size,
+			Results:     r.Results,
+			Revisions:   dt.Map(r.Items, serialisePostRevision),
+			TotalPages:  r.TotalPages,
+		},
+	}, nil
+}
+
+func (h *Revisions) PostRevisionGet(ctx context.Context, request openapi.PostRevisionGetRequestObject) (openapi.PostRevisionGetResponseObject, error) {
+	postID, err := h.thread_mark_svc.Lookup(ctx, string(request.PostId))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r, err := h.postHistory.Get(ctx, postID, post_revision.ID(deserialiseID(request.RevisionId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PostRevisionGet200JSONResponse{
+		PostRevisionGetOKJSONResponse: openapi.PostRevisionGetOKJSONResponse(serialisePostRevision(r)),
+	}, nil
+}
+
+func (h *Revisions) PostRevisionDiff(ctx context.Context, request openapi.PostRevisionDiffRequestObject) (openapi.PostRevisionDiffResponseObject, error) {
+	postID, err := h.thread_mark_svc.Lookup(ctx, string(request.PostId))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	against := opt.NewPtrMap(request.Params.Against, func(id openapi.Identifier) post_revision.ID {
+		return post_revision.ID(deserialiseID(id))
+	})
+
+	d, err := h.postHistory.Diff(ctx, postID, post_revision.ID(deserialiseID(request.RevisionId)), against)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PostRevisionDiff200JSONResponse{
+		RevisionDiffOKJSONResponse: openapi.RevisionDiffOKJSONResponse(serialiseRevisionDiff(d)),
+	}, nil
+}
+
+func (h *Revisions) PostRevisionRestore(ctx context.Context, request openapi.PostRevisionRestoreRequestObject) (openapi.PostRevisionRestoreResponseObject, error) {
+	postID, err := h.thread_mark_svc.Lookup(ctx, string(request.PostId))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	p, err := h.postHistory.Restore(ctx, postID, post_revision.ID(deserialiseID(request.RevisionId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.PostRevisionRestore200JSONResponse{
+		PostUpdateOKJSONResponse: openapi.PostUpdateOKJSONResponse(serialisePost(p)),
+	}, nil
+}
+
+func (h *Revisions) NodeRevisionList(ctx context.Context, request openapi.NodeRevisionListRequestObject) (openapi.NodeRevisionListResponseObject, error) {
+	r, err := h.nodeHistory.List(ctx, deserialiseNodeMark(request.NodeSlug), deserialisePageParams(request.Params.Page, 50))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.NodeRevisionList200JSONResponse{
+		NodeRevisionListOKJSONResponse: openapi.NodeRevisionListOKJSONResponse{
+			CurrentPage: r.CurrentPage,
+			NextPage:    r.NextPage.Ptr(),
+			PageSize:    r.Size,
+			Results:     r.Results,
+			Revisions:   dt.Map(r.Items, serialiseNodeRevision),
+			TotalPages:  r.TotalPages,
+		},
+	}, nil
+}
+
+func (h *Revisions) NodeRevisionGet(ctx context.Context, request openapi.NodeRevisionGetRequestObject) (openapi.NodeRevisionGetResponseObject, error) {
+	r, err := h.nodeHistory.Get(ctx, deserialiseNodeMark(request.NodeSlug), node_revision.ID(deserialiseID(request.RevisionId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.NodeRevisionGet200JSONResponse{
+		NodeRevisionGetOKJSONResponse: openapi.NodeRevisionGetOKJSONResponse(serialiseNodeRevision(r)),
+	}, nil
+}
+
+func (h *Revisions) NodeRevisionDiff(ctx context.Context, request openapi.NodeRevisionDiffRequestObject) (openapi.NodeRevisionDiffResponseObject, error) {
+	against := opt.NewPtrMap(request.Params.Against, func(id openapi.Identifier) node_revision.ID {
+		return node_revision.ID(deserialiseID(id))
+	})
+
+	d, err := h.nodeHistory.Diff(ctx, deserialiseNodeMark(request.NodeSlug), node_revision.ID(deserialiseID(request.RevisionId)), against)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.NodeRevisionDiff200JSONResponse{
+		RevisionDiffOKJSONResponse: openapi.RevisionDiffOKJSONResponse(serialiseRevisionDiff(d)),
+	}, nil
+}
+
+func (h *Revisions) NodeRevisionRestore(ctx context.Context, request openapi.NodeRevisionRestoreRequestObject) (openapi.NodeRevisionRestoreResponseObject, error) {
+	node, err := h.nodeHistory.Restore(ctx, deserialiseNodeMark(request.NodeSlug), node_revision.ID(deserialiseID(request.RevisionId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.NodeRevisionRestore200JSONResponse{
+		NodeUpdateOKJSONResponse: openapi.NodeUpdateOKJSONResponse{
+			Body: serialiseUpdatedNode(node),
+			Headers: openapi.NodeUpdateOKResponseHeaders{
+				LastModified: node.UpdatedAt.UTC().Format(time.RFC1123),
+				CacheControl: "private, no-cache, no-store, must-revalidate",
+			},
+		},
+	}, nil
+}
+
+func serialisePostRevision(in *post_revision.Revision) openapi.PostRevision {
+	return openapi.PostRevision{
+		Id:        openapi.Identifier(in.ID.String()),
+		CreatedAt: in.CreatedAt,
+		PostId:    openapi.Identifier(xid.ID(in.PostID).String()),
+		Author:    opt.Map(in.Author, serialiseProfileReference).Ptr(),
+		Title:     in.Title,
+		Body:      in.Content.HTML(),
+	}
+}
+
+func serialiseNodeRevision(in *node_revision.Revision) openapi.NodeRevision {
+	return openapi.NodeRevision{
+		Id:        openapi.Identifier(in.ID.String()),
+		CreatedAt: in.CreatedAt,
+		NodeId:    openapi.Identifier(xid.ID(in.NodeID).String()),
+		Author:    opt.Map(in.Author, serialiseProfileReference).Ptr(),
+		Name:      in.Name,
+		Content:   opt.Map(in.Content, serialiseContentHTML).Ptr(),
+	}
+}
+
+func serialiseRevisionDiff(in *revision_diff.Diff) openapi.RevisionDiff {
+	return openapi.RevisionDiff{
+		From:         in.From.Label,
+		To:           in.To.Label,
+		FromTitle:    in.From.Title,
+		ToTitle:      in.To.Title,
+		TitleChanged: in.TitleChanged,
+		Changes:      in.Changes,
+	}
+}
@@ -0,0 +1,169 @@
+package bindings
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/token"
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/reqinfo"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Sessions struct {
+	tokenRepo    token.Repository
+	accountQuery *account_querier.Querier
+	auditWriter  *audit_writer.Writer
+}
+
+func NewSessions(
+	tokenRepo token.Repository,
+	accountQuery *account_querier.Querier,
+	auditWriter *audit_writer.Writer,
+) Sessions {
+	return Sessions{
+		tokenRepo:    tokenRepo,
+		accountQuery: accountQuery,
+		auditWriter:  auditWriter,
+	}
+}
+
+func (h *Sessions) AuthSessionList(ctx context.Context, request openapi.AuthSessionListRequestObject) (openapi.AuthSessionListResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	sessions, err := h.tokenRepo.List(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	current := currentSessionToken(ctx)
+
+	return openapi.AuthSessionList200JSONResponse{
+		AuthSessionListOKJSONResponse: openapi.AuthSessionListOKJSONResponse{
+			Sessions: dt.Map(sessions, func(s *token.Session) openapi.AuthSession {
+				return serialiseAuthSession(s, current)
+			}),
+		},
+	}, nil
+}
+
+func (h *Sessions) AuthSessionRevoke(ctx context.Context, request openapi.AuthSessionRevokeRequestObject) (openapi.AuthSessionRevokeResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Sessions are only addressable by their public ID, which is derived from
+	// the token, so the account's sessions are searched for a match.
+	sessions, err := h.tokenRepo.List(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	target, found := lo.Find(sessions, func(s *token.Session) bool {
+		return s.ID() == token.ID(request.SessionId)
+	})
+	if !found {
+		return nil, fault.New("session not found", fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	if err := h.tokenRepo.Revoke(ctx, target.Token); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.NoContentResponse{}, nil
+}
+
+func (h *Sessions) AuthSessionRevokeOthers(ctx context.Context, request openapi.AuthSessionRevokeOthersRequestObject) (openapi.AuthSessionRevokeOthersResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Requests authenticated by an access key have no session to keep.
+	except := []token.Token{}
+	if t, ok := currentSessionToken(ctx).Get(); ok {
+		except = append(except, t)
+	}
+
+	_, err = h.tokenRepo.RevokeAll(ctx, accountID, except...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.NoContentResponse{}, nil
+}
+
+func (h *Sessions) AdminAccountSessionsRevoke(ctx context.Context, request openapi.AdminAccountSessionsRevokeRequestObject) (openapi.AdminAccountSessionsRevokeResponseObject, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, found, err := h.accountQuery.LookupByHandle(ctx, request.AccountHandle)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if !found {
+		return nil, fault.New("account not found", fctx.With(ctx), ftag.With(ftag.NotFound))
+	}
+
+	n, err := h.tokenRepo.RevokeAll(ctx, acc.ID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	err = h.auditWriter.Record(ctx, opt.New(accountID), audit.ActionSessionsRevoked,
+		audit_writer.WithTarget(datagraph.Ref{ID: xid.ID(acc.ID), Kind: datagraph.KindProfile}),
+		audit_writer.WithBefore("sessions: "+strconv.Itoa(n)),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.NoContentResponse{}, nil
+}
+
+func currentSessionToken(ctx context.Context) opt.Optional[token.Token] {
+	raw, ok := session.GetSessionToken(ctx).Get()
+	if !ok {
+		return opt.NewEmpty[token.Token]()
+	}
+
+	t, err := token.FromString(raw)
+	if err != nil {
+		return opt.NewEmpty[token.Token]()
+	}
+
+	return opt.New(t)
+}
+
+func serialiseAuthSession(in *token.Session, current opt.Optional[token.Token]) openapi.AuthSession {
+	ct, isCurrent := current.Get()
+
+	return openapi.AuthSession{
+		Id:         openapi.AuthSessionID(in.ID()),
+		CreatedAt:  in.CreatedAt,
+		ExpiresAt:  in.ExpiresAt,
+		LastSeenAt: in.LastSeenAt.Ptr(),
+		UserAgent:  in.Device.UserAgent.Ptr(),
+		DeviceName: opt.Map(in.Device.UserAgent, reqinfo.DeviceName).Ptr(),
+		IpAddress:  in.Device.IPAddress.Ptr(),
+		Current:    isCurrent && ct == in.Token,
+	}
+}
@@ -6,20 +6,23 @@ import (
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/tag"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_querier"
 	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/app/services/tag/tag_manager"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
 )
 
 type Tags struct {
 	tagQuerier *tag_querier.Querier
+	tagManager *tag_manager.Manager
 }
 
-func NewTags(tagQuerier *tag_querier.Querier) Tags {
-	return Tags{tagQuerier: tagQuerier}
+func NewTags(tagQuerier *tag_querier.Querier, tagManager *tag_manager.Manager) Tags {
+	return Tags{tagQuerier: tagQuerier, tagManager: tagManager}
 }
 
 func (h Tags) TagList(ctx context.Context, request openapi.TagListRequestObject) (openapi.TagListResponseObject, error) {
@@ -56,21 +59,60 @@ func (h Tags) TagGet(ctx context.Context, request openapi.TagGetRequestObject) (
 	}, nil
 }
 
+func (h Tags) TagUpdate(ctx context.Context, request openapi.TagUpdateRequestObject) (openapi.TagUpdateResponseObject, error) {
+	tag, err := h.tagManager.Update(ctx, deserialiseTagName(request.TagName), tag_manager.Partial{
+		Name:        opt.NewPtr(request.Body.Name),
+		Description: opt.NewPtr(request.Body.Description),
+		Colour:      opt.NewPtr(request.Body.Colour),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.TagUpdate200JSONResponse{
+		TagUpdateOKJSONResponse: openapi.TagUpdateOKJSONResponse(serialiseTagReference(tag)),
+	}, nil
+}
+
+func (h Tags) TagDelete(ctx context.Context, request openapi.TagDeleteRequestObject) (openapi.TagDeleteResponseObject, error) {
+	err := h.tagManager.Delete(ctx, deserialiseTagName(request.TagName))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.TagDelete200Response{}, nil
+}
+
+func (h Tags) TagMerge(ctx context.Context, request openapi.TagMergeRequestObject) (openapi.TagMergeResponseObject, error) {
+	from := dt.Map(request.Body.Tags, deserialiseTagName)
+
+	tag, err := h.tagManager.Merge(ctx, deserialiseTagName(request.TagName), from)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.TagMerge200JSONResponse{
+		TagUpdateOKJSONResponse: openapi.TagUpdateOKJSONResponse(serialiseTagReference(tag)),
+	}, nil
+}
+
 func serialiseTag(in *tag.Tag) openapi.Tag {
 	return openapi.Tag{
-		Id:        in.ID.String(),
-		Name:      in.Name.String(),
-		Colour:    in.Colour,
-		ItemCount: in.ItemCount,
-		Items:     serialiseDatagraphItemList(in.Items),
+		Id:          in.ID.String(),
+		Name:        in.Name.String(),
+		Colour:      in.Colour,
+		ItemCount:   in.ItemCount,
+		Description: in.Description.Ptr(),
+		Items:       serialiseDatagraphItemList(in.Items),
 	}
 }
 
 func serialiseTagReference(in *tag_ref.Tag) openapi.TagReference {
 	return openapi.TagReference{
-		Name:      in.Name.String(),
-		Colour:    in.Colour,
-		ItemCount: in.ItemCount,
+		Name:        in.Name.String(),
+		Colour:      in.Colour,
+		ItemCount:   in.ItemCount,
+		Description: in.Description.Ptr(),
 	}
 }
 
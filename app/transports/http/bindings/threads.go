@@ -17,6 +17,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
 	"github.com/Southclaws/storyden/app/resources/cachecontrol"
 	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/post/poll"
 	"github.com/Southclaws/storyden/app/resources/post/reply"
 	"github.com/Southclaws/storyden/app/resources/post/thread_cache"
 	"github.com/Southclaws/storyden/app/resources/post/thread_querier"
@@ -26,6 +27,8 @@ import (
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/services/reqinfo"
 	thread_service "github.com/Southclaws/storyden/app/services/thread"
+	"github.com/Southclaws/storyden/app/services/thread/thread_answer"
+	"github.com/Southclaws/storyden/app/services/thread/thread_poll"
 	"github.com/Southclaws/storyden/app/services/thread_mark"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
 )
@@ -34,6 +37,8 @@ type Threads struct {
 	thread_cache    *thread_cache.Cache
 	thread_svc      thread_service.Service
 	thread_mark_svc thread_mark.Service
+	answerManager   *thread_answer.Manager
+	pollManager     *thread_poll.Manager
 	accountQuery    *account_querier.Querier
 	profileQuery    *profile_querier.Querier
 }
@@ -42,10 +47,12 @@ func NewThreads(
 	thread_cache *thread_cache.Cache,
 	thread_svc thread_service.Service,
 	thread_mark_svc thread_mark.Service,
+	answerManager *thread_answer.Manager,
+	pollManager *thread_poll.Manager,
 	accountQuery *account_querier.Querier,
 	profileQuery *profile_querier.Querier,
 ) Threads {
-	return Threads{thread_cache, thread_svc, thread_mark_svc, accountQuery, profileQuery}
+	return Threads{thread_cache, thread_svc, thread_mark_svc, answerManager, pollManager, accountQuery, profileQuery}
 }
 
 func (i *Threads) ThreadCreate(ctx context.Context, request openapi.ThreadCreateRequestObject) (openapi.ThreadCreateResponseObject, error) {
@@ -98,6 +105,7 @@ func (i *Threads) ThreadCreate(ctx context.Context, request openapi.ThreadCreate
 			Tags:       tags,
 			Visibility: status,
 			URL:        url,
+			Poll:       opt.NewPtrMap(request.Body.Poll, deserialisePollInitialProps),
 		},
 	)
 	if err != nil {
@@ -135,6 +143,9 @@ func (i *Threads) ThreadUpdate(ctx context.Context, request openapi.ThreadUpdate
 		Tags:       tags,
 		Category:   opt.NewPtrMap(request.Body.Category, deserialiseID),
 		Visibility: Visibility,
+		Locked:     opt.NewPtr(request.Body.Locked),
+		LockReason: opt.NewPtr(request.Body.LockReason),
+		Poll:       opt.NewPtrMap(request.Body.Poll, deserialisePollMutableProps),
 	})
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
@@ -159,6 +170,76 @@ func (i *Threads) ThreadDelete(ctx context.Context, request openapi.ThreadDelete
 	return openapi.ThreadDelete200Response{}, nil
 }
 
+func (i *Threads) ThreadAnswerAccept(ctx context.Context, request openapi.ThreadAnswerAcceptRequestObject) (openapi.ThreadAnswerAcceptResponseObject, error) {
+	threadID, err := i.thread_mark_svc.Lookup(ctx, string(request.ThreadMark))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	thread, err := i.answerManager.Accept(ctx, threadID, deserialisePostID(request.PostId))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ThreadAnswerAccept200JSONResponse{
+		ThreadAnswerOKJSONResponse: openapi.ThreadAnswerOKJSONResponse(serialiseThread(thread)),
+	}, nil
+}
+
+func (i *Threads) ThreadAnswerRemove(ctx context.Context, request openapi.ThreadAnswerRemoveRequestObject) (openapi.ThreadAnswerRemoveResponseObject, error) {
+	threadID, err := i.thread_mark_svc.Lookup(ctx, string(request.ThreadMark))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	thread, err := i.answerManager.Remove(ctx, threadID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ThreadAnswerRemove200JSONResponse{
+		ThreadAnswerOKJSONResponse: openapi.ThreadAnswerOKJSONResponse(serialiseThread(thread)),
+	}, nil
+}
+
+func (i *Threads) ThreadPollVote(ctx context.Context, request openapi.ThreadPollVoteRequestObject) (openapi.ThreadPollVoteResponseObject, error) {
+	threadID, err := i.thread_mark_svc.Lookup(ctx, string(request.ThreadMark))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	options := dt.Map(request.Body.Options, func(id openapi.Identifier) poll.OptionID {
+		return poll.OptionID(openapi.ParseID(id))
+	})
+
+	p, err := i.pollManager.Vote(ctx, threadID, options)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.ThreadPollVote200JSONResponse{
+		ThreadPollVoteOKJSONResponse: openapi.ThreadPollVoteOKJSONResponse(serialisePoll(*p)),
+	}, nil
+}
+
+func deserialisePollInitialProps(in openapi.PollInitialProps) thread_poll.Params {
+	return thread_poll.Params{
+		Options:    opt.New(in.Options),
+		MaxChoices: opt.NewPtr(in.MaxChoices),
+		Anonymous:  opt.NewPtr(in.Anonymous),
+		ClosesAt:   opt.NewPtr(in.ClosesAt),
+	}
+}
+
+func deserialisePollMutableProps(in openapi.PollMutableProps) thread_poll.Params {
+	return thread_poll.Params{
+		Options:    opt.NewPtr(in.Options),
+		MaxChoices: opt.NewPtr(in.MaxChoices),
+		Anonymous:  opt.NewPtr(in.Anonymous),
+		ClosesAt:   opt.NewPtr(in.ClosesAt),
+	}
+}
+
 func (i *Threads) ThreadList(ctx context.Context, request openapi.ThreadListRequestObject) (openapi.ThreadListResponseObject, error) {
 	pageSize := 50
 
@@ -198,6 +279,8 @@ func (i *Threads) ThreadList(ctx context.Context, request openapi.ThreadListRequ
 		Visibility: visibilities,
 		Tags:       tags,
 		Categories: cats,
+		Locked:     opt.NewPtr(request.Params.Locked),
+		Answered:   opt.NewPtr(request.Params.Answered),
 	})
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
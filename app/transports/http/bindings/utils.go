@@ -16,6 +16,7 @@ import (
 	"github.com/Southclaws/storyden/app/resources/mark"
 	"github.com/Southclaws/storyden/app/resources/pagination"
 	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/poll"
 	"github.com/Southclaws/storyden/app/resources/post/reply"
 	"github.com/Southclaws/storyden/app/resources/post/thread"
 	"github.com/Southclaws/storyden/app/resources/visibility"
@@ -37,23 +38,35 @@ func serialiseAccount(acc *account.AccountWithEdges) openapi.Account {
 		return serialiseProfileReferenceFromAccount(ib)
 	})
 
+	suspension := opt.Map(acc.Suspension, func(s account.Suspension) openapi.AccountSuspension {
+		return openapi.AccountSuspension{
+			Reason:    s.Reason.Ptr(),
+			ExpiresAt: s.Until.Ptr(),
+			SuspendedBy: opt.Map(s.SuspendedBy, func(id account.AccountID) openapi.Identifier {
+				return openapi.Identifier(id.String())
+			}).Ptr(),
+		}
+	})
+
 	return openapi.Account{
-		Id:             openapi.Identifier(acc.ID.String()),
-		Joined:         acc.CreatedAt,
-		Suspended:      acc.DeletedAt.Ptr(),
-		Handle:         acc.Handle,
-		Name:           acc.Name,
-		Bio:            acc.Bio.HTML(),
-		Meta:           acc.Metadata,
-		Links:          serialiseExternalLinks(acc.ExternalLinks),
-		CreatedAt:      acc.CreatedAt,
-		UpdatedAt:      acc.UpdatedAt,
-		DeletedAt:      acc.DeletedAt.Ptr(),
-		Admin:          acc.Admin,
-		VerifiedStatus: openapi.AccountVerifiedStatus(acc.VerifiedStatus.String()),
-		EmailAddresses: dt.Map(acc.EmailAddresses, serialiseEmailAddressPtr),
-		Roles:          serialiseHeldRoleList(acc.Roles),
-		InvitedBy:      invitedBy.Ptr(),
+		Id:                  openapi.Identifier(acc.ID.String()),
+		Joined:              acc.CreatedAt,
+		Suspended:           acc.DeletedAt.Ptr(),
+		Suspension:          suspension.Ptr(),
+		DeletionScheduledAt: acc.DeletionScheduledAt.Ptr(),
+		Handle:              acc.Handle,
+		Name:                acc.Name,
+		Bio:                 acc.Bio.HTML(),
+		Meta:                acc.Metadata,
+		Links:               serialiseExternalLinks(acc.ExternalLinks),
+		CreatedAt:           acc.CreatedAt,
+		UpdatedAt:           acc.UpdatedAt,
+		DeletedAt:           acc.DeletedAt.Ptr(),
+		Admin:               acc.Admin,
+		VerifiedStatus:      openapi.AccountVerifiedStatus(acc.VerifiedStatus.String()),
+		EmailAddresses:      dt.Map(acc.EmailAddresses, serialiseEmailAddressPtr),
+		Roles:               serialiseHeldRoleList(acc.Roles),
+		InvitedBy:           invitedBy.Ptr(),
 	}
 }
 
@@ -96,6 +109,7 @@ func serialiseThreadReference(t *thread.Thread) openapi.ThreadReference {
 		Category:    opt.Map(t.Category, serialiseCategoryReference).Ptr(),
 		Visibility:  serialiseVisibility(t.Visibility),
 		Pinned:      t.Pinned,
+		Lock:        opt.PtrMap(t.Lock, serialiseThreadLock),
 		ReadStatus:  opt.PtrMap(t.ReadStatus, serialiseReadStatus),
 		ReplyStatus: serialiseReplyStatus(t.ReplyStatus),
 		Likes:       serialiseLikeStatus(&t.Likes),
@@ -104,6 +118,8 @@ func serialiseThreadReference(t *thread.Thread) openapi.ThreadReference {
 		Assets:      dt.Map(t.Assets, serialiseAssetPtr),
 		Collections: serialiseCollectionStatus(t.Collections),
 		Link:        opt.Map(t.WebLink, serialiseLinkRef).Ptr(),
+
+		AcceptedAnswerId: opt.PtrMap(t.AcceptedAnswer, serialisePostID),
 	}
 }
 
@@ -127,6 +143,7 @@ func serialiseThread(t *thread.Thread) openapi.Thread {
 		Link:           opt.Map(t.WebLink, serialiseLinkRef).Ptr(),
 		Meta:           (*openapi.Metadata)(&t.Meta),
 		Pinned:         t.Pinned,
+		Lock:           opt.PtrMap(t.Lock, serialiseThreadLock),
 		ReadStatus:     opt.PtrMap(t.ReadStatus, serialiseReadStatus),
 		ReplyStatus:    serialiseReplyStatus(t.ReplyStatus),
 		Reacts:         dt.Map(t.Reacts, serialiseReact),
@@ -137,6 +154,49 @@ func serialiseThread(t *thread.Thread) openapi.Thread {
 		Title:          t.Title,
 		UpdatedAt:      t.UpdatedAt,
 		LastReplyAt:    t.LastReplyAt.Ptr(),
+
+		AcceptedAnswerId: opt.PtrMap(t.AcceptedAnswer, serialisePostID),
+		Poll:             opt.PtrMap(t.Poll, serialisePoll),
+	}
+}
+
+func serialisePoll(p poll.Poll) openapi.Poll {
+	return openapi.Poll{
+		Id:         openapi.Identifier(p.ID.String()),
+		MaxChoices: p.MaxChoices,
+		Anonymous:  p.Anonymous,
+		ClosesAt:   p.ClosesAt.Ptr(),
+		Closed:     p.Closed,
+		Voters:     p.Voters,
+		Options:    dt.Map(p.Options, serialisePollOption(p.Anonymous)),
+	}
+}
+
+func serialisePollOption(anonymous bool) func(o *poll.Option) openapi.PollOption {
+	return func(o *poll.Option) openapi.PollOption {
+		var voters *[]openapi.ProfileReference
+		if !anonymous {
+			v := dt.Map(o.Voters, serialiseProfileReference)
+			voters = &v
+		}
+
+		return openapi.PollOption{
+			Id:     openapi.Identifier(o.ID.String()),
+			Text:   o.Text,
+			Votes:  o.Votes,
+			Voted:  o.Voted,
+			Voters: voters,
+		}
+	}
+}
+
+func serialiseThreadLock(l thread.Lock) openapi.ThreadLock {
+	return openapi.ThreadLock{
+		LockedAt: l.At,
+		LockedBy: opt.PtrMap(l.By, func(id account.AccountID) openapi.Identifier {
+			return openapi.Identifier(id.String())
+		}),
+		Reason: l.Reason.Ptr(),
 	}
 }
 
@@ -228,6 +288,10 @@ func serialisePostRef(p *post.Post) openapi.PostReference {
 	}
 }
 
+func serialisePostID(id post.ID) openapi.Identifier {
+	return openapi.Identifier(id.String())
+}
+
 func deserialisePostID(s string) post.ID {
 	return post.ID(openapi.ParseID(s))
 }
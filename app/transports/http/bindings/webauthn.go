@@ -22,6 +22,7 @@ import (
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/services/authentication/provider/totp"
 	waprovider "github.com/Southclaws/storyden/app/services/authentication/provider/webauthn"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/session_cookie"
@@ -38,6 +39,7 @@ type WebAuthn struct {
 	si           *session.Issuer
 	accountQuery *account_querier.Querier
 	wa           *waprovider.Provider
+	tp           *totp.Provider
 	address      url.URL
 }
 
@@ -47,6 +49,7 @@ func NewWebAuthn(
 	accountQuery *account_querier.Querier,
 	cj *session_cookie.Jar,
 	wa *waprovider.Provider,
+	tp *totp.Provider,
 	router *echo.Echo,
 ) WebAuthn {
 	// in order to retain context across the credential request and creation,
@@ -70,7 +73,7 @@ func NewWebAuthn(
 		}
 	})
 
-	return WebAuthn{cj, si, accountQuery, wa, cfg.PublicAPIAddress}
+	return WebAuthn{cj, si, accountQuery, wa, tp, cfg.PublicAPIAddress}
 }
 
 func (a *WebAuthn) WebAuthnRequestCredential(ctx context.Context, request openapi.WebAuthnRequestCredentialRequestObject) (openapi.WebAuthnRequestCredentialResponseObject, error) {
@@ -240,6 +243,15 @@ func (a *WebAuthn) WebAuthnMakeAssertion(ctx context.Context, request openapi.We
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
+	challenge, err := challenge(ctx, a.tp, acc.ID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if ch, ok := challenge.Get(); ok {
+		return openapi.WebAuthnMakeAssertion202JSONResponse{AuthTwoFactorChallengeOKJSONResponse: ch}, nil
+	}
+
 	t, err := a.si.Issue(ctx, acc.ID)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
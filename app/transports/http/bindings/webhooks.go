@@ -0,0 +1,195 @@
+package bindings
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_manager"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+)
+
+type Webhooks struct {
+	webhookManager *webhook_manager.Manager
+}
+
+func NewWebhooks(webhookManager *webhook_manager.Manager) Webhooks {
+	return Webhooks{webhookManager: webhookManager}
+}
+
+func (h *Webhooks) WebhookList(ctx context.Context, request openapi.WebhookListRequestObject) (openapi.WebhookListResponseObject, error) {
+	ws, err := h.webhookManager.List(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.WebhookList200JSONResponse{
+		WebhookListOKJSONResponse: openapi.WebhookListOKJSONResponse{
+			Webhooks: dt.Map(ws, serialiseWebhook),
+		},
+	}, nil
+}
+
+func (h *Webhooks) WebhookCreate(ctx context.Context, request openapi.WebhookCreateRequestObject) (openapi.WebhookCreateResponseObject, error) {
+	eventTypes, err := deserialiseWebhookEventTypes(request.Body.EventTypes)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	wh, err := h.webhookManager.Create(ctx,
+		request.Body.Name,
+		request.Body.Url,
+		eventTypes,
+		opt.NewPtr(request.Body.Enabled),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.WebhookCreate200JSONResponse{
+		WebhookCreateOKJSONResponse: openapi.WebhookCreateOKJSONResponse(serialiseWebhookWithSecret(wh)),
+	}, nil
+}
+
+func (h *Webhooks) WebhookGet(ctx context.Context, request openapi.WebhookGetRequestObject) (openapi.WebhookGetResponseObject, error) {
+	wh, err := h.webhookManager.Get(ctx, webhook.ID(deserialiseID(request.WebhookId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.WebhookGet200JSONResponse{
+		WebhookGetOKJSONResponse: openapi.WebhookGetOKJSONResponse(serialiseWebhook(wh)),
+	}, nil
+}
+
+func (h *Webhooks) WebhookUpdate(ctx context.Context, request openapi.WebhookUpdateRequestObject) (openapi.WebhookUpdateResponseObject, error) {
+	eventTypes, err := opt.MapErr(opt.NewPtr(request.Body.EventTypes), deserialiseWebhookEventTypes)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	wh, err := h.webhookManager.Update(ctx, webhook.ID(deserialiseID(request.WebhookId)), webhook_manager.Partial{
+		Name:       opt.NewPtr(request.Body.Name),
+		URL:        opt.NewPtr(request.Body.Url),
+		EventTypes: eventTypes,
+		Enabled:    opt.NewPtr(request.Body.Enabled),
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.WebhookUpdate200JSONResponse{
+		WebhookGetOKJSONResponse: openapi.WebhookGetOKJSONResponse(serialiseWebhook(wh)),
+	}, nil
+}
+
+func (h *Webhooks) WebhookDelete(ctx context.Context, request openapi.WebhookDeleteRequestObject) (openapi.WebhookDeleteResponseObject, error) {
+	if err := h.webhookManager.Delete(ctx, webhook.ID(deserialiseID(request.WebhookId))); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.WebhookDelete204Response{}, nil
+}
+
+func (h *Webhooks) WebhookSecretRotate(ctx context.Context, request openapi.WebhookSecretRotateRequestObject) (openapi.WebhookSecretRotateResponseObject, error) {
+	wh, err := h.webhookManager.RotateSecret(ctx, webhook.ID(deserialiseID(request.WebhookId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.WebhookSecretRotate200JSONResponse{
+		WebhookSecretRotateOKJSONResponse: openapi.WebhookSecretRotateOKJSONResponse(serialiseWebhookWithSecret(wh)),
+	}, nil
+}
+
+func (h *Webhooks) WebhookTest(ctx context.Context, request openapi.WebhookTestRequestObject) (openapi.WebhookTestResponseObject, error) {
+	d, err := h.webhookManager.Test(ctx, webhook.ID(deserialiseID(request.WebhookId)))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.WebhookTest200JSONResponse{
+		WebhookTestOKJSONResponse: openapi.WebhookTestOKJSONResponse(serialiseWebhookDelivery(d)),
+	}, nil
+}
+
+func (h *Webhooks) WebhookDeliveryList(ctx context.Context, request openapi.WebhookDeliveryListRequestObject) (openapi.WebhookDeliveryListResponseObject, error) {
+	r, err := h.webhookManager.ListDeliveries(ctx, webhook.ID(deserialiseID(request.WebhookId)), deserialisePageParams(request.Params.Page, 50))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return openapi.WebhookDeliveryList200JSONResponse{
+		WebhookDeliveryListOKJSONResponse: openapi.WebhookDeliveryListOKJSONResponse{
+			CurrentPage: r.CurrentPage,
+			NextPage:    r.NextPage.Ptr(),
+			PageSize:    r.Size,
+			Results:     r.Results,
+			Deliveries:  dt.Map(r.Items, serialiseWebhookDelivery),
+			TotalPages:  r.TotalPages,
+		},
+	}, nil
+}
+
+func deserialiseWebhookEventTypes(in openapi.WebhookEventTypeList) ([]webhook.EventType, error) {
+	return dt.MapErr(in, func(t openapi.WebhookEventType) (webhook.EventType, error) {
+		return webhook.NewEventType(string(t))
+	})
+}
+
+func serialiseWebhook(in *webhook.Webhook) openapi.Webhook {
+	return openapi.Webhook{
+		Id:        openapi.Identifier(in.ID.String()),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		Name:      in.Name,
+		Url:       in.URL,
+		Enabled:   in.Enabled,
+		EventTypes: dt.Map(in.EventTypes, func(t webhook.EventType) openapi.WebhookEventType {
+			return openapi.WebhookEventType(t.String())
+		}),
+	}
+}
+
+// serialiseWebhookWithSecret is only for the responses which hand the signing
+// secret to the administrator, everywhere else the secret is never exposed.
+func serialiseWebhookWithSecret(in *webhook.Webhook) openapi.WebhookWithSecret {
+	wh := serialiseWebhook(in)
+
+	return openapi.WebhookWithSecret{
+		Id:         wh.Id,
+		CreatedAt:  wh.CreatedAt,
+		UpdatedAt:  wh.UpdatedAt,
+		Name:       wh.Name,
+		Url:        wh.Url,
+		Secret:     in.Secret,
+		Enabled:    wh.Enabled,
+		EventTypes: wh.EventTypes,
+	}
+}
+
+func serialiseWebhookDelivery(in *webhook.Delivery) openapi.WebhookDelivery {
+	// The payload is always produced by json.Marshal on a struct or map so it
+	// is safe to assume it's an object, if it somehow isn't, it's left empty.
+	payload := map[string]any{}
+	_ = json.Unmarshal(in.Payload, &payload)
+
+	return openapi.WebhookDelivery{
+		Id:             openapi.Identifier(in.ID.String()),
+		CreatedAt:      in.CreatedAt,
+		UpdatedAt:      in.UpdatedAt,
+		WebhookId:      openapi.Identifier(in.WebhookID.String()),
+		EventType:      openapi.WebhookEventType(in.EventType.String()),
+		Status:         openapi.WebhookDeliveryStatus(in.Status.String()),
+		Attempts:       in.Attempts,
+		Payload:        payload,
+		ResponseStatus: in.ResponseStatus.Ptr(),
+		Error:          in.Error.Ptr(),
+	}
+}
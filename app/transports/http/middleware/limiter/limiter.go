@@ -6,6 +6,14 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/swirl"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
 	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/infrastructure/rate"
 )
@@ -18,24 +26,58 @@ const (
 	MaxRequestSizeBytes = 10 * 1024 * 1024
 )
 
+// Operation describes how an OpenAPI operation is rate limited. These are
+// declared with the `x-rate-limit` extension in openapi.yaml and generated by
+// ratelimitgen into the operations table.
+type Operation struct {
+	// Cost is charged against the global rate limit for each request.
+	Cost int
+
+	// Limit and Period, if set, are a separate rate limit for the operation.
+	Limit  int
+	Period time.Duration
+}
+
 type Middleware struct {
-	rl        rate.Limiter
-	kf        KeyFunc
-	sizeLimit int64
+	rl         rate.Limiter
+	operations map[string]rate.Limiter
+	router     routers.Router
+	kf         KeyFunc
+	sizeLimit  int64
 }
 
 func New(
 	cfg config.Config,
 
 	f *rate.LimiterFactory,
-) *Middleware {
+) (*Middleware, error) {
 	rl := f.NewLimiter(cfg.RateLimit, cfg.RateLimitPeriod, cfg.RateLimitExpire)
 
-	return &Middleware{
-		rl:        rl,
-		kf:        fromIP("CF-Connecting-IP", "X-Real-IP", "True-Client-IP"),
-		sizeLimit: MaxRequestSizeBytes, // TODO: cfg.MaxRequestSize
+	ops := map[string]rate.Limiter{}
+	for name, op := range operations {
+		if op.Limit > 0 {
+			ops[name] = f.NewLimiter(op.Limit, op.Period, cfg.RateLimitExpire)
+		}
+	}
+
+	spec, err := openapi.GetSwagger()
+	if err != nil {
+		return nil, fault.Wrap(err)
 	}
+
+	// Only used to resolve requests to operation IDs.
+	router, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	return &Middleware{
+		rl:         rl,
+		operations: ops,
+		router:     router,
+		kf:         fromSession(fromIP("CF-Connecting-IP", "X-Real-IP", "True-Client-IP")),
+		sizeLimit:  MaxRequestSizeBytes, // TODO: cfg.MaxRequestSize
+	}, nil
 }
 
 func (m *Middleware) WithRateLimit() func(next http.Handler) http.Handler {
@@ -43,31 +85,73 @@ func (m *Middleware) WithRateLimit() func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
 
+			if exempt(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			key, err := m.kf(r)
 			if err != nil {
 				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 				return
 			}
 
-			// TODO: Generate costs per-operation from OpenAPI spec
-			cost := 1
+			name, op := m.operation(r)
+			orl, hasOperationLimit := m.operations[name]
+
+			// Both limits are checked before either is charged so a request
+			// rejected by one limit doesn't consume the other.
+			status, allowed, err := m.rl.Peek(ctx, key, op.Cost)
+			if err != nil {
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+
+			// Operations with their own limit are counted separately, the
+			// headers describe whichever of the two limits is closest.
+			if hasOperationLimit && allowed {
+				opStatus, opAllowed, err := orl.Peek(ctx, name+":"+key, 1)
+				if err != nil {
+					http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+					return
+				}
+
+				if !opAllowed || opStatus.Remaining < status.Remaining {
+					status, allowed = opStatus, opAllowed
+				}
+			}
+
+			if !allowed {
+				writeHeaders(w, status)
+				w.Header().Set(RetryAfter, status.Reset.UTC().Format(time.RFC1123))
+				http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+				return
+			}
 
-			status, allowed, err := m.rl.Increment(ctx, key, cost)
+			status, allowed, err = m.rl.Increment(ctx, key, op.Cost)
 			if err != nil {
 				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 				return
 			}
 
-			limit := status.Limit
-			remaining := status.Remaining
-			resetTime := status.Reset.UTC().Format(time.RFC1123)
+			if hasOperationLimit && allowed {
+				opStatus, opAllowed, err := orl.Increment(ctx, name+":"+key, 1)
+				if err != nil {
+					http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+					return
+				}
 
-			w.Header().Set(RateLimitLimit, strconv.FormatUint(uint64(limit), 10))
-			w.Header().Set(RateLimitRemaining, strconv.FormatUint(uint64(remaining), 10))
-			w.Header().Set(RateLimitReset, resetTime)
+				if !opAllowed || opStatus.Remaining < status.Remaining {
+					status, allowed = opStatus, opAllowed
+				}
+			}
+
+			writeHeaders(w, status)
 
+			// A concurrent request may still win the race between the check
+			// and the charge, in which case the increment has the final say.
 			if !allowed {
-				w.Header().Set(RetryAfter, resetTime)
+				w.Header().Set(RetryAfter, status.Reset.UTC().Format(time.RFC1123))
 				http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
 				return
 			}
@@ -77,8 +161,64 @@ func (m *Middleware) WithRateLimit() func(next http.Handler) http.Handler {
 	}
 }
 
+// operation resolves the OpenAPI operation for a request. Requests outside of
+// the API, or to routes that don't exist, cost the default amount.
+func (m *Middleware) operation(r *http.Request) (string, Operation) {
+	route, _, err := m.router.FindRoute(r)
+	if err != nil {
+		return "", Operation{Cost: 1}
+	}
+
+	name := route.Operation.OperationID
+
+	op, ok := operations[name]
+	if !ok {
+		return name, Operation{Cost: 1}
+	}
+
+	return name, op
+}
+
+func writeHeaders(w http.ResponseWriter, status *swirl.Status) {
+	w.Header().Set(RateLimitLimit, strconv.FormatUint(uint64(status.Limit), 10))
+	w.Header().Set(RateLimitRemaining, strconv.FormatUint(uint64(status.Remaining), 10))
+	w.Header().Set(RateLimitReset, status.Reset.UTC().Format(time.RFC1123))
+}
+
+// exempt reports whether the request was made by an account holding a role
+// which is not subject to rate limits at all, such as trusted bots.
+func exempt(r *http.Request) bool {
+	ctx := r.Context()
+
+	// Guests are always limited, this also guards against requests which never
+	// received a session context because resolving the default roles failed.
+	if _, ok := session.GetOptAccountID(ctx).Get(); !ok {
+		return false
+	}
+
+	return session.GetRoles(ctx).Permissions().HasAny(rbac.PermissionBypassRateLimits, rbac.PermissionAdministrator)
+}
+
 type KeyFunc func(r *http.Request) (string, error)
 
+// fromSession keys requests from authenticated accounts on the account ID so a
+// member's limit follows them across addresses and members behind a shared
+// address don't consume each other's limit. Guests fall back to the next key.
+func fromSession(fallback KeyFunc) KeyFunc {
+	return func(r *http.Request) (string, error) {
+		if id, ok := session.GetOptAccountID(r.Context()).Get(); ok {
+			return "account:" + id.String(), nil
+		}
+
+		key, err := fallback(r)
+		if err != nil {
+			return "", err
+		}
+
+		return "ip:" + key, nil
+	}
+}
+
 func fromIP(headers ...string) KeyFunc {
 	return func(r *http.Request) (string, error) {
 		for _, h := range headers {
@@ -0,0 +1,50 @@
+// Code generated by ratelimitgen. DO NOT EDIT.
+
+package limiter
+
+import "time"
+
+// operations holds the rate limit parameters for every operation which
+// declares the x-rate-limit extension, all other operations cost 1.
+var operations = map[string]Operation{
+	"AccountDataExportRequest": {
+		Cost:   5,
+		Limit:  5,
+		Period: 86400 * time.Second,
+	},
+	"AssetUpload": {
+		Cost:   10,
+		Limit:  100,
+		Period: 3600 * time.Second,
+	},
+	"AuthEmailPasswordSignin": {Cost: 5},
+	"AuthEmailPasswordSignup": {Cost: 10},
+	"AuthEmailSignin":         {Cost: 10},
+	"AuthEmailSignup":         {Cost: 10},
+	"AuthPasswordSignin":      {Cost: 5},
+	"AuthPasswordSignup":      {Cost: 10},
+	"AuthTOTPVerify":          {Cost: 5},
+	"ConversationCreate":      {Cost: 5},
+	"ConversationMessageSend": {Cost: 2},
+	"NodeCreate":              {Cost: 5},
+	"NodeExport": {
+		Cost:   10,
+		Limit:  30,
+		Period: 3600 * time.Second,
+	},
+	"ReplyCreate": {
+		Cost:   5,
+		Limit:  300,
+		Period: 3600 * time.Second,
+	},
+	"ReportCreate": {
+		Cost:   5,
+		Limit:  30,
+		Period: 3600 * time.Second,
+	},
+	"ThreadCreate": {
+		Cost:   10,
+		Limit:  60,
+		Period: 3600 * time.Second,
+	},
+}
@@ -0,0 +1,86 @@
+// Command backup writes the entire instance, the database and every stored
+// asset, to a single archive which can be restored with cmd/restore.
+//
+//	go run ./cmd/backup storyden-backup.zip
+//
+// The database and asset storage are configured with the same environment
+// variables as the Storyden server. Backups can be taken while it's running.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/backup"
+	"github.com/Southclaws/storyden/internal/script"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: backup [file or - for stdout]\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	path := flag.Arg(0)
+	if path == "" {
+		path = fmt.Sprintf("storyden-backup-%s.zip", time.Now().Format("20060102-150405"))
+	}
+
+	script.Run(
+		fx.Provide(backup.New),
+		fx.Invoke(func(lc fx.Lifecycle, logger *slog.Logger, archiver *backup.Archiver) {
+			// Runs once the database schema has been migrated on start.
+			lc.Append(fx.StartHook(func(ctx context.Context) error {
+				return run(ctx, logger, archiver, path)
+			}))
+		}),
+	)
+}
+
+func run(ctx context.Context, logger *slog.Logger, archiver *backup.Archiver, path string) error {
+	var w io.Writer = os.Stdout
+	if path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			return fault.Wrap(err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	m, err := archiver.Backup(ctx, w)
+	if err != nil {
+		if path != "-" {
+			os.Remove(path)
+		}
+		return fault.Wrap(err)
+	}
+
+	rows := 0
+	for _, t := range m.Tables {
+		rows += t.Rows
+	}
+
+	logger.Info("backup complete",
+		slog.String("path", path),
+		slog.Int("tables", len(m.Tables)),
+		slog.Int("rows", rows),
+		slog.Int("objects", len(m.Objects)),
+	)
+
+	return nil
+}
@@ -0,0 +1,111 @@
+// Command import brings a community over from another forum platform.
+//
+//	go run ./cmd/import -from discourse discourse.sql
+//	go run ./cmd/import -from phpbb -prefix phpbb_ phpbb.sql
+//	go run ./cmd/import -from jsonl export.jsonl
+//	go run ./cmd/import -from jsonl -admins export.jsonl
+//
+// The database is configured with the same environment variables as the
+// Storyden server. Imports can be safely run again, anything imported
+// previously from the same source is skipped. Administrators on the original
+// platform are imported as regular members unless -admins is set.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/Southclaws/fault"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/importer"
+	"github.com/Southclaws/storyden/internal/importer/discourse"
+	"github.com/Southclaws/storyden/internal/importer/jsonl"
+	"github.com/Southclaws/storyden/internal/importer/phpbb"
+	"github.com/Southclaws/storyden/internal/script"
+)
+
+func main() {
+	from := flag.String("from", "", "source platform: discourse, phpbb or jsonl")
+	prefix := flag.String("prefix", phpbb.DefaultPrefix, "table prefix of a phpBB database")
+	admins := flag.Bool("admins", false, "make administrators on the original platform administrators here too")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: import -from <platform> [flags] <file or - for stdin>\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var source importer.Source
+	switch *from {
+	case "discourse":
+		source = discourse.New()
+	case "phpbb":
+		source = phpbb.New(*prefix)
+	case "jsonl":
+		source = jsonl.New()
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	script.Run(
+		fx.Provide(importer.New),
+		fx.Invoke(func(lc fx.Lifecycle, logger *slog.Logger, imp *importer.Importer) {
+			// Runs once the database schema has been migrated on start.
+			lc.Append(fx.StartHook(func(ctx context.Context) error {
+				return run(ctx, logger, imp, source, flag.Arg(0), importer.WithAdmins(*admins))
+			}))
+		}),
+	)
+}
+
+func run(ctx context.Context, logger *slog.Logger, imp *importer.Importer, source importer.Source, path string, opts ...importer.Option) error {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fault.Wrap(err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	dump, err := source.Read(ctx, r)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+
+	logger.Info("read export",
+		slog.String("source", dump.Source),
+		slog.Int("accounts", len(dump.Accounts)),
+		slog.Int("categories", len(dump.Categories)),
+		slog.Int("threads", len(dump.Threads)),
+		slog.Int("replies", len(dump.Replies)),
+		slog.Int("likes", len(dump.Likes)),
+		slog.Int("reactions", len(dump.Reactions)),
+	)
+
+	report, err := imp.Run(ctx, dump, opts...)
+	if report != nil {
+		fmt.Printf("accounts:   %s\n", report.Accounts)
+		fmt.Printf("categories: %s\n", report.Categories)
+		fmt.Printf("threads:    %s\n", report.Threads)
+		fmt.Printf("replies:    %s\n", report.Replies)
+		fmt.Printf("likes:      %s\n", report.Likes)
+		fmt.Printf("reactions:  %s\n", report.Reactions)
+	}
+	if err != nil {
+		return fault.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Command restore replaces the entire instance, the database and stored
+// assets, with the contents of an archive written by cmd/backup.
+//
+//	go run ./cmd/restore storyden-backup.zip
+//
+// The database and asset storage are configured with the same environment
+// variables as the Storyden server, which should be stopped while restoring.
+// The database may be a different kind to the one the backup was taken from.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/Southclaws/fault"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/internal/backup"
+	"github.com/Southclaws/storyden/internal/script"
+)
+
+func main() {
+	replace := flag.Bool("replace", false, "restore over an instance which already has accounts, removing everything in it")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: restore [flags] <file>\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	script.Run(
+		fx.Provide(backup.New),
+		fx.Invoke(func(lc fx.Lifecycle, logger *slog.Logger, archiver *backup.Archiver) {
+			// Runs once the database schema has been migrated on start.
+			lc.Append(fx.StartHook(func(ctx context.Context) error {
+				return run(ctx, logger, archiver, flag.Arg(0), *replace)
+			}))
+		}),
+	)
+}
+
+func run(ctx context.Context, logger *slog.Logger, archiver *backup.Archiver, path string, replace bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fault.Wrap(err)
+	}
+
+	m, err := archiver.Restore(ctx, f, info.Size(), replace)
+	if err != nil {
+		return fault.Wrap(err)
+	}
+
+	logger.Info("restore complete",
+		slog.String("version", m.Version),
+		slog.Time("created_at", m.CreatedAt),
+		slog.Int("tables", len(m.Tables)),
+		slog.Int("objects", len(m.Objects)),
+	)
+
+	return nil
+}
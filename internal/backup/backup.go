@@ -0,0 +1,266 @@
+// Package backup writes an entire Storyden instance to a single archive and
+// restores it again. Archives are independent of the database they were made
+// from so an instance on SQLite can be restored into PostgreSQL and the other
+// way around, and of where assets are stored, local or S3.
+//
+// An archive is a zip file containing:
+//
+//	manifest.json          format version, schema fingerprint and checksums
+//	database/<table>.jsonl one JSON object per row, keyed by column name
+//	objects/<path>         every object in the asset storage
+//
+// The manifest is written last and lists the SHA-256 checksum of every other
+// file, these are verified before anything is restored.
+package backup
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Southclaws/storyden/app/resources/asset"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+)
+
+// FormatVersion is the version of the archive layout, it's increased when an
+// archive written by a newer version could not be read by an older one.
+const FormatVersion = 1
+
+const (
+	manifestName   = "manifest.json"
+	databasePrefix = "database/"
+	objectsPrefix  = "objects/"
+)
+
+// storagePrefixes are where Storyden keeps objects. Only these are backed up
+// as local storage shares its directory with the SQLite database by default.
+var storagePrefixes = []string{
+	asset.AssetsSubdirectory + "/",
+	"avatar/",
+}
+
+type Manifest struct {
+	Format    int       `json:"format"`
+	Version   string    `json:"version"`
+	Schema    string    `json:"schema"`
+	Database  string    `json:"database"`
+	CreatedAt time.Time `json:"created_at"`
+	Tables    []File    `json:"tables"`
+	Objects   []File    `json:"objects"`
+}
+
+// File is an entry in the archive. Rows is only set for database tables.
+type File struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	Rows   int    `json:"rows,omitempty"`
+	SHA256 string `json:"sha256"`
+}
+
+type Archiver struct {
+	logger *slog.Logger
+	db     *sqlx.DB
+	store  object.Storer
+}
+
+func New(logger *slog.Logger, db *sqlx.DB, store object.Storer) *Archiver {
+	return &Archiver{
+		logger: logger,
+		db:     db,
+		store:  store,
+	}
+}
+
+// Backup writes the whole database and asset storage as an archive to w.
+func (a *Archiver) Backup(ctx context.Context, w io.Writer) (*Manifest, error) {
+	zw := zip.NewWriter(w)
+
+	m := &Manifest{
+		Format:    FormatVersion,
+		Version:   config.Version,
+		Schema:    fingerprint(),
+		Database:  a.db.DriverName(),
+		CreatedAt: time.Now().UTC(),
+		Tables:    []File{},
+		Objects:   []File{},
+	}
+
+	// Every table is read from the same snapshot so a backup of a running
+	// instance is consistent. SQLite transactions are already snapshots.
+	var opts *sql.TxOptions
+	if a.db.DriverName() == "pgx" {
+		opts = &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
+	}
+
+	tx, err := a.db.BeginTxx(ctx, opts)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer tx.Rollback()
+
+	for _, t := range plan() {
+		f, err := a.backupTable(ctx, tx, zw, t)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		m.Tables = append(m.Tables, *f)
+	}
+
+	for _, prefix := range storagePrefixes {
+		objects, err := a.store.List(ctx, prefix)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		for _, o := range objects {
+			f, err := a.backupObject(ctx, zw, o)
+			if err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx))
+			}
+
+			m.Objects = append(m.Objects, *f)
+		}
+	}
+
+	mw, err := zw.Create(manifestName)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	enc := json.NewEncoder(mw)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(m); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return m, nil
+}
+
+func (a *Archiver) backupTable(ctx context.Context, tx *sqlx.Tx, zw *zip.Writer, t table) (*File, error) {
+	name := databasePrefix + t.Name + ".jsonl"
+
+	fw, err := zw.Create(name)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	cw := newChecksumWriter(fw)
+
+	order := columnList(t.PrimaryKey)
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT %s FROM %s ORDER BY %s", columnList(t.Columns), quote(t.Name), order))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer rows.Close()
+
+	dest := make([]any, len(t.Columns))
+	enc := json.NewEncoder(cw)
+	count := 0
+
+	for rows.Next() {
+		for i, c := range t.Columns {
+			dest[i] = scanner(c)
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		row := make(map[string]json.RawMessage, len(t.Columns))
+		for i, c := range t.Columns {
+			v, err := encode(c, dest[i])
+			if err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx))
+			}
+			row[c.Name] = v
+		}
+
+		if err := enc.Encode(row); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	a.logger.Debug("backed up table", slog.String("table", t.Name), slog.Int("rows", count))
+
+	return &File{
+		Name:   name,
+		Size:   cw.size,
+		Rows:   count,
+		SHA256: cw.sum(),
+	}, nil
+}
+
+func (a *Archiver) backupObject(ctx context.Context, zw *zip.Writer, o object.Object) (*File, error) {
+	name := objectsPrefix + o.Path
+
+	r, _, err := a.store.Read(ctx, o.Path)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	if c, ok := r.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	// Assets are mostly images which are already compressed.
+	fw, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Store,
+		Modified: o.Modified,
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	cw := newChecksumWriter(fw)
+
+	if _, err := io.Copy(cw, r); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return &File{
+		Name:   name,
+		Size:   cw.size,
+		SHA256: cw.sum(),
+	}, nil
+}
+
+type checksumWriter struct {
+	w    io.Writer
+	h    hash.Hash
+	size int64
+}
+
+func newChecksumWriter(w io.Writer) *checksumWriter {
+	return &checksumWriter{w: w, h: sha256.New()}
+}
+
+func (c *checksumWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.h.Write(p[:n])
+	c.size += int64(n)
+	return n, err
+}
+
+func (c *checksumWriter) sum() string {
+	return hex.EncodeToString(c.h.Sum(nil))
+}
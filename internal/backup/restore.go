@@ -0,0 +1,372 @@
+package backup
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+// maxParameters keeps multi-row inserts under the bind parameter limits of
+// every supported database.
+const maxParameters = 1000
+
+const indexedAtColumn = "indexed_at"
+
+// Restore replaces the contents of the database and writes every object in
+// the archive to storage. The archive must have been created by a version of
+// Storyden with the same database schema and every file in it must match its
+// checksum in the manifest, this is checked before anything is written.
+//
+// Restoring over an instance which already has accounts is refused unless
+// replace is set, in which case everything in the database is removed.
+func (a *Archiver) Restore(ctx context.Context, r io.ReaderAt, size int64, replace bool) (*Manifest, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument), fmsg.With("not a backup archive"))
+	}
+
+	files := map[string]*zip.File{}
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	m, err := readManifest(files)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if m.Format > FormatVersion {
+		return nil, fault.Wrap(fault.Newf("archive format %d is newer than this version of Storyden supports, upgrade to restore it", m.Format),
+			fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	if m.Schema != fingerprint() {
+		return nil, fault.Wrap(fault.Newf("archive was created by Storyden %s which has a different database schema to Storyden %s, restore it using %s", m.Version, config.Version, m.Version),
+			fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	if err := verify(files, m); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	tx, err := a.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer tx.Rollback()
+
+	if !replace {
+		var accounts int
+		if err := tx.GetContext(ctx, &accounts, `SELECT COUNT(*) FROM "accounts"`); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		if accounts > 0 {
+			return nil, fault.New("database already has accounts, restoring would replace them",
+				fctx.With(ctx), ftag.With(ftag.AlreadyExists))
+		}
+	}
+
+	tables := plan()
+
+	if err := truncate(ctx, tx, tables); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	updates := []update{}
+	for _, t := range tables {
+		u, err := a.restoreTable(ctx, tx, files[databasePrefix+t.Name+".jsonl"], t)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to restore "+t.Name))
+		}
+		updates = append(updates, u...)
+	}
+
+	for _, u := range updates {
+		if _, err := tx.ExecContext(ctx, u.query, u.args...); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, o := range m.Objects {
+		if err := a.restoreObject(ctx, files[o.Name]); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return m, nil
+}
+
+func readManifest(files map[string]*zip.File) (*Manifest, error) {
+	f, ok := files[manifestName]
+	if !ok {
+		return nil, fault.New("archive has no manifest", ftag.With(ftag.InvalidArgument))
+	}
+
+	r, err := f.Open()
+	if err != nil {
+		return nil, fault.Wrap(err)
+	}
+	defer r.Close()
+
+	m := &Manifest{}
+	if err := json.NewDecoder(r).Decode(m); err != nil {
+		return nil, fault.Wrap(err, ftag.With(ftag.InvalidArgument), fmsg.With("archive manifest is invalid"))
+	}
+
+	return m, nil
+}
+
+// verify checks every file listed in the manifest is present and unchanged.
+func verify(files map[string]*zip.File, m *Manifest) error {
+	tables := map[string]bool{}
+	for _, t := range m.Tables {
+		tables[t.Name] = true
+	}
+	for _, t := range plan() {
+		if name := databasePrefix + t.Name + ".jsonl"; !tables[name] {
+			return fault.Newf("archive is missing table %s", t.Name)
+		}
+	}
+
+	for _, o := range m.Objects {
+		p := strings.TrimPrefix(o.Name, objectsPrefix)
+		if !strings.HasPrefix(o.Name, objectsPrefix) || p != path.Clean(p) || strings.HasPrefix(p, "../") || path.IsAbs(p) {
+			return fault.Newf("archive has an invalid object path %s", o.Name)
+		}
+	}
+
+	for _, entry := range slices.Concat(m.Tables, m.Objects) {
+		f, ok := files[entry.Name]
+		if !ok {
+			return fault.Newf("archive is missing %s", entry.Name)
+		}
+
+		r, err := f.Open()
+		if err != nil {
+			return fault.Wrap(err)
+		}
+
+		h := sha256.New()
+		n, err := io.Copy(h, r)
+		r.Close()
+		if err != nil {
+			return fault.Wrap(err)
+		}
+
+		if n != entry.Size || hex.EncodeToString(h.Sum(nil)) != entry.SHA256 {
+			return fault.Newf("checksum of %s does not match the manifest, the archive is corrupt", entry.Name)
+		}
+	}
+
+	return nil
+}
+
+// truncate removes every row from the database. References which are restored
+// after the rows themselves are removed first so the tables can be emptied in
+// the reverse of the order they're restored in.
+func truncate(ctx context.Context, tx *sqlx.Tx, tables []table) error {
+	for _, t := range tables {
+		if len(t.deferred) == 0 {
+			continue
+		}
+
+		set := make([]string, len(t.deferred))
+		for i, c := range t.deferred {
+			set[i] = quote(c.Name) + " = NULL"
+		}
+
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET %s", quote(t.Name), strings.Join(set, ", "))); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	for i := len(tables) - 1; i >= 0; i-- {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+quote(tables[i].Name)); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+// update sets the deferred references of a row once every table is restored.
+type update struct {
+	query string
+	args  []any
+}
+
+func (a *Archiver) restoreTable(ctx context.Context, tx *sqlx.Tx, f *zip.File, t table) ([]update, error) {
+	r, err := f.Open()
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	defer r.Close()
+
+	deferred := map[string]bool{}
+	for _, c := range t.deferred {
+		deferred[c.Name] = true
+	}
+
+	batchSize := max(1, maxParameters/len(t.Columns))
+	batch := make([][]any, 0, batchSize)
+	updates := []update{}
+	count := 0
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, insertQuery(t.Table, len(batch)), flatten(batch)...); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		batch = batch[:0]
+		return nil
+	}
+
+	dec := json.NewDecoder(r)
+	for dec.More() {
+		row := map[string]json.RawMessage{}
+		if err := dec.Decode(&row); err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+
+		values := make([]any, len(t.Columns))
+		later := map[*schema.Column]any{}
+		for i, c := range t.Columns {
+			v, err := decode(c, row[c.Name])
+			if err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("invalid value for "+c.Name))
+			}
+
+			// The search index isn't part of a backup, clearing when content
+			// was last indexed means it's all indexed when Storyden starts.
+			if c.Name == indexedAtColumn {
+				v = nil
+			}
+
+			if deferred[c.Name] && v != nil {
+				later[c] = v
+				continue
+			}
+			values[i] = v
+		}
+
+		if len(later) > 0 {
+			updates = append(updates, updateQuery(t, row, later))
+		}
+
+		batch = append(batch, values)
+		count++
+
+		if len(batch) == batchSize {
+			if err := flush(); err != nil {
+				return nil, fault.Wrap(err, fctx.With(ctx))
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	a.logger.Debug("restored table", slog.String("table", t.Name), slog.Int("rows", count))
+
+	return updates, nil
+}
+
+func insertQuery(t *schema.Table, rows int) string {
+	b := strings.Builder{}
+	fmt.Fprintf(&b, "INSERT INTO %s (%s) VALUES ", quote(t.Name), columnList(t.Columns))
+
+	n := 1
+	for r := range rows {
+		if r > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString("(")
+		for c := range t.Columns {
+			if c > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "$%d", n)
+			n++
+		}
+		b.WriteString(")")
+	}
+
+	return b.String()
+}
+
+func updateQuery(t table, row map[string]json.RawMessage, values map[*schema.Column]any) update {
+	set := []string{}
+	where := []string{}
+	args := []any{}
+
+	for _, c := range t.deferred {
+		v, ok := values[c]
+		if !ok {
+			continue
+		}
+		args = append(args, v)
+		set = append(set, fmt.Sprintf("%s = $%d", quote(c.Name), len(args)))
+	}
+
+	for _, c := range t.PrimaryKey {
+		// Primary keys were already decoded once when the row was inserted.
+		v, _ := decode(c, row[c.Name])
+		args = append(args, v)
+		where = append(where, fmt.Sprintf("%s = $%d", quote(c.Name), len(args)))
+	}
+
+	return update{
+		query: fmt.Sprintf("UPDATE %s SET %s WHERE %s", quote(t.Name), strings.Join(set, ", "), strings.Join(where, " AND ")),
+		args:  args,
+	}
+}
+
+func flatten(rows [][]any) []any {
+	args := make([]any, 0, len(rows)*len(rows[0]))
+	for _, r := range rows {
+		args = append(args, r...)
+	}
+	return args
+}
+
+func (a *Archiver) restoreObject(ctx context.Context, f *zip.File) error {
+	r, err := f.Open()
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+	defer r.Close()
+
+	p := strings.TrimPrefix(f.Name, objectsPrefix)
+
+	if err := a.store.Write(ctx, p, r, int64(f.UncompressedSize64)); err != nil {
+		return fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to restore object "+p))
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package backup
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/fault"
+
+	"github.com/Southclaws/storyden/internal/ent/migrate"
+)
+
+// table is a database table along with the foreign key columns that can only
+// be filled in once every table has been restored. These are references to
+// rows in the same table, which may come later in the dump, and references
+// which form a cycle between tables such as accounts and invitations.
+type table struct {
+	*schema.Table
+	deferred []*schema.Column
+}
+
+// plan orders the tables so that tables are restored after the tables they
+// reference. The order is deterministic so it can also be used for backups.
+func plan() []table {
+	placed := map[string]bool{}
+	remaining := slices.Clone(migrate.Tables)
+	ordered := []table{}
+
+	ready := func(t *schema.Table) bool {
+		for _, fk := range t.ForeignKeys {
+			if fk.RefTable != t && !placed[fk.RefTable.Name] {
+				return false
+			}
+		}
+		return true
+	}
+
+	for len(remaining) > 0 {
+		i := slices.IndexFunc(remaining, ready)
+		if i == -1 {
+			// Every remaining table is part of a cycle, break it at the first
+			// one and fill its references to the others in afterwards.
+			i = 0
+		}
+
+		t := remaining[i]
+		remaining = slices.Delete(remaining, i, i+1)
+
+		deferred := []*schema.Column{}
+		for _, fk := range t.ForeignKeys {
+			if fk.RefTable == t || !placed[fk.RefTable.Name] {
+				deferred = append(deferred, fk.Columns...)
+			}
+		}
+
+		placed[t.Name] = true
+		ordered = append(ordered, table{Table: t, deferred: deferred})
+	}
+
+	return ordered
+}
+
+// fingerprint identifies the shape of the schema this build of Storyden
+// migrates the database to. There are no versioned migrations, so this is
+// what an archive is checked against before restoring it.
+func fingerprint() string {
+	lines := []string{}
+	for _, t := range migrate.Tables {
+		for _, c := range t.Columns {
+			lines = append(lines, fmt.Sprintf("%s.%s %s %t", t.Name, c.Name, c.Type, c.Nullable))
+		}
+	}
+	slices.Sort(lines)
+
+	h := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(h[:])
+}
+
+func quote(name string) string {
+	return `"` + name + `"`
+}
+
+func columnList(cols []*schema.Column) string {
+	names := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = quote(c.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// scanner returns a destination for scanning a column of the given type which
+// works the same way across every database Storyden supports.
+func scanner(c *schema.Column) any {
+	switch c.Type {
+	case field.TypeTime:
+		return &sql.NullTime{}
+	case field.TypeBool:
+		return &sql.NullBool{}
+	case field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt64,
+		field.TypeUint, field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint64:
+		return &sql.NullInt64{}
+	case field.TypeFloat32, field.TypeFloat64:
+		return &sql.NullFloat64{}
+	case field.TypeBytes:
+		return &[]byte{}
+	default:
+		return &sql.NullString{}
+	}
+}
+
+// encode turns a scanned column value into its archive representation. JSON
+// columns are embedded as-is rather than as strings so archives are readable.
+func encode(c *schema.Column, v any) (json.RawMessage, error) {
+	var value any
+	switch v := v.(type) {
+	case *sql.NullTime:
+		if v.Valid {
+			value = v.Time.UTC().Format(time.RFC3339Nano)
+		}
+	case *sql.NullBool:
+		if v.Valid {
+			value = v.Bool
+		}
+	case *sql.NullInt64:
+		if v.Valid {
+			value = v.Int64
+		}
+	case *sql.NullFloat64:
+		if v.Valid {
+			value = v.Float64
+		}
+	case *[]byte:
+		if *v != nil {
+			value = *v
+		}
+	case *sql.NullString:
+		if !v.Valid {
+			break
+		}
+		if c.Type == field.TypeJSON {
+			if !json.Valid([]byte(v.String)) {
+				return nil, fault.Newf("column %s holds invalid JSON", c.Name)
+			}
+			return json.RawMessage(v.String), nil
+		}
+		value = v.String
+	}
+
+	return json.Marshal(value)
+}
+
+// decode turns an archived column value back into a value for the database.
+func decode(c *schema.Column, raw json.RawMessage) (any, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	switch c.Type {
+	case field.TypeTime:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, fault.Wrap(err)
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		return t, nil
+
+	case field.TypeBool:
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return nil, fault.Wrap(err)
+		}
+		return b, nil
+
+	case field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt64,
+		field.TypeUint, field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint64:
+		var i int64
+		if err := json.Unmarshal(raw, &i); err != nil {
+			return nil, fault.Wrap(err)
+		}
+		return i, nil
+
+	case field.TypeFloat32, field.TypeFloat64:
+		var f float64
+		if err := json.Unmarshal(raw, &f); err != nil {
+			return nil, fault.Wrap(err)
+		}
+		return f, nil
+
+	case field.TypeBytes:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, fault.Wrap(err)
+		}
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, fault.Wrap(err)
+		}
+		return b, nil
+
+	case field.TypeJSON:
+		return string(raw), nil
+
+	default:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, fault.Wrap(err)
+		}
+		return s, nil
+	}
+}
@@ -0,0 +1,38 @@
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Southclaws/storyden/internal/ent/migrate"
+)
+
+func TestPlan(t *testing.T) {
+	a := assert.New(t)
+
+	tables := plan()
+	a.Len(tables, len(migrate.Tables))
+
+	position := map[string]int{}
+	for i, t := range tables {
+		position[t.Name] = i
+	}
+
+	for _, t := range tables {
+		deferred := map[string]bool{}
+		for _, c := range t.deferred {
+			// Deferred references are inserted as NULL and set afterwards.
+			a.True(c.Nullable, "%s.%s is deferred but not nullable", t.Name, c.Name)
+			deferred[c.Name] = true
+		}
+
+		for _, fk := range t.ForeignKeys {
+			for _, c := range fk.Columns {
+				if !deferred[c.Name] {
+					a.Less(position[fk.RefTable.Name], position[t.Name], "%s.%s is restored before %s", t.Name, c.Name, fk.RefTable.Name)
+				}
+			}
+		}
+	}
+}
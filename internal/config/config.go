@@ -146,6 +146,7 @@ type Config struct {
 
 	   - unset (default) for no email sending. Email sending is not a requirement for a production deployment.
 	   - `sendgrid` for SendGrid based email sending.
+	   - `smtp` for sending via any SMTP server, such as a self-hosted mail server or a transactional email service's SMTP relay.
 	   - `mock` for logging emails to the console. Only useful for Storyden developers and testing.
 	*/
 	EmailProvider string `envconfig:"EMAIL_PROVIDER"`
@@ -167,6 +168,34 @@ type Config struct {
 	   This is typically a long string of characters that you can generate in the SendGrid dashboard.
 	*/
 	SendGridAPIKey string `envconfig:"SENDGRID_API_KEY"`
+	// The hostname of the SMTP server to send emails through. Required when `EMAIL_PROVIDER` is set to `smtp`.
+	SMTPHost string `envconfig:"SMTP_HOST"`
+	// The port of the SMTP server. This is usually `587` for STARTTLS, `465` for implicit TLS or `25` for unencrypted connections.
+	SMTPPort int `default:"587" envconfig:"SMTP_PORT"`
+	// The username used to authenticate with the SMTP server. If unset, no authentication is performed.
+	SMTPUsername string `envconfig:"SMTP_USERNAME"`
+	// The password used to authenticate with the SMTP server.
+	SMTPPassword string `envconfig:"SMTP_PASSWORD"`
+	/*
+	   How the connection to the SMTP server is secured. Either:
+
+	   - `starttls` (default) connects in plain text and upgrades the connection with the STARTTLS command. The server must support STARTTLS.
+	   - `implicit` connects over TLS from the start, sometimes referred to as SMTPS.
+	   - `none` sends emails over an unencrypted connection. Only use this for a mail server on a trusted local network.
+	*/
+	SMTPTLSMode string `default:"starttls" envconfig:"SMTP_TLS_MODE"`
+	/*
+	   The name that will be used as the sender name for emails sent via SMTP.
+
+	   This is typically the name of your community or organisation.
+	*/
+	SMTPFromName string `envconfig:"SMTP_FROM_NAME"`
+	/*
+	   The email address that will be used as the sender address for emails sent via SMTP. Required when `EMAIL_PROVIDER` is set to `smtp`.
+
+	   This is typically a no-reply address, such as `no-reply@<your-domain>`.
+	*/
+	SMTPFromAddress string `envconfig:"SMTP_FROM_ADDRESS"`
 
 	// -
 	// Authentication
@@ -263,6 +292,14 @@ type Config struct {
 	S3AccessKey string `envconfig:"S3_ACCESS_KEY"`
 	// The secret key for the S3-compatible storage provider.
 	S3SecretKey string `envconfig:"S3_SECRET_KEY"`
+	/*
+	   How often to remove orphaned files from asset storage. An orphaned file is either a stored file with no asset record or an asset which nothing on the site uses any more, such as an image removed from a library page or attached to a deleted post.
+
+	   Set to `0` to disable the periodic cleanup, administrators can still run it manually from the admin API.
+	*/
+	AssetGCInterval time.Duration `default:"24h" envconfig:"ASSET_GC_INTERVAL"`
+	// How old an orphaned file or asset must be before it's removed. This prevents removing uploads which have not been attached to a post or page yet, such as while a member is still writing a draft.
+	AssetGCGracePeriod time.Duration `default:"72h" envconfig:"ASSET_GC_GRACE_PERIOD"`
 
 	// -
 	// Cache
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/Southclaws/storyden/internal/ent/account"
 	"github.com/Southclaws/storyden/internal/ent/invitation"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigest"
 	"github.com/Southclaws/storyden/internal/ent/schema"
 	"github.com/rs/xid"
 )
@@ -45,6 +46,16 @@ type Account struct {
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// InvitedByID holds the value of the "invited_by_id" field.
 	InvitedByID *xid.ID `json:"invited_by_id,omitempty"`
+	// SuspendedReason holds the value of the "suspended_reason" field.
+	SuspendedReason *string `json:"suspended_reason,omitempty"`
+	// SuspendedUntil holds the value of the "suspended_until" field.
+	SuspendedUntil *time.Time `json:"suspended_until,omitempty"`
+	// SuspendedByID holds the value of the "suspended_by_id" field.
+	SuspendedByID *xid.ID `json:"suspended_by_id,omitempty"`
+	// DeletionScheduledAt holds the value of the "deletion_scheduled_at" field.
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+	// CalendarToken holds the value of the "calendar_token" field.
+	CalendarToken *string `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AccountQuery when eager-loading is set.
 	Edges        AccountEdges `json:"edges"`
@@ -99,11 +110,33 @@ type AccountEdges struct {
 	Reports []*Report `json:"reports,omitempty"`
 	// HandledReports holds the value of the handled_reports edge.
 	HandledReports []*Report `json:"handled_reports,omitempty"`
+	// AuditLogs holds the value of the audit_logs edge.
+	AuditLogs []*AuditLog `json:"audit_logs,omitempty"`
+	// PostRevisions holds the value of the post_revisions edge.
+	PostRevisions []*PostRevision `json:"post_revisions,omitempty"`
+	// NodeRevisions holds the value of the node_revisions edge.
+	NodeRevisions []*NodeRevision `json:"node_revisions,omitempty"`
+	// NotificationPreferences holds the value of the notification_preferences edge.
+	NotificationPreferences []*NotificationPreference `json:"notification_preferences,omitempty"`
+	// NotificationDigest holds the value of the notification_digest edge.
+	NotificationDigest *NotificationDigest `json:"notification_digest,omitempty"`
+	// PollVotes holds the value of the poll_votes edge.
+	PollVotes []*PollVote `json:"poll_votes,omitempty"`
+	// Conversations holds the value of the conversations edge.
+	Conversations []*ConversationParticipant `json:"conversations,omitempty"`
+	// ConversationMessages holds the value of the conversation_messages edge.
+	ConversationMessages []*ConversationMessage `json:"conversation_messages,omitempty"`
+	// Blocks holds the value of the blocks edge.
+	Blocks []*AccountBlock `json:"blocks,omitempty"`
+	// BlockedBy holds the value of the blocked_by edge.
+	BlockedBy []*AccountBlock `json:"blocked_by,omitempty"`
+	// PublishSchedules holds the value of the publish_schedules edge.
+	PublishSchedules []*PublishSchedule `json:"publish_schedules,omitempty"`
 	// AccountRoles holds the value of the account_roles edge.
 	AccountRoles []*AccountRoles `json:"account_roles,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [24]bool
+	loadedTypes [35]bool
 }
 
 // SessionsOrErr returns the Sessions value or an error if the edge
@@ -315,10 +348,111 @@ func (e AccountEdges) HandledReportsOrErr() ([]*Report, error) {
 	return nil, &NotLoadedError{edge: "handled_reports"}
 }
 
+// AuditLogsOrErr returns the AuditLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) AuditLogsOrErr() ([]*AuditLog, error) {
+	if e.loadedTypes[23] {
+		return e.AuditLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "audit_logs"}
+}
+
+// PostRevisionsOrErr returns the PostRevisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) PostRevisionsOrErr() ([]*PostRevision, error) {
+	if e.loadedTypes[24] {
+		return e.PostRevisions, nil
+	}
+	return nil, &NotLoadedError{edge: "post_revisions"}
+}
+
+// NodeRevisionsOrErr returns the NodeRevisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) NodeRevisionsOrErr() ([]*NodeRevision, error) {
+	if e.loadedTypes[25] {
+		return e.NodeRevisions, nil
+	}
+	return nil, &NotLoadedError{edge: "node_revisions"}
+}
+
+// NotificationPreferencesOrErr returns the NotificationPreferences value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) NotificationPreferencesOrErr() ([]*NotificationPreference, error) {
+	if e.loadedTypes[26] {
+		return e.NotificationPreferences, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_preferences"}
+}
+
+// NotificationDigestOrErr returns the NotificationDigest value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountEdges) NotificationDigestOrErr() (*NotificationDigest, error) {
+	if e.NotificationDigest != nil {
+		return e.NotificationDigest, nil
+	} else if e.loadedTypes[27] {
+		return nil, &NotFoundError{label: notificationdigest.Label}
+	}
+	return nil, &NotLoadedError{edge: "notification_digest"}
+}
+
+// PollVotesOrErr returns the PollVotes value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) PollVotesOrErr() ([]*PollVote, error) {
+	if e.loadedTypes[28] {
+		return e.PollVotes, nil
+	}
+	return nil, &NotLoadedError{edge: "poll_votes"}
+}
+
+// ConversationsOrErr returns the Conversations value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) ConversationsOrErr() ([]*ConversationParticipant, error) {
+	if e.loadedTypes[29] {
+		return e.Conversations, nil
+	}
+	return nil, &NotLoadedError{edge: "conversations"}
+}
+
+// ConversationMessagesOrErr returns the ConversationMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) ConversationMessagesOrErr() ([]*ConversationMessage, error) {
+	if e.loadedTypes[30] {
+		return e.ConversationMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "conversation_messages"}
+}
+
+// BlocksOrErr returns the Blocks value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) BlocksOrErr() ([]*AccountBlock, error) {
+	if e.loadedTypes[31] {
+		return e.Blocks, nil
+	}
+	return nil, &NotLoadedError{edge: "blocks"}
+}
+
+// BlockedByOrErr returns the BlockedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) BlockedByOrErr() ([]*AccountBlock, error) {
+	if e.loadedTypes[32] {
+		return e.BlockedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_by"}
+}
+
+// PublishSchedulesOrErr returns the PublishSchedules value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) PublishSchedulesOrErr() ([]*PublishSchedule, error) {
+	if e.loadedTypes[33] {
+		return e.PublishSchedules, nil
+	}
+	return nil, &NotLoadedError{edge: "publish_schedules"}
+}
+
 // AccountRolesOrErr returns the AccountRoles value or an error if the edge
 // was not loaded in eager-loading.
 func (e AccountEdges) AccountRolesOrErr() ([]*AccountRoles, error) {
-	if e.loadedTypes[23] {
+	if e.loadedTypes[34] {
 		return e.AccountRoles, nil
 	}
 	return nil, &NotLoadedError{edge: "account_roles"}
@@ -329,15 +463,15 @@ func (*Account) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case account.FieldInvitedByID:
+		case account.FieldInvitedByID, account.FieldSuspendedByID:
 			values[i] = &sql.NullScanner{S: new(xid.ID)}
 		case account.FieldLinks, account.FieldMetadata:
 			values[i] = new([]byte)
 		case account.FieldAdmin:
 			values[i] = new(sql.NullBool)
-		case account.FieldHandle, account.FieldName, account.FieldBio, account.FieldKind:
+		case account.FieldHandle, account.FieldName, account.FieldBio, account.FieldKind, account.FieldSuspendedReason, account.FieldCalendarToken:
 			values[i] = new(sql.NullString)
-		case account.FieldCreatedAt, account.FieldUpdatedAt, account.FieldDeletedAt, account.FieldIndexedAt:
+		case account.FieldCreatedAt, account.FieldUpdatedAt, account.FieldDeletedAt, account.FieldIndexedAt, account.FieldSuspendedUntil, account.FieldDeletionScheduledAt:
 			values[i] = new(sql.NullTime)
 		case account.FieldID:
 			values[i] = new(xid.ID)
@@ -441,6 +575,41 @@ func (_m *Account) assignValues(columns []string, values []any) error {
 				_m.InvitedByID = new(xid.ID)
 				*_m.InvitedByID = *value.S.(*xid.ID)
 			}
+		case account.FieldSuspendedReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_reason", values[i])
+			} else if value.Valid {
+				_m.SuspendedReason = new(string)
+				*_m.SuspendedReason = value.String
+			}
+		case account.FieldSuspendedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_until", values[i])
+			} else if value.Valid {
+				_m.SuspendedUntil = new(time.Time)
+				*_m.SuspendedUntil = value.Time
+			}
+		case account.FieldSuspendedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_by_id", values[i])
+			} else if value.Valid {
+				_m.SuspendedByID = new(xid.ID)
+				*_m.SuspendedByID = *value.S.(*xid.ID)
+			}
+		case account.FieldDeletionScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_scheduled_at", values[i])
+			} else if value.Valid {
+				_m.DeletionScheduledAt = new(time.Time)
+				*_m.DeletionScheduledAt = value.Time
+			}
+		case account.FieldCalendarToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field calendar_token", values[i])
+			} else if value.Valid {
+				_m.CalendarToken = new(string)
+				*_m.CalendarToken = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -569,6 +738,61 @@ func (_m *Account) QueryHandledReports() *ReportQuery {
 	return NewAccountClient(_m.config).QueryHandledReports(_m)
 }
 
+// QueryAuditLogs queries the "audit_logs" edge of the Account entity.
+func (_m *Account) QueryAuditLogs() *AuditLogQuery {
+	return NewAccountClient(_m.config).QueryAuditLogs(_m)
+}
+
+// QueryPostRevisions queries the "post_revisions" edge of the Account entity.
+func (_m *Account) QueryPostRevisions() *PostRevisionQuery {
+	return NewAccountClient(_m.config).QueryPostRevisions(_m)
+}
+
+// QueryNodeRevisions queries the "node_revisions" edge of the Account entity.
+func (_m *Account) QueryNodeRevisions() *NodeRevisionQuery {
+	return NewAccountClient(_m.config).QueryNodeRevisions(_m)
+}
+
+// QueryNotificationPreferences queries the "notification_preferences" edge of the Account entity.
+func (_m *Account) QueryNotificationPreferences() *NotificationPreferenceQuery {
+	return NewAccountClient(_m.config).QueryNotificationPreferences(_m)
+}
+
+// QueryNotificationDigest queries the "notification_digest" edge of the Account entity.
+func (_m *Account) QueryNotificationDigest() *NotificationDigestQuery {
+	return NewAccountClient(_m.config).QueryNotificationDigest(_m)
+}
+
+// QueryPollVotes queries the "poll_votes" edge of the Account entity.
+func (_m *Account) QueryPollVotes() *PollVoteQuery {
+	return NewAccountClient(_m.config).QueryPollVotes(_m)
+}
+
+// QueryConversations queries the "conversations" edge of the Account entity.
+func (_m *Account) QueryConversations() *ConversationParticipantQuery {
+	return NewAccountClient(_m.config).QueryConversations(_m)
+}
+
+// QueryConversationMessages queries the "conversation_messages" edge of the Account entity.
+func (_m *Account) QueryConversationMessages() *ConversationMessageQuery {
+	return NewAccountClient(_m.config).QueryConversationMessages(_m)
+}
+
+// QueryBlocks queries the "blocks" edge of the Account entity.
+func (_m *Account) QueryBlocks() *AccountBlockQuery {
+	return NewAccountClient(_m.config).QueryBlocks(_m)
+}
+
+// QueryBlockedBy queries the "blocked_by" edge of the Account entity.
+func (_m *Account) QueryBlockedBy() *AccountBlockQuery {
+	return NewAccountClient(_m.config).QueryBlockedBy(_m)
+}
+
+// QueryPublishSchedules queries the "publish_schedules" edge of the Account entity.
+func (_m *Account) QueryPublishSchedules() *PublishScheduleQuery {
+	return NewAccountClient(_m.config).QueryPublishSchedules(_m)
+}
+
 // QueryAccountRoles queries the "account_roles" edge of the Account entity.
 func (_m *Account) QueryAccountRoles() *AccountRolesQuery {
 	return NewAccountClient(_m.config).QueryAccountRoles(_m)
@@ -638,6 +862,28 @@ func (_m *Account) String() string {
 		builder.WriteString("invited_by_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := _m.SuspendedReason; v != nil {
+		builder.WriteString("suspended_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.SuspendedUntil; v != nil {
+		builder.WriteString("suspended_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.SuspendedByID; v != nil {
+		builder.WriteString("suspended_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletionScheduledAt; v != nil {
+		builder.WriteString("deletion_scheduled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("calendar_token=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
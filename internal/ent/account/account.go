@@ -40,6 +40,16 @@ const (
 	FieldMetadata = "metadata"
 	// FieldInvitedByID holds the string denoting the invited_by_id field in the database.
 	FieldInvitedByID = "invited_by_id"
+	// FieldSuspendedReason holds the string denoting the suspended_reason field in the database.
+	FieldSuspendedReason = "suspended_reason"
+	// FieldSuspendedUntil holds the string denoting the suspended_until field in the database.
+	FieldSuspendedUntil = "suspended_until"
+	// FieldSuspendedByID holds the string denoting the suspended_by_id field in the database.
+	FieldSuspendedByID = "suspended_by_id"
+	// FieldDeletionScheduledAt holds the string denoting the deletion_scheduled_at field in the database.
+	FieldDeletionScheduledAt = "deletion_scheduled_at"
+	// FieldCalendarToken holds the string denoting the calendar_token field in the database.
+	FieldCalendarToken = "calendar_token"
 	// EdgeSessions holds the string denoting the sessions edge name in mutations.
 	EdgeSessions = "sessions"
 	// EdgeEmails holds the string denoting the emails edge name in mutations.
@@ -86,6 +96,28 @@ const (
 	EdgeReports = "reports"
 	// EdgeHandledReports holds the string denoting the handled_reports edge name in mutations.
 	EdgeHandledReports = "handled_reports"
+	// EdgeAuditLogs holds the string denoting the audit_logs edge name in mutations.
+	EdgeAuditLogs = "audit_logs"
+	// EdgePostRevisions holds the string denoting the post_revisions edge name in mutations.
+	EdgePostRevisions = "post_revisions"
+	// EdgeNodeRevisions holds the string denoting the node_revisions edge name in mutations.
+	EdgeNodeRevisions = "node_revisions"
+	// EdgeNotificationPreferences holds the string denoting the notification_preferences edge name in mutations.
+	EdgeNotificationPreferences = "notification_preferences"
+	// EdgeNotificationDigest holds the string denoting the notification_digest edge name in mutations.
+	EdgeNotificationDigest = "notification_digest"
+	// EdgePollVotes holds the string denoting the poll_votes edge name in mutations.
+	EdgePollVotes = "poll_votes"
+	// EdgeConversations holds the string denoting the conversations edge name in mutations.
+	EdgeConversations = "conversations"
+	// EdgeConversationMessages holds the string denoting the conversation_messages edge name in mutations.
+	EdgeConversationMessages = "conversation_messages"
+	// EdgeBlocks holds the string denoting the blocks edge name in mutations.
+	EdgeBlocks = "blocks"
+	// EdgeBlockedBy holds the string denoting the blocked_by edge name in mutations.
+	EdgeBlockedBy = "blocked_by"
+	// EdgePublishSchedules holds the string denoting the publish_schedules edge name in mutations.
+	EdgePublishSchedules = "publish_schedules"
 	// EdgeAccountRoles holds the string denoting the account_roles edge name in mutations.
 	EdgeAccountRoles = "account_roles"
 	// Table holds the table name of the account in the database.
@@ -247,6 +279,83 @@ const (
 	HandledReportsInverseTable = "reports"
 	// HandledReportsColumn is the table column denoting the handled_reports relation/edge.
 	HandledReportsColumn = "handled_by_id"
+	// AuditLogsTable is the table that holds the audit_logs relation/edge.
+	AuditLogsTable = "audit_logs"
+	// AuditLogsInverseTable is the table name for the AuditLog entity.
+	// It exists in this package in order to avoid circular dependency with the "auditlog" package.
+	AuditLogsInverseTable = "audit_logs"
+	// AuditLogsColumn is the table column denoting the audit_logs relation/edge.
+	AuditLogsColumn = "actor_id"
+	// PostRevisionsTable is the table that holds the post_revisions relation/edge.
+	PostRevisionsTable = "post_revisions"
+	// PostRevisionsInverseTable is the table name for the PostRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "postrevision" package.
+	PostRevisionsInverseTable = "post_revisions"
+	// PostRevisionsColumn is the table column denoting the post_revisions relation/edge.
+	PostRevisionsColumn = "account_id"
+	// NodeRevisionsTable is the table that holds the node_revisions relation/edge.
+	NodeRevisionsTable = "node_revisions"
+	// NodeRevisionsInverseTable is the table name for the NodeRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "noderevision" package.
+	NodeRevisionsInverseTable = "node_revisions"
+	// NodeRevisionsColumn is the table column denoting the node_revisions relation/edge.
+	NodeRevisionsColumn = "account_id"
+	// NotificationPreferencesTable is the table that holds the notification_preferences relation/edge.
+	NotificationPreferencesTable = "notification_preferences"
+	// NotificationPreferencesInverseTable is the table name for the NotificationPreference entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationpreference" package.
+	NotificationPreferencesInverseTable = "notification_preferences"
+	// NotificationPreferencesColumn is the table column denoting the notification_preferences relation/edge.
+	NotificationPreferencesColumn = "account_id"
+	// NotificationDigestTable is the table that holds the notification_digest relation/edge.
+	NotificationDigestTable = "notification_digests"
+	// NotificationDigestInverseTable is the table name for the NotificationDigest entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationdigest" package.
+	NotificationDigestInverseTable = "notification_digests"
+	// NotificationDigestColumn is the table column denoting the notification_digest relation/edge.
+	NotificationDigestColumn = "account_id"
+	// PollVotesTable is the table that holds the poll_votes relation/edge.
+	PollVotesTable = "poll_votes"
+	// PollVotesInverseTable is the table name for the PollVote entity.
+	// It exists in this package in order to avoid circular dependency with the "pollvote" package.
+	PollVotesInverseTable = "poll_votes"
+	// PollVotesColumn is the table column denoting the poll_votes relation/edge.
+	PollVotesColumn = "account_id"
+	// ConversationsTable is the table that holds the conversations relation/edge.
+	ConversationsTable = "conversation_participants"
+	// ConversationsInverseTable is the table name for the ConversationParticipant entity.
+	// It exists in this package in order to avoid circular dependency with the "conversationparticipant" package.
+	ConversationsInverseTable = "conversation_participants"
+	// ConversationsColumn is the table column denoting the conversations relation/edge.
+	ConversationsColumn = "account_id"
+	// ConversationMessagesTable is the table that holds the conversation_messages relation/edge.
+	ConversationMessagesTable = "conversation_messages"
+	// ConversationMessagesInverseTable is the table name for the ConversationMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "conversationmessage" package.
+	ConversationMessagesInverseTable = "conversation_messages"
+	// ConversationMessagesColumn is the table column denoting the conversation_messages relation/edge.
+	ConversationMessagesColumn = "account_id"
+	// BlocksTable is the table that holds the blocks relation/edge.
+	BlocksTable = "account_blocks"
+	// BlocksInverseTable is the table name for the AccountBlock entity.
+	// It exists in this package in order to avoid circular dependency with the "accountblock" package.
+	BlocksInverseTable = "account_blocks"
+	// BlocksColumn is the table column denoting the blocks relation/edge.
+	BlocksColumn = "account_id"
+	// BlockedByTable is the table that holds the blocked_by relation/edge.
+	BlockedByTable = "account_blocks"
+	// BlockedByInverseTable is the table name for the AccountBlock entity.
+	// It exists in this package in order to avoid circular dependency with the "accountblock" package.
+	BlockedByInverseTable = "account_blocks"
+	// BlockedByColumn is the table column denoting the blocked_by relation/edge.
+	BlockedByColumn = "blocked_account_id"
+	// PublishSchedulesTable is the table that holds the publish_schedules relation/edge.
+	PublishSchedulesTable = "publish_schedules"
+	// PublishSchedulesInverseTable is the table name for the PublishSchedule entity.
+	// It exists in this package in order to avoid circular dependency with the "publishschedule" package.
+	PublishSchedulesInverseTable = "publish_schedules"
+	// PublishSchedulesColumn is the table column denoting the publish_schedules relation/edge.
+	PublishSchedulesColumn = "account_id"
 	// AccountRolesTable is the table that holds the account_roles relation/edge.
 	AccountRolesTable = "account_roles"
 	// AccountRolesInverseTable is the table name for the AccountRoles entity.
@@ -271,6 +380,11 @@ var Columns = []string{
 	FieldLinks,
 	FieldMetadata,
 	FieldInvitedByID,
+	FieldSuspendedReason,
+	FieldSuspendedUntil,
+	FieldSuspendedByID,
+	FieldDeletionScheduledAt,
+	FieldCalendarToken,
 }
 
 var (
@@ -395,6 +509,31 @@ func ByInvitedByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInvitedByID, opts...).ToFunc()
 }
 
+// BySuspendedReason orders the results by the suspended_reason field.
+func BySuspendedReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspendedReason, opts...).ToFunc()
+}
+
+// BySuspendedUntil orders the results by the suspended_until field.
+func BySuspendedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspendedUntil, opts...).ToFunc()
+}
+
+// BySuspendedByID orders the results by the suspended_by_id field.
+func BySuspendedByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspendedByID, opts...).ToFunc()
+}
+
+// ByDeletionScheduledAt orders the results by the deletion_scheduled_at field.
+func ByDeletionScheduledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionScheduledAt, opts...).ToFunc()
+}
+
+// ByCalendarToken orders the results by the calendar_token field.
+func ByCalendarToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCalendarToken, opts...).ToFunc()
+}
+
 // BySessionsCount orders the results by sessions count.
 func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -710,6 +849,153 @@ func ByHandledReports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByAuditLogsCount orders the results by audit_logs count.
+func ByAuditLogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAuditLogsStep(), opts...)
+	}
+}
+
+// ByAuditLogs orders the results by audit_logs terms.
+func ByAuditLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuditLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPostRevisionsCount orders the results by post_revisions count.
+func ByPostRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPostRevisionsStep(), opts...)
+	}
+}
+
+// ByPostRevisions orders the results by post_revisions terms.
+func ByPostRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPostRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNodeRevisionsCount orders the results by node_revisions count.
+func ByNodeRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNodeRevisionsStep(), opts...)
+	}
+}
+
+// ByNodeRevisions orders the results by node_revisions terms.
+func ByNodeRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNodeRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationPreferencesCount orders the results by notification_preferences count.
+func ByNotificationPreferencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationPreferencesStep(), opts...)
+	}
+}
+
+// ByNotificationPreferences orders the results by notification_preferences terms.
+func ByNotificationPreferences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationPreferencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationDigestField orders the results by notification_digest field.
+func ByNotificationDigestField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationDigestStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPollVotesCount orders the results by poll_votes count.
+func ByPollVotesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPollVotesStep(), opts...)
+	}
+}
+
+// ByPollVotes orders the results by poll_votes terms.
+func ByPollVotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPollVotesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByConversationsCount orders the results by conversations count.
+func ByConversationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newConversationsStep(), opts...)
+	}
+}
+
+// ByConversations orders the results by conversations terms.
+func ByConversations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConversationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByConversationMessagesCount orders the results by conversation_messages count.
+func ByConversationMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newConversationMessagesStep(), opts...)
+	}
+}
+
+// ByConversationMessages orders the results by conversation_messages terms.
+func ByConversationMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConversationMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlocksCount orders the results by blocks count.
+func ByBlocksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlocksStep(), opts...)
+	}
+}
+
+// ByBlocks orders the results by blocks terms.
+func ByBlocks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlocksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockedByCount orders the results by blocked_by count.
+func ByBlockedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedByStep(), opts...)
+	}
+}
+
+// ByBlockedBy orders the results by blocked_by terms.
+func ByBlockedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPublishSchedulesCount orders the results by publish_schedules count.
+func ByPublishSchedulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPublishSchedulesStep(), opts...)
+	}
+}
+
+// ByPublishSchedules orders the results by publish_schedules terms.
+func ByPublishSchedules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPublishSchedulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByAccountRolesCount orders the results by account_roles count.
 func ByAccountRolesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -884,6 +1170,83 @@ func newHandledReportsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, HandledReportsTable, HandledReportsColumn),
 	)
 }
+func newAuditLogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuditLogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AuditLogsTable, AuditLogsColumn),
+	)
+}
+func newPostRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PostRevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PostRevisionsTable, PostRevisionsColumn),
+	)
+}
+func newNodeRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NodeRevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NodeRevisionsTable, NodeRevisionsColumn),
+	)
+}
+func newNotificationPreferencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationPreferencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationPreferencesTable, NotificationPreferencesColumn),
+	)
+}
+func newNotificationDigestStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationDigestInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, NotificationDigestTable, NotificationDigestColumn),
+	)
+}
+func newPollVotesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PollVotesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PollVotesTable, PollVotesColumn),
+	)
+}
+func newConversationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConversationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ConversationsTable, ConversationsColumn),
+	)
+}
+func newConversationMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConversationMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ConversationMessagesTable, ConversationMessagesColumn),
+	)
+}
+func newBlocksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlocksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BlocksTable, BlocksColumn),
+	)
+}
+func newBlockedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BlockedByTable, BlockedByColumn),
+	)
+}
+func newPublishSchedulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PublishSchedulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PublishSchedulesTable, PublishSchedulesColumn),
+	)
+}
 func newAccountRolesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
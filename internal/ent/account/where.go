@@ -101,6 +101,31 @@ func InvitedByID(v xid.ID) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldInvitedByID, v))
 }
 
+// SuspendedReason applies equality check predicate on the "suspended_reason" field. It's identical to SuspendedReasonEQ.
+func SuspendedReason(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldSuspendedReason, v))
+}
+
+// SuspendedUntil applies equality check predicate on the "suspended_until" field. It's identical to SuspendedUntilEQ.
+func SuspendedUntil(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldSuspendedUntil, v))
+}
+
+// SuspendedByID applies equality check predicate on the "suspended_by_id" field. It's identical to SuspendedByIDEQ.
+func SuspendedByID(v xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldSuspendedByID, v))
+}
+
+// DeletionScheduledAt applies equality check predicate on the "deletion_scheduled_at" field. It's identical to DeletionScheduledAtEQ.
+func DeletionScheduledAt(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldDeletionScheduledAt, v))
+}
+
+// CalendarToken applies equality check predicate on the "calendar_token" field. It's identical to CalendarTokenEQ.
+func CalendarToken(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCalendarToken, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldCreatedAt, v))
@@ -616,6 +641,336 @@ func InvitedByIDContainsFold(v xid.ID) predicate.Account {
 	return predicate.Account(sql.FieldContainsFold(FieldInvitedByID, vc))
 }
 
+// SuspendedReasonEQ applies the EQ predicate on the "suspended_reason" field.
+func SuspendedReasonEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldSuspendedReason, v))
+}
+
+// SuspendedReasonNEQ applies the NEQ predicate on the "suspended_reason" field.
+func SuspendedReasonNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldSuspendedReason, v))
+}
+
+// SuspendedReasonIn applies the In predicate on the "suspended_reason" field.
+func SuspendedReasonIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldSuspendedReason, vs...))
+}
+
+// SuspendedReasonNotIn applies the NotIn predicate on the "suspended_reason" field.
+func SuspendedReasonNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldSuspendedReason, vs...))
+}
+
+// SuspendedReasonGT applies the GT predicate on the "suspended_reason" field.
+func SuspendedReasonGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldSuspendedReason, v))
+}
+
+// SuspendedReasonGTE applies the GTE predicate on the "suspended_reason" field.
+func SuspendedReasonGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldSuspendedReason, v))
+}
+
+// SuspendedReasonLT applies the LT predicate on the "suspended_reason" field.
+func SuspendedReasonLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldSuspendedReason, v))
+}
+
+// SuspendedReasonLTE applies the LTE predicate on the "suspended_reason" field.
+func SuspendedReasonLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldSuspendedReason, v))
+}
+
+// SuspendedReasonContains applies the Contains predicate on the "suspended_reason" field.
+func SuspendedReasonContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldSuspendedReason, v))
+}
+
+// SuspendedReasonHasPrefix applies the HasPrefix predicate on the "suspended_reason" field.
+func SuspendedReasonHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldSuspendedReason, v))
+}
+
+// SuspendedReasonHasSuffix applies the HasSuffix predicate on the "suspended_reason" field.
+func SuspendedReasonHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldSuspendedReason, v))
+}
+
+// SuspendedReasonIsNil applies the IsNil predicate on the "suspended_reason" field.
+func SuspendedReasonIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldSuspendedReason))
+}
+
+// SuspendedReasonNotNil applies the NotNil predicate on the "suspended_reason" field.
+func SuspendedReasonNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldSuspendedReason))
+}
+
+// SuspendedReasonEqualFold applies the EqualFold predicate on the "suspended_reason" field.
+func SuspendedReasonEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldSuspendedReason, v))
+}
+
+// SuspendedReasonContainsFold applies the ContainsFold predicate on the "suspended_reason" field.
+func SuspendedReasonContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldSuspendedReason, v))
+}
+
+// SuspendedUntilEQ applies the EQ predicate on the "suspended_until" field.
+func SuspendedUntilEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilNEQ applies the NEQ predicate on the "suspended_until" field.
+func SuspendedUntilNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilIn applies the In predicate on the "suspended_until" field.
+func SuspendedUntilIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldSuspendedUntil, vs...))
+}
+
+// SuspendedUntilNotIn applies the NotIn predicate on the "suspended_until" field.
+func SuspendedUntilNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldSuspendedUntil, vs...))
+}
+
+// SuspendedUntilGT applies the GT predicate on the "suspended_until" field.
+func SuspendedUntilGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilGTE applies the GTE predicate on the "suspended_until" field.
+func SuspendedUntilGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilLT applies the LT predicate on the "suspended_until" field.
+func SuspendedUntilLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilLTE applies the LTE predicate on the "suspended_until" field.
+func SuspendedUntilLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilIsNil applies the IsNil predicate on the "suspended_until" field.
+func SuspendedUntilIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldSuspendedUntil))
+}
+
+// SuspendedUntilNotNil applies the NotNil predicate on the "suspended_until" field.
+func SuspendedUntilNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldSuspendedUntil))
+}
+
+// SuspendedByIDEQ applies the EQ predicate on the "suspended_by_id" field.
+func SuspendedByIDEQ(v xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldSuspendedByID, v))
+}
+
+// SuspendedByIDNEQ applies the NEQ predicate on the "suspended_by_id" field.
+func SuspendedByIDNEQ(v xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldSuspendedByID, v))
+}
+
+// SuspendedByIDIn applies the In predicate on the "suspended_by_id" field.
+func SuspendedByIDIn(vs ...xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldSuspendedByID, vs...))
+}
+
+// SuspendedByIDNotIn applies the NotIn predicate on the "suspended_by_id" field.
+func SuspendedByIDNotIn(vs ...xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldSuspendedByID, vs...))
+}
+
+// SuspendedByIDGT applies the GT predicate on the "suspended_by_id" field.
+func SuspendedByIDGT(v xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldSuspendedByID, v))
+}
+
+// SuspendedByIDGTE applies the GTE predicate on the "suspended_by_id" field.
+func SuspendedByIDGTE(v xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldSuspendedByID, v))
+}
+
+// SuspendedByIDLT applies the LT predicate on the "suspended_by_id" field.
+func SuspendedByIDLT(v xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldSuspendedByID, v))
+}
+
+// SuspendedByIDLTE applies the LTE predicate on the "suspended_by_id" field.
+func SuspendedByIDLTE(v xid.ID) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldSuspendedByID, v))
+}
+
+// SuspendedByIDContains applies the Contains predicate on the "suspended_by_id" field.
+func SuspendedByIDContains(v xid.ID) predicate.Account {
+	vc := v.String()
+	return predicate.Account(sql.FieldContains(FieldSuspendedByID, vc))
+}
+
+// SuspendedByIDHasPrefix applies the HasPrefix predicate on the "suspended_by_id" field.
+func SuspendedByIDHasPrefix(v xid.ID) predicate.Account {
+	vc := v.String()
+	return predicate.Account(sql.FieldHasPrefix(FieldSuspendedByID, vc))
+}
+
+// SuspendedByIDHasSuffix applies the HasSuffix predicate on the "suspended_by_id" field.
+func SuspendedByIDHasSuffix(v xid.ID) predicate.Account {
+	vc := v.String()
+	return predicate.Account(sql.FieldHasSuffix(FieldSuspendedByID, vc))
+}
+
+// SuspendedByIDIsNil applies the IsNil predicate on the "suspended_by_id" field.
+func SuspendedByIDIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldSuspendedByID))
+}
+
+// SuspendedByIDNotNil applies the NotNil predicate on the "suspended_by_id" field.
+func SuspendedByIDNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldSuspendedByID))
+}
+
+// SuspendedByIDEqualFold applies the EqualFold predicate on the "suspended_by_id" field.
+func SuspendedByIDEqualFold(v xid.ID) predicate.Account {
+	vc := v.String()
+	return predicate.Account(sql.FieldEqualFold(FieldSuspendedByID, vc))
+}
+
+// SuspendedByIDContainsFold applies the ContainsFold predicate on the "suspended_by_id" field.
+func SuspendedByIDContainsFold(v xid.ID) predicate.Account {
+	vc := v.String()
+	return predicate.Account(sql.FieldContainsFold(FieldSuspendedByID, vc))
+}
+
+// DeletionScheduledAtEQ applies the EQ predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtNEQ applies the NEQ predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtIn applies the In predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldDeletionScheduledAt, vs...))
+}
+
+// DeletionScheduledAtNotIn applies the NotIn predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldDeletionScheduledAt, vs...))
+}
+
+// DeletionScheduledAtGT applies the GT predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtGTE applies the GTE predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtLT applies the LT predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtLTE applies the LTE predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldDeletionScheduledAt, v))
+}
+
+// DeletionScheduledAtIsNil applies the IsNil predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldDeletionScheduledAt))
+}
+
+// DeletionScheduledAtNotNil applies the NotNil predicate on the "deletion_scheduled_at" field.
+func DeletionScheduledAtNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldDeletionScheduledAt))
+}
+
+// CalendarTokenEQ applies the EQ predicate on the "calendar_token" field.
+func CalendarTokenEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCalendarToken, v))
+}
+
+// CalendarTokenNEQ applies the NEQ predicate on the "calendar_token" field.
+func CalendarTokenNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldCalendarToken, v))
+}
+
+// CalendarTokenIn applies the In predicate on the "calendar_token" field.
+func CalendarTokenIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldCalendarToken, vs...))
+}
+
+// CalendarTokenNotIn applies the NotIn predicate on the "calendar_token" field.
+func CalendarTokenNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldCalendarToken, vs...))
+}
+
+// CalendarTokenGT applies the GT predicate on the "calendar_token" field.
+func CalendarTokenGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldCalendarToken, v))
+}
+
+// CalendarTokenGTE applies the GTE predicate on the "calendar_token" field.
+func CalendarTokenGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldCalendarToken, v))
+}
+
+// CalendarTokenLT applies the LT predicate on the "calendar_token" field.
+func CalendarTokenLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldCalendarToken, v))
+}
+
+// CalendarTokenLTE applies the LTE predicate on the "calendar_token" field.
+func CalendarTokenLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldCalendarToken, v))
+}
+
+// CalendarTokenContains applies the Contains predicate on the "calendar_token" field.
+func CalendarTokenContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldCalendarToken, v))
+}
+
+// CalendarTokenHasPrefix applies the HasPrefix predicate on the "calendar_token" field.
+func CalendarTokenHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldCalendarToken, v))
+}
+
+// CalendarTokenHasSuffix applies the HasSuffix predicate on the "calendar_token" field.
+func CalendarTokenHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldCalendarToken, v))
+}
+
+// CalendarTokenIsNil applies the IsNil predicate on the "calendar_token" field.
+func CalendarTokenIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldCalendarToken))
+}
+
+// CalendarTokenNotNil applies the NotNil predicate on the "calendar_token" field.
+func CalendarTokenNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldCalendarToken))
+}
+
+// CalendarTokenEqualFold applies the EqualFold predicate on the "calendar_token" field.
+func CalendarTokenEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldCalendarToken, v))
+}
+
+// CalendarTokenContainsFold applies the ContainsFold predicate on the "calendar_token" field.
+func CalendarTokenContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldCalendarToken, v))
+}
+
 // HasSessions applies the HasEdge predicate on the "sessions" edge.
 func HasSessions() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
@@ -1145,6 +1500,259 @@ func HasHandledReportsWith(preds ...predicate.Report) predicate.Account {
 	})
 }
 
+// HasAuditLogs applies the HasEdge predicate on the "audit_logs" edge.
+func HasAuditLogs() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AuditLogsTable, AuditLogsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuditLogsWith applies the HasEdge predicate on the "audit_logs" edge with a given conditions (other predicates).
+func HasAuditLogsWith(preds ...predicate.AuditLog) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newAuditLogsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPostRevisions applies the HasEdge predicate on the "post_revisions" edge.
+func HasPostRevisions() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PostRevisionsTable, PostRevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPostRevisionsWith applies the HasEdge predicate on the "post_revisions" edge with a given conditions (other predicates).
+func HasPostRevisionsWith(preds ...predicate.PostRevision) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newPostRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNodeRevisions applies the HasEdge predicate on the "node_revisions" edge.
+func HasNodeRevisions() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NodeRevisionsTable, NodeRevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNodeRevisionsWith applies the HasEdge predicate on the "node_revisions" edge with a given conditions (other predicates).
+func HasNodeRevisionsWith(preds ...predicate.NodeRevision) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newNodeRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotificationPreferences applies the HasEdge predicate on the "notification_preferences" edge.
+func HasNotificationPreferences() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationPreferencesTable, NotificationPreferencesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationPreferencesWith applies the HasEdge predicate on the "notification_preferences" edge with a given conditions (other predicates).
+func HasNotificationPreferencesWith(preds ...predicate.NotificationPreference) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newNotificationPreferencesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotificationDigest applies the HasEdge predicate on the "notification_digest" edge.
+func HasNotificationDigest() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, NotificationDigestTable, NotificationDigestColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationDigestWith applies the HasEdge predicate on the "notification_digest" edge with a given conditions (other predicates).
+func HasNotificationDigestWith(preds ...predicate.NotificationDigest) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newNotificationDigestStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPollVotes applies the HasEdge predicate on the "poll_votes" edge.
+func HasPollVotes() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PollVotesTable, PollVotesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPollVotesWith applies the HasEdge predicate on the "poll_votes" edge with a given conditions (other predicates).
+func HasPollVotesWith(preds ...predicate.PollVote) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newPollVotesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasConversations applies the HasEdge predicate on the "conversations" edge.
+func HasConversations() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ConversationsTable, ConversationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConversationsWith applies the HasEdge predicate on the "conversations" edge with a given conditions (other predicates).
+func HasConversationsWith(preds ...predicate.ConversationParticipant) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newConversationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasConversationMessages applies the HasEdge predicate on the "conversation_messages" edge.
+func HasConversationMessages() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ConversationMessagesTable, ConversationMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConversationMessagesWith applies the HasEdge predicate on the "conversation_messages" edge with a given conditions (other predicates).
+func HasConversationMessagesWith(preds ...predicate.ConversationMessage) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newConversationMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlocks applies the HasEdge predicate on the "blocks" edge.
+func HasBlocks() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BlocksTable, BlocksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlocksWith applies the HasEdge predicate on the "blocks" edge with a given conditions (other predicates).
+func HasBlocksWith(preds ...predicate.AccountBlock) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newBlocksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlockedBy applies the HasEdge predicate on the "blocked_by" edge.
+func HasBlockedBy() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BlockedByTable, BlockedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedByWith applies the HasEdge predicate on the "blocked_by" edge with a given conditions (other predicates).
+func HasBlockedByWith(preds ...predicate.AccountBlock) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newBlockedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPublishSchedules applies the HasEdge predicate on the "publish_schedules" edge.
+func HasPublishSchedules() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PublishSchedulesTable, PublishSchedulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPublishSchedulesWith applies the HasEdge predicate on the "publish_schedules" edge with a given conditions (other predicates).
+func HasPublishSchedulesWith(preds ...predicate.PublishSchedule) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newPublishSchedulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasAccountRoles applies the HasEdge predicate on the "account_roles" edge.
 func HasAccountRoles() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
@@ -13,20 +13,30 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
 	"github.com/Southclaws/storyden/internal/ent/accountfollow"
 	"github.com/Southclaws/storyden/internal/ent/accountroles"
 	"github.com/Southclaws/storyden/internal/ent/asset"
+	"github.com/Southclaws/storyden/internal/ent/auditlog"
 	"github.com/Southclaws/storyden/internal/ent/authentication"
 	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/conversationmessage"
+	"github.com/Southclaws/storyden/internal/ent/conversationparticipant"
 	"github.com/Southclaws/storyden/internal/ent/email"
 	"github.com/Southclaws/storyden/internal/ent/eventparticipant"
 	"github.com/Southclaws/storyden/internal/ent/invitation"
 	"github.com/Southclaws/storyden/internal/ent/likepost"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
 	"github.com/Southclaws/storyden/internal/ent/node"
+	"github.com/Southclaws/storyden/internal/ent/noderevision"
 	"github.com/Southclaws/storyden/internal/ent/notification"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigest"
+	"github.com/Southclaws/storyden/internal/ent/notificationpreference"
+	"github.com/Southclaws/storyden/internal/ent/pollvote"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
+	"github.com/Southclaws/storyden/internal/ent/postrevision"
+	"github.com/Southclaws/storyden/internal/ent/publishschedule"
 	"github.com/Southclaws/storyden/internal/ent/question"
 	"github.com/Southclaws/storyden/internal/ent/react"
 	"github.com/Southclaws/storyden/internal/ent/report"
@@ -181,6 +191,76 @@ func (_c *AccountCreate) SetNillableInvitedByID(v *xid.ID) *AccountCreate {
 	return _c
 }
 
+// SetSuspendedReason sets the "suspended_reason" field.
+func (_c *AccountCreate) SetSuspendedReason(v string) *AccountCreate {
+	_c.mutation.SetSuspendedReason(v)
+	return _c
+}
+
+// SetNillableSuspendedReason sets the "suspended_reason" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableSuspendedReason(v *string) *AccountCreate {
+	if v != nil {
+		_c.SetSuspendedReason(*v)
+	}
+	return _c
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (_c *AccountCreate) SetSuspendedUntil(v time.Time) *AccountCreate {
+	_c.mutation.SetSuspendedUntil(v)
+	return _c
+}
+
+// SetNillableSuspendedUntil sets the "suspended_until" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableSuspendedUntil(v *time.Time) *AccountCreate {
+	if v != nil {
+		_c.SetSuspendedUntil(*v)
+	}
+	return _c
+}
+
+// SetSuspendedByID sets the "suspended_by_id" field.
+func (_c *AccountCreate) SetSuspendedByID(v xid.ID) *AccountCreate {
+	_c.mutation.SetSuspendedByID(v)
+	return _c
+}
+
+// SetNillableSuspendedByID sets the "suspended_by_id" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableSuspendedByID(v *xid.ID) *AccountCreate {
+	if v != nil {
+		_c.SetSuspendedByID(*v)
+	}
+	return _c
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (_c *AccountCreate) SetDeletionScheduledAt(v time.Time) *AccountCreate {
+	_c.mutation.SetDeletionScheduledAt(v)
+	return _c
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableDeletionScheduledAt(v *time.Time) *AccountCreate {
+	if v != nil {
+		_c.SetDeletionScheduledAt(*v)
+	}
+	return _c
+}
+
+// SetCalendarToken sets the "calendar_token" field.
+func (_c *AccountCreate) SetCalendarToken(v string) *AccountCreate {
+	_c.mutation.SetCalendarToken(v)
+	return _c
+}
+
+// SetNillableCalendarToken sets the "calendar_token" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableCalendarToken(v *string) *AccountCreate {
+	if v != nil {
+		_c.SetCalendarToken(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AccountCreate) SetID(v xid.ID) *AccountCreate {
 	_c.mutation.SetID(v)
@@ -530,6 +610,175 @@ func (_c *AccountCreate) AddHandledReports(v ...*Report) *AccountCreate {
 	return _c.AddHandledReportIDs(ids...)
 }
 
+// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by IDs.
+func (_c *AccountCreate) AddAuditLogIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddAuditLogIDs(ids...)
+	return _c
+}
+
+// AddAuditLogs adds the "audit_logs" edges to the AuditLog entity.
+func (_c *AccountCreate) AddAuditLogs(v ...*AuditLog) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAuditLogIDs(ids...)
+}
+
+// AddPostRevisionIDs adds the "post_revisions" edge to the PostRevision entity by IDs.
+func (_c *AccountCreate) AddPostRevisionIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddPostRevisionIDs(ids...)
+	return _c
+}
+
+// AddPostRevisions adds the "post_revisions" edges to the PostRevision entity.
+func (_c *AccountCreate) AddPostRevisions(v ...*PostRevision) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPostRevisionIDs(ids...)
+}
+
+// AddNodeRevisionIDs adds the "node_revisions" edge to the NodeRevision entity by IDs.
+func (_c *AccountCreate) AddNodeRevisionIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddNodeRevisionIDs(ids...)
+	return _c
+}
+
+// AddNodeRevisions adds the "node_revisions" edges to the NodeRevision entity.
+func (_c *AccountCreate) AddNodeRevisions(v ...*NodeRevision) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNodeRevisionIDs(ids...)
+}
+
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the NotificationPreference entity by IDs.
+func (_c *AccountCreate) AddNotificationPreferenceIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddNotificationPreferenceIDs(ids...)
+	return _c
+}
+
+// AddNotificationPreferences adds the "notification_preferences" edges to the NotificationPreference entity.
+func (_c *AccountCreate) AddNotificationPreferences(v ...*NotificationPreference) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationPreferenceIDs(ids...)
+}
+
+// SetNotificationDigestID sets the "notification_digest" edge to the NotificationDigest entity by ID.
+func (_c *AccountCreate) SetNotificationDigestID(id xid.ID) *AccountCreate {
+	_c.mutation.SetNotificationDigestID(id)
+	return _c
+}
+
+// SetNillableNotificationDigestID sets the "notification_digest" edge to the NotificationDigest entity by ID if the given value is not nil.
+func (_c *AccountCreate) SetNillableNotificationDigestID(id *xid.ID) *AccountCreate {
+	if id != nil {
+		_c = _c.SetNotificationDigestID(*id)
+	}
+	return _c
+}
+
+// SetNotificationDigest sets the "notification_digest" edge to the NotificationDigest entity.
+func (_c *AccountCreate) SetNotificationDigest(v *NotificationDigest) *AccountCreate {
+	return _c.SetNotificationDigestID(v.ID)
+}
+
+// AddPollVoteIDs adds the "poll_votes" edge to the PollVote entity by IDs.
+func (_c *AccountCreate) AddPollVoteIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddPollVoteIDs(ids...)
+	return _c
+}
+
+// AddPollVotes adds the "poll_votes" edges to the PollVote entity.
+func (_c *AccountCreate) AddPollVotes(v ...*PollVote) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPollVoteIDs(ids...)
+}
+
+// AddConversationIDs adds the "conversations" edge to the ConversationParticipant entity by IDs.
+func (_c *AccountCreate) AddConversationIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddConversationIDs(ids...)
+	return _c
+}
+
+// AddConversations adds the "conversations" edges to the ConversationParticipant entity.
+func (_c *AccountCreate) AddConversations(v ...*ConversationParticipant) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddConversationIDs(ids...)
+}
+
+// AddConversationMessageIDs adds the "conversation_messages" edge to the ConversationMessage entity by IDs.
+func (_c *AccountCreate) AddConversationMessageIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddConversationMessageIDs(ids...)
+	return _c
+}
+
+// AddConversationMessages adds the "conversation_messages" edges to the ConversationMessage entity.
+func (_c *AccountCreate) AddConversationMessages(v ...*ConversationMessage) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddConversationMessageIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the AccountBlock entity by IDs.
+func (_c *AccountCreate) AddBlockIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddBlockIDs(ids...)
+	return _c
+}
+
+// AddBlocks adds the "blocks" edges to the AccountBlock entity.
+func (_c *AccountCreate) AddBlocks(v ...*AccountBlock) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBlockIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the AccountBlock entity by IDs.
+func (_c *AccountCreate) AddBlockedByIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddBlockedByIDs(ids...)
+	return _c
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the AccountBlock entity.
+func (_c *AccountCreate) AddBlockedBy(v ...*AccountBlock) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBlockedByIDs(ids...)
+}
+
+// AddPublishScheduleIDs adds the "publish_schedules" edge to the PublishSchedule entity by IDs.
+func (_c *AccountCreate) AddPublishScheduleIDs(ids ...xid.ID) *AccountCreate {
+	_c.mutation.AddPublishScheduleIDs(ids...)
+	return _c
+}
+
+// AddPublishSchedules adds the "publish_schedules" edges to the PublishSchedule entity.
+func (_c *AccountCreate) AddPublishSchedules(v ...*PublishSchedule) *AccountCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPublishScheduleIDs(ids...)
+}
+
 // AddAccountRoleIDs adds the "account_roles" edge to the AccountRoles entity by IDs.
 func (_c *AccountCreate) AddAccountRoleIDs(ids ...xid.ID) *AccountCreate {
 	_c.mutation.AddAccountRoleIDs(ids...)
@@ -722,6 +971,26 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_spec.SetField(account.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := _c.mutation.SuspendedReason(); ok {
+		_spec.SetField(account.FieldSuspendedReason, field.TypeString, value)
+		_node.SuspendedReason = &value
+	}
+	if value, ok := _c.mutation.SuspendedUntil(); ok {
+		_spec.SetField(account.FieldSuspendedUntil, field.TypeTime, value)
+		_node.SuspendedUntil = &value
+	}
+	if value, ok := _c.mutation.SuspendedByID(); ok {
+		_spec.SetField(account.FieldSuspendedByID, field.TypeString, value)
+		_node.SuspendedByID = &value
+	}
+	if value, ok := _c.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(account.FieldDeletionScheduledAt, field.TypeTime, value)
+		_node.DeletionScheduledAt = &value
+	}
+	if value, ok := _c.mutation.CalendarToken(); ok {
+		_spec.SetField(account.FieldCalendarToken, field.TypeString, value)
+		_node.CalendarToken = &value
+	}
 	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1098,6 +1367,182 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AuditLogsTable,
+			Columns: []string{account.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PostRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostRevisionsTable,
+			Columns: []string{account.PostRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NodeRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NodeRevisionsTable,
+			Columns: []string{account.NodeRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noderevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NotificationPreferencesTable,
+			Columns: []string{account.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NotificationDigestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.NotificationDigestTable,
+			Columns: []string{account.NotificationDigestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdigest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PollVotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PollVotesTable,
+			Columns: []string{account.PollVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ConversationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ConversationsTable,
+			Columns: []string{account.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ConversationMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ConversationMessagesTable,
+			Columns: []string{account.ConversationMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlocksTable,
+			Columns: []string{account.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlockedByTable,
+			Columns: []string{account.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PublishSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PublishSchedulesTable,
+			Columns: []string{account.PublishSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publishschedule.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.AccountRolesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1334,6 +1779,96 @@ func (u *AccountUpsert) ClearInvitedByID() *AccountUpsert {
 	return u
 }
 
+// SetSuspendedReason sets the "suspended_reason" field.
+func (u *AccountUpsert) SetSuspendedReason(v string) *AccountUpsert {
+	u.Set(account.FieldSuspendedReason, v)
+	return u
+}
+
+// UpdateSuspendedReason sets the "suspended_reason" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateSuspendedReason() *AccountUpsert {
+	u.SetExcluded(account.FieldSuspendedReason)
+	return u
+}
+
+// ClearSuspendedReason clears the value of the "suspended_reason" field.
+func (u *AccountUpsert) ClearSuspendedReason() *AccountUpsert {
+	u.SetNull(account.FieldSuspendedReason)
+	return u
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (u *AccountUpsert) SetSuspendedUntil(v time.Time) *AccountUpsert {
+	u.Set(account.FieldSuspendedUntil, v)
+	return u
+}
+
+// UpdateSuspendedUntil sets the "suspended_until" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateSuspendedUntil() *AccountUpsert {
+	u.SetExcluded(account.FieldSuspendedUntil)
+	return u
+}
+
+// ClearSuspendedUntil clears the value of the "suspended_until" field.
+func (u *AccountUpsert) ClearSuspendedUntil() *AccountUpsert {
+	u.SetNull(account.FieldSuspendedUntil)
+	return u
+}
+
+// SetSuspendedByID sets the "suspended_by_id" field.
+func (u *AccountUpsert) SetSuspendedByID(v xid.ID) *AccountUpsert {
+	u.Set(account.FieldSuspendedByID, v)
+	return u
+}
+
+// UpdateSuspendedByID sets the "suspended_by_id" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateSuspendedByID() *AccountUpsert {
+	u.SetExcluded(account.FieldSuspendedByID)
+	return u
+}
+
+// ClearSuspendedByID clears the value of the "suspended_by_id" field.
+func (u *AccountUpsert) ClearSuspendedByID() *AccountUpsert {
+	u.SetNull(account.FieldSuspendedByID)
+	return u
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (u *AccountUpsert) SetDeletionScheduledAt(v time.Time) *AccountUpsert {
+	u.Set(account.FieldDeletionScheduledAt, v)
+	return u
+}
+
+// UpdateDeletionScheduledAt sets the "deletion_scheduled_at" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateDeletionScheduledAt() *AccountUpsert {
+	u.SetExcluded(account.FieldDeletionScheduledAt)
+	return u
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (u *AccountUpsert) ClearDeletionScheduledAt() *AccountUpsert {
+	u.SetNull(account.FieldDeletionScheduledAt)
+	return u
+}
+
+// SetCalendarToken sets the "calendar_token" field.
+func (u *AccountUpsert) SetCalendarToken(v string) *AccountUpsert {
+	u.Set(account.FieldCalendarToken, v)
+	return u
+}
+
+// UpdateCalendarToken sets the "calendar_token" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateCalendarToken() *AccountUpsert {
+	u.SetExcluded(account.FieldCalendarToken)
+	return u
+}
+
+// ClearCalendarToken clears the value of the "calendar_token" field.
+func (u *AccountUpsert) ClearCalendarToken() *AccountUpsert {
+	u.SetNull(account.FieldCalendarToken)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1581,6 +2116,111 @@ func (u *AccountUpsertOne) ClearInvitedByID() *AccountUpsertOne {
 	})
 }
 
+// SetSuspendedReason sets the "suspended_reason" field.
+func (u *AccountUpsertOne) SetSuspendedReason(v string) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetSuspendedReason(v)
+	})
+}
+
+// UpdateSuspendedReason sets the "suspended_reason" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateSuspendedReason() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateSuspendedReason()
+	})
+}
+
+// ClearSuspendedReason clears the value of the "suspended_reason" field.
+func (u *AccountUpsertOne) ClearSuspendedReason() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearSuspendedReason()
+	})
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (u *AccountUpsertOne) SetSuspendedUntil(v time.Time) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetSuspendedUntil(v)
+	})
+}
+
+// UpdateSuspendedUntil sets the "suspended_until" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateSuspendedUntil() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateSuspendedUntil()
+	})
+}
+
+// ClearSuspendedUntil clears the value of the "suspended_until" field.
+func (u *AccountUpsertOne) ClearSuspendedUntil() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearSuspendedUntil()
+	})
+}
+
+// SetSuspendedByID sets the "suspended_by_id" field.
+func (u *AccountUpsertOne) SetSuspendedByID(v xid.ID) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetSuspendedByID(v)
+	})
+}
+
+// UpdateSuspendedByID sets the "suspended_by_id" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateSuspendedByID() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateSuspendedByID()
+	})
+}
+
+// ClearSuspendedByID clears the value of the "suspended_by_id" field.
+func (u *AccountUpsertOne) ClearSuspendedByID() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearSuspendedByID()
+	})
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (u *AccountUpsertOne) SetDeletionScheduledAt(v time.Time) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetDeletionScheduledAt(v)
+	})
+}
+
+// UpdateDeletionScheduledAt sets the "deletion_scheduled_at" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateDeletionScheduledAt() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateDeletionScheduledAt()
+	})
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (u *AccountUpsertOne) ClearDeletionScheduledAt() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearDeletionScheduledAt()
+	})
+}
+
+// SetCalendarToken sets the "calendar_token" field.
+func (u *AccountUpsertOne) SetCalendarToken(v string) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetCalendarToken(v)
+	})
+}
+
+// UpdateCalendarToken sets the "calendar_token" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateCalendarToken() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateCalendarToken()
+	})
+}
+
+// ClearCalendarToken clears the value of the "calendar_token" field.
+func (u *AccountUpsertOne) ClearCalendarToken() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearCalendarToken()
+	})
+}
+
 // Exec executes the query.
 func (u *AccountUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1995,6 +2635,111 @@ func (u *AccountUpsertBulk) ClearInvitedByID() *AccountUpsertBulk {
 	})
 }
 
+// SetSuspendedReason sets the "suspended_reason" field.
+func (u *AccountUpsertBulk) SetSuspendedReason(v string) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetSuspendedReason(v)
+	})
+}
+
+// UpdateSuspendedReason sets the "suspended_reason" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateSuspendedReason() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateSuspendedReason()
+	})
+}
+
+// ClearSuspendedReason clears the value of the "suspended_reason" field.
+func (u *AccountUpsertBulk) ClearSuspendedReason() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearSuspendedReason()
+	})
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (u *AccountUpsertBulk) SetSuspendedUntil(v time.Time) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetSuspendedUntil(v)
+	})
+}
+
+// UpdateSuspendedUntil sets the "suspended_until" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateSuspendedUntil() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateSuspendedUntil()
+	})
+}
+
+// ClearSuspendedUntil clears the value of the "suspended_until" field.
+func (u *AccountUpsertBulk) ClearSuspendedUntil() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearSuspendedUntil()
+	})
+}
+
+// SetSuspendedByID sets the "suspended_by_id" field.
+func (u *AccountUpsertBulk) SetSuspendedByID(v xid.ID) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetSuspendedByID(v)
+	})
+}
+
+// UpdateSuspendedByID sets the "suspended_by_id" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateSuspendedByID() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateSuspendedByID()
+	})
+}
+
+// ClearSuspendedByID clears the value of the "suspended_by_id" field.
+func (u *AccountUpsertBulk) ClearSuspendedByID() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearSuspendedByID()
+	})
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (u *AccountUpsertBulk) SetDeletionScheduledAt(v time.Time) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetDeletionScheduledAt(v)
+	})
+}
+
+// UpdateDeletionScheduledAt sets the "deletion_scheduled_at" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateDeletionScheduledAt() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateDeletionScheduledAt()
+	})
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (u *AccountUpsertBulk) ClearDeletionScheduledAt() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearDeletionScheduledAt()
+	})
+}
+
+// SetCalendarToken sets the "calendar_token" field.
+func (u *AccountUpsertBulk) SetCalendarToken(v string) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetCalendarToken(v)
+	})
+}
+
+// UpdateCalendarToken sets the "calendar_token" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateCalendarToken() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateCalendarToken()
+	})
+}
+
+// ClearCalendarToken clears the value of the "calendar_token" field.
+func (u *AccountUpsertBulk) ClearCalendarToken() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearCalendarToken()
+	})
+}
+
 // Exec executes the query.
 func (u *AccountUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -13,21 +13,31 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
 	"github.com/Southclaws/storyden/internal/ent/accountfollow"
 	"github.com/Southclaws/storyden/internal/ent/accountroles"
 	"github.com/Southclaws/storyden/internal/ent/asset"
+	"github.com/Southclaws/storyden/internal/ent/auditlog"
 	"github.com/Southclaws/storyden/internal/ent/authentication"
 	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/conversationmessage"
+	"github.com/Southclaws/storyden/internal/ent/conversationparticipant"
 	"github.com/Southclaws/storyden/internal/ent/email"
 	"github.com/Southclaws/storyden/internal/ent/eventparticipant"
 	"github.com/Southclaws/storyden/internal/ent/invitation"
 	"github.com/Southclaws/storyden/internal/ent/likepost"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
 	"github.com/Southclaws/storyden/internal/ent/node"
+	"github.com/Southclaws/storyden/internal/ent/noderevision"
 	"github.com/Southclaws/storyden/internal/ent/notification"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigest"
+	"github.com/Southclaws/storyden/internal/ent/notificationpreference"
+	"github.com/Southclaws/storyden/internal/ent/pollvote"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
+	"github.com/Southclaws/storyden/internal/ent/postrevision"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/publishschedule"
 	"github.com/Southclaws/storyden/internal/ent/question"
 	"github.com/Southclaws/storyden/internal/ent/react"
 	"github.com/Southclaws/storyden/internal/ent/report"
@@ -40,35 +50,46 @@ import (
 // AccountQuery is the builder for querying Account entities.
 type AccountQuery struct {
 	config
-	ctx                        *QueryContext
-	order                      []account.OrderOption
-	inters                     []Interceptor
-	predicates                 []predicate.Account
-	withSessions               *SessionQuery
-	withEmails                 *EmailQuery
-	withNotifications          *NotificationQuery
-	withTriggeredNotifications *NotificationQuery
-	withFollowing              *AccountFollowQuery
-	withFollowedBy             *AccountFollowQuery
-	withInvitations            *InvitationQuery
-	withInvitedBy              *InvitationQuery
-	withPosts                  *PostQuery
-	withQuestions              *QuestionQuery
-	withReacts                 *ReactQuery
-	withLikes                  *LikePostQuery
-	withMentions               *MentionProfileQuery
-	withRoles                  *RoleQuery
-	withAuthentication         *AuthenticationQuery
-	withTags                   *TagQuery
-	withCollections            *CollectionQuery
-	withNodes                  *NodeQuery
-	withAssets                 *AssetQuery
-	withEvents                 *EventParticipantQuery
-	withPostReads              *PostReadQuery
-	withReports                *ReportQuery
-	withHandledReports         *ReportQuery
-	withAccountRoles           *AccountRolesQuery
-	modifiers                  []func(*sql.Selector)
+	ctx                         *QueryContext
+	order                       []account.OrderOption
+	inters                      []Interceptor
+	predicates                  []predicate.Account
+	withSessions                *SessionQuery
+	withEmails                  *EmailQuery
+	withNotifications           *NotificationQuery
+	withTriggeredNotifications  *NotificationQuery
+	withFollowing               *AccountFollowQuery
+	withFollowedBy              *AccountFollowQuery
+	withInvitations             *InvitationQuery
+	withInvitedBy               *InvitationQuery
+	withPosts                   *PostQuery
+	withQuestions               *QuestionQuery
+	withReacts                  *ReactQuery
+	withLikes                   *LikePostQuery
+	withMentions                *MentionProfileQuery
+	withRoles                   *RoleQuery
+	withAuthentication          *AuthenticationQuery
+	withTags                    *TagQuery
+	withCollections             *CollectionQuery
+	withNodes                   *NodeQuery
+	withAssets                  *AssetQuery
+	withEvents                  *EventParticipantQuery
+	withPostReads               *PostReadQuery
+	withReports                 *ReportQuery
+	withHandledReports          *ReportQuery
+	withAuditLogs               *AuditLogQuery
+	withPostRevisions           *PostRevisionQuery
+	withNodeRevisions           *NodeRevisionQuery
+	withNotificationPreferences *NotificationPreferenceQuery
+	withNotificationDigest      *NotificationDigestQuery
+	withPollVotes               *PollVoteQuery
+	withConversations           *ConversationParticipantQuery
+	withConversationMessages    *ConversationMessageQuery
+	withBlocks                  *AccountBlockQuery
+	withBlockedBy               *AccountBlockQuery
+	withPublishSchedules        *PublishScheduleQuery
+	withAccountRoles            *AccountRolesQuery
+	modifiers                   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -611,6 +632,248 @@ func (_q *AccountQuery) QueryHandledReports() *ReportQuery {
 	return query
 }
 
+// QueryAuditLogs chains the current query on the "audit_logs" edge.
+func (_q *AccountQuery) QueryAuditLogs() *AuditLogQuery {
+	query := (&AuditLogClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(auditlog.Table, auditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.AuditLogsTable, account.AuditLogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPostRevisions chains the current query on the "post_revisions" edge.
+func (_q *AccountQuery) QueryPostRevisions() *PostRevisionQuery {
+	query := (&PostRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(postrevision.Table, postrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.PostRevisionsTable, account.PostRevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNodeRevisions chains the current query on the "node_revisions" edge.
+func (_q *AccountQuery) QueryNodeRevisions() *NodeRevisionQuery {
+	query := (&NodeRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(noderevision.Table, noderevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.NodeRevisionsTable, account.NodeRevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotificationPreferences chains the current query on the "notification_preferences" edge.
+func (_q *AccountQuery) QueryNotificationPreferences() *NotificationPreferenceQuery {
+	query := (&NotificationPreferenceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(notificationpreference.Table, notificationpreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.NotificationPreferencesTable, account.NotificationPreferencesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotificationDigest chains the current query on the "notification_digest" edge.
+func (_q *AccountQuery) QueryNotificationDigest() *NotificationDigestQuery {
+	query := (&NotificationDigestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(notificationdigest.Table, notificationdigest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, account.NotificationDigestTable, account.NotificationDigestColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPollVotes chains the current query on the "poll_votes" edge.
+func (_q *AccountQuery) QueryPollVotes() *PollVoteQuery {
+	query := (&PollVoteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(pollvote.Table, pollvote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.PollVotesTable, account.PollVotesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryConversations chains the current query on the "conversations" edge.
+func (_q *AccountQuery) QueryConversations() *ConversationParticipantQuery {
+	query := (&ConversationParticipantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(conversationparticipant.Table, conversationparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ConversationsTable, account.ConversationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryConversationMessages chains the current query on the "conversation_messages" edge.
+func (_q *AccountQuery) QueryConversationMessages() *ConversationMessageQuery {
+	query := (&ConversationMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(conversationmessage.Table, conversationmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.ConversationMessagesTable, account.ConversationMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlocks chains the current query on the "blocks" edge.
+func (_q *AccountQuery) QueryBlocks() *AccountBlockQuery {
+	query := (&AccountBlockClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(accountblock.Table, accountblock.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BlocksTable, account.BlocksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlockedBy chains the current query on the "blocked_by" edge.
+func (_q *AccountQuery) QueryBlockedBy() *AccountBlockQuery {
+	query := (&AccountBlockClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(accountblock.Table, accountblock.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BlockedByTable, account.BlockedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPublishSchedules chains the current query on the "publish_schedules" edge.
+func (_q *AccountQuery) QueryPublishSchedules() *PublishScheduleQuery {
+	query := (&PublishScheduleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(publishschedule.Table, publishschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.PublishSchedulesTable, account.PublishSchedulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryAccountRoles chains the current query on the "account_roles" edge.
 func (_q *AccountQuery) QueryAccountRoles() *AccountRolesQuery {
 	query := (&AccountRolesClient{config: _q.config}).Query()
@@ -820,35 +1083,46 @@ func (_q *AccountQuery) Clone() *AccountQuery {
 		return nil
 	}
 	return &AccountQuery{
-		config:                     _q.config,
-		ctx:                        _q.ctx.Clone(),
-		order:                      append([]account.OrderOption{}, _q.order...),
-		inters:                     append([]Interceptor{}, _q.inters...),
-		predicates:                 append([]predicate.Account{}, _q.predicates...),
-		withSessions:               _q.withSessions.Clone(),
-		withEmails:                 _q.withEmails.Clone(),
-		withNotifications:          _q.withNotifications.Clone(),
-		withTriggeredNotifications: _q.withTriggeredNotifications.Clone(),
-		withFollowing:              _q.withFollowing.Clone(),
-		withFollowedBy:             _q.withFollowedBy.Clone(),
-		withInvitations:            _q.withInvitations.Clone(),
-		withInvitedBy:              _q.withInvitedBy.Clone(),
-		withPosts:                  _q.withPosts.Clone(),
-		withQuestions:              _q.withQuestions.Clone(),
-		withReacts:                 _q.withReacts.Clone(),
-		withLikes:                  _q.withLikes.Clone(),
-		withMentions:               _q.withMentions.Clone(),
-		withRoles:                  _q.withRoles.Clone(),
-		withAuthentication:         _q.withAuthentication.Clone(),
-		withTags:                   _q.withTags.Clone(),
-		withCollections:            _q.withCollections.Clone(),
-		withNodes:                  _q.withNodes.Clone(),
-		withAssets:                 _q.withAssets.Clone(),
-		withEvents:                 _q.withEvents.Clone(),
-		withPostReads:              _q.withPostReads.Clone(),
-		withReports:                _q.withReports.Clone(),
-		withHandledReports:         _q.withHandledReports.Clone(),
-		withAccountRoles:           _q.withAccountRoles.Clone(),
+		config:                      _q.config,
+		ctx:                         _q.ctx.Clone(),
+		order:                       append([]account.OrderOption{}, _q.order...),
+		inters:                      append([]Interceptor{}, _q.inters...),
+		predicates:                  append([]predicate.Account{}, _q.predicates...),
+		withSessions:                _q.withSessions.Clone(),
+		withEmails:                  _q.withEmails.Clone(),
+		withNotifications:           _q.withNotifications.Clone(),
+		withTriggeredNotifications:  _q.withTriggeredNotifications.Clone(),
+		withFollowing:               _q.withFollowing.Clone(),
+		withFollowedBy:              _q.withFollowedBy.Clone(),
+		withInvitations:             _q.withInvitations.Clone(),
+		withInvitedBy:               _q.withInvitedBy.Clone(),
+		withPosts:                   _q.withPosts.Clone(),
+		withQuestions:               _q.withQuestions.Clone(),
+		withReacts:                  _q.withReacts.Clone(),
+		withLikes:                   _q.withLikes.Clone(),
+		withMentions:                _q.withMentions.Clone(),
+		withRoles:                   _q.withRoles.Clone(),
+		withAuthentication:          _q.withAuthentication.Clone(),
+		withTags:                    _q.withTags.Clone(),
+		withCollections:             _q.withCollections.Clone(),
+		withNodes:                   _q.withNodes.Clone(),
+		withAssets:                  _q.withAssets.Clone(),
+		withEvents:                  _q.withEvents.Clone(),
+		withPostReads:               _q.withPostReads.Clone(),
+		withReports:                 _q.withReports.Clone(),
+		withHandledReports:          _q.withHandledReports.Clone(),
+		withAuditLogs:               _q.withAuditLogs.Clone(),
+		withPostRevisions:           _q.withPostRevisions.Clone(),
+		withNodeRevisions:           _q.withNodeRevisions.Clone(),
+		withNotificationPreferences: _q.withNotificationPreferences.Clone(),
+		withNotificationDigest:      _q.withNotificationDigest.Clone(),
+		withPollVotes:               _q.withPollVotes.Clone(),
+		withConversations:           _q.withConversations.Clone(),
+		withConversationMessages:    _q.withConversationMessages.Clone(),
+		withBlocks:                  _q.withBlocks.Clone(),
+		withBlockedBy:               _q.withBlockedBy.Clone(),
+		withPublishSchedules:        _q.withPublishSchedules.Clone(),
+		withAccountRoles:            _q.withAccountRoles.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -1109,6 +1383,127 @@ func (_q *AccountQuery) WithHandledReports(opts ...func(*ReportQuery)) *AccountQ
 	return _q
 }
 
+// WithAuditLogs tells the query-builder to eager-load the nodes that are connected to
+// the "audit_logs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithAuditLogs(opts ...func(*AuditLogQuery)) *AccountQuery {
+	query := (&AuditLogClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAuditLogs = query
+	return _q
+}
+
+// WithPostRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "post_revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithPostRevisions(opts ...func(*PostRevisionQuery)) *AccountQuery {
+	query := (&PostRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPostRevisions = query
+	return _q
+}
+
+// WithNodeRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "node_revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithNodeRevisions(opts ...func(*NodeRevisionQuery)) *AccountQuery {
+	query := (&NodeRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNodeRevisions = query
+	return _q
+}
+
+// WithNotificationPreferences tells the query-builder to eager-load the nodes that are connected to
+// the "notification_preferences" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithNotificationPreferences(opts ...func(*NotificationPreferenceQuery)) *AccountQuery {
+	query := (&NotificationPreferenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotificationPreferences = query
+	return _q
+}
+
+// WithNotificationDigest tells the query-builder to eager-load the nodes that are connected to
+// the "notification_digest" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithNotificationDigest(opts ...func(*NotificationDigestQuery)) *AccountQuery {
+	query := (&NotificationDigestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotificationDigest = query
+	return _q
+}
+
+// WithPollVotes tells the query-builder to eager-load the nodes that are connected to
+// the "poll_votes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithPollVotes(opts ...func(*PollVoteQuery)) *AccountQuery {
+	query := (&PollVoteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPollVotes = query
+	return _q
+}
+
+// WithConversations tells the query-builder to eager-load the nodes that are connected to
+// the "conversations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithConversations(opts ...func(*ConversationParticipantQuery)) *AccountQuery {
+	query := (&ConversationParticipantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withConversations = query
+	return _q
+}
+
+// WithConversationMessages tells the query-builder to eager-load the nodes that are connected to
+// the "conversation_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithConversationMessages(opts ...func(*ConversationMessageQuery)) *AccountQuery {
+	query := (&ConversationMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withConversationMessages = query
+	return _q
+}
+
+// WithBlocks tells the query-builder to eager-load the nodes that are connected to
+// the "blocks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithBlocks(opts ...func(*AccountBlockQuery)) *AccountQuery {
+	query := (&AccountBlockClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBlocks = query
+	return _q
+}
+
+// WithBlockedBy tells the query-builder to eager-load the nodes that are connected to
+// the "blocked_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithBlockedBy(opts ...func(*AccountBlockQuery)) *AccountQuery {
+	query := (&AccountBlockClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBlockedBy = query
+	return _q
+}
+
+// WithPublishSchedules tells the query-builder to eager-load the nodes that are connected to
+// the "publish_schedules" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithPublishSchedules(opts ...func(*PublishScheduleQuery)) *AccountQuery {
+	query := (&PublishScheduleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPublishSchedules = query
+	return _q
+}
+
 // WithAccountRoles tells the query-builder to eager-load the nodes that are connected to
 // the "account_roles" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *AccountQuery) WithAccountRoles(opts ...func(*AccountRolesQuery)) *AccountQuery {
@@ -1198,7 +1593,7 @@ func (_q *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 	var (
 		nodes       = []*Account{}
 		_spec       = _q.querySpec()
-		loadedTypes = [24]bool{
+		loadedTypes = [35]bool{
 			_q.withSessions != nil,
 			_q.withEmails != nil,
 			_q.withNotifications != nil,
@@ -1222,6 +1617,17 @@ func (_q *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 			_q.withPostReads != nil,
 			_q.withReports != nil,
 			_q.withHandledReports != nil,
+			_q.withAuditLogs != nil,
+			_q.withPostRevisions != nil,
+			_q.withNodeRevisions != nil,
+			_q.withNotificationPreferences != nil,
+			_q.withNotificationDigest != nil,
+			_q.withPollVotes != nil,
+			_q.withConversations != nil,
+			_q.withConversationMessages != nil,
+			_q.withBlocks != nil,
+			_q.withBlockedBy != nil,
+			_q.withPublishSchedules != nil,
 			_q.withAccountRoles != nil,
 		}
 	)
@@ -1408,9 +1814,89 @@ func (_q *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 			return nil, err
 		}
 	}
-	if query := _q.withAccountRoles; query != nil {
-		if err := _q.loadAccountRoles(ctx, query, nodes,
-			func(n *Account) { n.Edges.AccountRoles = []*AccountRoles{} },
+	if query := _q.withAuditLogs; query != nil {
+		if err := _q.loadAuditLogs(ctx, query, nodes,
+			func(n *Account) { n.Edges.AuditLogs = []*AuditLog{} },
+			func(n *Account, e *AuditLog) { n.Edges.AuditLogs = append(n.Edges.AuditLogs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPostRevisions; query != nil {
+		if err := _q.loadPostRevisions(ctx, query, nodes,
+			func(n *Account) { n.Edges.PostRevisions = []*PostRevision{} },
+			func(n *Account, e *PostRevision) { n.Edges.PostRevisions = append(n.Edges.PostRevisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNodeRevisions; query != nil {
+		if err := _q.loadNodeRevisions(ctx, query, nodes,
+			func(n *Account) { n.Edges.NodeRevisions = []*NodeRevision{} },
+			func(n *Account, e *NodeRevision) { n.Edges.NodeRevisions = append(n.Edges.NodeRevisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNotificationPreferences; query != nil {
+		if err := _q.loadNotificationPreferences(ctx, query, nodes,
+			func(n *Account) { n.Edges.NotificationPreferences = []*NotificationPreference{} },
+			func(n *Account, e *NotificationPreference) {
+				n.Edges.NotificationPreferences = append(n.Edges.NotificationPreferences, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNotificationDigest; query != nil {
+		if err := _q.loadNotificationDigest(ctx, query, nodes, nil,
+			func(n *Account, e *NotificationDigest) { n.Edges.NotificationDigest = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPollVotes; query != nil {
+		if err := _q.loadPollVotes(ctx, query, nodes,
+			func(n *Account) { n.Edges.PollVotes = []*PollVote{} },
+			func(n *Account, e *PollVote) { n.Edges.PollVotes = append(n.Edges.PollVotes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withConversations; query != nil {
+		if err := _q.loadConversations(ctx, query, nodes,
+			func(n *Account) { n.Edges.Conversations = []*ConversationParticipant{} },
+			func(n *Account, e *ConversationParticipant) { n.Edges.Conversations = append(n.Edges.Conversations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withConversationMessages; query != nil {
+		if err := _q.loadConversationMessages(ctx, query, nodes,
+			func(n *Account) { n.Edges.ConversationMessages = []*ConversationMessage{} },
+			func(n *Account, e *ConversationMessage) {
+				n.Edges.ConversationMessages = append(n.Edges.ConversationMessages, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBlocks; query != nil {
+		if err := _q.loadBlocks(ctx, query, nodes,
+			func(n *Account) { n.Edges.Blocks = []*AccountBlock{} },
+			func(n *Account, e *AccountBlock) { n.Edges.Blocks = append(n.Edges.Blocks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBlockedBy; query != nil {
+		if err := _q.loadBlockedBy(ctx, query, nodes,
+			func(n *Account) { n.Edges.BlockedBy = []*AccountBlock{} },
+			func(n *Account, e *AccountBlock) { n.Edges.BlockedBy = append(n.Edges.BlockedBy, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPublishSchedules; query != nil {
+		if err := _q.loadPublishSchedules(ctx, query, nodes,
+			func(n *Account) { n.Edges.PublishSchedules = []*PublishSchedule{} },
+			func(n *Account, e *PublishSchedule) { n.Edges.PublishSchedules = append(n.Edges.PublishSchedules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAccountRoles; query != nil {
+		if err := _q.loadAccountRoles(ctx, query, nodes,
+			func(n *Account) { n.Edges.AccountRoles = []*AccountRoles{} },
 			func(n *Account, e *AccountRoles) { n.Edges.AccountRoles = append(n.Edges.AccountRoles, e) }); err != nil {
 			return nil, err
 		}
@@ -2185,6 +2671,342 @@ func (_q *AccountQuery) loadHandledReports(ctx context.Context, query *ReportQue
 	}
 	return nil
 }
+func (_q *AccountQuery) loadAuditLogs(ctx context.Context, query *AuditLogQuery, nodes []*Account, init func(*Account), assign func(*Account, *AuditLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(auditlog.FieldActorID)
+	}
+	query.Where(predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.AuditLogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ActorID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "actor_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "actor_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadPostRevisions(ctx context.Context, query *PostRevisionQuery, nodes []*Account, init func(*Account), assign func(*Account, *PostRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(postrevision.FieldAccountID)
+	}
+	query.Where(predicate.PostRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.PostRevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadNodeRevisions(ctx context.Context, query *NodeRevisionQuery, nodes []*Account, init func(*Account), assign func(*Account, *NodeRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(noderevision.FieldAccountID)
+	}
+	query.Where(predicate.NodeRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.NodeRevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadNotificationPreferences(ctx context.Context, query *NotificationPreferenceQuery, nodes []*Account, init func(*Account), assign func(*Account, *NotificationPreference)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notificationpreference.FieldAccountID)
+	}
+	query.Where(predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.NotificationPreferencesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadNotificationDigest(ctx context.Context, query *NotificationDigestQuery, nodes []*Account, init func(*Account), assign func(*Account, *NotificationDigest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notificationdigest.FieldAccountID)
+	}
+	query.Where(predicate.NotificationDigest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.NotificationDigestColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadPollVotes(ctx context.Context, query *PollVoteQuery, nodes []*Account, init func(*Account), assign func(*Account, *PollVote)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pollvote.FieldAccountID)
+	}
+	query.Where(predicate.PollVote(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.PollVotesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadConversations(ctx context.Context, query *ConversationParticipantQuery, nodes []*Account, init func(*Account), assign func(*Account, *ConversationParticipant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(conversationparticipant.FieldAccountID)
+	}
+	query.Where(predicate.ConversationParticipant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.ConversationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadConversationMessages(ctx context.Context, query *ConversationMessageQuery, nodes []*Account, init func(*Account), assign func(*Account, *ConversationMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(conversationmessage.FieldAccountID)
+	}
+	query.Where(predicate.ConversationMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.ConversationMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadBlocks(ctx context.Context, query *AccountBlockQuery, nodes []*Account, init func(*Account), assign func(*Account, *AccountBlock)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accountblock.FieldAccountID)
+	}
+	query.Where(predicate.AccountBlock(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.BlocksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadBlockedBy(ctx context.Context, query *AccountBlockQuery, nodes []*Account, init func(*Account), assign func(*Account, *AccountBlock)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accountblock.FieldBlockedAccountID)
+	}
+	query.Where(predicate.AccountBlock(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.BlockedByColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BlockedAccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "blocked_account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadPublishSchedules(ctx context.Context, query *PublishScheduleQuery, nodes []*Account, init func(*Account), assign func(*Account, *PublishSchedule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(publishschedule.FieldAccountID)
+	}
+	query.Where(predicate.PublishSchedule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.PublishSchedulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *AccountQuery) loadAccountRoles(ctx context.Context, query *AccountRolesQuery, nodes []*Account, init func(*Account), assign func(*Account, *AccountRoles)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Account)
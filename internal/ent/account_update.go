@@ -13,21 +13,31 @@ import (
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
 	"github.com/Southclaws/storyden/internal/ent/accountfollow"
 	"github.com/Southclaws/storyden/internal/ent/accountroles"
 	"github.com/Southclaws/storyden/internal/ent/asset"
+	"github.com/Southclaws/storyden/internal/ent/auditlog"
 	"github.com/Southclaws/storyden/internal/ent/authentication"
 	"github.com/Southclaws/storyden/internal/ent/collection"
+	"github.com/Southclaws/storyden/internal/ent/conversationmessage"
+	"github.com/Southclaws/storyden/internal/ent/conversationparticipant"
 	"github.com/Southclaws/storyden/internal/ent/email"
 	"github.com/Southclaws/storyden/internal/ent/eventparticipant"
 	"github.com/Southclaws/storyden/internal/ent/invitation"
 	"github.com/Southclaws/storyden/internal/ent/likepost"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
 	"github.com/Southclaws/storyden/internal/ent/node"
+	"github.com/Southclaws/storyden/internal/ent/noderevision"
 	"github.com/Southclaws/storyden/internal/ent/notification"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigest"
+	"github.com/Southclaws/storyden/internal/ent/notificationpreference"
+	"github.com/Southclaws/storyden/internal/ent/pollvote"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
+	"github.com/Southclaws/storyden/internal/ent/postrevision"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/publishschedule"
 	"github.com/Southclaws/storyden/internal/ent/question"
 	"github.com/Southclaws/storyden/internal/ent/react"
 	"github.com/Southclaws/storyden/internal/ent/report"
@@ -224,6 +234,106 @@ func (_u *AccountUpdate) ClearInvitedByID() *AccountUpdate {
 	return _u
 }
 
+// SetSuspendedReason sets the "suspended_reason" field.
+func (_u *AccountUpdate) SetSuspendedReason(v string) *AccountUpdate {
+	_u.mutation.SetSuspendedReason(v)
+	return _u
+}
+
+// SetNillableSuspendedReason sets the "suspended_reason" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableSuspendedReason(v *string) *AccountUpdate {
+	if v != nil {
+		_u.SetSuspendedReason(*v)
+	}
+	return _u
+}
+
+// ClearSuspendedReason clears the value of the "suspended_reason" field.
+func (_u *AccountUpdate) ClearSuspendedReason() *AccountUpdate {
+	_u.mutation.ClearSuspendedReason()
+	return _u
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (_u *AccountUpdate) SetSuspendedUntil(v time.Time) *AccountUpdate {
+	_u.mutation.SetSuspendedUntil(v)
+	return _u
+}
+
+// SetNillableSuspendedUntil sets the "suspended_until" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableSuspendedUntil(v *time.Time) *AccountUpdate {
+	if v != nil {
+		_u.SetSuspendedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSuspendedUntil clears the value of the "suspended_until" field.
+func (_u *AccountUpdate) ClearSuspendedUntil() *AccountUpdate {
+	_u.mutation.ClearSuspendedUntil()
+	return _u
+}
+
+// SetSuspendedByID sets the "suspended_by_id" field.
+func (_u *AccountUpdate) SetSuspendedByID(v xid.ID) *AccountUpdate {
+	_u.mutation.SetSuspendedByID(v)
+	return _u
+}
+
+// SetNillableSuspendedByID sets the "suspended_by_id" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableSuspendedByID(v *xid.ID) *AccountUpdate {
+	if v != nil {
+		_u.SetSuspendedByID(*v)
+	}
+	return _u
+}
+
+// ClearSuspendedByID clears the value of the "suspended_by_id" field.
+func (_u *AccountUpdate) ClearSuspendedByID() *AccountUpdate {
+	_u.mutation.ClearSuspendedByID()
+	return _u
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (_u *AccountUpdate) SetDeletionScheduledAt(v time.Time) *AccountUpdate {
+	_u.mutation.SetDeletionScheduledAt(v)
+	return _u
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableDeletionScheduledAt(v *time.Time) *AccountUpdate {
+	if v != nil {
+		_u.SetDeletionScheduledAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (_u *AccountUpdate) ClearDeletionScheduledAt() *AccountUpdate {
+	_u.mutation.ClearDeletionScheduledAt()
+	return _u
+}
+
+// SetCalendarToken sets the "calendar_token" field.
+func (_u *AccountUpdate) SetCalendarToken(v string) *AccountUpdate {
+	_u.mutation.SetCalendarToken(v)
+	return _u
+}
+
+// SetNillableCalendarToken sets the "calendar_token" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableCalendarToken(v *string) *AccountUpdate {
+	if v != nil {
+		_u.SetCalendarToken(*v)
+	}
+	return _u
+}
+
+// ClearCalendarToken clears the value of the "calendar_token" field.
+func (_u *AccountUpdate) ClearCalendarToken() *AccountUpdate {
+	_u.mutation.ClearCalendarToken()
+	return _u
+}
+
 // AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
 func (_u *AccountUpdate) AddSessionIDs(ids ...xid.ID) *AccountUpdate {
 	_u.mutation.AddSessionIDs(ids...)
@@ -559,6 +669,175 @@ func (_u *AccountUpdate) AddHandledReports(v ...*Report) *AccountUpdate {
 	return _u.AddHandledReportIDs(ids...)
 }
 
+// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by IDs.
+func (_u *AccountUpdate) AddAuditLogIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddAuditLogIDs(ids...)
+	return _u
+}
+
+// AddAuditLogs adds the "audit_logs" edges to the AuditLog entity.
+func (_u *AccountUpdate) AddAuditLogs(v ...*AuditLog) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuditLogIDs(ids...)
+}
+
+// AddPostRevisionIDs adds the "post_revisions" edge to the PostRevision entity by IDs.
+func (_u *AccountUpdate) AddPostRevisionIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddPostRevisionIDs(ids...)
+	return _u
+}
+
+// AddPostRevisions adds the "post_revisions" edges to the PostRevision entity.
+func (_u *AccountUpdate) AddPostRevisions(v ...*PostRevision) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPostRevisionIDs(ids...)
+}
+
+// AddNodeRevisionIDs adds the "node_revisions" edge to the NodeRevision entity by IDs.
+func (_u *AccountUpdate) AddNodeRevisionIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddNodeRevisionIDs(ids...)
+	return _u
+}
+
+// AddNodeRevisions adds the "node_revisions" edges to the NodeRevision entity.
+func (_u *AccountUpdate) AddNodeRevisions(v ...*NodeRevision) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNodeRevisionIDs(ids...)
+}
+
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the NotificationPreference entity by IDs.
+func (_u *AccountUpdate) AddNotificationPreferenceIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// AddNotificationPreferences adds the "notification_preferences" edges to the NotificationPreference entity.
+func (_u *AccountUpdate) AddNotificationPreferences(v ...*NotificationPreference) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationPreferenceIDs(ids...)
+}
+
+// SetNotificationDigestID sets the "notification_digest" edge to the NotificationDigest entity by ID.
+func (_u *AccountUpdate) SetNotificationDigestID(id xid.ID) *AccountUpdate {
+	_u.mutation.SetNotificationDigestID(id)
+	return _u
+}
+
+// SetNillableNotificationDigestID sets the "notification_digest" edge to the NotificationDigest entity by ID if the given value is not nil.
+func (_u *AccountUpdate) SetNillableNotificationDigestID(id *xid.ID) *AccountUpdate {
+	if id != nil {
+		_u = _u.SetNotificationDigestID(*id)
+	}
+	return _u
+}
+
+// SetNotificationDigest sets the "notification_digest" edge to the NotificationDigest entity.
+func (_u *AccountUpdate) SetNotificationDigest(v *NotificationDigest) *AccountUpdate {
+	return _u.SetNotificationDigestID(v.ID)
+}
+
+// AddPollVoteIDs adds the "poll_votes" edge to the PollVote entity by IDs.
+func (_u *AccountUpdate) AddPollVoteIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddPollVoteIDs(ids...)
+	return _u
+}
+
+// AddPollVotes adds the "poll_votes" edges to the PollVote entity.
+func (_u *AccountUpdate) AddPollVotes(v ...*PollVote) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPollVoteIDs(ids...)
+}
+
+// AddConversationIDs adds the "conversations" edge to the ConversationParticipant entity by IDs.
+func (_u *AccountUpdate) AddConversationIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddConversationIDs(ids...)
+	return _u
+}
+
+// AddConversations adds the "conversations" edges to the ConversationParticipant entity.
+func (_u *AccountUpdate) AddConversations(v ...*ConversationParticipant) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddConversationIDs(ids...)
+}
+
+// AddConversationMessageIDs adds the "conversation_messages" edge to the ConversationMessage entity by IDs.
+func (_u *AccountUpdate) AddConversationMessageIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddConversationMessageIDs(ids...)
+	return _u
+}
+
+// AddConversationMessages adds the "conversation_messages" edges to the ConversationMessage entity.
+func (_u *AccountUpdate) AddConversationMessages(v ...*ConversationMessage) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddConversationMessageIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the AccountBlock entity by IDs.
+func (_u *AccountUpdate) AddBlockIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddBlockIDs(ids...)
+	return _u
+}
+
+// AddBlocks adds the "blocks" edges to the AccountBlock entity.
+func (_u *AccountUpdate) AddBlocks(v ...*AccountBlock) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the AccountBlock entity by IDs.
+func (_u *AccountUpdate) AddBlockedByIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddBlockedByIDs(ids...)
+	return _u
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the AccountBlock entity.
+func (_u *AccountUpdate) AddBlockedBy(v ...*AccountBlock) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockedByIDs(ids...)
+}
+
+// AddPublishScheduleIDs adds the "publish_schedules" edge to the PublishSchedule entity by IDs.
+func (_u *AccountUpdate) AddPublishScheduleIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.AddPublishScheduleIDs(ids...)
+	return _u
+}
+
+// AddPublishSchedules adds the "publish_schedules" edges to the PublishSchedule entity.
+func (_u *AccountUpdate) AddPublishSchedules(v ...*PublishSchedule) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPublishScheduleIDs(ids...)
+}
+
 // AddAccountRoleIDs adds the "account_roles" edge to the AccountRoles entity by IDs.
 func (_u *AccountUpdate) AddAccountRoleIDs(ids ...xid.ID) *AccountUpdate {
 	_u.mutation.AddAccountRoleIDs(ids...)
@@ -1047,99 +1326,315 @@ func (_u *AccountUpdate) RemoveHandledReports(v ...*Report) *AccountUpdate {
 	return _u.RemoveHandledReportIDs(ids...)
 }
 
-// ClearAccountRoles clears all "account_roles" edges to the AccountRoles entity.
-func (_u *AccountUpdate) ClearAccountRoles() *AccountUpdate {
-	_u.mutation.ClearAccountRoles()
+// ClearAuditLogs clears all "audit_logs" edges to the AuditLog entity.
+func (_u *AccountUpdate) ClearAuditLogs() *AccountUpdate {
+	_u.mutation.ClearAuditLogs()
 	return _u
 }
 
-// RemoveAccountRoleIDs removes the "account_roles" edge to AccountRoles entities by IDs.
-func (_u *AccountUpdate) RemoveAccountRoleIDs(ids ...xid.ID) *AccountUpdate {
-	_u.mutation.RemoveAccountRoleIDs(ids...)
+// RemoveAuditLogIDs removes the "audit_logs" edge to AuditLog entities by IDs.
+func (_u *AccountUpdate) RemoveAuditLogIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveAuditLogIDs(ids...)
 	return _u
 }
 
-// RemoveAccountRoles removes "account_roles" edges to AccountRoles entities.
-func (_u *AccountUpdate) RemoveAccountRoles(v ...*AccountRoles) *AccountUpdate {
+// RemoveAuditLogs removes "audit_logs" edges to AuditLog entities.
+func (_u *AccountUpdate) RemoveAuditLogs(v ...*AuditLog) *AccountUpdate {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAccountRoleIDs(ids...)
+	return _u.RemoveAuditLogIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *AccountUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearPostRevisions clears all "post_revisions" edges to the PostRevision entity.
+func (_u *AccountUpdate) ClearPostRevisions() *AccountUpdate {
+	_u.mutation.ClearPostRevisions()
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *AccountUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemovePostRevisionIDs removes the "post_revisions" edge to PostRevision entities by IDs.
+func (_u *AccountUpdate) RemovePostRevisionIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemovePostRevisionIDs(ids...)
+	return _u
+}
+
+// RemovePostRevisions removes "post_revisions" edges to PostRevision entities.
+func (_u *AccountUpdate) RemovePostRevisions(v ...*PostRevision) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return affected
+	return _u.RemovePostRevisionIDs(ids...)
 }
 
-// Exec executes the query.
-func (_u *AccountUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearNodeRevisions clears all "node_revisions" edges to the NodeRevision entity.
+func (_u *AccountUpdate) ClearNodeRevisions() *AccountUpdate {
+	_u.mutation.ClearNodeRevisions()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *AccountUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
-	}
+// RemoveNodeRevisionIDs removes the "node_revisions" edge to NodeRevision entities by IDs.
+func (_u *AccountUpdate) RemoveNodeRevisionIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveNodeRevisionIDs(ids...)
+	return _u
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *AccountUpdate) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := account.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
+// RemoveNodeRevisions removes "node_revisions" edges to NodeRevision entities.
+func (_u *AccountUpdate) RemoveNodeRevisions(v ...*NodeRevision) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveNodeRevisionIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *AccountUpdate) check() error {
-	if v, ok := _u.mutation.Handle(); ok {
-		if err := account.HandleValidator(v); err != nil {
-			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "Account.handle": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.Name(); ok {
-		if err := account.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Account.name": %w`, err)}
-		}
+// ClearNotificationPreferences clears all "notification_preferences" edges to the NotificationPreference entity.
+func (_u *AccountUpdate) ClearNotificationPreferences() *AccountUpdate {
+	_u.mutation.ClearNotificationPreferences()
+	return _u
+}
+
+// RemoveNotificationPreferenceIDs removes the "notification_preferences" edge to NotificationPreference entities by IDs.
+func (_u *AccountUpdate) RemoveNotificationPreferenceIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationPreferences removes "notification_preferences" edges to NotificationPreference entities.
+func (_u *AccountUpdate) RemoveNotificationPreferences(v ...*NotificationPreference) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if v, ok := _u.mutation.Kind(); ok {
-		if err := account.KindValidator(v); err != nil {
-			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Account.kind": %w`, err)}
-		}
+	return _u.RemoveNotificationPreferenceIDs(ids...)
+}
+
+// ClearNotificationDigest clears the "notification_digest" edge to the NotificationDigest entity.
+func (_u *AccountUpdate) ClearNotificationDigest() *AccountUpdate {
+	_u.mutation.ClearNotificationDigest()
+	return _u
+}
+
+// ClearPollVotes clears all "poll_votes" edges to the PollVote entity.
+func (_u *AccountUpdate) ClearPollVotes() *AccountUpdate {
+	_u.mutation.ClearPollVotes()
+	return _u
+}
+
+// RemovePollVoteIDs removes the "poll_votes" edge to PollVote entities by IDs.
+func (_u *AccountUpdate) RemovePollVoteIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemovePollVoteIDs(ids...)
+	return _u
+}
+
+// RemovePollVotes removes "poll_votes" edges to PollVote entities.
+func (_u *AccountUpdate) RemovePollVotes(v ...*PollVote) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return nil
+	return _u.RemovePollVoteIDs(ids...)
 }
 
-// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
-func (_u *AccountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountUpdate {
-	_u.modifiers = append(_u.modifiers, modifiers...)
+// ClearConversations clears all "conversations" edges to the ConversationParticipant entity.
+func (_u *AccountUpdate) ClearConversations() *AccountUpdate {
+	_u.mutation.ClearConversations()
 	return _u
 }
 
-func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
+// RemoveConversationIDs removes the "conversations" edge to ConversationParticipant entities by IDs.
+func (_u *AccountUpdate) RemoveConversationIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveConversationIDs(ids...)
+	return _u
+}
+
+// RemoveConversations removes "conversations" edges to ConversationParticipant entities.
+func (_u *AccountUpdate) RemoveConversations(v ...*ConversationParticipant) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeString))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	return _u.RemoveConversationIDs(ids...)
+}
+
+// ClearConversationMessages clears all "conversation_messages" edges to the ConversationMessage entity.
+func (_u *AccountUpdate) ClearConversationMessages() *AccountUpdate {
+	_u.mutation.ClearConversationMessages()
+	return _u
+}
+
+// RemoveConversationMessageIDs removes the "conversation_messages" edge to ConversationMessage entities by IDs.
+func (_u *AccountUpdate) RemoveConversationMessageIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveConversationMessageIDs(ids...)
+	return _u
+}
+
+// RemoveConversationMessages removes "conversation_messages" edges to ConversationMessage entities.
+func (_u *AccountUpdate) RemoveConversationMessages(v ...*ConversationMessage) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveConversationMessageIDs(ids...)
+}
+
+// ClearBlocks clears all "blocks" edges to the AccountBlock entity.
+func (_u *AccountUpdate) ClearBlocks() *AccountUpdate {
+	_u.mutation.ClearBlocks()
+	return _u
+}
+
+// RemoveBlockIDs removes the "blocks" edge to AccountBlock entities by IDs.
+func (_u *AccountUpdate) RemoveBlockIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveBlockIDs(ids...)
+	return _u
+}
+
+// RemoveBlocks removes "blocks" edges to AccountBlock entities.
+func (_u *AccountUpdate) RemoveBlocks(v ...*AccountBlock) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the AccountBlock entity.
+func (_u *AccountUpdate) ClearBlockedBy() *AccountUpdate {
+	_u.mutation.ClearBlockedBy()
+	return _u
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to AccountBlock entities by IDs.
+func (_u *AccountUpdate) RemoveBlockedByIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveBlockedByIDs(ids...)
+	return _u
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to AccountBlock entities.
+func (_u *AccountUpdate) RemoveBlockedBy(v ...*AccountBlock) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockedByIDs(ids...)
+}
+
+// ClearPublishSchedules clears all "publish_schedules" edges to the PublishSchedule entity.
+func (_u *AccountUpdate) ClearPublishSchedules() *AccountUpdate {
+	_u.mutation.ClearPublishSchedules()
+	return _u
+}
+
+// RemovePublishScheduleIDs removes the "publish_schedules" edge to PublishSchedule entities by IDs.
+func (_u *AccountUpdate) RemovePublishScheduleIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemovePublishScheduleIDs(ids...)
+	return _u
+}
+
+// RemovePublishSchedules removes "publish_schedules" edges to PublishSchedule entities.
+func (_u *AccountUpdate) RemovePublishSchedules(v ...*PublishSchedule) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePublishScheduleIDs(ids...)
+}
+
+// ClearAccountRoles clears all "account_roles" edges to the AccountRoles entity.
+func (_u *AccountUpdate) ClearAccountRoles() *AccountUpdate {
+	_u.mutation.ClearAccountRoles()
+	return _u
+}
+
+// RemoveAccountRoleIDs removes the "account_roles" edge to AccountRoles entities by IDs.
+func (_u *AccountUpdate) RemoveAccountRoleIDs(ids ...xid.ID) *AccountUpdate {
+	_u.mutation.RemoveAccountRoleIDs(ids...)
+	return _u
+}
+
+// RemoveAccountRoles removes "account_roles" edges to AccountRoles entities.
+func (_u *AccountUpdate) RemoveAccountRoles(v ...*AccountRoles) *AccountUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAccountRoleIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccountUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AccountUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := account.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountUpdate) check() error {
+	if v, ok := _u.mutation.Handle(); ok {
+		if err := account.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "Account.handle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := account.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Account.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := account.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Account.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
 		}
 	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
@@ -1192,6 +1687,36 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(account.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.SuspendedReason(); ok {
+		_spec.SetField(account.FieldSuspendedReason, field.TypeString, value)
+	}
+	if _u.mutation.SuspendedReasonCleared() {
+		_spec.ClearField(account.FieldSuspendedReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuspendedUntil(); ok {
+		_spec.SetField(account.FieldSuspendedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SuspendedUntilCleared() {
+		_spec.ClearField(account.FieldSuspendedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SuspendedByID(); ok {
+		_spec.SetField(account.FieldSuspendedByID, field.TypeString, value)
+	}
+	if _u.mutation.SuspendedByIDCleared() {
+		_spec.ClearField(account.FieldSuspendedByID, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(account.FieldDeletionScheduledAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletionScheduledAtCleared() {
+		_spec.ClearField(account.FieldDeletionScheduledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CalendarToken(); ok {
+		_spec.SetField(account.FieldCalendarToken, field.TypeString, value)
+	}
+	if _u.mutation.CalendarTokenCleared() {
+		_spec.ClearField(account.FieldCalendarToken, field.TypeString)
+	}
 	if _u.mutation.SessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -2232,28 +2757,28 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AccountRolesCleared() {
+	if _u.mutation.AuditLogsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   account.AccountRolesTable,
-			Columns: []string{account.AccountRolesColumn},
+			Inverse: false,
+			Table:   account.AuditLogsTable,
+			Columns: []string{account.AuditLogsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountroles.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAccountRolesIDs(); len(nodes) > 0 && !_u.mutation.AccountRolesCleared() {
+	if nodes := _u.mutation.RemovedAuditLogsIDs(); len(nodes) > 0 && !_u.mutation.AuditLogsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   account.AccountRolesTable,
-			Columns: []string{account.AccountRolesColumn},
+			Inverse: false,
+			Table:   account.AuditLogsTable,
+			Columns: []string{account.AuditLogsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountroles.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2261,15 +2786,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AccountRolesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AuditLogsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   account.AccountRolesTable,
-			Columns: []string{account.AccountRolesColumn},
+			Inverse: false,
+			Table:   account.AuditLogsTable,
+			Columns: []string{account.AuditLogsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountroles.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2277,66 +2802,545 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{account.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.PostRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostRevisionsTable,
+			Columns: []string{account.PostRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeString),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// AccountUpdateOne is the builder for updating a single Account entity.
-type AccountUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *AccountMutation
-	modifiers []func(*sql.UpdateBuilder)
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *AccountUpdateOne) SetUpdatedAt(v time.Time) *AccountUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *AccountUpdateOne) SetDeletedAt(v time.Time) *AccountUpdateOne {
-	_u.mutation.SetDeletedAt(v)
-	return _u
-}
-
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *AccountUpdateOne) SetNillableDeletedAt(v *time.Time) *AccountUpdateOne {
-	if v != nil {
-		_u.SetDeletedAt(*v)
+	if nodes := _u.mutation.RemovedPostRevisionsIDs(); len(nodes) > 0 && !_u.mutation.PostRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostRevisionsTable,
+			Columns: []string{account.PostRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *AccountUpdateOne) ClearDeletedAt() *AccountUpdateOne {
-	_u.mutation.ClearDeletedAt()
-	return _u
-}
-
-// SetIndexedAt sets the "indexed_at" field.
-func (_u *AccountUpdateOne) SetIndexedAt(v time.Time) *AccountUpdateOne {
-	_u.mutation.SetIndexedAt(v)
-	return _u
-}
-
-// SetNillableIndexedAt sets the "indexed_at" field if the given value is not nil.
-func (_u *AccountUpdateOne) SetNillableIndexedAt(v *time.Time) *AccountUpdateOne {
-	if v != nil {
-		_u.SetIndexedAt(*v)
+	if nodes := _u.mutation.PostRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostRevisionsTable,
+			Columns: []string{account.PostRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
+	if _u.mutation.NodeRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NodeRevisionsTable,
+			Columns: []string{account.NodeRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noderevision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNodeRevisionsIDs(); len(nodes) > 0 && !_u.mutation.NodeRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NodeRevisionsTable,
+			Columns: []string{account.NodeRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noderevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NodeRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NodeRevisionsTable,
+			Columns: []string{account.NodeRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noderevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NotificationPreferencesTable,
+			Columns: []string{account.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationPreferencesIDs(); len(nodes) > 0 && !_u.mutation.NotificationPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NotificationPreferencesTable,
+			Columns: []string{account.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NotificationPreferencesTable,
+			Columns: []string{account.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationDigestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.NotificationDigestTable,
+			Columns: []string{account.NotificationDigestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdigest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationDigestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.NotificationDigestTable,
+			Columns: []string{account.NotificationDigestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdigest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PollVotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PollVotesTable,
+			Columns: []string{account.PollVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPollVotesIDs(); len(nodes) > 0 && !_u.mutation.PollVotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PollVotesTable,
+			Columns: []string{account.PollVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollVotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PollVotesTable,
+			Columns: []string{account.PollVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ConversationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ConversationsTable,
+			Columns: []string{account.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedConversationsIDs(); len(nodes) > 0 && !_u.mutation.ConversationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ConversationsTable,
+			Columns: []string{account.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ConversationsTable,
+			Columns: []string{account.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ConversationMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ConversationMessagesTable,
+			Columns: []string{account.ConversationMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedConversationMessagesIDs(); len(nodes) > 0 && !_u.mutation.ConversationMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ConversationMessagesTable,
+			Columns: []string{account.ConversationMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ConversationMessagesTable,
+			Columns: []string{account.ConversationMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlocksTable,
+			Columns: []string{account.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !_u.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlocksTable,
+			Columns: []string{account.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlocksTable,
+			Columns: []string{account.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlockedByTable,
+			Columns: []string{account.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !_u.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlockedByTable,
+			Columns: []string{account.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BlockedByTable,
+			Columns: []string{account.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PublishSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PublishSchedulesTable,
+			Columns: []string{account.PublishSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publishschedule.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPublishSchedulesIDs(); len(nodes) > 0 && !_u.mutation.PublishSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PublishSchedulesTable,
+			Columns: []string{account.PublishSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publishschedule.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PublishSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PublishSchedulesTable,
+			Columns: []string{account.PublishSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publishschedule.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.AccountRolesTable,
+			Columns: []string{account.AccountRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountroles.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAccountRolesIDs(); len(nodes) > 0 && !_u.mutation.AccountRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.AccountRolesTable,
+			Columns: []string{account.AccountRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountroles.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   account.AccountRolesTable,
+			Columns: []string{account.AccountRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountroles.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{account.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccountUpdateOne is the builder for updating a single Account entity.
+type AccountUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AccountUpdateOne) SetUpdatedAt(v time.Time) *AccountUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AccountUpdateOne) SetDeletedAt(v time.Time) *AccountUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableDeletedAt(v *time.Time) *AccountUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AccountUpdateOne) ClearDeletedAt() *AccountUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetIndexedAt sets the "indexed_at" field.
+func (_u *AccountUpdateOne) SetIndexedAt(v time.Time) *AccountUpdateOne {
+	_u.mutation.SetIndexedAt(v)
+	return _u
+}
+
+// SetNillableIndexedAt sets the "indexed_at" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableIndexedAt(v *time.Time) *AccountUpdateOne {
+	if v != nil {
+		_u.SetIndexedAt(*v)
+	}
+	return _u
 }
 
 // ClearIndexedAt clears the value of the "indexed_at" field.
@@ -2471,6 +3475,106 @@ func (_u *AccountUpdateOne) ClearInvitedByID() *AccountUpdateOne {
 	return _u
 }
 
+// SetSuspendedReason sets the "suspended_reason" field.
+func (_u *AccountUpdateOne) SetSuspendedReason(v string) *AccountUpdateOne {
+	_u.mutation.SetSuspendedReason(v)
+	return _u
+}
+
+// SetNillableSuspendedReason sets the "suspended_reason" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableSuspendedReason(v *string) *AccountUpdateOne {
+	if v != nil {
+		_u.SetSuspendedReason(*v)
+	}
+	return _u
+}
+
+// ClearSuspendedReason clears the value of the "suspended_reason" field.
+func (_u *AccountUpdateOne) ClearSuspendedReason() *AccountUpdateOne {
+	_u.mutation.ClearSuspendedReason()
+	return _u
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (_u *AccountUpdateOne) SetSuspendedUntil(v time.Time) *AccountUpdateOne {
+	_u.mutation.SetSuspendedUntil(v)
+	return _u
+}
+
+// SetNillableSuspendedUntil sets the "suspended_until" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableSuspendedUntil(v *time.Time) *AccountUpdateOne {
+	if v != nil {
+		_u.SetSuspendedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSuspendedUntil clears the value of the "suspended_until" field.
+func (_u *AccountUpdateOne) ClearSuspendedUntil() *AccountUpdateOne {
+	_u.mutation.ClearSuspendedUntil()
+	return _u
+}
+
+// SetSuspendedByID sets the "suspended_by_id" field.
+func (_u *AccountUpdateOne) SetSuspendedByID(v xid.ID) *AccountUpdateOne {
+	_u.mutation.SetSuspendedByID(v)
+	return _u
+}
+
+// SetNillableSuspendedByID sets the "suspended_by_id" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableSuspendedByID(v *xid.ID) *AccountUpdateOne {
+	if v != nil {
+		_u.SetSuspendedByID(*v)
+	}
+	return _u
+}
+
+// ClearSuspendedByID clears the value of the "suspended_by_id" field.
+func (_u *AccountUpdateOne) ClearSuspendedByID() *AccountUpdateOne {
+	_u.mutation.ClearSuspendedByID()
+	return _u
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (_u *AccountUpdateOne) SetDeletionScheduledAt(v time.Time) *AccountUpdateOne {
+	_u.mutation.SetDeletionScheduledAt(v)
+	return _u
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableDeletionScheduledAt(v *time.Time) *AccountUpdateOne {
+	if v != nil {
+		_u.SetDeletionScheduledAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (_u *AccountUpdateOne) ClearDeletionScheduledAt() *AccountUpdateOne {
+	_u.mutation.ClearDeletionScheduledAt()
+	return _u
+}
+
+// SetCalendarToken sets the "calendar_token" field.
+func (_u *AccountUpdateOne) SetCalendarToken(v string) *AccountUpdateOne {
+	_u.mutation.SetCalendarToken(v)
+	return _u
+}
+
+// SetNillableCalendarToken sets the "calendar_token" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableCalendarToken(v *string) *AccountUpdateOne {
+	if v != nil {
+		_u.SetCalendarToken(*v)
+	}
+	return _u
+}
+
+// ClearCalendarToken clears the value of the "calendar_token" field.
+func (_u *AccountUpdateOne) ClearCalendarToken() *AccountUpdateOne {
+	_u.mutation.ClearCalendarToken()
+	return _u
+}
+
 // AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
 func (_u *AccountUpdateOne) AddSessionIDs(ids ...xid.ID) *AccountUpdateOne {
 	_u.mutation.AddSessionIDs(ids...)
@@ -2707,103 +3811,272 @@ func (_u *AccountUpdateOne) AddCollectionIDs(ids ...xid.ID) *AccountUpdateOne {
 	return _u
 }
 
-// AddCollections adds the "collections" edges to the Collection entity.
-func (_u *AccountUpdateOne) AddCollections(v ...*Collection) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddCollectionIDs(ids...)
+// AddCollections adds the "collections" edges to the Collection entity.
+func (_u *AccountUpdateOne) AddCollections(v ...*Collection) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCollectionIDs(ids...)
+}
+
+// AddNodeIDs adds the "nodes" edge to the Node entity by IDs.
+func (_u *AccountUpdateOne) AddNodeIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddNodeIDs(ids...)
+	return _u
+}
+
+// AddNodes adds the "nodes" edges to the Node entity.
+func (_u *AccountUpdateOne) AddNodes(v ...*Node) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNodeIDs(ids...)
+}
+
+// AddAssetIDs adds the "assets" edge to the Asset entity by IDs.
+func (_u *AccountUpdateOne) AddAssetIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddAssetIDs(ids...)
+	return _u
+}
+
+// AddAssets adds the "assets" edges to the Asset entity.
+func (_u *AccountUpdateOne) AddAssets(v ...*Asset) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssetIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the EventParticipant entity by IDs.
+func (_u *AccountUpdateOne) AddEventIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
+}
+
+// AddEvents adds the "events" edges to the EventParticipant entity.
+func (_u *AccountUpdateOne) AddEvents(v ...*EventParticipant) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventIDs(ids...)
+}
+
+// AddPostReadIDs adds the "post_reads" edge to the PostRead entity by IDs.
+func (_u *AccountUpdateOne) AddPostReadIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddPostReadIDs(ids...)
+	return _u
+}
+
+// AddPostReads adds the "post_reads" edges to the PostRead entity.
+func (_u *AccountUpdateOne) AddPostReads(v ...*PostRead) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPostReadIDs(ids...)
+}
+
+// AddReportIDs adds the "reports" edge to the Report entity by IDs.
+func (_u *AccountUpdateOne) AddReportIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddReportIDs(ids...)
+	return _u
+}
+
+// AddReports adds the "reports" edges to the Report entity.
+func (_u *AccountUpdateOne) AddReports(v ...*Report) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReportIDs(ids...)
+}
+
+// AddHandledReportIDs adds the "handled_reports" edge to the Report entity by IDs.
+func (_u *AccountUpdateOne) AddHandledReportIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddHandledReportIDs(ids...)
+	return _u
+}
+
+// AddHandledReports adds the "handled_reports" edges to the Report entity.
+func (_u *AccountUpdateOne) AddHandledReports(v ...*Report) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHandledReportIDs(ids...)
+}
+
+// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by IDs.
+func (_u *AccountUpdateOne) AddAuditLogIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddAuditLogIDs(ids...)
+	return _u
+}
+
+// AddAuditLogs adds the "audit_logs" edges to the AuditLog entity.
+func (_u *AccountUpdateOne) AddAuditLogs(v ...*AuditLog) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuditLogIDs(ids...)
+}
+
+// AddPostRevisionIDs adds the "post_revisions" edge to the PostRevision entity by IDs.
+func (_u *AccountUpdateOne) AddPostRevisionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddPostRevisionIDs(ids...)
+	return _u
+}
+
+// AddPostRevisions adds the "post_revisions" edges to the PostRevision entity.
+func (_u *AccountUpdateOne) AddPostRevisions(v ...*PostRevision) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPostRevisionIDs(ids...)
+}
+
+// AddNodeRevisionIDs adds the "node_revisions" edge to the NodeRevision entity by IDs.
+func (_u *AccountUpdateOne) AddNodeRevisionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddNodeRevisionIDs(ids...)
+	return _u
+}
+
+// AddNodeRevisions adds the "node_revisions" edges to the NodeRevision entity.
+func (_u *AccountUpdateOne) AddNodeRevisions(v ...*NodeRevision) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNodeRevisionIDs(ids...)
+}
+
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the NotificationPreference entity by IDs.
+func (_u *AccountUpdateOne) AddNotificationPreferenceIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// AddNotificationPreferences adds the "notification_preferences" edges to the NotificationPreference entity.
+func (_u *AccountUpdateOne) AddNotificationPreferences(v ...*NotificationPreference) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationPreferenceIDs(ids...)
+}
+
+// SetNotificationDigestID sets the "notification_digest" edge to the NotificationDigest entity by ID.
+func (_u *AccountUpdateOne) SetNotificationDigestID(id xid.ID) *AccountUpdateOne {
+	_u.mutation.SetNotificationDigestID(id)
+	return _u
+}
+
+// SetNillableNotificationDigestID sets the "notification_digest" edge to the NotificationDigest entity by ID if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableNotificationDigestID(id *xid.ID) *AccountUpdateOne {
+	if id != nil {
+		_u = _u.SetNotificationDigestID(*id)
+	}
+	return _u
+}
+
+// SetNotificationDigest sets the "notification_digest" edge to the NotificationDigest entity.
+func (_u *AccountUpdateOne) SetNotificationDigest(v *NotificationDigest) *AccountUpdateOne {
+	return _u.SetNotificationDigestID(v.ID)
 }
 
-// AddNodeIDs adds the "nodes" edge to the Node entity by IDs.
-func (_u *AccountUpdateOne) AddNodeIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddNodeIDs(ids...)
+// AddPollVoteIDs adds the "poll_votes" edge to the PollVote entity by IDs.
+func (_u *AccountUpdateOne) AddPollVoteIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddPollVoteIDs(ids...)
 	return _u
 }
 
-// AddNodes adds the "nodes" edges to the Node entity.
-func (_u *AccountUpdateOne) AddNodes(v ...*Node) *AccountUpdateOne {
+// AddPollVotes adds the "poll_votes" edges to the PollVote entity.
+func (_u *AccountUpdateOne) AddPollVotes(v ...*PollVote) *AccountUpdateOne {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddNodeIDs(ids...)
+	return _u.AddPollVoteIDs(ids...)
 }
 
-// AddAssetIDs adds the "assets" edge to the Asset entity by IDs.
-func (_u *AccountUpdateOne) AddAssetIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddAssetIDs(ids...)
+// AddConversationIDs adds the "conversations" edge to the ConversationParticipant entity by IDs.
+func (_u *AccountUpdateOne) AddConversationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddConversationIDs(ids...)
 	return _u
 }
 
-// AddAssets adds the "assets" edges to the Asset entity.
-func (_u *AccountUpdateOne) AddAssets(v ...*Asset) *AccountUpdateOne {
+// AddConversations adds the "conversations" edges to the ConversationParticipant entity.
+func (_u *AccountUpdateOne) AddConversations(v ...*ConversationParticipant) *AccountUpdateOne {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddAssetIDs(ids...)
+	return _u.AddConversationIDs(ids...)
 }
 
-// AddEventIDs adds the "events" edge to the EventParticipant entity by IDs.
-func (_u *AccountUpdateOne) AddEventIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddEventIDs(ids...)
+// AddConversationMessageIDs adds the "conversation_messages" edge to the ConversationMessage entity by IDs.
+func (_u *AccountUpdateOne) AddConversationMessageIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddConversationMessageIDs(ids...)
 	return _u
 }
 
-// AddEvents adds the "events" edges to the EventParticipant entity.
-func (_u *AccountUpdateOne) AddEvents(v ...*EventParticipant) *AccountUpdateOne {
+// AddConversationMessages adds the "conversation_messages" edges to the ConversationMessage entity.
+func (_u *AccountUpdateOne) AddConversationMessages(v ...*ConversationMessage) *AccountUpdateOne {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddEventIDs(ids...)
+	return _u.AddConversationMessageIDs(ids...)
 }
 
-// AddPostReadIDs adds the "post_reads" edge to the PostRead entity by IDs.
-func (_u *AccountUpdateOne) AddPostReadIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddPostReadIDs(ids...)
+// AddBlockIDs adds the "blocks" edge to the AccountBlock entity by IDs.
+func (_u *AccountUpdateOne) AddBlockIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddBlockIDs(ids...)
 	return _u
 }
 
-// AddPostReads adds the "post_reads" edges to the PostRead entity.
-func (_u *AccountUpdateOne) AddPostReads(v ...*PostRead) *AccountUpdateOne {
+// AddBlocks adds the "blocks" edges to the AccountBlock entity.
+func (_u *AccountUpdateOne) AddBlocks(v ...*AccountBlock) *AccountUpdateOne {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddPostReadIDs(ids...)
+	return _u.AddBlockIDs(ids...)
 }
 
-// AddReportIDs adds the "reports" edge to the Report entity by IDs.
-func (_u *AccountUpdateOne) AddReportIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddReportIDs(ids...)
+// AddBlockedByIDs adds the "blocked_by" edge to the AccountBlock entity by IDs.
+func (_u *AccountUpdateOne) AddBlockedByIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddBlockedByIDs(ids...)
 	return _u
 }
 
-// AddReports adds the "reports" edges to the Report entity.
-func (_u *AccountUpdateOne) AddReports(v ...*Report) *AccountUpdateOne {
+// AddBlockedBy adds the "blocked_by" edges to the AccountBlock entity.
+func (_u *AccountUpdateOne) AddBlockedBy(v ...*AccountBlock) *AccountUpdateOne {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddReportIDs(ids...)
+	return _u.AddBlockedByIDs(ids...)
 }
 
-// AddHandledReportIDs adds the "handled_reports" edge to the Report entity by IDs.
-func (_u *AccountUpdateOne) AddHandledReportIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.AddHandledReportIDs(ids...)
+// AddPublishScheduleIDs adds the "publish_schedules" edge to the PublishSchedule entity by IDs.
+func (_u *AccountUpdateOne) AddPublishScheduleIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.AddPublishScheduleIDs(ids...)
 	return _u
 }
 
-// AddHandledReports adds the "handled_reports" edges to the Report entity.
-func (_u *AccountUpdateOne) AddHandledReports(v ...*Report) *AccountUpdateOne {
+// AddPublishSchedules adds the "publish_schedules" edges to the PublishSchedule entity.
+func (_u *AccountUpdateOne) AddPublishSchedules(v ...*PublishSchedule) *AccountUpdateOne {
 	ids := make([]xid.ID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddHandledReportIDs(ids...)
+	return _u.AddPublishScheduleIDs(ids...)
 }
 
 // AddAccountRoleIDs adds the "account_roles" edge to the AccountRoles entity by IDs.
@@ -3249,248 +4522,973 @@ func (_u *AccountUpdateOne) RemovePostReads(v ...*PostRead) *AccountUpdateOne {
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemovePostReadIDs(ids...)
+	return _u.RemovePostReadIDs(ids...)
+}
+
+// ClearReports clears all "reports" edges to the Report entity.
+func (_u *AccountUpdateOne) ClearReports() *AccountUpdateOne {
+	_u.mutation.ClearReports()
+	return _u
+}
+
+// RemoveReportIDs removes the "reports" edge to Report entities by IDs.
+func (_u *AccountUpdateOne) RemoveReportIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveReportIDs(ids...)
+	return _u
+}
+
+// RemoveReports removes "reports" edges to Report entities.
+func (_u *AccountUpdateOne) RemoveReports(v ...*Report) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReportIDs(ids...)
+}
+
+// ClearHandledReports clears all "handled_reports" edges to the Report entity.
+func (_u *AccountUpdateOne) ClearHandledReports() *AccountUpdateOne {
+	_u.mutation.ClearHandledReports()
+	return _u
+}
+
+// RemoveHandledReportIDs removes the "handled_reports" edge to Report entities by IDs.
+func (_u *AccountUpdateOne) RemoveHandledReportIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveHandledReportIDs(ids...)
+	return _u
+}
+
+// RemoveHandledReports removes "handled_reports" edges to Report entities.
+func (_u *AccountUpdateOne) RemoveHandledReports(v ...*Report) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHandledReportIDs(ids...)
+}
+
+// ClearAuditLogs clears all "audit_logs" edges to the AuditLog entity.
+func (_u *AccountUpdateOne) ClearAuditLogs() *AccountUpdateOne {
+	_u.mutation.ClearAuditLogs()
+	return _u
+}
+
+// RemoveAuditLogIDs removes the "audit_logs" edge to AuditLog entities by IDs.
+func (_u *AccountUpdateOne) RemoveAuditLogIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveAuditLogIDs(ids...)
+	return _u
+}
+
+// RemoveAuditLogs removes "audit_logs" edges to AuditLog entities.
+func (_u *AccountUpdateOne) RemoveAuditLogs(v ...*AuditLog) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuditLogIDs(ids...)
+}
+
+// ClearPostRevisions clears all "post_revisions" edges to the PostRevision entity.
+func (_u *AccountUpdateOne) ClearPostRevisions() *AccountUpdateOne {
+	_u.mutation.ClearPostRevisions()
+	return _u
+}
+
+// RemovePostRevisionIDs removes the "post_revisions" edge to PostRevision entities by IDs.
+func (_u *AccountUpdateOne) RemovePostRevisionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemovePostRevisionIDs(ids...)
+	return _u
+}
+
+// RemovePostRevisions removes "post_revisions" edges to PostRevision entities.
+func (_u *AccountUpdateOne) RemovePostRevisions(v ...*PostRevision) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePostRevisionIDs(ids...)
+}
+
+// ClearNodeRevisions clears all "node_revisions" edges to the NodeRevision entity.
+func (_u *AccountUpdateOne) ClearNodeRevisions() *AccountUpdateOne {
+	_u.mutation.ClearNodeRevisions()
+	return _u
+}
+
+// RemoveNodeRevisionIDs removes the "node_revisions" edge to NodeRevision entities by IDs.
+func (_u *AccountUpdateOne) RemoveNodeRevisionIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveNodeRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveNodeRevisions removes "node_revisions" edges to NodeRevision entities.
+func (_u *AccountUpdateOne) RemoveNodeRevisions(v ...*NodeRevision) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNodeRevisionIDs(ids...)
+}
+
+// ClearNotificationPreferences clears all "notification_preferences" edges to the NotificationPreference entity.
+func (_u *AccountUpdateOne) ClearNotificationPreferences() *AccountUpdateOne {
+	_u.mutation.ClearNotificationPreferences()
+	return _u
+}
+
+// RemoveNotificationPreferenceIDs removes the "notification_preferences" edge to NotificationPreference entities by IDs.
+func (_u *AccountUpdateOne) RemoveNotificationPreferenceIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationPreferences removes "notification_preferences" edges to NotificationPreference entities.
+func (_u *AccountUpdateOne) RemoveNotificationPreferences(v ...*NotificationPreference) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationPreferenceIDs(ids...)
+}
+
+// ClearNotificationDigest clears the "notification_digest" edge to the NotificationDigest entity.
+func (_u *AccountUpdateOne) ClearNotificationDigest() *AccountUpdateOne {
+	_u.mutation.ClearNotificationDigest()
+	return _u
+}
+
+// ClearPollVotes clears all "poll_votes" edges to the PollVote entity.
+func (_u *AccountUpdateOne) ClearPollVotes() *AccountUpdateOne {
+	_u.mutation.ClearPollVotes()
+	return _u
+}
+
+// RemovePollVoteIDs removes the "poll_votes" edge to PollVote entities by IDs.
+func (_u *AccountUpdateOne) RemovePollVoteIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemovePollVoteIDs(ids...)
+	return _u
+}
+
+// RemovePollVotes removes "poll_votes" edges to PollVote entities.
+func (_u *AccountUpdateOne) RemovePollVotes(v ...*PollVote) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePollVoteIDs(ids...)
+}
+
+// ClearConversations clears all "conversations" edges to the ConversationParticipant entity.
+func (_u *AccountUpdateOne) ClearConversations() *AccountUpdateOne {
+	_u.mutation.ClearConversations()
+	return _u
+}
+
+// RemoveConversationIDs removes the "conversations" edge to ConversationParticipant entities by IDs.
+func (_u *AccountUpdateOne) RemoveConversationIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveConversationIDs(ids...)
+	return _u
+}
+
+// RemoveConversations removes "conversations" edges to ConversationParticipant entities.
+func (_u *AccountUpdateOne) RemoveConversations(v ...*ConversationParticipant) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveConversationIDs(ids...)
+}
+
+// ClearConversationMessages clears all "conversation_messages" edges to the ConversationMessage entity.
+func (_u *AccountUpdateOne) ClearConversationMessages() *AccountUpdateOne {
+	_u.mutation.ClearConversationMessages()
+	return _u
+}
+
+// RemoveConversationMessageIDs removes the "conversation_messages" edge to ConversationMessage entities by IDs.
+func (_u *AccountUpdateOne) RemoveConversationMessageIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveConversationMessageIDs(ids...)
+	return _u
+}
+
+// RemoveConversationMessages removes "conversation_messages" edges to ConversationMessage entities.
+func (_u *AccountUpdateOne) RemoveConversationMessages(v ...*ConversationMessage) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveConversationMessageIDs(ids...)
+}
+
+// ClearBlocks clears all "blocks" edges to the AccountBlock entity.
+func (_u *AccountUpdateOne) ClearBlocks() *AccountUpdateOne {
+	_u.mutation.ClearBlocks()
+	return _u
+}
+
+// RemoveBlockIDs removes the "blocks" edge to AccountBlock entities by IDs.
+func (_u *AccountUpdateOne) RemoveBlockIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveBlockIDs(ids...)
+	return _u
+}
+
+// RemoveBlocks removes "blocks" edges to AccountBlock entities.
+func (_u *AccountUpdateOne) RemoveBlocks(v ...*AccountBlock) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the AccountBlock entity.
+func (_u *AccountUpdateOne) ClearBlockedBy() *AccountUpdateOne {
+	_u.mutation.ClearBlockedBy()
+	return _u
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to AccountBlock entities by IDs.
+func (_u *AccountUpdateOne) RemoveBlockedByIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveBlockedByIDs(ids...)
+	return _u
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to AccountBlock entities.
+func (_u *AccountUpdateOne) RemoveBlockedBy(v ...*AccountBlock) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockedByIDs(ids...)
+}
+
+// ClearPublishSchedules clears all "publish_schedules" edges to the PublishSchedule entity.
+func (_u *AccountUpdateOne) ClearPublishSchedules() *AccountUpdateOne {
+	_u.mutation.ClearPublishSchedules()
+	return _u
+}
+
+// RemovePublishScheduleIDs removes the "publish_schedules" edge to PublishSchedule entities by IDs.
+func (_u *AccountUpdateOne) RemovePublishScheduleIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemovePublishScheduleIDs(ids...)
+	return _u
+}
+
+// RemovePublishSchedules removes "publish_schedules" edges to PublishSchedule entities.
+func (_u *AccountUpdateOne) RemovePublishSchedules(v ...*PublishSchedule) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePublishScheduleIDs(ids...)
+}
+
+// ClearAccountRoles clears all "account_roles" edges to the AccountRoles entity.
+func (_u *AccountUpdateOne) ClearAccountRoles() *AccountUpdateOne {
+	_u.mutation.ClearAccountRoles()
+	return _u
+}
+
+// RemoveAccountRoleIDs removes the "account_roles" edge to AccountRoles entities by IDs.
+func (_u *AccountUpdateOne) RemoveAccountRoleIDs(ids ...xid.ID) *AccountUpdateOne {
+	_u.mutation.RemoveAccountRoleIDs(ids...)
+	return _u
+}
+
+// RemoveAccountRoles removes "account_roles" edges to AccountRoles entities.
+func (_u *AccountUpdateOne) RemoveAccountRoles(v ...*AccountRoles) *AccountUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAccountRoleIDs(ids...)
+}
+
+// Where appends a list predicates to the AccountUpdate builder.
+func (_u *AccountUpdateOne) Where(ps ...predicate.Account) *AccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccountUpdateOne) Select(field string, fields ...string) *AccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Account entity.
+func (_u *AccountUpdateOne) Save(ctx context.Context) (*Account, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountUpdateOne) SaveX(ctx context.Context) *Account {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
 }
 
-// ClearReports clears all "reports" edges to the Report entity.
-func (_u *AccountUpdateOne) ClearReports() *AccountUpdateOne {
-	_u.mutation.ClearReports()
-	return _u
+// Exec executes the query on the entity.
+func (_u *AccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
 }
 
-// RemoveReportIDs removes the "reports" edge to Report entities by IDs.
-func (_u *AccountUpdateOne) RemoveReportIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveReportIDs(ids...)
-	return _u
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
 
-// RemoveReports removes "reports" edges to Report entities.
-func (_u *AccountUpdateOne) RemoveReports(v ...*Report) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// defaults sets the default values of the builder before save.
+func (_u *AccountUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := account.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
 	}
-	return _u.RemoveReportIDs(ids...)
 }
 
-// ClearHandledReports clears all "handled_reports" edges to the Report entity.
-func (_u *AccountUpdateOne) ClearHandledReports() *AccountUpdateOne {
-	_u.mutation.ClearHandledReports()
-	return _u
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountUpdateOne) check() error {
+	if v, ok := _u.mutation.Handle(); ok {
+		if err := account.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "Account.handle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := account.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Account.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := account.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Account.kind": %w`, err)}
+		}
+	}
+	return nil
 }
 
-// RemoveHandledReportIDs removes the "handled_reports" edge to Report entities by IDs.
-func (_u *AccountUpdateOne) RemoveHandledReportIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveHandledReportIDs(ids...)
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
 	return _u
 }
 
-// RemoveHandledReports removes "handled_reports" edges to Report entities.
-func (_u *AccountUpdateOne) RemoveHandledReports(v ...*Report) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Account.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, account.FieldID)
+		for _, f := range fields {
+			if !account.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != account.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(account.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(account.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(account.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.IndexedAt(); ok {
+		_spec.SetField(account.FieldIndexedAt, field.TypeTime, value)
+	}
+	if _u.mutation.IndexedAtCleared() {
+		_spec.ClearField(account.FieldIndexedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Handle(); ok {
+		_spec.SetField(account.FieldHandle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(account.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(account.FieldBio, field.TypeString, value)
+	}
+	if _u.mutation.BioCleared() {
+		_spec.ClearField(account.FieldBio, field.TypeString)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(account.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Admin(); ok {
+		_spec.SetField(account.FieldAdmin, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Links(); ok {
+		_spec.SetField(account.FieldLinks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLinks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, account.FieldLinks, value)
+		})
+	}
+	if _u.mutation.LinksCleared() {
+		_spec.ClearField(account.FieldLinks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(account.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(account.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SuspendedReason(); ok {
+		_spec.SetField(account.FieldSuspendedReason, field.TypeString, value)
+	}
+	if _u.mutation.SuspendedReasonCleared() {
+		_spec.ClearField(account.FieldSuspendedReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuspendedUntil(); ok {
+		_spec.SetField(account.FieldSuspendedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SuspendedUntilCleared() {
+		_spec.ClearField(account.FieldSuspendedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SuspendedByID(); ok {
+		_spec.SetField(account.FieldSuspendedByID, field.TypeString, value)
+	}
+	if _u.mutation.SuspendedByIDCleared() {
+		_spec.ClearField(account.FieldSuspendedByID, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(account.FieldDeletionScheduledAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletionScheduledAtCleared() {
+		_spec.ClearField(account.FieldDeletionScheduledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CalendarToken(); ok {
+		_spec.SetField(account.FieldCalendarToken, field.TypeString, value)
+	}
+	if _u.mutation.CalendarTokenCleared() {
+		_spec.ClearField(account.FieldCalendarToken, field.TypeString)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SessionsTable,
+			Columns: []string{account.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SessionsTable,
+			Columns: []string{account.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SessionsTable,
+			Columns: []string{account.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EmailsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EmailsTable,
+			Columns: []string{account.EmailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEmailsIDs(); len(nodes) > 0 && !_u.mutation.EmailsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EmailsTable,
+			Columns: []string{account.EmailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EmailsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.EmailsTable,
+			Columns: []string{account.EmailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NotificationsTable,
+			Columns: []string{account.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveHandledReportIDs(ids...)
-}
-
-// ClearAccountRoles clears all "account_roles" edges to the AccountRoles entity.
-func (_u *AccountUpdateOne) ClearAccountRoles() *AccountUpdateOne {
-	_u.mutation.ClearAccountRoles()
-	return _u
-}
-
-// RemoveAccountRoleIDs removes the "account_roles" edge to AccountRoles entities by IDs.
-func (_u *AccountUpdateOne) RemoveAccountRoleIDs(ids ...xid.ID) *AccountUpdateOne {
-	_u.mutation.RemoveAccountRoleIDs(ids...)
-	return _u
-}
-
-// RemoveAccountRoles removes "account_roles" edges to AccountRoles entities.
-func (_u *AccountUpdateOne) RemoveAccountRoles(v ...*AccountRoles) *AccountUpdateOne {
-	ids := make([]xid.ID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NotificationsTable,
+			Columns: []string{account.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveAccountRoleIDs(ids...)
-}
-
-// Where appends a list predicates to the AccountUpdate builder.
-func (_u *AccountUpdateOne) Where(ps ...predicate.Account) *AccountUpdateOne {
-	_u.mutation.Where(ps...)
-	return _u
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (_u *AccountUpdateOne) Select(field string, fields ...string) *AccountUpdateOne {
-	_u.fields = append([]string{field}, fields...)
-	return _u
-}
-
-// Save executes the query and returns the updated Account entity.
-func (_u *AccountUpdateOne) Save(ctx context.Context) (*Account, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (_u *AccountUpdateOne) SaveX(ctx context.Context) *Account {
-	node, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.NotificationsTable,
+			Columns: []string{account.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (_u *AccountUpdateOne) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *AccountUpdateOne) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+	if _u.mutation.TriggeredNotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TriggeredNotificationsTable,
+			Columns: []string{account.TriggeredNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (_u *AccountUpdateOne) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := account.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
+	if nodes := _u.mutation.RemovedTriggeredNotificationsIDs(); len(nodes) > 0 && !_u.mutation.TriggeredNotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TriggeredNotificationsTable,
+			Columns: []string{account.TriggeredNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (_u *AccountUpdateOne) check() error {
-	if v, ok := _u.mutation.Handle(); ok {
-		if err := account.HandleValidator(v); err != nil {
-			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "Account.handle": %w`, err)}
+	if nodes := _u.mutation.TriggeredNotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TriggeredNotificationsTable,
+			Columns: []string{account.TriggeredNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := _u.mutation.Name(); ok {
-		if err := account.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Account.name": %w`, err)}
+	if _u.mutation.FollowingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowingTable,
+			Columns: []string{account.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := _u.mutation.Kind(); ok {
-		if err := account.KindValidator(v); err != nil {
-			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Account.kind": %w`, err)}
+	if nodes := _u.mutation.RemovedFollowingIDs(); len(nodes) > 0 && !_u.mutation.FollowingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowingTable,
+			Columns: []string{account.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
-func (_u *AccountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountUpdateOne {
-	_u.modifiers = append(_u.modifiers, modifiers...)
-	return _u
-}
-
-func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
+	if nodes := _u.mutation.FollowingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowingTable,
+			Columns: []string{account.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeString))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Account.id" for update`)}
+	if _u.mutation.FollowedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowedByTable,
+			Columns: []string{account.FollowedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, account.FieldID)
-		for _, f := range fields {
-			if !account.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != account.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := _u.mutation.RemovedFollowedByIDs(); len(nodes) > 0 && !_u.mutation.FollowedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowedByTable,
+			Columns: []string{account.FollowedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FollowedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FollowedByTable,
+			Columns: []string{account.FollowedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+			},
 		}
-	}
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(account.FieldUpdatedAt, field.TypeTime, value)
-	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(account.FieldDeletedAt, field.TypeTime, value)
-	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(account.FieldDeletedAt, field.TypeTime)
+	if _u.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.InvitationsTable,
+			Columns: []string{account.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.IndexedAt(); ok {
-		_spec.SetField(account.FieldIndexedAt, field.TypeTime, value)
+	if nodes := _u.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !_u.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.InvitationsTable,
+			Columns: []string{account.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.IndexedAtCleared() {
-		_spec.ClearField(account.FieldIndexedAt, field.TypeTime)
+	if nodes := _u.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.InvitationsTable,
+			Columns: []string{account.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.Handle(); ok {
-		_spec.SetField(account.FieldHandle, field.TypeString, value)
+	if _u.mutation.InvitedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.InvitedByTable,
+			Columns: []string{account.InvitedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(account.FieldName, field.TypeString, value)
+	if nodes := _u.mutation.InvitedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.InvitedByTable,
+			Columns: []string{account.InvitedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.Bio(); ok {
-		_spec.SetField(account.FieldBio, field.TypeString, value)
+	if _u.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostsTable,
+			Columns: []string{account.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.BioCleared() {
-		_spec.ClearField(account.FieldBio, field.TypeString)
+	if nodes := _u.mutation.RemovedPostsIDs(); len(nodes) > 0 && !_u.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostsTable,
+			Columns: []string{account.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Kind(); ok {
-		_spec.SetField(account.FieldKind, field.TypeEnum, value)
+	if nodes := _u.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostsTable,
+			Columns: []string{account.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.Admin(); ok {
-		_spec.SetField(account.FieldAdmin, field.TypeBool, value)
+	if _u.mutation.QuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.QuestionsTable,
+			Columns: []string{account.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Links(); ok {
-		_spec.SetField(account.FieldLinks, field.TypeJSON, value)
+	if nodes := _u.mutation.RemovedQuestionsIDs(); len(nodes) > 0 && !_u.mutation.QuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.QuestionsTable,
+			Columns: []string{account.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.AppendedLinks(); ok {
-		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, account.FieldLinks, value)
-		})
+	if nodes := _u.mutation.QuestionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.QuestionsTable,
+			Columns: []string{account.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LinksCleared() {
-		_spec.ClearField(account.FieldLinks, field.TypeJSON)
+	if _u.mutation.ReactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReactsTable,
+			Columns: []string{account.ReactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Metadata(); ok {
-		_spec.SetField(account.FieldMetadata, field.TypeJSON, value)
+	if nodes := _u.mutation.RemovedReactsIDs(); len(nodes) > 0 && !_u.mutation.ReactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReactsTable,
+			Columns: []string{account.ReactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.MetadataCleared() {
-		_spec.ClearField(account.FieldMetadata, field.TypeJSON)
+	if nodes := _u.mutation.ReactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ReactsTable,
+			Columns: []string{account.ReactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SessionsCleared() {
+	if _u.mutation.LikesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.SessionsTable,
-			Columns: []string{account.SessionsColumn},
+			Table:   account.LikesTable,
+			Columns: []string{account.LikesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+	if nodes := _u.mutation.RemovedLikesIDs(); len(nodes) > 0 && !_u.mutation.LikesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.SessionsTable,
-			Columns: []string{account.SessionsColumn},
+			Table:   account.LikesTable,
+			Columns: []string{account.LikesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3498,15 +5496,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LikesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.SessionsTable,
-			Columns: []string{account.SessionsColumn},
+			Table:   account.LikesTable,
+			Columns: []string{account.LikesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3514,28 +5512,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.EmailsCleared() {
+	if _u.mutation.MentionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EmailsTable,
-			Columns: []string{account.EmailsColumn},
+			Table:   account.MentionsTable,
+			Columns: []string{account.MentionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedEmailsIDs(); len(nodes) > 0 && !_u.mutation.EmailsCleared() {
+	if nodes := _u.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !_u.mutation.MentionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EmailsTable,
-			Columns: []string{account.EmailsColumn},
+			Table:   account.MentionsTable,
+			Columns: []string{account.MentionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3543,15 +5541,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.EmailsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.MentionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EmailsTable,
-			Columns: []string{account.EmailsColumn},
+			Table:   account.MentionsTable,
+			Columns: []string{account.MentionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(email.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3559,73 +5557,94 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NotificationsCleared() {
+	if _u.mutation.RolesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.NotificationsTable,
-			Columns: []string{account.NotificationsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   account.RolesTable,
+			Columns: account.RolesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
 			},
 		}
+		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.NotificationsTable,
-			Columns: []string{account.NotificationsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   account.RolesTable,
+			Columns: account.RolesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.NotificationsTable,
-			Columns: []string{account.NotificationsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   account.RolesTable,
+			Columns: account.RolesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TriggeredNotificationsCleared() {
+	if _u.mutation.AuthenticationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.TriggeredNotificationsTable,
-			Columns: []string{account.TriggeredNotificationsColumn},
+			Table:   account.AuthenticationTable,
+			Columns: []string{account.AuthenticationColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTriggeredNotificationsIDs(); len(nodes) > 0 && !_u.mutation.TriggeredNotificationsCleared() {
+	if nodes := _u.mutation.RemovedAuthenticationIDs(); len(nodes) > 0 && !_u.mutation.AuthenticationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.TriggeredNotificationsTable,
-			Columns: []string{account.TriggeredNotificationsColumn},
+			Table:   account.AuthenticationTable,
+			Columns: []string{account.AuthenticationColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3633,15 +5652,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TriggeredNotificationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AuthenticationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.TriggeredNotificationsTable,
-			Columns: []string{account.TriggeredNotificationsColumn},
+			Table:   account.AuthenticationTable,
+			Columns: []string{account.AuthenticationColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3649,28 +5668,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.FollowingCleared() {
+	if _u.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   account.FollowingTable,
-			Columns: []string{account.FollowingColumn},
+			Table:   account.TagsTable,
+			Columns: account.TagsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedFollowingIDs(); len(nodes) > 0 && !_u.mutation.FollowingCleared() {
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   account.FollowingTable,
-			Columns: []string{account.FollowingColumn},
+			Table:   account.TagsTable,
+			Columns: account.TagsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3678,15 +5697,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.FollowingIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   account.FollowingTable,
-			Columns: []string{account.FollowingColumn},
+			Table:   account.TagsTable,
+			Columns: account.TagsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3694,28 +5713,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.FollowedByCleared() {
+	if _u.mutation.CollectionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.FollowedByTable,
-			Columns: []string{account.FollowedByColumn},
+			Table:   account.CollectionsTable,
+			Columns: []string{account.CollectionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedFollowedByIDs(); len(nodes) > 0 && !_u.mutation.FollowedByCleared() {
+	if nodes := _u.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !_u.mutation.CollectionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.FollowedByTable,
-			Columns: []string{account.FollowedByColumn},
+			Table:   account.CollectionsTable,
+			Columns: []string{account.CollectionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3723,15 +5742,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.FollowedByIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CollectionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.FollowedByTable,
-			Columns: []string{account.FollowedByColumn},
+			Table:   account.CollectionsTable,
+			Columns: []string{account.CollectionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(accountfollow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3739,28 +5758,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.InvitationsCleared() {
+	if _u.mutation.NodesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.InvitationsTable,
-			Columns: []string{account.InvitationsColumn},
+			Table:   account.NodesTable,
+			Columns: []string{account.NodesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !_u.mutation.InvitationsCleared() {
+	if nodes := _u.mutation.RemovedNodesIDs(); len(nodes) > 0 && !_u.mutation.NodesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.InvitationsTable,
-			Columns: []string{account.InvitationsColumn},
+			Table:   account.NodesTable,
+			Columns: []string{account.NodesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3768,15 +5787,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InvitationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NodesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.InvitationsTable,
-			Columns: []string{account.InvitationsColumn},
+			Table:   account.NodesTable,
+			Columns: []string{account.NodesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3784,28 +5803,44 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.InvitedByCleared() {
+	if _u.mutation.AssetsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   account.InvitedByTable,
-			Columns: []string{account.InvitedByColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AssetsTable,
+			Columns: []string{account.AssetsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InvitedByIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RemovedAssetsIDs(); len(nodes) > 0 && !_u.mutation.AssetsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   account.InvitedByTable,
-			Columns: []string{account.InvitedByColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AssetsTable,
+			Columns: []string{account.AssetsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AssetsTable,
+			Columns: []string{account.AssetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3813,28 +5848,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PostsCleared() {
+	if _u.mutation.EventsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostsTable,
-			Columns: []string{account.PostsColumn},
+			Table:   account.EventsTable,
+			Columns: []string{account.EventsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPostsIDs(); len(nodes) > 0 && !_u.mutation.PostsCleared() {
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostsTable,
-			Columns: []string{account.PostsColumn},
+			Table:   account.EventsTable,
+			Columns: []string{account.EventsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3842,15 +5877,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PostsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostsTable,
-			Columns: []string{account.PostsColumn},
+			Table:   account.EventsTable,
+			Columns: []string{account.EventsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3858,28 +5893,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.QuestionsCleared() {
+	if _u.mutation.PostReadsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.QuestionsTable,
-			Columns: []string{account.QuestionsColumn},
+			Table:   account.PostReadsTable,
+			Columns: []string{account.PostReadsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedQuestionsIDs(); len(nodes) > 0 && !_u.mutation.QuestionsCleared() {
+	if nodes := _u.mutation.RemovedPostReadsIDs(); len(nodes) > 0 && !_u.mutation.PostReadsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.QuestionsTable,
-			Columns: []string{account.QuestionsColumn},
+			Table:   account.PostReadsTable,
+			Columns: []string{account.PostReadsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3887,15 +5922,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.QuestionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PostReadsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.QuestionsTable,
-			Columns: []string{account.QuestionsColumn},
+			Table:   account.PostReadsTable,
+			Columns: []string{account.PostReadsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3903,28 +5938,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReactsCleared() {
+	if _u.mutation.ReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReactsTable,
-			Columns: []string{account.ReactsColumn},
+			Table:   account.ReportsTable,
+			Columns: []string{account.ReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReactsIDs(); len(nodes) > 0 && !_u.mutation.ReactsCleared() {
+	if nodes := _u.mutation.RemovedReportsIDs(); len(nodes) > 0 && !_u.mutation.ReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReactsTable,
-			Columns: []string{account.ReactsColumn},
+			Table:   account.ReportsTable,
+			Columns: []string{account.ReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3932,15 +5967,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReactsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ReportsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReactsTable,
-			Columns: []string{account.ReactsColumn},
+			Table:   account.ReportsTable,
+			Columns: []string{account.ReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(react.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3948,28 +5983,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LikesCleared() {
+	if _u.mutation.HandledReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.LikesTable,
-			Columns: []string{account.LikesColumn},
+			Table:   account.HandledReportsTable,
+			Columns: []string{account.HandledReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLikesIDs(); len(nodes) > 0 && !_u.mutation.LikesCleared() {
+	if nodes := _u.mutation.RemovedHandledReportsIDs(); len(nodes) > 0 && !_u.mutation.HandledReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.LikesTable,
-			Columns: []string{account.LikesColumn},
+			Table:   account.HandledReportsTable,
+			Columns: []string{account.HandledReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3977,15 +6012,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LikesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.HandledReportsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.LikesTable,
-			Columns: []string{account.LikesColumn},
+			Table:   account.HandledReportsTable,
+			Columns: []string{account.HandledReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(likepost.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -3993,28 +6028,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.MentionsCleared() {
+	if _u.mutation.AuditLogsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.MentionsTable,
-			Columns: []string{account.MentionsColumn},
+			Table:   account.AuditLogsTable,
+			Columns: []string{account.AuditLogsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !_u.mutation.MentionsCleared() {
+	if nodes := _u.mutation.RemovedAuditLogsIDs(); len(nodes) > 0 && !_u.mutation.AuditLogsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.MentionsTable,
-			Columns: []string{account.MentionsColumn},
+			Table:   account.AuditLogsTable,
+			Columns: []string{account.AuditLogsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4022,15 +6057,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.MentionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AuditLogsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.MentionsTable,
-			Columns: []string{account.MentionsColumn},
+			Table:   account.AuditLogsTable,
+			Columns: []string{account.AuditLogsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(mentionprofile.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4038,94 +6073,73 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.RolesCleared() {
+	if _u.mutation.PostRevisionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   account.RolesTable,
-			Columns: account.RolesPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostRevisionsTable,
+			Columns: []string{account.PostRevisionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeString),
 			},
 		}
-		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+	if nodes := _u.mutation.RemovedPostRevisionsIDs(); len(nodes) > 0 && !_u.mutation.PostRevisionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   account.RolesTable,
-			Columns: account.RolesPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostRevisionsTable,
+			Columns: []string{account.PostRevisionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PostRevisionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   account.RolesTable,
-			Columns: account.RolesPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostRevisionsTable,
+			Columns: []string{account.PostRevisionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &AccountRolesCreate{config: _u.config, mutation: newAccountRolesMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
-		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AuthenticationCleared() {
+	if _u.mutation.NodeRevisionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AuthenticationTable,
-			Columns: []string{account.AuthenticationColumn},
+			Table:   account.NodeRevisionsTable,
+			Columns: []string{account.NodeRevisionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(noderevision.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAuthenticationIDs(); len(nodes) > 0 && !_u.mutation.AuthenticationCleared() {
+	if nodes := _u.mutation.RemovedNodeRevisionsIDs(); len(nodes) > 0 && !_u.mutation.NodeRevisionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AuthenticationTable,
-			Columns: []string{account.AuthenticationColumn},
+			Table:   account.NodeRevisionsTable,
+			Columns: []string{account.NodeRevisionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(noderevision.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4133,15 +6147,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AuthenticationIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NodeRevisionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AuthenticationTable,
-			Columns: []string{account.AuthenticationColumn},
+			Table:   account.NodeRevisionsTable,
+			Columns: []string{account.NodeRevisionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(authentication.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(noderevision.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4149,28 +6163,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TagsCleared() {
+	if _u.mutation.NotificationPreferencesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.TagsTable,
-			Columns: account.TagsPrimaryKey,
+			Table:   account.NotificationPreferencesTable,
+			Columns: []string{account.NotificationPreferencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+	if nodes := _u.mutation.RemovedNotificationPreferencesIDs(); len(nodes) > 0 && !_u.mutation.NotificationPreferencesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.TagsTable,
-			Columns: account.TagsPrimaryKey,
+			Table:   account.NotificationPreferencesTable,
+			Columns: []string{account.NotificationPreferencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4178,15 +6192,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.TagsTable,
-			Columns: account.TagsPrimaryKey,
+			Table:   account.NotificationPreferencesTable,
+			Columns: []string{account.NotificationPreferencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4194,44 +6208,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CollectionsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   account.CollectionsTable,
-			Columns: []string{account.CollectionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !_u.mutation.CollectionsCleared() {
+	if _u.mutation.NotificationDigestCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.O2O,
 			Inverse: false,
-			Table:   account.CollectionsTable,
-			Columns: []string{account.CollectionsColumn},
+			Table:   account.NotificationDigestTable,
+			Columns: []string{account.NotificationDigestColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notificationdigest.FieldID, field.TypeString),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CollectionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.NotificationDigestIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.O2O,
 			Inverse: false,
-			Table:   account.CollectionsTable,
-			Columns: []string{account.CollectionsColumn},
+			Table:   account.NotificationDigestTable,
+			Columns: []string{account.NotificationDigestColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notificationdigest.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4239,28 +6237,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.NodesCleared() {
+	if _u.mutation.PollVotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.NodesTable,
-			Columns: []string{account.NodesColumn},
+			Table:   account.PollVotesTable,
+			Columns: []string{account.PollVotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedNodesIDs(); len(nodes) > 0 && !_u.mutation.NodesCleared() {
+	if nodes := _u.mutation.RemovedPollVotesIDs(); len(nodes) > 0 && !_u.mutation.PollVotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.NodesTable,
-			Columns: []string{account.NodesColumn},
+			Table:   account.PollVotesTable,
+			Columns: []string{account.PollVotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4268,15 +6266,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.NodesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PollVotesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.NodesTable,
-			Columns: []string{account.NodesColumn},
+			Table:   account.PollVotesTable,
+			Columns: []string{account.PollVotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4284,28 +6282,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AssetsCleared() {
+	if _u.mutation.ConversationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AssetsTable,
-			Columns: []string{account.AssetsColumn},
+			Table:   account.ConversationsTable,
+			Columns: []string{account.ConversationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAssetsIDs(); len(nodes) > 0 && !_u.mutation.AssetsCleared() {
+	if nodes := _u.mutation.RemovedConversationsIDs(); len(nodes) > 0 && !_u.mutation.ConversationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AssetsTable,
-			Columns: []string{account.AssetsColumn},
+			Table:   account.ConversationsTable,
+			Columns: []string{account.ConversationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4313,15 +6311,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AssetsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ConversationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.AssetsTable,
-			Columns: []string{account.AssetsColumn},
+			Table:   account.ConversationsTable,
+			Columns: []string{account.ConversationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4329,28 +6327,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.EventsCleared() {
+	if _u.mutation.ConversationMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EventsTable,
-			Columns: []string{account.EventsColumn},
+			Table:   account.ConversationMessagesTable,
+			Columns: []string{account.ConversationMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(conversationmessage.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+	if nodes := _u.mutation.RemovedConversationMessagesIDs(); len(nodes) > 0 && !_u.mutation.ConversationMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EventsTable,
-			Columns: []string{account.EventsColumn},
+			Table:   account.ConversationMessagesTable,
+			Columns: []string{account.ConversationMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(conversationmessage.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4358,15 +6356,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ConversationMessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.EventsTable,
-			Columns: []string{account.EventsColumn},
+			Table:   account.ConversationMessagesTable,
+			Columns: []string{account.ConversationMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(eventparticipant.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(conversationmessage.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4374,28 +6372,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PostReadsCleared() {
+	if _u.mutation.BlocksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostReadsTable,
-			Columns: []string{account.PostReadsColumn},
+			Table:   account.BlocksTable,
+			Columns: []string{account.BlocksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPostReadsIDs(); len(nodes) > 0 && !_u.mutation.PostReadsCleared() {
+	if nodes := _u.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !_u.mutation.BlocksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostReadsTable,
-			Columns: []string{account.PostReadsColumn},
+			Table:   account.BlocksTable,
+			Columns: []string{account.BlocksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4403,15 +6401,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PostReadsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BlocksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.PostReadsTable,
-			Columns: []string{account.PostReadsColumn},
+			Table:   account.BlocksTable,
+			Columns: []string{account.BlocksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(postread.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4419,28 +6417,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReportsCleared() {
+	if _u.mutation.BlockedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReportsTable,
-			Columns: []string{account.ReportsColumn},
+			Table:   account.BlockedByTable,
+			Columns: []string{account.BlockedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReportsIDs(); len(nodes) > 0 && !_u.mutation.ReportsCleared() {
+	if nodes := _u.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !_u.mutation.BlockedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReportsTable,
-			Columns: []string{account.ReportsColumn},
+			Table:   account.BlockedByTable,
+			Columns: []string{account.BlockedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4448,15 +6446,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReportsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BlockedByIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.ReportsTable,
-			Columns: []string{account.ReportsColumn},
+			Table:   account.BlockedByTable,
+			Columns: []string{account.BlockedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(accountblock.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4464,28 +6462,28 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.HandledReportsCleared() {
+	if _u.mutation.PublishSchedulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.HandledReportsTable,
-			Columns: []string{account.HandledReportsColumn},
+			Table:   account.PublishSchedulesTable,
+			Columns: []string{account.PublishSchedulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(publishschedule.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedHandledReportsIDs(); len(nodes) > 0 && !_u.mutation.HandledReportsCleared() {
+	if nodes := _u.mutation.RemovedPublishSchedulesIDs(); len(nodes) > 0 && !_u.mutation.PublishSchedulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.HandledReportsTable,
-			Columns: []string{account.HandledReportsColumn},
+			Table:   account.PublishSchedulesTable,
+			Columns: []string{account.PublishSchedulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(publishschedule.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -4493,15 +6491,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.HandledReportsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PublishSchedulesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   account.HandledReportsTable,
-			Columns: []string{account.HandledReportsColumn},
+			Table:   account.PublishSchedulesTable,
+			Columns: []string{account.PublishSchedulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(publishschedule.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
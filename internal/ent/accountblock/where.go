@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountblock
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldAccountID, v))
+}
+
+// BlockedAccountID applies equality check predicate on the "blocked_account_id" field. It's identical to BlockedAccountIDEQ.
+func BlockedAccountID(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldBlockedAccountID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldContains(FieldAccountID, vc))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldHasPrefix(FieldAccountID, vc))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldHasSuffix(FieldAccountID, vc))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldEqualFold(FieldAccountID, vc))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldContainsFold(FieldAccountID, vc))
+}
+
+// BlockedAccountIDEQ applies the EQ predicate on the "blocked_account_id" field.
+func BlockedAccountIDEQ(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldEQ(FieldBlockedAccountID, v))
+}
+
+// BlockedAccountIDNEQ applies the NEQ predicate on the "blocked_account_id" field.
+func BlockedAccountIDNEQ(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNEQ(FieldBlockedAccountID, v))
+}
+
+// BlockedAccountIDIn applies the In predicate on the "blocked_account_id" field.
+func BlockedAccountIDIn(vs ...xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldIn(FieldBlockedAccountID, vs...))
+}
+
+// BlockedAccountIDNotIn applies the NotIn predicate on the "blocked_account_id" field.
+func BlockedAccountIDNotIn(vs ...xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldNotIn(FieldBlockedAccountID, vs...))
+}
+
+// BlockedAccountIDGT applies the GT predicate on the "blocked_account_id" field.
+func BlockedAccountIDGT(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGT(FieldBlockedAccountID, v))
+}
+
+// BlockedAccountIDGTE applies the GTE predicate on the "blocked_account_id" field.
+func BlockedAccountIDGTE(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldGTE(FieldBlockedAccountID, v))
+}
+
+// BlockedAccountIDLT applies the LT predicate on the "blocked_account_id" field.
+func BlockedAccountIDLT(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLT(FieldBlockedAccountID, v))
+}
+
+// BlockedAccountIDLTE applies the LTE predicate on the "blocked_account_id" field.
+func BlockedAccountIDLTE(v xid.ID) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.FieldLTE(FieldBlockedAccountID, v))
+}
+
+// BlockedAccountIDContains applies the Contains predicate on the "blocked_account_id" field.
+func BlockedAccountIDContains(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldContains(FieldBlockedAccountID, vc))
+}
+
+// BlockedAccountIDHasPrefix applies the HasPrefix predicate on the "blocked_account_id" field.
+func BlockedAccountIDHasPrefix(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldHasPrefix(FieldBlockedAccountID, vc))
+}
+
+// BlockedAccountIDHasSuffix applies the HasSuffix predicate on the "blocked_account_id" field.
+func BlockedAccountIDHasSuffix(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldHasSuffix(FieldBlockedAccountID, vc))
+}
+
+// BlockedAccountIDEqualFold applies the EqualFold predicate on the "blocked_account_id" field.
+func BlockedAccountIDEqualFold(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldEqualFold(FieldBlockedAccountID, vc))
+}
+
+// BlockedAccountIDContainsFold applies the ContainsFold predicate on the "blocked_account_id" field.
+func BlockedAccountIDContainsFold(v xid.ID) predicate.AccountBlock {
+	vc := v.String()
+	return predicate.AccountBlock(sql.FieldContainsFold(FieldBlockedAccountID, vc))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.AccountBlock {
+	return predicate.AccountBlock(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.AccountBlock {
+	return predicate.AccountBlock(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlocked applies the HasEdge predicate on the "blocked" edge.
+func HasBlocked() predicate.AccountBlock {
+	return predicate.AccountBlock(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BlockedTable, BlockedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedWith applies the HasEdge predicate on the "blocked" edge with a given conditions (other predicates).
+func HasBlockedWith(preds ...predicate.Account) predicate.AccountBlock {
+	return predicate.AccountBlock(func(s *sql.Selector) {
+		step := newBlockedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccountBlock) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccountBlock) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccountBlock) predicate.AccountBlock {
+	return predicate.AccountBlock(sql.NotPredicates(p))
+}
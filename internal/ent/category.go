@@ -36,6 +36,8 @@ type Category struct {
 	Sort int `json:"sort,omitempty"`
 	// Admin holds the value of the "admin" field.
 	Admin bool `json:"admin,omitempty"`
+	// Threads are treated as questions which may have an accepted answer.
+	QaMode bool `json:"qa_mode,omitempty"`
 	// ParentCategoryID holds the value of the "parent_category_id" field.
 	ParentCategoryID xid.ID `json:"parent_category_id,omitempty"`
 	// CoverImageAssetID holds the value of the "cover_image_asset_id" field.
@@ -112,7 +114,7 @@ func (*Category) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(xid.ID)}
 		case category.FieldMetadata:
 			values[i] = new([]byte)
-		case category.FieldAdmin:
+		case category.FieldAdmin, category.FieldQaMode:
 			values[i] = new(sql.NullBool)
 		case category.FieldSort:
 			values[i] = new(sql.NullInt64)
@@ -191,6 +193,12 @@ func (_m *Category) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Admin = value.Bool
 			}
+		case category.FieldQaMode:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field qa_mode", values[i])
+			} else if value.Valid {
+				_m.QaMode = value.Bool
+			}
 		case category.FieldParentCategoryID:
 			if value, ok := values[i].(*xid.ID); !ok {
 				return fmt.Errorf("unexpected type %T for field parent_category_id", values[i])
@@ -292,6 +300,9 @@ func (_m *Category) String() string {
 	builder.WriteString("admin=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Admin))
 	builder.WriteString(", ")
+	builder.WriteString("qa_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QaMode))
+	builder.WriteString(", ")
 	builder.WriteString("parent_category_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ParentCategoryID))
 	builder.WriteString(", ")
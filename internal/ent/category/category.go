@@ -31,6 +31,8 @@ const (
 	FieldSort = "sort"
 	// FieldAdmin holds the string denoting the admin field in the database.
 	FieldAdmin = "admin"
+	// FieldQaMode holds the string denoting the qa_mode field in the database.
+	FieldQaMode = "qa_mode"
 	// FieldParentCategoryID holds the string denoting the parent_category_id field in the database.
 	FieldParentCategoryID = "parent_category_id"
 	// FieldCoverImageAssetID holds the string denoting the cover_image_asset_id field in the database.
@@ -82,6 +84,7 @@ var Columns = []string{
 	FieldColour,
 	FieldSort,
 	FieldAdmin,
+	FieldQaMode,
 	FieldParentCategoryID,
 	FieldCoverImageAssetID,
 	FieldMetadata,
@@ -112,6 +115,8 @@ var (
 	DefaultSort int
 	// DefaultAdmin holds the default value on creation for the "admin" field.
 	DefaultAdmin bool
+	// DefaultQaMode holds the default value on creation for the "qa_mode" field.
+	DefaultQaMode bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() xid.ID
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -166,6 +171,11 @@ func ByAdmin(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAdmin, opts...).ToFunc()
 }
 
+// ByQaMode orders the results by the qa_mode field.
+func ByQaMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQaMode, opts...).ToFunc()
+}
+
 // ByParentCategoryID orders the results by the parent_category_id field.
 func ByParentCategoryID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldParentCategoryID, opts...).ToFunc()
@@ -96,6 +96,11 @@ func Admin(v bool) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldAdmin, v))
 }
 
+// QaMode applies equality check predicate on the "qa_mode" field. It's identical to QaModeEQ.
+func QaMode(v bool) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldQaMode, v))
+}
+
 // ParentCategoryID applies equality check predicate on the "parent_category_id" field. It's identical to ParentCategoryIDEQ.
 func ParentCategoryID(v xid.ID) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldParentCategoryID, v))
@@ -496,6 +501,16 @@ func AdminNEQ(v bool) predicate.Category {
 	return predicate.Category(sql.FieldNEQ(FieldAdmin, v))
 }
 
+// QaModeEQ applies the EQ predicate on the "qa_mode" field.
+func QaModeEQ(v bool) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldQaMode, v))
+}
+
+// QaModeNEQ applies the NEQ predicate on the "qa_mode" field.
+func QaModeNEQ(v bool) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldQaMode, v))
+}
+
 // ParentCategoryIDEQ applies the EQ predicate on the "parent_category_id" field.
 func ParentCategoryIDEQ(v xid.ID) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldParentCategoryID, v))
@@ -122,6 +122,20 @@ func (_c *CategoryCreate) SetNillableAdmin(v *bool) *CategoryCreate {
 	return _c
 }
 
+// SetQaMode sets the "qa_mode" field.
+func (_c *CategoryCreate) SetQaMode(v bool) *CategoryCreate {
+	_c.mutation.SetQaMode(v)
+	return _c
+}
+
+// SetNillableQaMode sets the "qa_mode" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableQaMode(v *bool) *CategoryCreate {
+	if v != nil {
+		_c.SetQaMode(*v)
+	}
+	return _c
+}
+
 // SetParentCategoryID sets the "parent_category_id" field.
 func (_c *CategoryCreate) SetParentCategoryID(v xid.ID) *CategoryCreate {
 	_c.mutation.SetParentCategoryID(v)
@@ -297,6 +311,10 @@ func (_c *CategoryCreate) defaults() {
 		v := category.DefaultAdmin
 		_c.mutation.SetAdmin(v)
 	}
+	if _, ok := _c.mutation.QaMode(); !ok {
+		v := category.DefaultQaMode
+		_c.mutation.SetQaMode(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := category.DefaultID()
 		_c.mutation.SetID(v)
@@ -329,6 +347,9 @@ func (_c *CategoryCreate) check() error {
 	if _, ok := _c.mutation.Admin(); !ok {
 		return &ValidationError{Name: "admin", err: errors.New(`ent: missing required field "Category.admin"`)}
 	}
+	if _, ok := _c.mutation.QaMode(); !ok {
+		return &ValidationError{Name: "qa_mode", err: errors.New(`ent: missing required field "Category.qa_mode"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := category.IDValidator(v.String()); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Category.id": %w`, err)}
@@ -402,6 +423,10 @@ func (_c *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_spec.SetField(category.FieldAdmin, field.TypeBool, value)
 		_node.Admin = value
 	}
+	if value, ok := _c.mutation.QaMode(); ok {
+		_spec.SetField(category.FieldQaMode, field.TypeBool, value)
+		_node.QaMode = value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(category.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -614,6 +639,18 @@ func (u *CategoryUpsert) UpdateAdmin() *CategoryUpsert {
 	return u
 }
 
+// SetQaMode sets the "qa_mode" field.
+func (u *CategoryUpsert) SetQaMode(v bool) *CategoryUpsert {
+	u.Set(category.FieldQaMode, v)
+	return u
+}
+
+// UpdateQaMode sets the "qa_mode" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateQaMode() *CategoryUpsert {
+	u.SetExcluded(category.FieldQaMode)
+	return u
+}
+
 // SetParentCategoryID sets the "parent_category_id" field.
 func (u *CategoryUpsert) SetParentCategoryID(v xid.ID) *CategoryUpsert {
 	u.Set(category.FieldParentCategoryID, v)
@@ -824,6 +861,20 @@ func (u *CategoryUpsertOne) UpdateAdmin() *CategoryUpsertOne {
 	})
 }
 
+// SetQaMode sets the "qa_mode" field.
+func (u *CategoryUpsertOne) SetQaMode(v bool) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetQaMode(v)
+	})
+}
+
+// UpdateQaMode sets the "qa_mode" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateQaMode() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateQaMode()
+	})
+}
+
 // SetParentCategoryID sets the "parent_category_id" field.
 func (u *CategoryUpsertOne) SetParentCategoryID(v xid.ID) *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
@@ -1210,6 +1261,20 @@ func (u *CategoryUpsertBulk) UpdateAdmin() *CategoryUpsertBulk {
 	})
 }
 
+// SetQaMode sets the "qa_mode" field.
+func (u *CategoryUpsertBulk) SetQaMode(v bool) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetQaMode(v)
+	})
+}
+
+// UpdateQaMode sets the "qa_mode" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateQaMode() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateQaMode()
+	})
+}
+
 // SetParentCategoryID sets the "parent_category_id" field.
 func (u *CategoryUpsertBulk) SetParentCategoryID(v xid.ID) *CategoryUpsertBulk {
 	return u.Update(func(s *CategoryUpsert) {
@@ -129,6 +129,20 @@ func (_u *CategoryUpdate) SetNillableAdmin(v *bool) *CategoryUpdate {
 	return _u
 }
 
+// SetQaMode sets the "qa_mode" field.
+func (_u *CategoryUpdate) SetQaMode(v bool) *CategoryUpdate {
+	_u.mutation.SetQaMode(v)
+	return _u
+}
+
+// SetNillableQaMode sets the "qa_mode" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableQaMode(v *bool) *CategoryUpdate {
+	if v != nil {
+		_u.SetQaMode(*v)
+	}
+	return _u
+}
+
 // SetParentCategoryID sets the "parent_category_id" field.
 func (_u *CategoryUpdate) SetParentCategoryID(v xid.ID) *CategoryUpdate {
 	_u.mutation.SetParentCategoryID(v)
@@ -383,6 +397,9 @@ func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Admin(); ok {
 		_spec.SetField(category.FieldAdmin, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.QaMode(); ok {
+		_spec.SetField(category.FieldQaMode, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(category.FieldMetadata, field.TypeJSON, value)
 	}
@@ -656,6 +673,20 @@ func (_u *CategoryUpdateOne) SetNillableAdmin(v *bool) *CategoryUpdateOne {
 	return _u
 }
 
+// SetQaMode sets the "qa_mode" field.
+func (_u *CategoryUpdateOne) SetQaMode(v bool) *CategoryUpdateOne {
+	_u.mutation.SetQaMode(v)
+	return _u
+}
+
+// SetNillableQaMode sets the "qa_mode" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableQaMode(v *bool) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetQaMode(*v)
+	}
+	return _u
+}
+
 // SetParentCategoryID sets the "parent_category_id" field.
 func (_u *CategoryUpdateOne) SetParentCategoryID(v xid.ID) *CategoryUpdateOne {
 	_u.mutation.SetParentCategoryID(v)
@@ -940,6 +971,9 @@ func (_u *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if value, ok := _u.mutation.Admin(); ok {
 		_spec.SetField(category.FieldAdmin, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.QaMode(); ok {
+		_spec.SetField(category.FieldQaMode, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(category.FieldMetadata, field.TypeJSON, value)
 	}
@@ -0,0 +1,819 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/conversation"
+	"github.com/Southclaws/storyden/internal/ent/conversationmessage"
+	"github.com/rs/xid"
+)
+
+// ConversationMessageCreate is the builder for creating a ConversationMessage entity.
+type ConversationMessageCreate struct {
+	config
+	mutation *ConversationMessageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConversationMessageCreate) SetCreatedAt(v time.Time) *ConversationMessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ConversationMessageCreate) SetNillableCreatedAt(v *time.Time) *ConversationMessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ConversationMessageCreate) SetUpdatedAt(v time.Time) *ConversationMessageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ConversationMessageCreate) SetNillableUpdatedAt(v *time.Time) *ConversationMessageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_c *ConversationMessageCreate) SetConversationID(v xid.ID) *ConversationMessageCreate {
+	_c.mutation.SetConversationID(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *ConversationMessageCreate) SetAccountID(v xid.ID) *ConversationMessageCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *ConversationMessageCreate) SetBody(v string) *ConversationMessageCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetShort sets the "short" field.
+func (_c *ConversationMessageCreate) SetShort(v string) *ConversationMessageCreate {
+	_c.mutation.SetShort(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConversationMessageCreate) SetID(v xid.ID) *ConversationMessageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ConversationMessageCreate) SetNillableID(v *xid.ID) *ConversationMessageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (_c *ConversationMessageCreate) SetConversation(v *Conversation) *ConversationMessageCreate {
+	return _c.SetConversationID(v.ID)
+}
+
+// SetAuthorID sets the "author" edge to the Account entity by ID.
+func (_c *ConversationMessageCreate) SetAuthorID(id xid.ID) *ConversationMessageCreate {
+	_c.mutation.SetAuthorID(id)
+	return _c
+}
+
+// SetAuthor sets the "author" edge to the Account entity.
+func (_c *ConversationMessageCreate) SetAuthor(v *Account) *ConversationMessageCreate {
+	return _c.SetAuthorID(v.ID)
+}
+
+// Mutation returns the ConversationMessageMutation object of the builder.
+func (_c *ConversationMessageCreate) Mutation() *ConversationMessageMutation {
+	return _c.mutation
+}
+
+// Save creates the ConversationMessage in the database.
+func (_c *ConversationMessageCreate) Save(ctx context.Context) (*ConversationMessage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConversationMessageCreate) SaveX(ctx context.Context) *ConversationMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationMessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationMessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConversationMessageCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := conversationmessage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := conversationmessage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := conversationmessage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConversationMessageCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConversationMessage.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ConversationMessage.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`ent: missing required field "ConversationMessage.conversation_id"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "ConversationMessage.account_id"`)}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "ConversationMessage.body"`)}
+	}
+	if _, ok := _c.mutation.Short(); !ok {
+		return &ValidationError{Name: "short", err: errors.New(`ent: missing required field "ConversationMessage.short"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := conversationmessage.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ConversationMessage.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ConversationIDs()) == 0 {
+		return &ValidationError{Name: "conversation", err: errors.New(`ent: missing required edge "ConversationMessage.conversation"`)}
+	}
+	if len(_c.mutation.AuthorIDs()) == 0 {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required edge "ConversationMessage.author"`)}
+	}
+	return nil
+}
+
+func (_c *ConversationMessageCreate) sqlSave(ctx context.Context) (*ConversationMessage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConversationMessageCreate) createSpec() (*ConversationMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConversationMessage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(conversationmessage.Table, sqlgraph.NewFieldSpec(conversationmessage.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(conversationmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversationmessage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(conversationmessage.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.Short(); ok {
+		_spec.SetField(conversationmessage.FieldShort, field.TypeString, value)
+		_node.Short = value
+	}
+	if nodes := _c.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationmessage.ConversationTable,
+			Columns: []string{conversationmessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConversationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationmessage.AuthorTable,
+			Columns: []string{conversationmessage.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ConversationMessage.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConversationMessageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ConversationMessageCreate) OnConflict(opts ...sql.ConflictOption) *ConversationMessageUpsertOne {
+	_c.conflict = opts
+	return &ConversationMessageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ConversationMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ConversationMessageCreate) OnConflictColumns(columns ...string) *ConversationMessageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ConversationMessageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ConversationMessageUpsertOne is the builder for "upsert"-ing
+	//  one ConversationMessage node.
+	ConversationMessageUpsertOne struct {
+		create *ConversationMessageCreate
+	}
+
+	// ConversationMessageUpsert is the "OnConflict" setter.
+	ConversationMessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConversationMessageUpsert) SetUpdatedAt(v time.Time) *ConversationMessageUpsert {
+	u.Set(conversationmessage.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConversationMessageUpsert) UpdateUpdatedAt() *ConversationMessageUpsert {
+	u.SetExcluded(conversationmessage.FieldUpdatedAt)
+	return u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ConversationMessageUpsert) SetConversationID(v xid.ID) *ConversationMessageUpsert {
+	u.Set(conversationmessage.FieldConversationID, v)
+	return u
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ConversationMessageUpsert) UpdateConversationID() *ConversationMessageUpsert {
+	u.SetExcluded(conversationmessage.FieldConversationID)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ConversationMessageUpsert) SetAccountID(v xid.ID) *ConversationMessageUpsert {
+	u.Set(conversationmessage.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ConversationMessageUpsert) UpdateAccountID() *ConversationMessageUpsert {
+	u.SetExcluded(conversationmessage.FieldAccountID)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *ConversationMessageUpsert) SetBody(v string) *ConversationMessageUpsert {
+	u.Set(conversationmessage.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ConversationMessageUpsert) UpdateBody() *ConversationMessageUpsert {
+	u.SetExcluded(conversationmessage.FieldBody)
+	return u
+}
+
+// SetShort sets the "short" field.
+func (u *ConversationMessageUpsert) SetShort(v string) *ConversationMessageUpsert {
+	u.Set(conversationmessage.FieldShort, v)
+	return u
+}
+
+// UpdateShort sets the "short" field to the value that was provided on create.
+func (u *ConversationMessageUpsert) UpdateShort() *ConversationMessageUpsert {
+	u.SetExcluded(conversationmessage.FieldShort)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ConversationMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(conversationmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConversationMessageUpsertOne) UpdateNewValues() *ConversationMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(conversationmessage.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(conversationmessage.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ConversationMessage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ConversationMessageUpsertOne) Ignore() *ConversationMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConversationMessageUpsertOne) DoNothing() *ConversationMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConversationMessageCreate.OnConflict
+// documentation for more info.
+func (u *ConversationMessageUpsertOne) Update(set func(*ConversationMessageUpsert)) *ConversationMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConversationMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConversationMessageUpsertOne) SetUpdatedAt(v time.Time) *ConversationMessageUpsertOne {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConversationMessageUpsertOne) UpdateUpdatedAt() *ConversationMessageUpsertOne {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ConversationMessageUpsertOne) SetConversationID(v xid.ID) *ConversationMessageUpsertOne {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ConversationMessageUpsertOne) UpdateConversationID() *ConversationMessageUpsertOne {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ConversationMessageUpsertOne) SetAccountID(v xid.ID) *ConversationMessageUpsertOne {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ConversationMessageUpsertOne) UpdateAccountID() *ConversationMessageUpsertOne {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *ConversationMessageUpsertOne) SetBody(v string) *ConversationMessageUpsertOne {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ConversationMessageUpsertOne) UpdateBody() *ConversationMessageUpsertOne {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetShort sets the "short" field.
+func (u *ConversationMessageUpsertOne) SetShort(v string) *ConversationMessageUpsertOne {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.SetShort(v)
+	})
+}
+
+// UpdateShort sets the "short" field to the value that was provided on create.
+func (u *ConversationMessageUpsertOne) UpdateShort() *ConversationMessageUpsertOne {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.UpdateShort()
+	})
+}
+
+// Exec executes the query.
+func (u *ConversationMessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConversationMessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConversationMessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ConversationMessageUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ConversationMessageUpsertOne.ID is not supported by MySQL driver. Use ConversationMessageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ConversationMessageUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ConversationMessageCreateBulk is the builder for creating many ConversationMessage entities in bulk.
+type ConversationMessageCreateBulk struct {
+	config
+	err      error
+	builders []*ConversationMessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ConversationMessage entities in the database.
+func (_c *ConversationMessageCreateBulk) Save(ctx context.Context) ([]*ConversationMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConversationMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConversationMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConversationMessageCreateBulk) SaveX(ctx context.Context) []*ConversationMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ConversationMessage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConversationMessageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ConversationMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *ConversationMessageUpsertBulk {
+	_c.conflict = opts
+	return &ConversationMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ConversationMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ConversationMessageCreateBulk) OnConflictColumns(columns ...string) *ConversationMessageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ConversationMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// ConversationMessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of ConversationMessage nodes.
+type ConversationMessageUpsertBulk struct {
+	create *ConversationMessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ConversationMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(conversationmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConversationMessageUpsertBulk) UpdateNewValues() *ConversationMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(conversationmessage.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(conversationmessage.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ConversationMessage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ConversationMessageUpsertBulk) Ignore() *ConversationMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConversationMessageUpsertBulk) DoNothing() *ConversationMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConversationMessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *ConversationMessageUpsertBulk) Update(set func(*ConversationMessageUpsert)) *ConversationMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConversationMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConversationMessageUpsertBulk) SetUpdatedAt(v time.Time) *ConversationMessageUpsertBulk {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConversationMessageUpsertBulk) UpdateUpdatedAt() *ConversationMessageUpsertBulk {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ConversationMessageUpsertBulk) SetConversationID(v xid.ID) *ConversationMessageUpsertBulk {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ConversationMessageUpsertBulk) UpdateConversationID() *ConversationMessageUpsertBulk {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ConversationMessageUpsertBulk) SetAccountID(v xid.ID) *ConversationMessageUpsertBulk {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ConversationMessageUpsertBulk) UpdateAccountID() *ConversationMessageUpsertBulk {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *ConversationMessageUpsertBulk) SetBody(v string) *ConversationMessageUpsertBulk {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ConversationMessageUpsertBulk) UpdateBody() *ConversationMessageUpsertBulk {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetShort sets the "short" field.
+func (u *ConversationMessageUpsertBulk) SetShort(v string) *ConversationMessageUpsertBulk {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.SetShort(v)
+	})
+}
+
+// UpdateShort sets the "short" field to the value that was provided on create.
+func (u *ConversationMessageUpsertBulk) UpdateShort() *ConversationMessageUpsertBulk {
+	return u.Update(func(s *ConversationMessageUpsert) {
+		s.UpdateShort()
+	})
+}
+
+// Exec executes the query.
+func (u *ConversationMessageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ConversationMessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConversationMessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConversationMessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
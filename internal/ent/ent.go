@@ -13,14 +13,19 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
 	"github.com/Southclaws/storyden/internal/ent/accountfollow"
 	"github.com/Southclaws/storyden/internal/ent/accountroles"
 	"github.com/Southclaws/storyden/internal/ent/asset"
+	"github.com/Southclaws/storyden/internal/ent/auditlog"
 	"github.com/Southclaws/storyden/internal/ent/authentication"
 	"github.com/Southclaws/storyden/internal/ent/category"
 	"github.com/Southclaws/storyden/internal/ent/collection"
 	"github.com/Southclaws/storyden/internal/ent/collectionnode"
 	"github.com/Southclaws/storyden/internal/ent/collectionpost"
+	"github.com/Southclaws/storyden/internal/ent/conversation"
+	"github.com/Southclaws/storyden/internal/ent/conversationmessage"
+	"github.com/Southclaws/storyden/internal/ent/conversationparticipant"
 	"github.com/Southclaws/storyden/internal/ent/email"
 	"github.com/Southclaws/storyden/internal/ent/event"
 	"github.com/Southclaws/storyden/internal/ent/eventparticipant"
@@ -29,12 +34,21 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/link"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
 	"github.com/Southclaws/storyden/internal/ent/node"
+	"github.com/Southclaws/storyden/internal/ent/noderevision"
 	"github.com/Southclaws/storyden/internal/ent/notification"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigest"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigestitem"
+	"github.com/Southclaws/storyden/internal/ent/notificationpreference"
+	"github.com/Southclaws/storyden/internal/ent/poll"
+	"github.com/Southclaws/storyden/internal/ent/polloption"
+	"github.com/Southclaws/storyden/internal/ent/pollvote"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
+	"github.com/Southclaws/storyden/internal/ent/postrevision"
 	"github.com/Southclaws/storyden/internal/ent/property"
 	"github.com/Southclaws/storyden/internal/ent/propertyschema"
 	"github.com/Southclaws/storyden/internal/ent/propertyschemafield"
+	"github.com/Southclaws/storyden/internal/ent/publishschedule"
 	"github.com/Southclaws/storyden/internal/ent/question"
 	"github.com/Southclaws/storyden/internal/ent/react"
 	"github.com/Southclaws/storyden/internal/ent/report"
@@ -42,6 +56,8 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/session"
 	"github.com/Southclaws/storyden/internal/ent/setting"
 	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/webhook"
+	"github.com/Southclaws/storyden/internal/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -102,36 +118,52 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			account.Table:             account.ValidColumn,
-			accountfollow.Table:       accountfollow.ValidColumn,
-			accountroles.Table:        accountroles.ValidColumn,
-			asset.Table:               asset.ValidColumn,
-			authentication.Table:      authentication.ValidColumn,
-			category.Table:            category.ValidColumn,
-			collection.Table:          collection.ValidColumn,
-			collectionnode.Table:      collectionnode.ValidColumn,
-			collectionpost.Table:      collectionpost.ValidColumn,
-			email.Table:               email.ValidColumn,
-			event.Table:               event.ValidColumn,
-			eventparticipant.Table:    eventparticipant.ValidColumn,
-			invitation.Table:          invitation.ValidColumn,
-			likepost.Table:            likepost.ValidColumn,
-			link.Table:                link.ValidColumn,
-			mentionprofile.Table:      mentionprofile.ValidColumn,
-			node.Table:                node.ValidColumn,
-			notification.Table:        notification.ValidColumn,
-			post.Table:                post.ValidColumn,
-			postread.Table:            postread.ValidColumn,
-			property.Table:            property.ValidColumn,
-			propertyschema.Table:      propertyschema.ValidColumn,
-			propertyschemafield.Table: propertyschemafield.ValidColumn,
-			question.Table:            question.ValidColumn,
-			react.Table:               react.ValidColumn,
-			report.Table:              report.ValidColumn,
-			role.Table:                role.ValidColumn,
-			session.Table:             session.ValidColumn,
-			setting.Table:             setting.ValidColumn,
-			tag.Table:                 tag.ValidColumn,
+			account.Table:                 account.ValidColumn,
+			accountblock.Table:            accountblock.ValidColumn,
+			accountfollow.Table:           accountfollow.ValidColumn,
+			accountroles.Table:            accountroles.ValidColumn,
+			asset.Table:                   asset.ValidColumn,
+			auditlog.Table:                auditlog.ValidColumn,
+			authentication.Table:          authentication.ValidColumn,
+			category.Table:                category.ValidColumn,
+			collection.Table:              collection.ValidColumn,
+			collectionnode.Table:          collectionnode.ValidColumn,
+			collectionpost.Table:          collectionpost.ValidColumn,
+			conversation.Table:            conversation.ValidColumn,
+			conversationmessage.Table:     conversationmessage.ValidColumn,
+			conversationparticipant.Table: conversationparticipant.ValidColumn,
+			email.Table:                   email.ValidColumn,
+			event.Table:                   event.ValidColumn,
+			eventparticipant.Table:        eventparticipant.ValidColumn,
+			invitation.Table:              invitation.ValidColumn,
+			likepost.Table:                likepost.ValidColumn,
+			link.Table:                    link.ValidColumn,
+			mentionprofile.Table:          mentionprofile.ValidColumn,
+			node.Table:                    node.ValidColumn,
+			noderevision.Table:            noderevision.ValidColumn,
+			notification.Table:            notification.ValidColumn,
+			notificationdigest.Table:      notificationdigest.ValidColumn,
+			notificationdigestitem.Table:  notificationdigestitem.ValidColumn,
+			notificationpreference.Table:  notificationpreference.ValidColumn,
+			poll.Table:                    poll.ValidColumn,
+			polloption.Table:              polloption.ValidColumn,
+			pollvote.Table:                pollvote.ValidColumn,
+			post.Table:                    post.ValidColumn,
+			postread.Table:                postread.ValidColumn,
+			postrevision.Table:            postrevision.ValidColumn,
+			property.Table:                property.ValidColumn,
+			propertyschema.Table:          propertyschema.ValidColumn,
+			propertyschemafield.Table:     propertyschemafield.ValidColumn,
+			publishschedule.Table:         publishschedule.ValidColumn,
+			question.Table:                question.ValidColumn,
+			react.Table:                   react.ValidColumn,
+			report.Table:                  report.ValidColumn,
+			role.Table:                    role.ValidColumn,
+			session.Table:                 session.ValidColumn,
+			setting.Table:                 setting.ValidColumn,
+			tag.Table:                     tag.ValidColumn,
+			webhook.Table:                 webhook.ValidColumn,
+			webhookdelivery.Table:         webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -59,6 +59,8 @@ type Event struct {
 	Capacity *int `json:"capacity,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Sequence holds the value of the "sequence" field.
+	Sequence int `json:"sequence,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EventQuery when eager-loading is set.
 	Edges        EventEdges `json:"edges"`
@@ -120,7 +122,7 @@ func (*Event) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case event.FieldLocationLatitude, event.FieldLocationLongitude:
 			values[i] = new(sql.NullFloat64)
-		case event.FieldCapacity:
+		case event.FieldCapacity, event.FieldSequence:
 			values[i] = new(sql.NullInt64)
 		case event.FieldName, event.FieldSlug, event.FieldDescription, event.FieldParticipationPolicy, event.FieldVisibility, event.FieldLocationType, event.FieldLocationName, event.FieldLocationAddress, event.FieldLocationURL:
 			values[i] = new(sql.NullString)
@@ -279,6 +281,12 @@ func (_m *Event) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case event.FieldSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence", values[i])
+			} else if value.Valid {
+				_m.Sequence = int(value.Int64)
+			}
 		case event.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field asset_event", values[i])
@@ -420,6 +428,9 @@ func (_m *Event) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("sequence=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sequence))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -245,6 +245,20 @@ func (_c *EventCreate) SetMetadata(v map[string]interface{}) *EventCreate {
 	return _c
 }
 
+// SetSequence sets the "sequence" field.
+func (_c *EventCreate) SetSequence(v int) *EventCreate {
+	_c.mutation.SetSequence(v)
+	return _c
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (_c *EventCreate) SetNillableSequence(v *int) *EventCreate {
+	if v != nil {
+		_c.SetSequence(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *EventCreate) SetID(v xid.ID) *EventCreate {
 	_c.mutation.SetID(v)
@@ -351,6 +365,10 @@ func (_c *EventCreate) defaults() {
 		v := event.DefaultVisibility
 		_c.mutation.SetVisibility(v)
 	}
+	if _, ok := _c.mutation.Sequence(); !ok {
+		v := event.DefaultSequence
+		_c.mutation.SetSequence(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := event.DefaultID()
 		_c.mutation.SetID(v)
@@ -388,6 +406,9 @@ func (_c *EventCreate) check() error {
 			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Event.visibility": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Sequence(); !ok {
+		return &ValidationError{Name: "sequence", err: errors.New(`ent: missing required field "Event.sequence"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := event.IDValidator(v.String()); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Event.id": %w`, err)}
@@ -508,6 +529,10 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		_spec.SetField(event.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := _c.mutation.Sequence(); ok {
+		_spec.SetField(event.FieldSequence, field.TypeInt, value)
+		_node.Sequence = value
+	}
 	if nodes := _c.mutation.ParticipantsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -910,6 +935,24 @@ func (u *EventUpsert) ClearMetadata() *EventUpsert {
 	return u
 }
 
+// SetSequence sets the "sequence" field.
+func (u *EventUpsert) SetSequence(v int) *EventUpsert {
+	u.Set(event.FieldSequence, v)
+	return u
+}
+
+// UpdateSequence sets the "sequence" field to the value that was provided on create.
+func (u *EventUpsert) UpdateSequence() *EventUpsert {
+	u.SetExcluded(event.FieldSequence)
+	return u
+}
+
+// AddSequence adds v to the "sequence" field.
+func (u *EventUpsert) AddSequence(v int) *EventUpsert {
+	u.Add(event.FieldSequence, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1311,6 +1354,27 @@ func (u *EventUpsertOne) ClearMetadata() *EventUpsertOne {
 	})
 }
 
+// SetSequence sets the "sequence" field.
+func (u *EventUpsertOne) SetSequence(v int) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetSequence(v)
+	})
+}
+
+// AddSequence adds v to the "sequence" field.
+func (u *EventUpsertOne) AddSequence(v int) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.AddSequence(v)
+	})
+}
+
+// UpdateSequence sets the "sequence" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateSequence() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateSequence()
+	})
+}
+
 // Exec executes the query.
 func (u *EventUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1879,6 +1943,27 @@ func (u *EventUpsertBulk) ClearMetadata() *EventUpsertBulk {
 	})
 }
 
+// SetSequence sets the "sequence" field.
+func (u *EventUpsertBulk) SetSequence(v int) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetSequence(v)
+	})
+}
+
+// AddSequence adds v to the "sequence" field.
+func (u *EventUpsertBulk) AddSequence(v int) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.AddSequence(v)
+	})
+}
+
+// UpdateSequence sets the "sequence" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateSequence() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateSequence()
+	})
+}
+
 // Exec executes the query.
 func (u *EventUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
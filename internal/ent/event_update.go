@@ -356,6 +356,27 @@ func (_u *EventUpdate) ClearMetadata() *EventUpdate {
 	return _u
 }
 
+// SetSequence sets the "sequence" field.
+func (_u *EventUpdate) SetSequence(v int) *EventUpdate {
+	_u.mutation.ResetSequence()
+	_u.mutation.SetSequence(v)
+	return _u
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableSequence(v *int) *EventUpdate {
+	if v != nil {
+		_u.SetSequence(*v)
+	}
+	return _u
+}
+
+// AddSequence adds value to the "sequence" field.
+func (_u *EventUpdate) AddSequence(v int) *EventUpdate {
+	_u.mutation.AddSequence(v)
+	return _u
+}
+
 // AddParticipantIDs adds the "participants" edge to the EventParticipant entity by IDs.
 func (_u *EventUpdate) AddParticipantIDs(ids ...xid.ID) *EventUpdate {
 	_u.mutation.AddParticipantIDs(ids...)
@@ -602,6 +623,12 @@ func (_u *EventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(event.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Sequence(); ok {
+		_spec.SetField(event.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSequence(); ok {
+		_spec.AddField(event.FieldSequence, field.TypeInt, value)
+	}
 	if _u.mutation.ParticipantsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1050,6 +1077,27 @@ func (_u *EventUpdateOne) ClearMetadata() *EventUpdateOne {
 	return _u
 }
 
+// SetSequence sets the "sequence" field.
+func (_u *EventUpdateOne) SetSequence(v int) *EventUpdateOne {
+	_u.mutation.ResetSequence()
+	_u.mutation.SetSequence(v)
+	return _u
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableSequence(v *int) *EventUpdateOne {
+	if v != nil {
+		_u.SetSequence(*v)
+	}
+	return _u
+}
+
+// AddSequence adds value to the "sequence" field.
+func (_u *EventUpdateOne) AddSequence(v int) *EventUpdateOne {
+	_u.mutation.AddSequence(v)
+	return _u
+}
+
 // AddParticipantIDs adds the "participants" edge to the EventParticipant entity by IDs.
 func (_u *EventUpdateOne) AddParticipantIDs(ids ...xid.ID) *EventUpdateOne {
 	_u.mutation.AddParticipantIDs(ids...)
@@ -1326,6 +1374,12 @@ func (_u *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error)
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(event.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Sequence(); ok {
+		_spec.SetField(event.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSequence(); ok {
+		_spec.AddField(event.FieldSequence, field.TypeInt, value)
+	}
 	if _u.mutation.ParticipantsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Southclaws/storyden/internal/ent/account"
 	"github.com/Southclaws/storyden/internal/ent/invitation"
+	"github.com/Southclaws/storyden/internal/ent/role"
 	"github.com/rs/xid"
 )
 
@@ -87,6 +88,62 @@ func (_c *InvitationCreate) SetCreatorAccountID(v xid.ID) *InvitationCreate {
 	return _c
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (_c *InvitationCreate) SetExpiresAt(v time.Time) *InvitationCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *InvitationCreate) SetNillableExpiresAt(v *time.Time) *InvitationCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_c *InvitationCreate) SetMaxUses(v int) *InvitationCreate {
+	_c.mutation.SetMaxUses(v)
+	return _c
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_c *InvitationCreate) SetNillableMaxUses(v *int) *InvitationCreate {
+	if v != nil {
+		_c.SetMaxUses(*v)
+	}
+	return _c
+}
+
+// SetUses sets the "uses" field.
+func (_c *InvitationCreate) SetUses(v int) *InvitationCreate {
+	_c.mutation.SetUses(v)
+	return _c
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_c *InvitationCreate) SetNillableUses(v *int) *InvitationCreate {
+	if v != nil {
+		_c.SetUses(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *InvitationCreate) SetRevokedAt(v time.Time) *InvitationCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *InvitationCreate) SetNillableRevokedAt(v *time.Time) *InvitationCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *InvitationCreate) SetID(v xid.ID) *InvitationCreate {
 	_c.mutation.SetID(v)
@@ -127,6 +184,21 @@ func (_c *InvitationCreate) AddInvited(v ...*Account) *InvitationCreate {
 	return _c.AddInvitedIDs(ids...)
 }
 
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (_c *InvitationCreate) AddRoleIDs(ids ...xid.ID) *InvitationCreate {
+	_c.mutation.AddRoleIDs(ids...)
+	return _c
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (_c *InvitationCreate) AddRoles(v ...*Role) *InvitationCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRoleIDs(ids...)
+}
+
 // Mutation returns the InvitationMutation object of the builder.
 func (_c *InvitationCreate) Mutation() *InvitationMutation {
 	return _c.mutation
@@ -170,6 +242,10 @@ func (_c *InvitationCreate) defaults() {
 		v := invitation.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Uses(); !ok {
+		v := invitation.DefaultUses
+		_c.mutation.SetUses(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := invitation.DefaultID()
 		_c.mutation.SetID(v)
@@ -187,6 +263,9 @@ func (_c *InvitationCreate) check() error {
 	if _, ok := _c.mutation.CreatorAccountID(); !ok {
 		return &ValidationError{Name: "creator_account_id", err: errors.New(`ent: missing required field "Invitation.creator_account_id"`)}
 	}
+	if _, ok := _c.mutation.Uses(); !ok {
+		return &ValidationError{Name: "uses", err: errors.New(`ent: missing required field "Invitation.uses"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := invitation.IDValidator(v.String()); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Invitation.id": %w`, err)}
@@ -247,6 +326,22 @@ func (_c *InvitationCreate) createSpec() (*Invitation, *sqlgraph.CreateSpec) {
 		_spec.SetField(invitation.FieldMessage, field.TypeString, value)
 		_node.Message = &value
 	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.MaxUses(); ok {
+		_spec.SetField(invitation.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = &value
+	}
+	if value, ok := _c.mutation.Uses(); ok {
+		_spec.SetField(invitation.FieldUses, field.TypeInt, value)
+		_node.Uses = value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(invitation.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
 	if nodes := _c.mutation.CreatorIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -280,6 +375,22 @@ func (_c *InvitationCreate) createSpec() (*Invitation, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   invitation.RolesTable,
+			Columns: invitation.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -392,6 +503,84 @@ func (u *InvitationUpsert) UpdateCreatorAccountID() *InvitationUpsert {
 	return u
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationUpsert) SetExpiresAt(v time.Time) *InvitationUpsert {
+	u.Set(invitation.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateExpiresAt() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *InvitationUpsert) ClearExpiresAt() *InvitationUpsert {
+	u.SetNull(invitation.FieldExpiresAt)
+	return u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InvitationUpsert) SetMaxUses(v int) *InvitationUpsert {
+	u.Set(invitation.FieldMaxUses, v)
+	return u
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateMaxUses() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldMaxUses)
+	return u
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InvitationUpsert) AddMaxUses(v int) *InvitationUpsert {
+	u.Add(invitation.FieldMaxUses, v)
+	return u
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (u *InvitationUpsert) ClearMaxUses() *InvitationUpsert {
+	u.SetNull(invitation.FieldMaxUses)
+	return u
+}
+
+// SetUses sets the "uses" field.
+func (u *InvitationUpsert) SetUses(v int) *InvitationUpsert {
+	u.Set(invitation.FieldUses, v)
+	return u
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateUses() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldUses)
+	return u
+}
+
+// AddUses adds v to the "uses" field.
+func (u *InvitationUpsert) AddUses(v int) *InvitationUpsert {
+	u.Add(invitation.FieldUses, v)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *InvitationUpsert) SetRevokedAt(v time.Time) *InvitationUpsert {
+	u.Set(invitation.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateRevokedAt() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *InvitationUpsert) ClearRevokedAt() *InvitationUpsert {
+	u.SetNull(invitation.FieldRevokedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -513,6 +702,97 @@ func (u *InvitationUpsertOne) UpdateCreatorAccountID() *InvitationUpsertOne {
 	})
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationUpsertOne) SetExpiresAt(v time.Time) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateExpiresAt() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *InvitationUpsertOne) ClearExpiresAt() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InvitationUpsertOne) SetMaxUses(v int) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InvitationUpsertOne) AddMaxUses(v int) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateMaxUses() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (u *InvitationUpsertOne) ClearMaxUses() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearMaxUses()
+	})
+}
+
+// SetUses sets the "uses" field.
+func (u *InvitationUpsertOne) SetUses(v int) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetUses(v)
+	})
+}
+
+// AddUses adds v to the "uses" field.
+func (u *InvitationUpsertOne) AddUses(v int) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.AddUses(v)
+	})
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateUses() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateUses()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *InvitationUpsertOne) SetRevokedAt(v time.Time) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateRevokedAt() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *InvitationUpsertOne) ClearRevokedAt() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *InvitationUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -801,6 +1081,97 @@ func (u *InvitationUpsertBulk) UpdateCreatorAccountID() *InvitationUpsertBulk {
 	})
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationUpsertBulk) SetExpiresAt(v time.Time) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateExpiresAt() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *InvitationUpsertBulk) ClearExpiresAt() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InvitationUpsertBulk) SetMaxUses(v int) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InvitationUpsertBulk) AddMaxUses(v int) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateMaxUses() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (u *InvitationUpsertBulk) ClearMaxUses() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearMaxUses()
+	})
+}
+
+// SetUses sets the "uses" field.
+func (u *InvitationUpsertBulk) SetUses(v int) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetUses(v)
+	})
+}
+
+// AddUses adds v to the "uses" field.
+func (u *InvitationUpsertBulk) AddUses(v int) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.AddUses(v)
+	})
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateUses() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateUses()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *InvitationUpsertBulk) SetRevokedAt(v time.Time) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateRevokedAt() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *InvitationUpsertBulk) ClearRevokedAt() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *InvitationUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
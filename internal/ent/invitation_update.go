@@ -14,6 +14,7 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/account"
 	"github.com/Southclaws/storyden/internal/ent/invitation"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/Southclaws/storyden/internal/ent/role"
 	"github.com/rs/xid"
 )
 
@@ -91,6 +92,94 @@ func (_u *InvitationUpdate) SetNillableCreatorAccountID(v *xid.ID) *InvitationUp
 	return _u
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (_u *InvitationUpdate) SetExpiresAt(v time.Time) *InvitationUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *InvitationUpdate) SetNillableExpiresAt(v *time.Time) *InvitationUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *InvitationUpdate) ClearExpiresAt() *InvitationUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_u *InvitationUpdate) SetMaxUses(v int) *InvitationUpdate {
+	_u.mutation.ResetMaxUses()
+	_u.mutation.SetMaxUses(v)
+	return _u
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_u *InvitationUpdate) SetNillableMaxUses(v *int) *InvitationUpdate {
+	if v != nil {
+		_u.SetMaxUses(*v)
+	}
+	return _u
+}
+
+// AddMaxUses adds value to the "max_uses" field.
+func (_u *InvitationUpdate) AddMaxUses(v int) *InvitationUpdate {
+	_u.mutation.AddMaxUses(v)
+	return _u
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (_u *InvitationUpdate) ClearMaxUses() *InvitationUpdate {
+	_u.mutation.ClearMaxUses()
+	return _u
+}
+
+// SetUses sets the "uses" field.
+func (_u *InvitationUpdate) SetUses(v int) *InvitationUpdate {
+	_u.mutation.ResetUses()
+	_u.mutation.SetUses(v)
+	return _u
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_u *InvitationUpdate) SetNillableUses(v *int) *InvitationUpdate {
+	if v != nil {
+		_u.SetUses(*v)
+	}
+	return _u
+}
+
+// AddUses adds value to the "uses" field.
+func (_u *InvitationUpdate) AddUses(v int) *InvitationUpdate {
+	_u.mutation.AddUses(v)
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *InvitationUpdate) SetRevokedAt(v time.Time) *InvitationUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *InvitationUpdate) SetNillableRevokedAt(v *time.Time) *InvitationUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *InvitationUpdate) ClearRevokedAt() *InvitationUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
 // SetCreatorID sets the "creator" edge to the Account entity by ID.
 func (_u *InvitationUpdate) SetCreatorID(id xid.ID) *InvitationUpdate {
 	_u.mutation.SetCreatorID(id)
@@ -117,6 +206,21 @@ func (_u *InvitationUpdate) AddInvited(v ...*Account) *InvitationUpdate {
 	return _u.AddInvitedIDs(ids...)
 }
 
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (_u *InvitationUpdate) AddRoleIDs(ids ...xid.ID) *InvitationUpdate {
+	_u.mutation.AddRoleIDs(ids...)
+	return _u
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (_u *InvitationUpdate) AddRoles(v ...*Role) *InvitationUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleIDs(ids...)
+}
+
 // Mutation returns the InvitationMutation object of the builder.
 func (_u *InvitationUpdate) Mutation() *InvitationMutation {
 	return _u.mutation
@@ -149,6 +253,27 @@ func (_u *InvitationUpdate) RemoveInvited(v ...*Account) *InvitationUpdate {
 	return _u.RemoveInvitedIDs(ids...)
 }
 
+// ClearRoles clears all "roles" edges to the Role entity.
+func (_u *InvitationUpdate) ClearRoles() *InvitationUpdate {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (_u *InvitationUpdate) RemoveRoleIDs(ids ...xid.ID) *InvitationUpdate {
+	_u.mutation.RemoveRoleIDs(ids...)
+	return _u
+}
+
+// RemoveRoles removes "roles" edges to Role entities.
+func (_u *InvitationUpdate) RemoveRoles(v ...*Role) *InvitationUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *InvitationUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -226,6 +351,33 @@ func (_u *InvitationUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.MessageCleared() {
 		_spec.ClearField(invitation.FieldMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(invitation.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MaxUses(); ok {
+		_spec.SetField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if _u.mutation.MaxUsesCleared() {
+		_spec.ClearField(invitation.FieldMaxUses, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Uses(); ok {
+		_spec.SetField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUses(); ok {
+		_spec.AddField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(invitation.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(invitation.FieldRevokedAt, field.TypeTime)
+	}
 	if _u.mutation.CreatorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -300,6 +452,51 @@ func (_u *InvitationUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   invitation.RolesTable,
+			Columns: invitation.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   invitation.RolesTable,
+			Columns: invitation.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   invitation.RolesTable,
+			Columns: invitation.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -382,6 +579,94 @@ func (_u *InvitationUpdateOne) SetNillableCreatorAccountID(v *xid.ID) *Invitatio
 	return _u
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (_u *InvitationUpdateOne) SetExpiresAt(v time.Time) *InvitationUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *InvitationUpdateOne) SetNillableExpiresAt(v *time.Time) *InvitationUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *InvitationUpdateOne) ClearExpiresAt() *InvitationUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_u *InvitationUpdateOne) SetMaxUses(v int) *InvitationUpdateOne {
+	_u.mutation.ResetMaxUses()
+	_u.mutation.SetMaxUses(v)
+	return _u
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_u *InvitationUpdateOne) SetNillableMaxUses(v *int) *InvitationUpdateOne {
+	if v != nil {
+		_u.SetMaxUses(*v)
+	}
+	return _u
+}
+
+// AddMaxUses adds value to the "max_uses" field.
+func (_u *InvitationUpdateOne) AddMaxUses(v int) *InvitationUpdateOne {
+	_u.mutation.AddMaxUses(v)
+	return _u
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (_u *InvitationUpdateOne) ClearMaxUses() *InvitationUpdateOne {
+	_u.mutation.ClearMaxUses()
+	return _u
+}
+
+// SetUses sets the "uses" field.
+func (_u *InvitationUpdateOne) SetUses(v int) *InvitationUpdateOne {
+	_u.mutation.ResetUses()
+	_u.mutation.SetUses(v)
+	return _u
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_u *InvitationUpdateOne) SetNillableUses(v *int) *InvitationUpdateOne {
+	if v != nil {
+		_u.SetUses(*v)
+	}
+	return _u
+}
+
+// AddUses adds value to the "uses" field.
+func (_u *InvitationUpdateOne) AddUses(v int) *InvitationUpdateOne {
+	_u.mutation.AddUses(v)
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *InvitationUpdateOne) SetRevokedAt(v time.Time) *InvitationUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *InvitationUpdateOne) SetNillableRevokedAt(v *time.Time) *InvitationUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *InvitationUpdateOne) ClearRevokedAt() *InvitationUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
 // SetCreatorID sets the "creator" edge to the Account entity by ID.
 func (_u *InvitationUpdateOne) SetCreatorID(id xid.ID) *InvitationUpdateOne {
 	_u.mutation.SetCreatorID(id)
@@ -408,6 +693,21 @@ func (_u *InvitationUpdateOne) AddInvited(v ...*Account) *InvitationUpdateOne {
 	return _u.AddInvitedIDs(ids...)
 }
 
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (_u *InvitationUpdateOne) AddRoleIDs(ids ...xid.ID) *InvitationUpdateOne {
+	_u.mutation.AddRoleIDs(ids...)
+	return _u
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (_u *InvitationUpdateOne) AddRoles(v ...*Role) *InvitationUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleIDs(ids...)
+}
+
 // Mutation returns the InvitationMutation object of the builder.
 func (_u *InvitationUpdateOne) Mutation() *InvitationMutation {
 	return _u.mutation
@@ -440,6 +740,27 @@ func (_u *InvitationUpdateOne) RemoveInvited(v ...*Account) *InvitationUpdateOne
 	return _u.RemoveInvitedIDs(ids...)
 }
 
+// ClearRoles clears all "roles" edges to the Role entity.
+func (_u *InvitationUpdateOne) ClearRoles() *InvitationUpdateOne {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (_u *InvitationUpdateOne) RemoveRoleIDs(ids ...xid.ID) *InvitationUpdateOne {
+	_u.mutation.RemoveRoleIDs(ids...)
+	return _u
+}
+
+// RemoveRoles removes "roles" edges to Role entities.
+func (_u *InvitationUpdateOne) RemoveRoles(v ...*Role) *InvitationUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleIDs(ids...)
+}
+
 // Where appends a list predicates to the InvitationUpdate builder.
 func (_u *InvitationUpdateOne) Where(ps ...predicate.Invitation) *InvitationUpdateOne {
 	_u.mutation.Where(ps...)
@@ -547,6 +868,33 @@ func (_u *InvitationUpdateOne) sqlSave(ctx context.Context) (_node *Invitation,
 	if _u.mutation.MessageCleared() {
 		_spec.ClearField(invitation.FieldMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(invitation.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MaxUses(); ok {
+		_spec.SetField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if _u.mutation.MaxUsesCleared() {
+		_spec.ClearField(invitation.FieldMaxUses, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Uses(); ok {
+		_spec.SetField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUses(); ok {
+		_spec.AddField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(invitation.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(invitation.FieldRevokedAt, field.TypeTime)
+	}
 	if _u.mutation.CreatorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -621,6 +969,51 @@ func (_u *InvitationUpdateOne) sqlSave(ctx context.Context) (_node *Invitation,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   invitation.RolesTable,
+			Columns: invitation.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   invitation.RolesTable,
+			Columns: invitation.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   invitation.RolesTable,
+			Columns: invitation.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Invitation{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -22,6 +22,11 @@ var (
 		{Name: "admin", Type: field.TypeBool, Default: false},
 		{Name: "links", Type: field.TypeJSON, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "suspended_reason", Type: field.TypeString, Nullable: true},
+		{Name: "suspended_until", Type: field.TypeTime, Nullable: true},
+		{Name: "suspended_by_id", Type: field.TypeString, Nullable: true},
+		{Name: "deletion_scheduled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "calendar_token", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "invited_by_id", Type: field.TypeString, Nullable: true, Size: 20},
 	}
 	// AccountsTable holds the schema information for the "accounts" table.
@@ -32,12 +37,46 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "accounts_invitations_invited",
-				Columns:    []*schema.Column{AccountsColumns[12]},
+				Columns:    []*schema.Column{AccountsColumns[17]},
 				RefColumns: []*schema.Column{InvitationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// AccountBlocksColumns holds the columns for the "account_blocks" table.
+	AccountBlocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "blocked_account_id", Type: field.TypeString, Size: 20},
+	}
+	// AccountBlocksTable holds the schema information for the "account_blocks" table.
+	AccountBlocksTable = &schema.Table{
+		Name:       "account_blocks",
+		Columns:    AccountBlocksColumns,
+		PrimaryKey: []*schema.Column{AccountBlocksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "account_blocks_accounts_blocks",
+				Columns:    []*schema.Column{AccountBlocksColumns[2]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "account_blocks_accounts_blocked_by",
+				Columns:    []*schema.Column{AccountBlocksColumns[3]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unique_block_pair",
+				Unique:  true,
+				Columns: []*schema.Column{AccountBlocksColumns[2], AccountBlocksColumns[3]},
+			},
+		},
+	}
 	// AccountFollowsColumns holds the columns for the "account_follows" table.
 	AccountFollowsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -151,6 +190,48 @@ var (
 			},
 		},
 	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "action", Type: field.TypeString},
+		{Name: "target_id", Type: field.TypeString, Nullable: true},
+		{Name: "target_kind", Type: field.TypeString, Nullable: true},
+		{Name: "before", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "after", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "actor_id", Type: field.TypeString, Nullable: true, Size: 20},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audit_logs_accounts_audit_logs",
+				Columns:    []*schema.Column{AuditLogsColumns[7]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_action",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2]},
+			},
+			{
+				Name:    "auditlog_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[3]},
+			},
+		},
+	}
 	// AuthenticationsColumns holds the columns for the "authentications" table.
 	AuthenticationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -202,6 +283,7 @@ var (
 		{Name: "colour", Type: field.TypeString, Default: "#8577ce"},
 		{Name: "sort", Type: field.TypeInt, Default: -1},
 		{Name: "admin", Type: field.TypeBool, Default: false},
+		{Name: "qa_mode", Type: field.TypeBool, Default: false},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "parent_category_id", Type: field.TypeString, Nullable: true, Size: 20},
 		{Name: "cover_image_asset_id", Type: field.TypeString, Nullable: true, Size: 20},
@@ -214,13 +296,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "categories_categories_children",
-				Columns:    []*schema.Column{CategoriesColumns[10]},
+				Columns:    []*schema.Column{CategoriesColumns[11]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "categories_assets_cover_image",
-				Columns:    []*schema.Column{CategoriesColumns[11]},
+				Columns:    []*schema.Column{CategoriesColumns[12]},
 				RefColumns: []*schema.Column{AssetsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -327,6 +409,104 @@ var (
 			},
 		},
 	}
+	// ConversationsColumns holds the columns for the "conversations" table.
+	ConversationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "last_message_at", Type: field.TypeTime},
+	}
+	// ConversationsTable holds the schema information for the "conversations" table.
+	ConversationsTable = &schema.Table{
+		Name:       "conversations",
+		Columns:    ConversationsColumns,
+		PrimaryKey: []*schema.Column{ConversationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "conversation_last_message_at",
+				Unique:  false,
+				Columns: []*schema.Column{ConversationsColumns[4]},
+			},
+		},
+	}
+	// ConversationMessagesColumns holds the columns for the "conversation_messages" table.
+	ConversationMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "body", Type: field.TypeString},
+		{Name: "short", Type: field.TypeString},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "conversation_id", Type: field.TypeString, Size: 20},
+	}
+	// ConversationMessagesTable holds the schema information for the "conversation_messages" table.
+	ConversationMessagesTable = &schema.Table{
+		Name:       "conversation_messages",
+		Columns:    ConversationMessagesColumns,
+		PrimaryKey: []*schema.Column{ConversationMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "conversation_messages_accounts_conversation_messages",
+				Columns:    []*schema.Column{ConversationMessagesColumns[5]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "conversation_messages_conversations_messages",
+				Columns:    []*schema.Column{ConversationMessagesColumns[6]},
+				RefColumns: []*schema.Column{ConversationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "conversationmessage_conversation_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ConversationMessagesColumns[6], ConversationMessagesColumns[1]},
+			},
+		},
+	}
+	// ConversationParticipantsColumns holds the columns for the "conversation_participants" table.
+	ConversationParticipantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "last_read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "conversation_id", Type: field.TypeString, Size: 20},
+	}
+	// ConversationParticipantsTable holds the schema information for the "conversation_participants" table.
+	ConversationParticipantsTable = &schema.Table{
+		Name:       "conversation_participants",
+		Columns:    ConversationParticipantsColumns,
+		PrimaryKey: []*schema.Column{ConversationParticipantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "conversation_participants_accounts_conversations",
+				Columns:    []*schema.Column{ConversationParticipantsColumns[3]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "conversation_participants_conversations_participants",
+				Columns:    []*schema.Column{ConversationParticipantsColumns[4]},
+				RefColumns: []*schema.Column{ConversationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unique_conversation_participant",
+				Unique:  true,
+				Columns: []*schema.Column{ConversationParticipantsColumns[4], ConversationParticipantsColumns[3]},
+			},
+			{
+				Name:    "conversationparticipant_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{ConversationParticipantsColumns[3]},
+			},
+		},
+	}
 	// EmailsColumns holds the columns for the "emails" table.
 	EmailsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -372,6 +552,7 @@ var (
 		{Name: "location_url", Type: field.TypeString, Nullable: true},
 		{Name: "capacity", Type: field.TypeInt, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "sequence", Type: field.TypeInt, Default: 0},
 		{Name: "asset_event", Type: field.TypeString, Nullable: true, Size: 20},
 		{Name: "post_event", Type: field.TypeString, Size: 20},
 	}
@@ -383,13 +564,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "events_assets_event",
-				Columns:    []*schema.Column{EventsColumns[20]},
+				Columns:    []*schema.Column{EventsColumns[21]},
 				RefColumns: []*schema.Column{AssetsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "events_posts_event",
-				Columns:    []*schema.Column{EventsColumns[21]},
+				Columns:    []*schema.Column{EventsColumns[22]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -445,6 +626,10 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "message", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Nullable: true},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
 		{Name: "creator_account_id", Type: field.TypeString, Size: 20},
 	}
 	// InvitationsTable holds the schema information for the "invitations" table.
@@ -455,7 +640,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "invitations_accounts_invitations",
-				Columns:    []*schema.Column{InvitationsColumns[5]},
+				Columns:    []*schema.Column{InvitationsColumns[9]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -627,6 +812,42 @@ var (
 			},
 		},
 	}
+	// NodeRevisionsColumns holds the columns for the "node_revisions" table.
+	NodeRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "name", Type: field.TypeString},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "account_id", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "node_id", Type: field.TypeString, Size: 20},
+	}
+	// NodeRevisionsTable holds the schema information for the "node_revisions" table.
+	NodeRevisionsTable = &schema.Table{
+		Name:       "node_revisions",
+		Columns:    NodeRevisionsColumns,
+		PrimaryKey: []*schema.Column{NodeRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "node_revisions_accounts_node_revisions",
+				Columns:    []*schema.Column{NodeRevisionsColumns[4]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "node_revisions_nodes_revisions",
+				Columns:    []*schema.Column{NodeRevisionsColumns[5]},
+				RefColumns: []*schema.Column{NodesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "noderevision_node_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NodeRevisionsColumns[5], NodeRevisionsColumns[1]},
+			},
+		},
+	}
 	// NotificationsColumns holds the columns for the "notifications" table.
 	NotificationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -659,6 +880,180 @@ var (
 			},
 		},
 	}
+	// NotificationDigestsColumns holds the columns for the "notification_digests" table.
+	NotificationDigestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "frequency", Type: field.TypeString},
+		{Name: "last_sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "account_id", Type: field.TypeString, Unique: true, Size: 20},
+	}
+	// NotificationDigestsTable holds the schema information for the "notification_digests" table.
+	NotificationDigestsTable = &schema.Table{
+		Name:       "notification_digests",
+		Columns:    NotificationDigestsColumns,
+		PrimaryKey: []*schema.Column{NotificationDigestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_digests_accounts_notification_digest",
+				Columns:    []*schema.Column{NotificationDigestsColumns[5]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// NotificationDigestItemsColumns holds the columns for the "notification_digest_items" table.
+	NotificationDigestItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "item_kind", Type: field.TypeString},
+		{Name: "item_id", Type: field.TypeString},
+		{Name: "digest_id", Type: field.TypeString, Size: 20},
+	}
+	// NotificationDigestItemsTable holds the schema information for the "notification_digest_items" table.
+	NotificationDigestItemsTable = &schema.Table{
+		Name:       "notification_digest_items",
+		Columns:    NotificationDigestItemsColumns,
+		PrimaryKey: []*schema.Column{NotificationDigestItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_digest_items_notification_digests_items",
+				Columns:    []*schema.Column{NotificationDigestItemsColumns[4]},
+				RefColumns: []*schema.Column{NotificationDigestsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unique_notification_digest_item",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationDigestItemsColumns[4], NotificationDigestItemsColumns[2], NotificationDigestItemsColumns[3]},
+			},
+		},
+	}
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "delivery", Type: field.TypeString},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+	}
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_preferences_accounts_notification_preferences",
+				Columns:    []*schema.Column{NotificationPreferencesColumns[5]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unique_notification_preference",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationPreferencesColumns[5], NotificationPreferencesColumns[3]},
+			},
+		},
+	}
+	// PollsColumns holds the columns for the "polls" table.
+	PollsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "max_choices", Type: field.TypeInt, Default: 1},
+		{Name: "anonymous", Type: field.TypeBool, Default: false},
+		{Name: "closes_at", Type: field.TypeTime, Nullable: true},
+		{Name: "post_id", Type: field.TypeString, Unique: true, Size: 20},
+	}
+	// PollsTable holds the schema information for the "polls" table.
+	PollsTable = &schema.Table{
+		Name:       "polls",
+		Columns:    PollsColumns,
+		PrimaryKey: []*schema.Column{PollsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "polls_posts_poll",
+				Columns:    []*schema.Column{PollsColumns[6]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// PollOptionsColumns holds the columns for the "poll_options" table.
+	PollOptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "text", Type: field.TypeString},
+		{Name: "sort", Type: field.TypeInt, Default: 0},
+		{Name: "poll_id", Type: field.TypeString, Size: 20},
+	}
+	// PollOptionsTable holds the schema information for the "poll_options" table.
+	PollOptionsTable = &schema.Table{
+		Name:       "poll_options",
+		Columns:    PollOptionsColumns,
+		PrimaryKey: []*schema.Column{PollOptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "poll_options_polls_options",
+				Columns:    []*schema.Column{PollOptionsColumns[4]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// PollVotesColumns holds the columns for the "poll_votes" table.
+	PollVotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+		{Name: "poll_id", Type: field.TypeString, Size: 20},
+		{Name: "option_id", Type: field.TypeString, Size: 20},
+	}
+	// PollVotesTable holds the schema information for the "poll_votes" table.
+	PollVotesTable = &schema.Table{
+		Name:       "poll_votes",
+		Columns:    PollVotesColumns,
+		PrimaryKey: []*schema.Column{PollVotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "poll_votes_accounts_poll_votes",
+				Columns:    []*schema.Column{PollVotesColumns[2]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "poll_votes_polls_votes",
+				Columns:    []*schema.Column{PollVotesColumns[3]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "poll_votes_poll_options_votes",
+				Columns:    []*schema.Column{PollVotesColumns[4]},
+				RefColumns: []*schema.Column{PollOptionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unique_poll_vote",
+				Unique:  true,
+				Columns: []*schema.Column{PollVotesColumns[4], PollVotesColumns[2]},
+			},
+			{
+				Name:    "pollvote_poll_id_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{PollVotesColumns[3], PollVotesColumns[2]},
+			},
+		},
+	}
 	// PostsColumns holds the columns for the "posts" table.
 	PostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -670,6 +1065,10 @@ var (
 		{Name: "slug", Type: field.TypeString, Nullable: true},
 		{Name: "pinned", Type: field.TypeBool, Default: false},
 		{Name: "last_reply_at", Type: field.TypeTime},
+		{Name: "locked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "locked_by_id", Type: field.TypeString, Nullable: true},
+		{Name: "lock_reason", Type: field.TypeString, Nullable: true},
+		{Name: "accepted_answer_id", Type: field.TypeString, Nullable: true},
 		{Name: "body", Type: field.TypeString},
 		{Name: "short", Type: field.TypeString},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
@@ -688,31 +1087,31 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "posts_accounts_posts",
-				Columns:    []*schema.Column{PostsColumns[13]},
+				Columns:    []*schema.Column{PostsColumns[17]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "posts_categories_posts",
-				Columns:    []*schema.Column{PostsColumns[14]},
+				Columns:    []*schema.Column{PostsColumns[18]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "posts_links_posts",
-				Columns:    []*schema.Column{PostsColumns[15]},
+				Columns:    []*schema.Column{PostsColumns[19]},
 				RefColumns: []*schema.Column{LinksColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "posts_posts_posts",
-				Columns:    []*schema.Column{PostsColumns[16]},
+				Columns:    []*schema.Column{PostsColumns[20]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "posts_posts_replies",
-				Columns:    []*schema.Column{PostsColumns[17]},
+				Columns:    []*schema.Column{PostsColumns[21]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -721,17 +1120,17 @@ var (
 			{
 				Name:    "post_root_post_id_deleted_at_visibility_last_reply_at",
 				Unique:  false,
-				Columns: []*schema.Column{PostsColumns[16], PostsColumns[3], PostsColumns[12], PostsColumns[8]},
+				Columns: []*schema.Column{PostsColumns[20], PostsColumns[3], PostsColumns[16], PostsColumns[8]},
 			},
 			{
 				Name:    "post_root_post_id_deleted_at_visibility_category_id_last_reply_at",
 				Unique:  false,
-				Columns: []*schema.Column{PostsColumns[16], PostsColumns[3], PostsColumns[12], PostsColumns[14], PostsColumns[8]},
+				Columns: []*schema.Column{PostsColumns[20], PostsColumns[3], PostsColumns[16], PostsColumns[18], PostsColumns[8]},
 			},
 			{
 				Name:    "post_root_post_id_deleted_at_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{PostsColumns[16], PostsColumns[3], PostsColumns[1]},
+				Columns: []*schema.Column{PostsColumns[20], PostsColumns[3], PostsColumns[1]},
 			},
 		},
 	}
@@ -769,6 +1168,42 @@ var (
 			},
 		},
 	}
+	// PostRevisionsColumns holds the columns for the "post_revisions" table.
+	PostRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "account_id", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "post_id", Type: field.TypeString, Size: 20},
+	}
+	// PostRevisionsTable holds the schema information for the "post_revisions" table.
+	PostRevisionsTable = &schema.Table{
+		Name:       "post_revisions",
+		Columns:    PostRevisionsColumns,
+		PrimaryKey: []*schema.Column{PostRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_revisions_accounts_post_revisions",
+				Columns:    []*schema.Column{PostRevisionsColumns[4]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "post_revisions_posts_revisions",
+				Columns:    []*schema.Column{PostRevisionsColumns[5]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "postrevision_post_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PostRevisionsColumns[5], PostRevisionsColumns[1]},
+			},
+		},
+	}
 	// PropertiesColumns holds the columns for the "properties" table.
 	PropertiesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -858,6 +1293,46 @@ var (
 			},
 		},
 	}
+	// PublishSchedulesColumns holds the columns for the "publish_schedules" table.
+	PublishSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "target_id", Type: field.TypeString},
+		{Name: "target_kind", Type: field.TypeString},
+		{Name: "publish_at", Type: field.TypeTime},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "failed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "account_id", Type: field.TypeString, Size: 20},
+	}
+	// PublishSchedulesTable holds the schema information for the "publish_schedules" table.
+	PublishSchedulesTable = &schema.Table{
+		Name:       "publish_schedules",
+		Columns:    PublishSchedulesColumns,
+		PrimaryKey: []*schema.Column{PublishSchedulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "publish_schedules_accounts_publish_schedules",
+				Columns:    []*schema.Column{PublishSchedulesColumns[10]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "publishschedule_target_id",
+				Unique:  true,
+				Columns: []*schema.Column{PublishSchedulesColumns[3]},
+			},
+			{
+				Name:    "publishschedule_publish_at",
+				Unique:  false,
+				Columns: []*schema.Column{PublishSchedulesColumns[5]},
+			},
+		},
+	}
 	// QuestionsColumns holds the columns for the "questions" table.
 	QuestionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 20},
@@ -980,6 +1455,9 @@ var (
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "expires_at", Type: field.TypeTime},
 		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
 		{Name: "account_id", Type: field.TypeString, Size: 20},
 	}
 	// SessionsTable holds the schema information for the "sessions" table.
@@ -990,11 +1468,18 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sessions_accounts_sessions",
-				Columns:    []*schema.Column{SessionsColumns[4]},
+				Columns:    []*schema.Column{SessionsColumns[7]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[7]},
+			},
+		},
 	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
@@ -1013,6 +1498,8 @@ var (
 		{Name: "id", Type: field.TypeString, Size: 20},
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "colour", Type: field.TypeString, Nullable: true},
 	}
 	// TagsTable holds the schema information for the "tags" table.
 	TagsTable = &schema.Table{
@@ -1020,6 +1507,57 @@ var (
 		Columns:    TagsColumns,
 		PrimaryKey: []*schema.Column{TagsColumns[0]},
 	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "event_types", Type: field.TypeJSON},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 20},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "webhook_id", Type: field.TypeString, Size: 20},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[9]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[9], WebhookDeliveriesColumns[1]},
+			},
+		},
+	}
 	// AccountTagsColumns holds the columns for the "account_tags" table.
 	AccountTagsColumns = []*schema.Column{
 		{Name: "account_id", Type: field.TypeString, Size: 20},
@@ -1045,6 +1583,31 @@ var (
 			},
 		},
 	}
+	// InvitationRolesColumns holds the columns for the "invitation_roles" table.
+	InvitationRolesColumns = []*schema.Column{
+		{Name: "invitation_id", Type: field.TypeString, Size: 20},
+		{Name: "role_id", Type: field.TypeString, Size: 20},
+	}
+	// InvitationRolesTable holds the schema information for the "invitation_roles" table.
+	InvitationRolesTable = &schema.Table{
+		Name:       "invitation_roles",
+		Columns:    InvitationRolesColumns,
+		PrimaryKey: []*schema.Column{InvitationRolesColumns[0], InvitationRolesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invitation_roles_invitation_id",
+				Columns:    []*schema.Column{InvitationRolesColumns[0]},
+				RefColumns: []*schema.Column{InvitationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "invitation_roles_role_id",
+				Columns:    []*schema.Column{InvitationRolesColumns[1]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// LinkPostContentReferencesColumns holds the columns for the "link_post_content_references" table.
 	LinkPostContentReferencesColumns = []*schema.Column{
 		{Name: "link_id", Type: field.TypeString, Size: 20},
@@ -1223,14 +1786,19 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccountsTable,
+		AccountBlocksTable,
 		AccountFollowsTable,
 		AccountRolesTable,
 		AssetsTable,
+		AuditLogsTable,
 		AuthenticationsTable,
 		CategoriesTable,
 		CollectionsTable,
 		CollectionNodesTable,
 		CollectionPostsTable,
+		ConversationsTable,
+		ConversationMessagesTable,
+		ConversationParticipantsTable,
 		EmailsTable,
 		EventsTable,
 		EventParticipantsTable,
@@ -1239,12 +1807,21 @@ var (
 		LinksTable,
 		MentionProfilesTable,
 		NodesTable,
+		NodeRevisionsTable,
 		NotificationsTable,
+		NotificationDigestsTable,
+		NotificationDigestItemsTable,
+		NotificationPreferencesTable,
+		PollsTable,
+		PollOptionsTable,
+		PollVotesTable,
 		PostsTable,
 		PostReadsTable,
+		PostRevisionsTable,
 		PropertiesTable,
 		PropertySchemasTable,
 		PropertySchemaFieldsTable,
+		PublishSchedulesTable,
 		QuestionsTable,
 		ReactsTable,
 		ReportsTable,
@@ -1252,7 +1829,10 @@ var (
 		SessionsTable,
 		SettingsTable,
 		TagsTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 		AccountTagsTable,
+		InvitationRolesTable,
 		LinkPostContentReferencesTable,
 		LinkNodeContentReferencesTable,
 		LinkAssetsTable,
@@ -1265,12 +1845,15 @@ var (
 
 func init() {
 	AccountsTable.ForeignKeys[0].RefTable = InvitationsTable
+	AccountBlocksTable.ForeignKeys[0].RefTable = AccountsTable
+	AccountBlocksTable.ForeignKeys[1].RefTable = AccountsTable
 	AccountFollowsTable.ForeignKeys[0].RefTable = AccountsTable
 	AccountFollowsTable.ForeignKeys[1].RefTable = AccountsTable
 	AccountRolesTable.ForeignKeys[0].RefTable = AccountsTable
 	AccountRolesTable.ForeignKeys[1].RefTable = RolesTable
 	AssetsTable.ForeignKeys[0].RefTable = AccountsTable
 	AssetsTable.ForeignKeys[1].RefTable = AssetsTable
+	AuditLogsTable.ForeignKeys[0].RefTable = AccountsTable
 	AuthenticationsTable.ForeignKeys[0].RefTable = AccountsTable
 	CategoriesTable.ForeignKeys[0].RefTable = CategoriesTable
 	CategoriesTable.ForeignKeys[1].RefTable = AssetsTable
@@ -1280,6 +1863,10 @@ func init() {
 	CollectionNodesTable.ForeignKeys[1].RefTable = NodesTable
 	CollectionPostsTable.ForeignKeys[0].RefTable = CollectionsTable
 	CollectionPostsTable.ForeignKeys[1].RefTable = PostsTable
+	ConversationMessagesTable.ForeignKeys[0].RefTable = AccountsTable
+	ConversationMessagesTable.ForeignKeys[1].RefTable = ConversationsTable
+	ConversationParticipantsTable.ForeignKeys[0].RefTable = AccountsTable
+	ConversationParticipantsTable.ForeignKeys[1].RefTable = ConversationsTable
 	EmailsTable.ForeignKeys[0].RefTable = AccountsTable
 	EventsTable.ForeignKeys[0].RefTable = AssetsTable
 	EventsTable.ForeignKeys[1].RefTable = PostsTable
@@ -1297,8 +1884,18 @@ func init() {
 	NodesTable.ForeignKeys[2].RefTable = NodesTable
 	NodesTable.ForeignKeys[3].RefTable = AssetsTable
 	NodesTable.ForeignKeys[4].RefTable = PropertySchemasTable
+	NodeRevisionsTable.ForeignKeys[0].RefTable = AccountsTable
+	NodeRevisionsTable.ForeignKeys[1].RefTable = NodesTable
 	NotificationsTable.ForeignKeys[0].RefTable = AccountsTable
 	NotificationsTable.ForeignKeys[1].RefTable = AccountsTable
+	NotificationDigestsTable.ForeignKeys[0].RefTable = AccountsTable
+	NotificationDigestItemsTable.ForeignKeys[0].RefTable = NotificationDigestsTable
+	NotificationPreferencesTable.ForeignKeys[0].RefTable = AccountsTable
+	PollsTable.ForeignKeys[0].RefTable = PostsTable
+	PollOptionsTable.ForeignKeys[0].RefTable = PollsTable
+	PollVotesTable.ForeignKeys[0].RefTable = AccountsTable
+	PollVotesTable.ForeignKeys[1].RefTable = PollsTable
+	PollVotesTable.ForeignKeys[2].RefTable = PollOptionsTable
 	PostsTable.ForeignKeys[0].RefTable = AccountsTable
 	PostsTable.ForeignKeys[1].RefTable = CategoriesTable
 	PostsTable.ForeignKeys[2].RefTable = LinksTable
@@ -1306,9 +1903,12 @@ func init() {
 	PostsTable.ForeignKeys[4].RefTable = PostsTable
 	PostReadsTable.ForeignKeys[0].RefTable = AccountsTable
 	PostReadsTable.ForeignKeys[1].RefTable = PostsTable
+	PostRevisionsTable.ForeignKeys[0].RefTable = AccountsTable
+	PostRevisionsTable.ForeignKeys[1].RefTable = PostsTable
 	PropertiesTable.ForeignKeys[0].RefTable = NodesTable
 	PropertiesTable.ForeignKeys[1].RefTable = PropertySchemaFieldsTable
 	PropertySchemaFieldsTable.ForeignKeys[0].RefTable = PropertySchemasTable
+	PublishSchedulesTable.ForeignKeys[0].RefTable = AccountsTable
 	QuestionsTable.ForeignKeys[0].RefTable = AccountsTable
 	QuestionsTable.ForeignKeys[1].RefTable = QuestionsTable
 	ReactsTable.ForeignKeys[0].RefTable = AccountsTable
@@ -1316,8 +1916,11 @@ func init() {
 	ReportsTable.ForeignKeys[0].RefTable = AccountsTable
 	ReportsTable.ForeignKeys[1].RefTable = AccountsTable
 	SessionsTable.ForeignKeys[0].RefTable = AccountsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 	AccountTagsTable.ForeignKeys[0].RefTable = AccountsTable
 	AccountTagsTable.ForeignKeys[1].RefTable = TagsTable
+	InvitationRolesTable.ForeignKeys[0].RefTable = InvitationsTable
+	InvitationRolesTable.ForeignKeys[1].RefTable = RolesTable
 	LinkPostContentReferencesTable.ForeignKeys[0].RefTable = LinksTable
 	LinkPostContentReferencesTable.ForeignKeys[1].RefTable = PostsTable
 	LinkNodeContentReferencesTable.ForeignKeys[0].RefTable = LinksTable
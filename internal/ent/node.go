@@ -88,11 +88,13 @@ type NodeEdges struct {
 	ContentLinks []*Link `json:"content_links,omitempty"`
 	// Collections holds the value of the collections edge.
 	Collections []*Collection `json:"collections,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*NodeRevision `json:"revisions,omitempty"`
 	// CollectionNodes holds the value of the collection_nodes edge.
 	CollectionNodes []*CollectionNode `json:"collection_nodes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [12]bool
+	loadedTypes [13]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -204,10 +206,19 @@ func (e NodeEdges) CollectionsOrErr() ([]*Collection, error) {
 	return nil, &NotLoadedError{edge: "collections"}
 }
 
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e NodeEdges) RevisionsOrErr() ([]*NodeRevision, error) {
+	if e.loadedTypes[11] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
 // CollectionNodesOrErr returns the CollectionNodes value or an error if the edge
 // was not loaded in eager-loading.
 func (e NodeEdges) CollectionNodesOrErr() ([]*CollectionNode, error) {
-	if e.loadedTypes[11] {
+	if e.loadedTypes[12] {
 		return e.CollectionNodes, nil
 	}
 	return nil, &NotLoadedError{edge: "collection_nodes"}
@@ -431,6 +442,11 @@ func (_m *Node) QueryCollections() *CollectionQuery {
 	return NewNodeClient(_m.config).QueryCollections(_m)
 }
 
+// QueryRevisions queries the "revisions" edge of the Node entity.
+func (_m *Node) QueryRevisions() *NodeRevisionQuery {
+	return NewNodeClient(_m.config).QueryRevisions(_m)
+}
+
 // QueryCollectionNodes queries the "collection_nodes" edge of the Node entity.
 func (_m *Node) QueryCollectionNodes() *CollectionNodeQuery {
 	return NewNodeClient(_m.config).QueryCollectionNodes(_m)
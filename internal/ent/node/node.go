@@ -73,6 +73,8 @@ const (
 	EdgeContentLinks = "content_links"
 	// EdgeCollections holds the string denoting the collections edge name in mutations.
 	EdgeCollections = "collections"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
 	// EdgeCollectionNodes holds the string denoting the collection_nodes edge name in mutations.
 	EdgeCollectionNodes = "collection_nodes"
 	// Table holds the table name of the node in the database.
@@ -140,6 +142,13 @@ const (
 	// CollectionsInverseTable is the table name for the Collection entity.
 	// It exists in this package in order to avoid circular dependency with the "collection" package.
 	CollectionsInverseTable = "collections"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "node_revisions"
+	// RevisionsInverseTable is the table name for the NodeRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "noderevision" package.
+	RevisionsInverseTable = "node_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "node_id"
 	// CollectionNodesTable is the table that holds the collection_nodes relation/edge.
 	CollectionNodesTable = "collection_nodes"
 	// CollectionNodesInverseTable is the table name for the CollectionNode entity.
@@ -448,6 +457,20 @@ func ByCollections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByCollectionNodesCount orders the results by collection_nodes count.
 func ByCollectionNodesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -538,6 +561,13 @@ func newCollectionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, CollectionsTable, CollectionsPrimaryKey...),
 	)
 }
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
 func newCollectionNodesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
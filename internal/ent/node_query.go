@@ -18,6 +18,7 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/collectionnode"
 	"github.com/Southclaws/storyden/internal/ent/link"
 	"github.com/Southclaws/storyden/internal/ent/node"
+	"github.com/Southclaws/storyden/internal/ent/noderevision"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
 	"github.com/Southclaws/storyden/internal/ent/property"
 	"github.com/Southclaws/storyden/internal/ent/propertyschema"
@@ -43,6 +44,7 @@ type NodeQuery struct {
 	withLink            *LinkQuery
 	withContentLinks    *LinkQuery
 	withCollections     *CollectionQuery
+	withRevisions       *NodeRevisionQuery
 	withCollectionNodes *CollectionNodeQuery
 	modifiers           []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -323,6 +325,28 @@ func (_q *NodeQuery) QueryCollections() *CollectionQuery {
 	return query
 }
 
+// QueryRevisions chains the current query on the "revisions" edge.
+func (_q *NodeQuery) QueryRevisions() *NodeRevisionQuery {
+	query := (&NodeRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, selector),
+			sqlgraph.To(noderevision.Table, noderevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, node.RevisionsTable, node.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryCollectionNodes chains the current query on the "collection_nodes" edge.
 func (_q *NodeQuery) QueryCollectionNodes() *CollectionNodeQuery {
 	query := (&CollectionNodeClient{config: _q.config}).Query()
@@ -548,6 +572,7 @@ func (_q *NodeQuery) Clone() *NodeQuery {
 		withLink:            _q.withLink.Clone(),
 		withContentLinks:    _q.withContentLinks.Clone(),
 		withCollections:     _q.withCollections.Clone(),
+		withRevisions:       _q.withRevisions.Clone(),
 		withCollectionNodes: _q.withCollectionNodes.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
@@ -677,6 +702,17 @@ func (_q *NodeQuery) WithCollections(opts ...func(*CollectionQuery)) *NodeQuery
 	return _q
 }
 
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *NodeQuery) WithRevisions(opts ...func(*NodeRevisionQuery)) *NodeQuery {
+	query := (&NodeRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevisions = query
+	return _q
+}
+
 // WithCollectionNodes tells the query-builder to eager-load the nodes that are connected to
 // the "collection_nodes" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *NodeQuery) WithCollectionNodes(opts ...func(*CollectionNodeQuery)) *NodeQuery {
@@ -766,7 +802,7 @@ func (_q *NodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Node, e
 	var (
 		nodes       = []*Node{}
 		_spec       = _q.querySpec()
-		loadedTypes = [12]bool{
+		loadedTypes = [13]bool{
 			_q.withOwner != nil,
 			_q.withParent != nil,
 			_q.withNodes != nil,
@@ -778,6 +814,7 @@ func (_q *NodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Node, e
 			_q.withLink != nil,
 			_q.withContentLinks != nil,
 			_q.withCollections != nil,
+			_q.withRevisions != nil,
 			_q.withCollectionNodes != nil,
 		}
 	)
@@ -874,6 +911,13 @@ func (_q *NodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Node, e
 			return nil, err
 		}
 	}
+	if query := _q.withRevisions; query != nil {
+		if err := _q.loadRevisions(ctx, query, nodes,
+			func(n *Node) { n.Edges.Revisions = []*NodeRevision{} },
+			func(n *Node, e *NodeRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withCollectionNodes; query != nil {
 		if err := _q.loadCollectionNodes(ctx, query, nodes,
 			func(n *Node) { n.Edges.CollectionNodes = []*CollectionNode{} },
@@ -1339,6 +1383,36 @@ func (_q *NodeQuery) loadCollections(ctx context.Context, query *CollectionQuery
 	}
 	return nil
 }
+func (_q *NodeQuery) loadRevisions(ctx context.Context, query *NodeRevisionQuery, nodes []*Node, init func(*Node), assign func(*Node, *NodeRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[xid.ID]*Node)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(noderevision.FieldNodeID)
+	}
+	query.Where(predicate.NodeRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(node.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.NodeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "node_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *NodeQuery) loadCollectionNodes(ctx context.Context, query *CollectionNodeQuery, nodes []*Node, init func(*Node), assign func(*Node, *CollectionNode)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[xid.ID]*Node)
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/node"
+	"github.com/Southclaws/storyden/internal/ent/noderevision"
+	"github.com/rs/xid"
+)
+
+// NodeRevision is the model entity for the NodeRevision schema.
+type NodeRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// NodeID holds the value of the "node_id" field.
+	NodeID xid.ID `json:"node_id,omitempty"`
+	// The account which made the edit that caused this snapshot, cleared if that account is deleted.
+	AccountID *xid.ID `json:"account_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Content holds the value of the "content" field.
+	Content *string `json:"content,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NodeRevisionQuery when eager-loading is set.
+	Edges        NodeRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NodeRevisionEdges holds the relations/edges for other nodes in the graph.
+type NodeRevisionEdges struct {
+	// Node holds the value of the node edge.
+	Node *Node `json:"node,omitempty"`
+	// Author holds the value of the author edge.
+	Author *Account `json:"author,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// NodeOrErr returns the Node value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NodeRevisionEdges) NodeOrErr() (*Node, error) {
+	if e.Node != nil {
+		return e.Node, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: node.Label}
+	}
+	return nil, &NotLoadedError{edge: "node"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NodeRevisionEdges) AuthorOrErr() (*Account, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NodeRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case noderevision.FieldAccountID:
+			values[i] = &sql.NullScanner{S: new(xid.ID)}
+		case noderevision.FieldName, noderevision.FieldContent:
+			values[i] = new(sql.NullString)
+		case noderevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case noderevision.FieldID, noderevision.FieldNodeID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NodeRevision fields.
+func (_m *NodeRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case noderevision.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case noderevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case noderevision.FieldNodeID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field node_id", values[i])
+			} else if value != nil {
+				_m.NodeID = *value
+			}
+		case noderevision.FieldAccountID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = new(xid.ID)
+				*_m.AccountID = *value.S.(*xid.ID)
+			}
+		case noderevision.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case noderevision.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = new(string)
+				*_m.Content = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NodeRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *NodeRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryNode queries the "node" edge of the NodeRevision entity.
+func (_m *NodeRevision) QueryNode() *NodeQuery {
+	return NewNodeRevisionClient(_m.config).QueryNode(_m)
+}
+
+// QueryAuthor queries the "author" edge of the NodeRevision entity.
+func (_m *NodeRevision) QueryAuthor() *AccountQuery {
+	return NewNodeRevisionClient(_m.config).QueryAuthor(_m)
+}
+
+// Update returns a builder for updating this NodeRevision.
+// Note that you need to call NodeRevision.Unwrap() before calling this method if this NodeRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *NodeRevision) Update() *NodeRevisionUpdateOne {
+	return NewNodeRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the NodeRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *NodeRevision) Unwrap() *NodeRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NodeRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *NodeRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("NodeRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("node_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NodeID))
+	builder.WriteString(", ")
+	if v := _m.AccountID; v != nil {
+		builder.WriteString("account_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.Content; v != nil {
+		builder.WriteString("content=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NodeRevisions is a parsable slice of NodeRevision.
+type NodeRevisions []*NodeRevision
@@ -0,0 +1,809 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/node"
+	"github.com/Southclaws/storyden/internal/ent/noderevision"
+	"github.com/rs/xid"
+)
+
+// NodeRevisionCreate is the builder for creating a NodeRevision entity.
+type NodeRevisionCreate struct {
+	config
+	mutation *NodeRevisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NodeRevisionCreate) SetCreatedAt(v time.Time) *NodeRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NodeRevisionCreate) SetNillableCreatedAt(v *time.Time) *NodeRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetNodeID sets the "node_id" field.
+func (_c *NodeRevisionCreate) SetNodeID(v xid.ID) *NodeRevisionCreate {
+	_c.mutation.SetNodeID(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *NodeRevisionCreate) SetAccountID(v xid.ID) *NodeRevisionCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *NodeRevisionCreate) SetNillableAccountID(v *xid.ID) *NodeRevisionCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *NodeRevisionCreate) SetName(v string) *NodeRevisionCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *NodeRevisionCreate) SetContent(v string) *NodeRevisionCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_c *NodeRevisionCreate) SetNillableContent(v *string) *NodeRevisionCreate {
+	if v != nil {
+		_c.SetContent(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NodeRevisionCreate) SetID(v xid.ID) *NodeRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *NodeRevisionCreate) SetNillableID(v *xid.ID) *NodeRevisionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (_c *NodeRevisionCreate) SetNode(v *Node) *NodeRevisionCreate {
+	return _c.SetNodeID(v.ID)
+}
+
+// SetAuthorID sets the "author" edge to the Account entity by ID.
+func (_c *NodeRevisionCreate) SetAuthorID(id xid.ID) *NodeRevisionCreate {
+	_c.mutation.SetAuthorID(id)
+	return _c
+}
+
+// SetNillableAuthorID sets the "author" edge to the Account entity by ID if the given value is not nil.
+func (_c *NodeRevisionCreate) SetNillableAuthorID(id *xid.ID) *NodeRevisionCreate {
+	if id != nil {
+		_c = _c.SetAuthorID(*id)
+	}
+	return _c
+}
+
+// SetAuthor sets the "author" edge to the Account entity.
+func (_c *NodeRevisionCreate) SetAuthor(v *Account) *NodeRevisionCreate {
+	return _c.SetAuthorID(v.ID)
+}
+
+// Mutation returns the NodeRevisionMutation object of the builder.
+func (_c *NodeRevisionCreate) Mutation() *NodeRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the NodeRevision in the database.
+func (_c *NodeRevisionCreate) Save(ctx context.Context) (*NodeRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NodeRevisionCreate) SaveX(ctx context.Context) *NodeRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NodeRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NodeRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NodeRevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := noderevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := noderevision.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NodeRevisionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NodeRevision.created_at"`)}
+	}
+	if _, ok := _c.mutation.NodeID(); !ok {
+		return &ValidationError{Name: "node_id", err: errors.New(`ent: missing required field "NodeRevision.node_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "NodeRevision.name"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := noderevision.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "NodeRevision.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.NodeIDs()) == 0 {
+		return &ValidationError{Name: "node", err: errors.New(`ent: missing required edge "NodeRevision.node"`)}
+	}
+	return nil
+}
+
+func (_c *NodeRevisionCreate) sqlSave(ctx context.Context) (*NodeRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NodeRevisionCreate) createSpec() (*NodeRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NodeRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(noderevision.Table, sqlgraph.NewFieldSpec(noderevision.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(noderevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(noderevision.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(noderevision.FieldContent, field.TypeString, value)
+		_node.Content = &value
+	}
+	if nodes := _c.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noderevision.NodeTable,
+			Columns: []string{noderevision.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.NodeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noderevision.AuthorTable,
+			Columns: []string{noderevision.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NodeRevision.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NodeRevisionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NodeRevisionCreate) OnConflict(opts ...sql.ConflictOption) *NodeRevisionUpsertOne {
+	_c.conflict = opts
+	return &NodeRevisionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NodeRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NodeRevisionCreate) OnConflictColumns(columns ...string) *NodeRevisionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NodeRevisionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NodeRevisionUpsertOne is the builder for "upsert"-ing
+	//  one NodeRevision node.
+	NodeRevisionUpsertOne struct {
+		create *NodeRevisionCreate
+	}
+
+	// NodeRevisionUpsert is the "OnConflict" setter.
+	NodeRevisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetNodeID sets the "node_id" field.
+func (u *NodeRevisionUpsert) SetNodeID(v xid.ID) *NodeRevisionUpsert {
+	u.Set(noderevision.FieldNodeID, v)
+	return u
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *NodeRevisionUpsert) UpdateNodeID() *NodeRevisionUpsert {
+	u.SetExcluded(noderevision.FieldNodeID)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *NodeRevisionUpsert) SetAccountID(v xid.ID) *NodeRevisionUpsert {
+	u.Set(noderevision.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *NodeRevisionUpsert) UpdateAccountID() *NodeRevisionUpsert {
+	u.SetExcluded(noderevision.FieldAccountID)
+	return u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *NodeRevisionUpsert) ClearAccountID() *NodeRevisionUpsert {
+	u.SetNull(noderevision.FieldAccountID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *NodeRevisionUpsert) SetName(v string) *NodeRevisionUpsert {
+	u.Set(noderevision.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NodeRevisionUpsert) UpdateName() *NodeRevisionUpsert {
+	u.SetExcluded(noderevision.FieldName)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *NodeRevisionUpsert) SetContent(v string) *NodeRevisionUpsert {
+	u.Set(noderevision.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *NodeRevisionUpsert) UpdateContent() *NodeRevisionUpsert {
+	u.SetExcluded(noderevision.FieldContent)
+	return u
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *NodeRevisionUpsert) ClearContent() *NodeRevisionUpsert {
+	u.SetNull(noderevision.FieldContent)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.NodeRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(noderevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NodeRevisionUpsertOne) UpdateNewValues() *NodeRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(noderevision.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(noderevision.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NodeRevision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NodeRevisionUpsertOne) Ignore() *NodeRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NodeRevisionUpsertOne) DoNothing() *NodeRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NodeRevisionCreate.OnConflict
+// documentation for more info.
+func (u *NodeRevisionUpsertOne) Update(set func(*NodeRevisionUpsert)) *NodeRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NodeRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *NodeRevisionUpsertOne) SetNodeID(v xid.ID) *NodeRevisionUpsertOne {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.SetNodeID(v)
+	})
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *NodeRevisionUpsertOne) UpdateNodeID() *NodeRevisionUpsertOne {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.UpdateNodeID()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *NodeRevisionUpsertOne) SetAccountID(v xid.ID) *NodeRevisionUpsertOne {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *NodeRevisionUpsertOne) UpdateAccountID() *NodeRevisionUpsertOne {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *NodeRevisionUpsertOne) ClearAccountID() *NodeRevisionUpsertOne {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.ClearAccountID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *NodeRevisionUpsertOne) SetName(v string) *NodeRevisionUpsertOne {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NodeRevisionUpsertOne) UpdateName() *NodeRevisionUpsertOne {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *NodeRevisionUpsertOne) SetContent(v string) *NodeRevisionUpsertOne {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *NodeRevisionUpsertOne) UpdateContent() *NodeRevisionUpsertOne {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *NodeRevisionUpsertOne) ClearContent() *NodeRevisionUpsertOne {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.ClearContent()
+	})
+}
+
+// Exec executes the query.
+func (u *NodeRevisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NodeRevisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NodeRevisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NodeRevisionUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NodeRevisionUpsertOne.ID is not supported by MySQL driver. Use NodeRevisionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NodeRevisionUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NodeRevisionCreateBulk is the builder for creating many NodeRevision entities in bulk.
+type NodeRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*NodeRevisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NodeRevision entities in the database.
+func (_c *NodeRevisionCreateBulk) Save(ctx context.Context) ([]*NodeRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NodeRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NodeRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NodeRevisionCreateBulk) SaveX(ctx context.Context) []*NodeRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NodeRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NodeRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NodeRevision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NodeRevisionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NodeRevisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *NodeRevisionUpsertBulk {
+	_c.conflict = opts
+	return &NodeRevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NodeRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NodeRevisionCreateBulk) OnConflictColumns(columns ...string) *NodeRevisionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NodeRevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// NodeRevisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of NodeRevision nodes.
+type NodeRevisionUpsertBulk struct {
+	create *NodeRevisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NodeRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(noderevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NodeRevisionUpsertBulk) UpdateNewValues() *NodeRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(noderevision.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(noderevision.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NodeRevision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NodeRevisionUpsertBulk) Ignore() *NodeRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NodeRevisionUpsertBulk) DoNothing() *NodeRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NodeRevisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *NodeRevisionUpsertBulk) Update(set func(*NodeRevisionUpsert)) *NodeRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NodeRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *NodeRevisionUpsertBulk) SetNodeID(v xid.ID) *NodeRevisionUpsertBulk {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.SetNodeID(v)
+	})
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *NodeRevisionUpsertBulk) UpdateNodeID() *NodeRevisionUpsertBulk {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.UpdateNodeID()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *NodeRevisionUpsertBulk) SetAccountID(v xid.ID) *NodeRevisionUpsertBulk {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *NodeRevisionUpsertBulk) UpdateAccountID() *NodeRevisionUpsertBulk {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *NodeRevisionUpsertBulk) ClearAccountID() *NodeRevisionUpsertBulk {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.ClearAccountID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *NodeRevisionUpsertBulk) SetName(v string) *NodeRevisionUpsertBulk {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NodeRevisionUpsertBulk) UpdateName() *NodeRevisionUpsertBulk {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *NodeRevisionUpsertBulk) SetContent(v string) *NodeRevisionUpsertBulk {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *NodeRevisionUpsertBulk) UpdateContent() *NodeRevisionUpsertBulk {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *NodeRevisionUpsertBulk) ClearContent() *NodeRevisionUpsertBulk {
+	return u.Update(func(s *NodeRevisionUpsert) {
+		s.ClearContent()
+	})
+}
+
+// Exec executes the query.
+func (u *NodeRevisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NodeRevisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NodeRevisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NodeRevisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/node"
+	"github.com/Southclaws/storyden/internal/ent/noderevision"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// NodeRevisionUpdate is the builder for updating NodeRevision entities.
+type NodeRevisionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *NodeRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the NodeRevisionUpdate builder.
+func (_u *NodeRevisionUpdate) Where(ps ...predicate.NodeRevision) *NodeRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNodeID sets the "node_id" field.
+func (_u *NodeRevisionUpdate) SetNodeID(v xid.ID) *NodeRevisionUpdate {
+	_u.mutation.SetNodeID(v)
+	return _u
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (_u *NodeRevisionUpdate) SetNillableNodeID(v *xid.ID) *NodeRevisionUpdate {
+	if v != nil {
+		_u.SetNodeID(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *NodeRevisionUpdate) SetAccountID(v xid.ID) *NodeRevisionUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *NodeRevisionUpdate) SetNillableAccountID(v *xid.ID) *NodeRevisionUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *NodeRevisionUpdate) ClearAccountID() *NodeRevisionUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *NodeRevisionUpdate) SetName(v string) *NodeRevisionUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *NodeRevisionUpdate) SetNillableName(v *string) *NodeRevisionUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *NodeRevisionUpdate) SetContent(v string) *NodeRevisionUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *NodeRevisionUpdate) SetNillableContent(v *string) *NodeRevisionUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *NodeRevisionUpdate) ClearContent() *NodeRevisionUpdate {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (_u *NodeRevisionUpdate) SetNode(v *Node) *NodeRevisionUpdate {
+	return _u.SetNodeID(v.ID)
+}
+
+// SetAuthorID sets the "author" edge to the Account entity by ID.
+func (_u *NodeRevisionUpdate) SetAuthorID(id xid.ID) *NodeRevisionUpdate {
+	_u.mutation.SetAuthorID(id)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author" edge to the Account entity by ID if the given value is not nil.
+func (_u *NodeRevisionUpdate) SetNillableAuthorID(id *xid.ID) *NodeRevisionUpdate {
+	if id != nil {
+		_u = _u.SetAuthorID(*id)
+	}
+	return _u
+}
+
+// SetAuthor sets the "author" edge to the Account entity.
+func (_u *NodeRevisionUpdate) SetAuthor(v *Account) *NodeRevisionUpdate {
+	return _u.SetAuthorID(v.ID)
+}
+
+// Mutation returns the NodeRevisionMutation object of the builder.
+func (_u *NodeRevisionUpdate) Mutation() *NodeRevisionMutation {
+	return _u.mutation
+}
+
+// ClearNode clears the "node" edge to the Node entity.
+func (_u *NodeRevisionUpdate) ClearNode() *NodeRevisionUpdate {
+	_u.mutation.ClearNode()
+	return _u
+}
+
+// ClearAuthor clears the "author" edge to the Account entity.
+func (_u *NodeRevisionUpdate) ClearAuthor() *NodeRevisionUpdate {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NodeRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NodeRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NodeRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NodeRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NodeRevisionUpdate) check() error {
+	if _u.mutation.NodeCleared() && len(_u.mutation.NodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NodeRevision.node"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NodeRevisionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NodeRevisionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *NodeRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(noderevision.Table, noderevision.Columns, sqlgraph.NewFieldSpec(noderevision.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(noderevision.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(noderevision.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(noderevision.FieldContent, field.TypeString)
+	}
+	if _u.mutation.NodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noderevision.NodeTable,
+			Columns: []string{noderevision.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noderevision.NodeTable,
+			Columns: []string{noderevision.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noderevision.AuthorTable,
+			Columns: []string{noderevision.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noderevision.AuthorTable,
+			Columns: []string{noderevision.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{noderevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NodeRevisionUpdateOne is the builder for updating a single NodeRevision entity.
+type NodeRevisionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *NodeRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetNodeID sets the "node_id" field.
+func (_u *NodeRevisionUpdateOne) SetNodeID(v xid.ID) *NodeRevisionUpdateOne {
+	_u.mutation.SetNodeID(v)
+	return _u
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (_u *NodeRevisionUpdateOne) SetNillableNodeID(v *xid.ID) *NodeRevisionUpdateOne {
+	if v != nil {
+		_u.SetNodeID(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *NodeRevisionUpdateOne) SetAccountID(v xid.ID) *NodeRevisionUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *NodeRevisionUpdateOne) SetNillableAccountID(v *xid.ID) *NodeRevisionUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *NodeRevisionUpdateOne) ClearAccountID() *NodeRevisionUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *NodeRevisionUpdateOne) SetName(v string) *NodeRevisionUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *NodeRevisionUpdateOne) SetNillableName(v *string) *NodeRevisionUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *NodeRevisionUpdateOne) SetContent(v string) *NodeRevisionUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *NodeRevisionUpdateOne) SetNillableContent(v *string) *NodeRevisionUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *NodeRevisionUpdateOne) ClearContent() *NodeRevisionUpdateOne {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (_u *NodeRevisionUpdateOne) SetNode(v *Node) *NodeRevisionUpdateOne {
+	return _u.SetNodeID(v.ID)
+}
+
+// SetAuthorID sets the "author" edge to the Account entity by ID.
+func (_u *NodeRevisionUpdateOne) SetAuthorID(id xid.ID) *NodeRevisionUpdateOne {
+	_u.mutation.SetAuthorID(id)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author" edge to the Account entity by ID if the given value is not nil.
+func (_u *NodeRevisionUpdateOne) SetNillableAuthorID(id *xid.ID) *NodeRevisionUpdateOne {
+	if id != nil {
+		_u = _u.SetAuthorID(*id)
+	}
+	return _u
+}
+
+// SetAuthor sets the "author" edge to the Account entity.
+func (_u *NodeRevisionUpdateOne) SetAuthor(v *Account) *NodeRevisionUpdateOne {
+	return _u.SetAuthorID(v.ID)
+}
+
+// Mutation returns the NodeRevisionMutation object of the builder.
+func (_u *NodeRevisionUpdateOne) Mutation() *NodeRevisionMutation {
+	return _u.mutation
+}
+
+// ClearNode clears the "node" edge to the Node entity.
+func (_u *NodeRevisionUpdateOne) ClearNode() *NodeRevisionUpdateOne {
+	_u.mutation.ClearNode()
+	return _u
+}
+
+// ClearAuthor clears the "author" edge to the Account entity.
+func (_u *NodeRevisionUpdateOne) ClearAuthor() *NodeRevisionUpdateOne {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// Where appends a list predicates to the NodeRevisionUpdate builder.
+func (_u *NodeRevisionUpdateOne) Where(ps ...predicate.NodeRevision) *NodeRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NodeRevisionUpdateOne) Select(field string, fields ...string) *NodeRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NodeRevision entity.
+func (_u *NodeRevisionUpdateOne) Save(ctx context.Context) (*NodeRevision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NodeRevisionUpdateOne) SaveX(ctx context.Context) *NodeRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NodeRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NodeRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NodeRevisionUpdateOne) check() error {
+	if _u.mutation.NodeCleared() && len(_u.mutation.NodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NodeRevision.node"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NodeRevisionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NodeRevisionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *NodeRevisionUpdateOne) sqlSave(ctx context.Context) (_node *NodeRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(noderevision.Table, noderevision.Columns, sqlgraph.NewFieldSpec(noderevision.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NodeRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, noderevision.FieldID)
+		for _, f := range fields {
+			if !noderevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != noderevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(noderevision.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(noderevision.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(noderevision.FieldContent, field.TypeString)
+	}
+	if _u.mutation.NodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noderevision.NodeTable,
+			Columns: []string{noderevision.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noderevision.NodeTable,
+			Columns: []string{noderevision.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noderevision.AuthorTable,
+			Columns: []string{noderevision.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noderevision.AuthorTable,
+			Columns: []string{noderevision.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &NodeRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{noderevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,795 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigest"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigestitem"
+	"github.com/rs/xid"
+)
+
+// NotificationDigestCreate is the builder for creating a NotificationDigest entity.
+type NotificationDigestCreate struct {
+	config
+	mutation *NotificationDigestMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NotificationDigestCreate) SetCreatedAt(v time.Time) *NotificationDigestCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NotificationDigestCreate) SetNillableCreatedAt(v *time.Time) *NotificationDigestCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *NotificationDigestCreate) SetUpdatedAt(v time.Time) *NotificationDigestCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *NotificationDigestCreate) SetNillableUpdatedAt(v *time.Time) *NotificationDigestCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *NotificationDigestCreate) SetAccountID(v xid.ID) *NotificationDigestCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetFrequency sets the "frequency" field.
+func (_c *NotificationDigestCreate) SetFrequency(v string) *NotificationDigestCreate {
+	_c.mutation.SetFrequency(v)
+	return _c
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (_c *NotificationDigestCreate) SetLastSentAt(v time.Time) *NotificationDigestCreate {
+	_c.mutation.SetLastSentAt(v)
+	return _c
+}
+
+// SetNillableLastSentAt sets the "last_sent_at" field if the given value is not nil.
+func (_c *NotificationDigestCreate) SetNillableLastSentAt(v *time.Time) *NotificationDigestCreate {
+	if v != nil {
+		_c.SetLastSentAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NotificationDigestCreate) SetID(v xid.ID) *NotificationDigestCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *NotificationDigestCreate) SetNillableID(v *xid.ID) *NotificationDigestCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *NotificationDigestCreate) SetAccount(v *Account) *NotificationDigestCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// AddItemIDs adds the "items" edge to the NotificationDigestItem entity by IDs.
+func (_c *NotificationDigestCreate) AddItemIDs(ids ...xid.ID) *NotificationDigestCreate {
+	_c.mutation.AddItemIDs(ids...)
+	return _c
+}
+
+// AddItems adds the "items" edges to the NotificationDigestItem entity.
+func (_c *NotificationDigestCreate) AddItems(v ...*NotificationDigestItem) *NotificationDigestCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemIDs(ids...)
+}
+
+// Mutation returns the NotificationDigestMutation object of the builder.
+func (_c *NotificationDigestCreate) Mutation() *NotificationDigestMutation {
+	return _c.mutation
+}
+
+// Save creates the NotificationDigest in the database.
+func (_c *NotificationDigestCreate) Save(ctx context.Context) (*NotificationDigest, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NotificationDigestCreate) SaveX(ctx context.Context) *NotificationDigest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationDigestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationDigestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NotificationDigestCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := notificationdigest.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := notificationdigest.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := notificationdigest.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NotificationDigestCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationDigest.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NotificationDigest.updated_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "NotificationDigest.account_id"`)}
+	}
+	if _, ok := _c.mutation.Frequency(); !ok {
+		return &ValidationError{Name: "frequency", err: errors.New(`ent: missing required field "NotificationDigest.frequency"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := notificationdigest.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "NotificationDigest.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "NotificationDigest.account"`)}
+	}
+	return nil
+}
+
+func (_c *NotificationDigestCreate) sqlSave(ctx context.Context) (*NotificationDigest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NotificationDigestCreate) createSpec() (*NotificationDigest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationDigest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(notificationdigest.Table, sqlgraph.NewFieldSpec(notificationdigest.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationdigest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdigest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Frequency(); ok {
+		_spec.SetField(notificationdigest.FieldFrequency, field.TypeString, value)
+		_node.Frequency = value
+	}
+	if value, ok := _c.mutation.LastSentAt(); ok {
+		_spec.SetField(notificationdigest.FieldLastSentAt, field.TypeTime, value)
+		_node.LastSentAt = &value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationdigest.AccountTable,
+			Columns: []string{notificationdigest.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationdigest.ItemsTable,
+			Columns: []string{notificationdigest.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdigestitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationDigest.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationDigestUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotificationDigestCreate) OnConflict(opts ...sql.ConflictOption) *NotificationDigestUpsertOne {
+	_c.conflict = opts
+	return &NotificationDigestUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationDigest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotificationDigestCreate) OnConflictColumns(columns ...string) *NotificationDigestUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotificationDigestUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NotificationDigestUpsertOne is the builder for "upsert"-ing
+	//  one NotificationDigest node.
+	NotificationDigestUpsertOne struct {
+		create *NotificationDigestCreate
+	}
+
+	// NotificationDigestUpsert is the "OnConflict" setter.
+	NotificationDigestUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationDigestUpsert) SetUpdatedAt(v time.Time) *NotificationDigestUpsert {
+	u.Set(notificationdigest.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationDigestUpsert) UpdateUpdatedAt() *NotificationDigestUpsert {
+	u.SetExcluded(notificationdigest.FieldUpdatedAt)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *NotificationDigestUpsert) SetAccountID(v xid.ID) *NotificationDigestUpsert {
+	u.Set(notificationdigest.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *NotificationDigestUpsert) UpdateAccountID() *NotificationDigestUpsert {
+	u.SetExcluded(notificationdigest.FieldAccountID)
+	return u
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *NotificationDigestUpsert) SetFrequency(v string) *NotificationDigestUpsert {
+	u.Set(notificationdigest.FieldFrequency, v)
+	return u
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *NotificationDigestUpsert) UpdateFrequency() *NotificationDigestUpsert {
+	u.SetExcluded(notificationdigest.FieldFrequency)
+	return u
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (u *NotificationDigestUpsert) SetLastSentAt(v time.Time) *NotificationDigestUpsert {
+	u.Set(notificationdigest.FieldLastSentAt, v)
+	return u
+}
+
+// UpdateLastSentAt sets the "last_sent_at" field to the value that was provided on create.
+func (u *NotificationDigestUpsert) UpdateLastSentAt() *NotificationDigestUpsert {
+	u.SetExcluded(notificationdigest.FieldLastSentAt)
+	return u
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (u *NotificationDigestUpsert) ClearLastSentAt() *NotificationDigestUpsert {
+	u.SetNull(notificationdigest.FieldLastSentAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDigest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationdigest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationDigestUpsertOne) UpdateNewValues() *NotificationDigestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(notificationdigest.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(notificationdigest.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDigest.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NotificationDigestUpsertOne) Ignore() *NotificationDigestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationDigestUpsertOne) DoNothing() *NotificationDigestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationDigestCreate.OnConflict
+// documentation for more info.
+func (u *NotificationDigestUpsertOne) Update(set func(*NotificationDigestUpsert)) *NotificationDigestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationDigestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationDigestUpsertOne) SetUpdatedAt(v time.Time) *NotificationDigestUpsertOne {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationDigestUpsertOne) UpdateUpdatedAt() *NotificationDigestUpsertOne {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *NotificationDigestUpsertOne) SetAccountID(v xid.ID) *NotificationDigestUpsertOne {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *NotificationDigestUpsertOne) UpdateAccountID() *NotificationDigestUpsertOne {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *NotificationDigestUpsertOne) SetFrequency(v string) *NotificationDigestUpsertOne {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.SetFrequency(v)
+	})
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *NotificationDigestUpsertOne) UpdateFrequency() *NotificationDigestUpsertOne {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.UpdateFrequency()
+	})
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (u *NotificationDigestUpsertOne) SetLastSentAt(v time.Time) *NotificationDigestUpsertOne {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.SetLastSentAt(v)
+	})
+}
+
+// UpdateLastSentAt sets the "last_sent_at" field to the value that was provided on create.
+func (u *NotificationDigestUpsertOne) UpdateLastSentAt() *NotificationDigestUpsertOne {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.UpdateLastSentAt()
+	})
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (u *NotificationDigestUpsertOne) ClearLastSentAt() *NotificationDigestUpsertOne {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.ClearLastSentAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationDigestUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationDigestCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationDigestUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationDigestUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NotificationDigestUpsertOne.ID is not supported by MySQL driver. Use NotificationDigestUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationDigestUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotificationDigestCreateBulk is the builder for creating many NotificationDigest entities in bulk.
+type NotificationDigestCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationDigestCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NotificationDigest entities in the database.
+func (_c *NotificationDigestCreateBulk) Save(ctx context.Context) ([]*NotificationDigest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NotificationDigest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationDigestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NotificationDigestCreateBulk) SaveX(ctx context.Context) []*NotificationDigest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationDigestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationDigestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationDigest.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationDigestUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotificationDigestCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationDigestUpsertBulk {
+	_c.conflict = opts
+	return &NotificationDigestUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationDigest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotificationDigestCreateBulk) OnConflictColumns(columns ...string) *NotificationDigestUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotificationDigestUpsertBulk{
+		create: _c,
+	}
+}
+
+// NotificationDigestUpsertBulk is the builder for "upsert"-ing
+// a bulk of NotificationDigest nodes.
+type NotificationDigestUpsertBulk struct {
+	create *NotificationDigestCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NotificationDigest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationdigest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationDigestUpsertBulk) UpdateNewValues() *NotificationDigestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(notificationdigest.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(notificationdigest.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDigest.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NotificationDigestUpsertBulk) Ignore() *NotificationDigestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationDigestUpsertBulk) DoNothing() *NotificationDigestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationDigestCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationDigestUpsertBulk) Update(set func(*NotificationDigestUpsert)) *NotificationDigestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationDigestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationDigestUpsertBulk) SetUpdatedAt(v time.Time) *NotificationDigestUpsertBulk {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationDigestUpsertBulk) UpdateUpdatedAt() *NotificationDigestUpsertBulk {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *NotificationDigestUpsertBulk) SetAccountID(v xid.ID) *NotificationDigestUpsertBulk {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *NotificationDigestUpsertBulk) UpdateAccountID() *NotificationDigestUpsertBulk {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *NotificationDigestUpsertBulk) SetFrequency(v string) *NotificationDigestUpsertBulk {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.SetFrequency(v)
+	})
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *NotificationDigestUpsertBulk) UpdateFrequency() *NotificationDigestUpsertBulk {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.UpdateFrequency()
+	})
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (u *NotificationDigestUpsertBulk) SetLastSentAt(v time.Time) *NotificationDigestUpsertBulk {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.SetLastSentAt(v)
+	})
+}
+
+// UpdateLastSentAt sets the "last_sent_at" field to the value that was provided on create.
+func (u *NotificationDigestUpsertBulk) UpdateLastSentAt() *NotificationDigestUpsertBulk {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.UpdateLastSentAt()
+	})
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (u *NotificationDigestUpsertBulk) ClearLastSentAt() *NotificationDigestUpsertBulk {
+	return u.Update(func(s *NotificationDigestUpsert) {
+		s.ClearLastSentAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationDigestUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NotificationDigestCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationDigestCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationDigestUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationdigestitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DigestID applies equality check predicate on the "digest_id" field. It's identical to DigestIDEQ.
+func DigestID(v xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldEQ(FieldDigestID, v))
+}
+
+// ItemKind applies equality check predicate on the "item_kind" field. It's identical to ItemKindEQ.
+func ItemKind(v string) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldEQ(FieldItemKind, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldEQ(FieldItemID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// DigestIDEQ applies the EQ predicate on the "digest_id" field.
+func DigestIDEQ(v xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldEQ(FieldDigestID, v))
+}
+
+// DigestIDNEQ applies the NEQ predicate on the "digest_id" field.
+func DigestIDNEQ(v xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldNEQ(FieldDigestID, v))
+}
+
+// DigestIDIn applies the In predicate on the "digest_id" field.
+func DigestIDIn(vs ...xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldIn(FieldDigestID, vs...))
+}
+
+// DigestIDNotIn applies the NotIn predicate on the "digest_id" field.
+func DigestIDNotIn(vs ...xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldNotIn(FieldDigestID, vs...))
+}
+
+// DigestIDGT applies the GT predicate on the "digest_id" field.
+func DigestIDGT(v xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldGT(FieldDigestID, v))
+}
+
+// DigestIDGTE applies the GTE predicate on the "digest_id" field.
+func DigestIDGTE(v xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldGTE(FieldDigestID, v))
+}
+
+// DigestIDLT applies the LT predicate on the "digest_id" field.
+func DigestIDLT(v xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldLT(FieldDigestID, v))
+}
+
+// DigestIDLTE applies the LTE predicate on the "digest_id" field.
+func DigestIDLTE(v xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldLTE(FieldDigestID, v))
+}
+
+// DigestIDContains applies the Contains predicate on the "digest_id" field.
+func DigestIDContains(v xid.ID) predicate.NotificationDigestItem {
+	vc := v.String()
+	return predicate.NotificationDigestItem(sql.FieldContains(FieldDigestID, vc))
+}
+
+// DigestIDHasPrefix applies the HasPrefix predicate on the "digest_id" field.
+func DigestIDHasPrefix(v xid.ID) predicate.NotificationDigestItem {
+	vc := v.String()
+	return predicate.NotificationDigestItem(sql.FieldHasPrefix(FieldDigestID, vc))
+}
+
+// DigestIDHasSuffix applies the HasSuffix predicate on the "digest_id" field.
+func DigestIDHasSuffix(v xid.ID) predicate.NotificationDigestItem {
+	vc := v.String()
+	return predicate.NotificationDigestItem(sql.FieldHasSuffix(FieldDigestID, vc))
+}
+
+// DigestIDEqualFold applies the EqualFold predicate on the "digest_id" field.
+func DigestIDEqualFold(v xid.ID) predicate.NotificationDigestItem {
+	vc := v.String()
+	return predicate.NotificationDigestItem(sql.FieldEqualFold(FieldDigestID, vc))
+}
+
+// DigestIDContainsFold applies the ContainsFold predicate on the "digest_id" field.
+func DigestIDContainsFold(v xid.ID) predicate.NotificationDigestItem {
+	vc := v.String()
+	return predicate.NotificationDigestItem(sql.FieldContainsFold(FieldDigestID, vc))
+}
+
+// ItemKindEQ applies the EQ predicate on the "item_kind" field.
+func ItemKindEQ(v string) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldEQ(FieldItemKind, v))
+}
+
+// ItemKindNEQ applies the NEQ predicate on the "item_kind" field.
+func ItemKindNEQ(v string) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldNEQ(FieldItemKind, v))
+}
+
+// ItemKindIn applies the In predicate on the "item_kind" field.
+func ItemKindIn(vs ...string) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldIn(FieldItemKind, vs...))
+}
+
+// ItemKindNotIn applies the NotIn predicate on the "item_kind" field.
+func ItemKindNotIn(vs ...string) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldNotIn(FieldItemKind, vs...))
+}
+
+// ItemKindGT applies the GT predicate on the "item_kind" field.
+func ItemKindGT(v string) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldGT(FieldItemKind, v))
+}
+
+// ItemKindGTE applies the GTE predicate on the "item_kind" field.
+func ItemKindGTE(v string) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldGTE(FieldItemKind, v))
+}
+
+// ItemKindLT applies the LT predicate on the "item_kind" field.
+func ItemKindLT(v string) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldLT(FieldItemKind, v))
+}
+
+// ItemKindLTE applies the LTE predicate on the "item_kind" field.
+func ItemKindLTE(v string) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldLTE(FieldItemKind, v))
+}
+
+// ItemKindContains applies the Contains predicate on the "item_kind" field.
+func ItemKindContains(v string) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldContains(FieldItemKind, v))
+}
+
+// ItemKindHasPrefix applies the HasPrefix predicate on the "item_kind" field.
+func ItemKindHasPrefix(v string) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldHasPrefix(FieldItemKind, v))
+}
+
+// ItemKindHasSuffix applies the HasSuffix predicate on the "item_kind" field.
+func ItemKindHasSuffix(v string) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldHasSuffix(FieldItemKind, v))
+}
+
+// ItemKindEqualFold applies the EqualFold predicate on the "item_kind" field.
+func ItemKindEqualFold(v string) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldEqualFold(FieldItemKind, v))
+}
+
+// ItemKindContainsFold applies the ContainsFold predicate on the "item_kind" field.
+func ItemKindContainsFold(v string) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldContainsFold(FieldItemKind, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v xid.ID) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v xid.ID) predicate.NotificationDigestItem {
+	vc := v.String()
+	return predicate.NotificationDigestItem(sql.FieldContains(FieldItemID, vc))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v xid.ID) predicate.NotificationDigestItem {
+	vc := v.String()
+	return predicate.NotificationDigestItem(sql.FieldHasPrefix(FieldItemID, vc))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v xid.ID) predicate.NotificationDigestItem {
+	vc := v.String()
+	return predicate.NotificationDigestItem(sql.FieldHasSuffix(FieldItemID, vc))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v xid.ID) predicate.NotificationDigestItem {
+	vc := v.String()
+	return predicate.NotificationDigestItem(sql.FieldEqualFold(FieldItemID, vc))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v xid.ID) predicate.NotificationDigestItem {
+	vc := v.String()
+	return predicate.NotificationDigestItem(sql.FieldContainsFold(FieldItemID, vc))
+}
+
+// HasDigest applies the HasEdge predicate on the "digest" edge.
+func HasDigest() predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DigestTable, DigestColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDigestWith applies the HasEdge predicate on the "digest" edge with a given conditions (other predicates).
+func HasDigestWith(preds ...predicate.NotificationDigest) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(func(s *sql.Selector) {
+		step := newDigestStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotificationDigestItem) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotificationDigestItem) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotificationDigestItem) predicate.NotificationDigestItem {
+	return predicate.NotificationDigestItem(sql.NotPredicates(p))
+}
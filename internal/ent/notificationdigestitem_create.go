@@ -0,0 +1,673 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigest"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigestitem"
+	"github.com/rs/xid"
+)
+
+// NotificationDigestItemCreate is the builder for creating a NotificationDigestItem entity.
+type NotificationDigestItemCreate struct {
+	config
+	mutation *NotificationDigestItemMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NotificationDigestItemCreate) SetCreatedAt(v time.Time) *NotificationDigestItemCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NotificationDigestItemCreate) SetNillableCreatedAt(v *time.Time) *NotificationDigestItemCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetDigestID sets the "digest_id" field.
+func (_c *NotificationDigestItemCreate) SetDigestID(v xid.ID) *NotificationDigestItemCreate {
+	_c.mutation.SetDigestID(v)
+	return _c
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_c *NotificationDigestItemCreate) SetItemKind(v string) *NotificationDigestItemCreate {
+	_c.mutation.SetItemKind(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *NotificationDigestItemCreate) SetItemID(v xid.ID) *NotificationDigestItemCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NotificationDigestItemCreate) SetID(v xid.ID) *NotificationDigestItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *NotificationDigestItemCreate) SetNillableID(v *xid.ID) *NotificationDigestItemCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetDigest sets the "digest" edge to the NotificationDigest entity.
+func (_c *NotificationDigestItemCreate) SetDigest(v *NotificationDigest) *NotificationDigestItemCreate {
+	return _c.SetDigestID(v.ID)
+}
+
+// Mutation returns the NotificationDigestItemMutation object of the builder.
+func (_c *NotificationDigestItemCreate) Mutation() *NotificationDigestItemMutation {
+	return _c.mutation
+}
+
+// Save creates the NotificationDigestItem in the database.
+func (_c *NotificationDigestItemCreate) Save(ctx context.Context) (*NotificationDigestItem, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NotificationDigestItemCreate) SaveX(ctx context.Context) *NotificationDigestItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationDigestItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationDigestItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NotificationDigestItemCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := notificationdigestitem.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := notificationdigestitem.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NotificationDigestItemCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationDigestItem.created_at"`)}
+	}
+	if _, ok := _c.mutation.DigestID(); !ok {
+		return &ValidationError{Name: "digest_id", err: errors.New(`ent: missing required field "NotificationDigestItem.digest_id"`)}
+	}
+	if _, ok := _c.mutation.ItemKind(); !ok {
+		return &ValidationError{Name: "item_kind", err: errors.New(`ent: missing required field "NotificationDigestItem.item_kind"`)}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "NotificationDigestItem.item_id"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := notificationdigestitem.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "NotificationDigestItem.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.DigestIDs()) == 0 {
+		return &ValidationError{Name: "digest", err: errors.New(`ent: missing required edge "NotificationDigestItem.digest"`)}
+	}
+	return nil
+}
+
+func (_c *NotificationDigestItemCreate) sqlSave(ctx context.Context) (*NotificationDigestItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NotificationDigestItemCreate) createSpec() (*NotificationDigestItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationDigestItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(notificationdigestitem.Table, sqlgraph.NewFieldSpec(notificationdigestitem.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationdigestitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ItemKind(); ok {
+		_spec.SetField(notificationdigestitem.FieldItemKind, field.TypeString, value)
+		_node.ItemKind = value
+	}
+	if value, ok := _c.mutation.ItemID(); ok {
+		_spec.SetField(notificationdigestitem.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if nodes := _c.mutation.DigestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationdigestitem.DigestTable,
+			Columns: []string{notificationdigestitem.DigestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdigest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DigestID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationDigestItem.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationDigestItemUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotificationDigestItemCreate) OnConflict(opts ...sql.ConflictOption) *NotificationDigestItemUpsertOne {
+	_c.conflict = opts
+	return &NotificationDigestItemUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationDigestItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotificationDigestItemCreate) OnConflictColumns(columns ...string) *NotificationDigestItemUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotificationDigestItemUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NotificationDigestItemUpsertOne is the builder for "upsert"-ing
+	//  one NotificationDigestItem node.
+	NotificationDigestItemUpsertOne struct {
+		create *NotificationDigestItemCreate
+	}
+
+	// NotificationDigestItemUpsert is the "OnConflict" setter.
+	NotificationDigestItemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDigestID sets the "digest_id" field.
+func (u *NotificationDigestItemUpsert) SetDigestID(v xid.ID) *NotificationDigestItemUpsert {
+	u.Set(notificationdigestitem.FieldDigestID, v)
+	return u
+}
+
+// UpdateDigestID sets the "digest_id" field to the value that was provided on create.
+func (u *NotificationDigestItemUpsert) UpdateDigestID() *NotificationDigestItemUpsert {
+	u.SetExcluded(notificationdigestitem.FieldDigestID)
+	return u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *NotificationDigestItemUpsert) SetItemKind(v string) *NotificationDigestItemUpsert {
+	u.Set(notificationdigestitem.FieldItemKind, v)
+	return u
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *NotificationDigestItemUpsert) UpdateItemKind() *NotificationDigestItemUpsert {
+	u.SetExcluded(notificationdigestitem.FieldItemKind)
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *NotificationDigestItemUpsert) SetItemID(v xid.ID) *NotificationDigestItemUpsert {
+	u.Set(notificationdigestitem.FieldItemID, v)
+	return u
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *NotificationDigestItemUpsert) UpdateItemID() *NotificationDigestItemUpsert {
+	u.SetExcluded(notificationdigestitem.FieldItemID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDigestItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationdigestitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationDigestItemUpsertOne) UpdateNewValues() *NotificationDigestItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(notificationdigestitem.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(notificationdigestitem.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDigestItem.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NotificationDigestItemUpsertOne) Ignore() *NotificationDigestItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationDigestItemUpsertOne) DoNothing() *NotificationDigestItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationDigestItemCreate.OnConflict
+// documentation for more info.
+func (u *NotificationDigestItemUpsertOne) Update(set func(*NotificationDigestItemUpsert)) *NotificationDigestItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationDigestItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDigestID sets the "digest_id" field.
+func (u *NotificationDigestItemUpsertOne) SetDigestID(v xid.ID) *NotificationDigestItemUpsertOne {
+	return u.Update(func(s *NotificationDigestItemUpsert) {
+		s.SetDigestID(v)
+	})
+}
+
+// UpdateDigestID sets the "digest_id" field to the value that was provided on create.
+func (u *NotificationDigestItemUpsertOne) UpdateDigestID() *NotificationDigestItemUpsertOne {
+	return u.Update(func(s *NotificationDigestItemUpsert) {
+		s.UpdateDigestID()
+	})
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *NotificationDigestItemUpsertOne) SetItemKind(v string) *NotificationDigestItemUpsertOne {
+	return u.Update(func(s *NotificationDigestItemUpsert) {
+		s.SetItemKind(v)
+	})
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *NotificationDigestItemUpsertOne) UpdateItemKind() *NotificationDigestItemUpsertOne {
+	return u.Update(func(s *NotificationDigestItemUpsert) {
+		s.UpdateItemKind()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *NotificationDigestItemUpsertOne) SetItemID(v xid.ID) *NotificationDigestItemUpsertOne {
+	return u.Update(func(s *NotificationDigestItemUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *NotificationDigestItemUpsertOne) UpdateItemID() *NotificationDigestItemUpsertOne {
+	return u.Update(func(s *NotificationDigestItemUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationDigestItemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationDigestItemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationDigestItemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationDigestItemUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NotificationDigestItemUpsertOne.ID is not supported by MySQL driver. Use NotificationDigestItemUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationDigestItemUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotificationDigestItemCreateBulk is the builder for creating many NotificationDigestItem entities in bulk.
+type NotificationDigestItemCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationDigestItemCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NotificationDigestItem entities in the database.
+func (_c *NotificationDigestItemCreateBulk) Save(ctx context.Context) ([]*NotificationDigestItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NotificationDigestItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationDigestItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NotificationDigestItemCreateBulk) SaveX(ctx context.Context) []*NotificationDigestItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationDigestItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationDigestItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationDigestItem.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationDigestItemUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotificationDigestItemCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationDigestItemUpsertBulk {
+	_c.conflict = opts
+	return &NotificationDigestItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationDigestItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotificationDigestItemCreateBulk) OnConflictColumns(columns ...string) *NotificationDigestItemUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotificationDigestItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// NotificationDigestItemUpsertBulk is the builder for "upsert"-ing
+// a bulk of NotificationDigestItem nodes.
+type NotificationDigestItemUpsertBulk struct {
+	create *NotificationDigestItemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NotificationDigestItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationdigestitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationDigestItemUpsertBulk) UpdateNewValues() *NotificationDigestItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(notificationdigestitem.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(notificationdigestitem.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDigestItem.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NotificationDigestItemUpsertBulk) Ignore() *NotificationDigestItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationDigestItemUpsertBulk) DoNothing() *NotificationDigestItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationDigestItemCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationDigestItemUpsertBulk) Update(set func(*NotificationDigestItemUpsert)) *NotificationDigestItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationDigestItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDigestID sets the "digest_id" field.
+func (u *NotificationDigestItemUpsertBulk) SetDigestID(v xid.ID) *NotificationDigestItemUpsertBulk {
+	return u.Update(func(s *NotificationDigestItemUpsert) {
+		s.SetDigestID(v)
+	})
+}
+
+// UpdateDigestID sets the "digest_id" field to the value that was provided on create.
+func (u *NotificationDigestItemUpsertBulk) UpdateDigestID() *NotificationDigestItemUpsertBulk {
+	return u.Update(func(s *NotificationDigestItemUpsert) {
+		s.UpdateDigestID()
+	})
+}
+
+// SetItemKind sets the "item_kind" field.
+func (u *NotificationDigestItemUpsertBulk) SetItemKind(v string) *NotificationDigestItemUpsertBulk {
+	return u.Update(func(s *NotificationDigestItemUpsert) {
+		s.SetItemKind(v)
+	})
+}
+
+// UpdateItemKind sets the "item_kind" field to the value that was provided on create.
+func (u *NotificationDigestItemUpsertBulk) UpdateItemKind() *NotificationDigestItemUpsertBulk {
+	return u.Update(func(s *NotificationDigestItemUpsert) {
+		s.UpdateItemKind()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *NotificationDigestItemUpsertBulk) SetItemID(v xid.ID) *NotificationDigestItemUpsertBulk {
+	return u.Update(func(s *NotificationDigestItemUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *NotificationDigestItemUpsertBulk) UpdateItemID() *NotificationDigestItemUpsertBulk {
+	return u.Update(func(s *NotificationDigestItemUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationDigestItemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NotificationDigestItemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationDigestItemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationDigestItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigest"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigestitem"
+	"github.com/Southclaws/storyden/internal/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// NotificationDigestItemUpdate is the builder for updating NotificationDigestItem entities.
+type NotificationDigestItemUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *NotificationDigestItemMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the NotificationDigestItemUpdate builder.
+func (_u *NotificationDigestItemUpdate) Where(ps ...predicate.NotificationDigestItem) *NotificationDigestItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDigestID sets the "digest_id" field.
+func (_u *NotificationDigestItemUpdate) SetDigestID(v xid.ID) *NotificationDigestItemUpdate {
+	_u.mutation.SetDigestID(v)
+	return _u
+}
+
+// SetNillableDigestID sets the "digest_id" field if the given value is not nil.
+func (_u *NotificationDigestItemUpdate) SetNillableDigestID(v *xid.ID) *NotificationDigestItemUpdate {
+	if v != nil {
+		_u.SetDigestID(*v)
+	}
+	return _u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_u *NotificationDigestItemUpdate) SetItemKind(v string) *NotificationDigestItemUpdate {
+	_u.mutation.SetItemKind(v)
+	return _u
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_u *NotificationDigestItemUpdate) SetNillableItemKind(v *string) *NotificationDigestItemUpdate {
+	if v != nil {
+		_u.SetItemKind(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *NotificationDigestItemUpdate) SetItemID(v xid.ID) *NotificationDigestItemUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *NotificationDigestItemUpdate) SetNillableItemID(v *xid.ID) *NotificationDigestItemUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetDigest sets the "digest" edge to the NotificationDigest entity.
+func (_u *NotificationDigestItemUpdate) SetDigest(v *NotificationDigest) *NotificationDigestItemUpdate {
+	return _u.SetDigestID(v.ID)
+}
+
+// Mutation returns the NotificationDigestItemMutation object of the builder.
+func (_u *NotificationDigestItemUpdate) Mutation() *NotificationDigestItemMutation {
+	return _u.mutation
+}
+
+// ClearDigest clears the "digest" edge to the NotificationDigest entity.
+func (_u *NotificationDigestItemUpdate) ClearDigest() *NotificationDigestItemUpdate {
+	_u.mutation.ClearDigest()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotificationDigestItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationDigestItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotificationDigestItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationDigestItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationDigestItemUpdate) check() error {
+	if _u.mutation.DigestCleared() && len(_u.mutation.DigestIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationDigestItem.digest"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NotificationDigestItemUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotificationDigestItemUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *NotificationDigestItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationdigestitem.Table, notificationdigestitem.Columns, sqlgraph.NewFieldSpec(notificationdigestitem.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ItemKind(); ok {
+		_spec.SetField(notificationdigestitem.FieldItemKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(notificationdigestitem.FieldItemID, field.TypeString, value)
+	}
+	if _u.mutation.DigestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationdigestitem.DigestTable,
+			Columns: []string{notificationdigestitem.DigestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdigest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DigestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationdigestitem.DigestTable,
+			Columns: []string{notificationdigestitem.DigestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdigest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationdigestitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotificationDigestItemUpdateOne is the builder for updating a single NotificationDigestItem entity.
+type NotificationDigestItemUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *NotificationDigestItemMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDigestID sets the "digest_id" field.
+func (_u *NotificationDigestItemUpdateOne) SetDigestID(v xid.ID) *NotificationDigestItemUpdateOne {
+	_u.mutation.SetDigestID(v)
+	return _u
+}
+
+// SetNillableDigestID sets the "digest_id" field if the given value is not nil.
+func (_u *NotificationDigestItemUpdateOne) SetNillableDigestID(v *xid.ID) *NotificationDigestItemUpdateOne {
+	if v != nil {
+		_u.SetDigestID(*v)
+	}
+	return _u
+}
+
+// SetItemKind sets the "item_kind" field.
+func (_u *NotificationDigestItemUpdateOne) SetItemKind(v string) *NotificationDigestItemUpdateOne {
+	_u.mutation.SetItemKind(v)
+	return _u
+}
+
+// SetNillableItemKind sets the "item_kind" field if the given value is not nil.
+func (_u *NotificationDigestItemUpdateOne) SetNillableItemKind(v *string) *NotificationDigestItemUpdateOne {
+	if v != nil {
+		_u.SetItemKind(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *NotificationDigestItemUpdateOne) SetItemID(v xid.ID) *NotificationDigestItemUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *NotificationDigestItemUpdateOne) SetNillableItemID(v *xid.ID) *NotificationDigestItemUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetDigest sets the "digest" edge to the NotificationDigest entity.
+func (_u *NotificationDigestItemUpdateOne) SetDigest(v *NotificationDigest) *NotificationDigestItemUpdateOne {
+	return _u.SetDigestID(v.ID)
+}
+
+// Mutation returns the NotificationDigestItemMutation object of the builder.
+func (_u *NotificationDigestItemUpdateOne) Mutation() *NotificationDigestItemMutation {
+	return _u.mutation
+}
+
+// ClearDigest clears the "digest" edge to the NotificationDigest entity.
+func (_u *NotificationDigestItemUpdateOne) ClearDigest() *NotificationDigestItemUpdateOne {
+	_u.mutation.ClearDigest()
+	return _u
+}
+
+// Where appends a list predicates to the NotificationDigestItemUpdate builder.
+func (_u *NotificationDigestItemUpdateOne) Where(ps ...predicate.NotificationDigestItem) *NotificationDigestItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotificationDigestItemUpdateOne) Select(field string, fields ...string) *NotificationDigestItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NotificationDigestItem entity.
+func (_u *NotificationDigestItemUpdateOne) Save(ctx context.Context) (*NotificationDigestItem, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationDigestItemUpdateOne) SaveX(ctx context.Context) *NotificationDigestItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotificationDigestItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationDigestItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationDigestItemUpdateOne) check() error {
+	if _u.mutation.DigestCleared() && len(_u.mutation.DigestIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationDigestItem.digest"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *NotificationDigestItemUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NotificationDigestItemUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *NotificationDigestItemUpdateOne) sqlSave(ctx context.Context) (_node *NotificationDigestItem, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationdigestitem.Table, notificationdigestitem.Columns, sqlgraph.NewFieldSpec(notificationdigestitem.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationDigestItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationdigestitem.FieldID)
+		for _, f := range fields {
+			if !notificationdigestitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationdigestitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ItemKind(); ok {
+		_spec.SetField(notificationdigestitem.FieldItemKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(notificationdigestitem.FieldItemID, field.TypeString, value)
+	}
+	if _u.mutation.DigestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationdigestitem.DigestTable,
+			Columns: []string{notificationdigestitem.DigestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdigest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DigestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationdigestitem.DigestTable,
+			Columns: []string{notificationdigestitem.DigestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdigest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &NotificationDigestItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationdigestitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/poll"
+	"github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/rs/xid"
+)
+
+// Poll is the model entity for the Poll schema.
+type Poll struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// PostID holds the value of the "post_id" field.
+	PostID xid.ID `json:"post_id,omitempty"`
+	// How many options each account may vote for, 1 is a single choice poll.
+	MaxChoices int `json:"max_choices,omitempty"`
+	// Anonymous polls only expose vote counts, not who voted.
+	Anonymous bool `json:"anonymous,omitempty"`
+	// ClosesAt holds the value of the "closes_at" field.
+	ClosesAt *time.Time `json:"closes_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PollQuery when eager-loading is set.
+	Edges        PollEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PollEdges holds the relations/edges for other nodes in the graph.
+type PollEdges struct {
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// Options holds the value of the options edge.
+	Options []*PollOption `json:"options,omitempty"`
+	// Votes holds the value of the votes edge.
+	Votes []*PollVote `json:"votes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PollEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// OptionsOrErr returns the Options value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) OptionsOrErr() ([]*PollOption, error) {
+	if e.loadedTypes[1] {
+		return e.Options, nil
+	}
+	return nil, &NotLoadedError{edge: "options"}
+}
+
+// VotesOrErr returns the Votes value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) VotesOrErr() ([]*PollVote, error) {
+	if e.loadedTypes[2] {
+		return e.Votes, nil
+	}
+	return nil, &NotLoadedError{edge: "votes"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Poll) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case poll.FieldAnonymous:
+			values[i] = new(sql.NullBool)
+		case poll.FieldMaxChoices:
+			values[i] = new(sql.NullInt64)
+		case poll.FieldCreatedAt, poll.FieldUpdatedAt, poll.FieldClosesAt:
+			values[i] = new(sql.NullTime)
+		case poll.FieldID, poll.FieldPostID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Poll fields.
+func (_m *Poll) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case poll.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case poll.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case poll.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case poll.FieldPostID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field post_id", values[i])
+			} else if value != nil {
+				_m.PostID = *value
+			}
+		case poll.FieldMaxChoices:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_choices", values[i])
+			} else if value.Valid {
+				_m.MaxChoices = int(value.Int64)
+			}
+		case poll.FieldAnonymous:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field anonymous", values[i])
+			} else if value.Valid {
+				_m.Anonymous = value.Bool
+			}
+		case poll.FieldClosesAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closes_at", values[i])
+			} else if value.Valid {
+				_m.ClosesAt = new(time.Time)
+				*_m.ClosesAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Poll.
+// This includes values selected through modifiers, order, etc.
+func (_m *Poll) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the Poll entity.
+func (_m *Poll) QueryPost() *PostQuery {
+	return NewPollClient(_m.config).QueryPost(_m)
+}
+
+// QueryOptions queries the "options" edge of the Poll entity.
+func (_m *Poll) QueryOptions() *PollOptionQuery {
+	return NewPollClient(_m.config).QueryOptions(_m)
+}
+
+// QueryVotes queries the "votes" edge of the Poll entity.
+func (_m *Poll) QueryVotes() *PollVoteQuery {
+	return NewPollClient(_m.config).QueryVotes(_m)
+}
+
+// Update returns a builder for updating this Poll.
+// Note that you need to call Poll.Unwrap() before calling this method if this Poll
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Poll) Update() *PollUpdateOne {
+	return NewPollClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Poll entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Poll) Unwrap() *Poll {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Poll is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Poll) String() string {
+	var builder strings.Builder
+	builder.WriteString("Poll(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("post_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PostID))
+	builder.WriteString(", ")
+	builder.WriteString("max_choices=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxChoices))
+	builder.WriteString(", ")
+	builder.WriteString("anonymous=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Anonymous))
+	builder.WriteString(", ")
+	if v := _m.ClosesAt; v != nil {
+		builder.WriteString("closes_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Polls is a parsable slice of Poll.
+type Polls []*Poll
@@ -0,0 +1,924 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/poll"
+	"github.com/Southclaws/storyden/internal/ent/polloption"
+	"github.com/Southclaws/storyden/internal/ent/pollvote"
+	"github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/rs/xid"
+)
+
+// PollCreate is the builder for creating a Poll entity.
+type PollCreate struct {
+	config
+	mutation *PollMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PollCreate) SetCreatedAt(v time.Time) *PollCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableCreatedAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PollCreate) SetUpdatedAt(v time.Time) *PollCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableUpdatedAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPostID sets the "post_id" field.
+func (_c *PollCreate) SetPostID(v xid.ID) *PollCreate {
+	_c.mutation.SetPostID(v)
+	return _c
+}
+
+// SetMaxChoices sets the "max_choices" field.
+func (_c *PollCreate) SetMaxChoices(v int) *PollCreate {
+	_c.mutation.SetMaxChoices(v)
+	return _c
+}
+
+// SetNillableMaxChoices sets the "max_choices" field if the given value is not nil.
+func (_c *PollCreate) SetNillableMaxChoices(v *int) *PollCreate {
+	if v != nil {
+		_c.SetMaxChoices(*v)
+	}
+	return _c
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (_c *PollCreate) SetAnonymous(v bool) *PollCreate {
+	_c.mutation.SetAnonymous(v)
+	return _c
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (_c *PollCreate) SetNillableAnonymous(v *bool) *PollCreate {
+	if v != nil {
+		_c.SetAnonymous(*v)
+	}
+	return _c
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_c *PollCreate) SetClosesAt(v time.Time) *PollCreate {
+	_c.mutation.SetClosesAt(v)
+	return _c
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableClosesAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetClosesAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PollCreate) SetID(v xid.ID) *PollCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PollCreate) SetNillableID(v *xid.ID) *PollCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_c *PollCreate) SetPost(v *Post) *PollCreate {
+	return _c.SetPostID(v.ID)
+}
+
+// AddOptionIDs adds the "options" edge to the PollOption entity by IDs.
+func (_c *PollCreate) AddOptionIDs(ids ...xid.ID) *PollCreate {
+	_c.mutation.AddOptionIDs(ids...)
+	return _c
+}
+
+// AddOptions adds the "options" edges to the PollOption entity.
+func (_c *PollCreate) AddOptions(v ...*PollOption) *PollCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOptionIDs(ids...)
+}
+
+// AddVoteIDs adds the "votes" edge to the PollVote entity by IDs.
+func (_c *PollCreate) AddVoteIDs(ids ...xid.ID) *PollCreate {
+	_c.mutation.AddVoteIDs(ids...)
+	return _c
+}
+
+// AddVotes adds the "votes" edges to the PollVote entity.
+func (_c *PollCreate) AddVotes(v ...*PollVote) *PollCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVoteIDs(ids...)
+}
+
+// Mutation returns the PollMutation object of the builder.
+func (_c *PollCreate) Mutation() *PollMutation {
+	return _c.mutation
+}
+
+// Save creates the Poll in the database.
+func (_c *PollCreate) Save(ctx context.Context) (*Poll, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PollCreate) SaveX(ctx context.Context) *Poll {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PollCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PollCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PollCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := poll.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := poll.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.MaxChoices(); !ok {
+		v := poll.DefaultMaxChoices
+		_c.mutation.SetMaxChoices(v)
+	}
+	if _, ok := _c.mutation.Anonymous(); !ok {
+		v := poll.DefaultAnonymous
+		_c.mutation.SetAnonymous(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := poll.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PollCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Poll.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Poll.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "Poll.post_id"`)}
+	}
+	if _, ok := _c.mutation.MaxChoices(); !ok {
+		return &ValidationError{Name: "max_choices", err: errors.New(`ent: missing required field "Poll.max_choices"`)}
+	}
+	if _, ok := _c.mutation.Anonymous(); !ok {
+		return &ValidationError{Name: "anonymous", err: errors.New(`ent: missing required field "Poll.anonymous"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := poll.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Poll.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "Poll.post"`)}
+	}
+	return nil
+}
+
+func (_c *PollCreate) sqlSave(ctx context.Context) (*Poll, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PollCreate) createSpec() (*Poll, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Poll{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(poll.Table, sqlgraph.NewFieldSpec(poll.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(poll.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(poll.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.MaxChoices(); ok {
+		_spec.SetField(poll.FieldMaxChoices, field.TypeInt, value)
+		_node.MaxChoices = value
+	}
+	if value, ok := _c.mutation.Anonymous(); ok {
+		_spec.SetField(poll.FieldAnonymous, field.TypeBool, value)
+		_node.Anonymous = value
+	}
+	if value, ok := _c.mutation.ClosesAt(); ok {
+		_spec.SetField(poll.FieldClosesAt, field.TypeTime, value)
+		_node.ClosesAt = &value
+	}
+	if nodes := _c.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   poll.PostTable,
+			Columns: []string{poll.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PostID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.OptionsTable,
+			Columns: []string{poll.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Poll.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PollUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PollCreate) OnConflict(opts ...sql.ConflictOption) *PollUpsertOne {
+	_c.conflict = opts
+	return &PollUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Poll.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PollCreate) OnConflictColumns(columns ...string) *PollUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PollUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PollUpsertOne is the builder for "upsert"-ing
+	//  one Poll node.
+	PollUpsertOne struct {
+		create *PollCreate
+	}
+
+	// PollUpsert is the "OnConflict" setter.
+	PollUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PollUpsert) SetUpdatedAt(v time.Time) *PollUpsert {
+	u.Set(poll.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PollUpsert) UpdateUpdatedAt() *PollUpsert {
+	u.SetExcluded(poll.FieldUpdatedAt)
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *PollUpsert) SetPostID(v xid.ID) *PollUpsert {
+	u.Set(poll.FieldPostID, v)
+	return u
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *PollUpsert) UpdatePostID() *PollUpsert {
+	u.SetExcluded(poll.FieldPostID)
+	return u
+}
+
+// SetMaxChoices sets the "max_choices" field.
+func (u *PollUpsert) SetMaxChoices(v int) *PollUpsert {
+	u.Set(poll.FieldMaxChoices, v)
+	return u
+}
+
+// UpdateMaxChoices sets the "max_choices" field to the value that was provided on create.
+func (u *PollUpsert) UpdateMaxChoices() *PollUpsert {
+	u.SetExcluded(poll.FieldMaxChoices)
+	return u
+}
+
+// AddMaxChoices adds v to the "max_choices" field.
+func (u *PollUpsert) AddMaxChoices(v int) *PollUpsert {
+	u.Add(poll.FieldMaxChoices, v)
+	return u
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (u *PollUpsert) SetAnonymous(v bool) *PollUpsert {
+	u.Set(poll.FieldAnonymous, v)
+	return u
+}
+
+// UpdateAnonymous sets the "anonymous" field to the value that was provided on create.
+func (u *PollUpsert) UpdateAnonymous() *PollUpsert {
+	u.SetExcluded(poll.FieldAnonymous)
+	return u
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (u *PollUpsert) SetClosesAt(v time.Time) *PollUpsert {
+	u.Set(poll.FieldClosesAt, v)
+	return u
+}
+
+// UpdateClosesAt sets the "closes_at" field to the value that was provided on create.
+func (u *PollUpsert) UpdateClosesAt() *PollUpsert {
+	u.SetExcluded(poll.FieldClosesAt)
+	return u
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (u *PollUpsert) ClearClosesAt() *PollUpsert {
+	u.SetNull(poll.FieldClosesAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Poll.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(poll.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PollUpsertOne) UpdateNewValues() *PollUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(poll.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(poll.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Poll.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PollUpsertOne) Ignore() *PollUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PollUpsertOne) DoNothing() *PollUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PollCreate.OnConflict
+// documentation for more info.
+func (u *PollUpsertOne) Update(set func(*PollUpsert)) *PollUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PollUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PollUpsertOne) SetUpdatedAt(v time.Time) *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PollUpsertOne) UpdateUpdatedAt() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *PollUpsertOne) SetPostID(v xid.ID) *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *PollUpsertOne) UpdatePostID() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetMaxChoices sets the "max_choices" field.
+func (u *PollUpsertOne) SetMaxChoices(v int) *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.SetMaxChoices(v)
+	})
+}
+
+// AddMaxChoices adds v to the "max_choices" field.
+func (u *PollUpsertOne) AddMaxChoices(v int) *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.AddMaxChoices(v)
+	})
+}
+
+// UpdateMaxChoices sets the "max_choices" field to the value that was provided on create.
+func (u *PollUpsertOne) UpdateMaxChoices() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateMaxChoices()
+	})
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (u *PollUpsertOne) SetAnonymous(v bool) *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.SetAnonymous(v)
+	})
+}
+
+// UpdateAnonymous sets the "anonymous" field to the value that was provided on create.
+func (u *PollUpsertOne) UpdateAnonymous() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateAnonymous()
+	})
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (u *PollUpsertOne) SetClosesAt(v time.Time) *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.SetClosesAt(v)
+	})
+}
+
+// UpdateClosesAt sets the "closes_at" field to the value that was provided on create.
+func (u *PollUpsertOne) UpdateClosesAt() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateClosesAt()
+	})
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (u *PollUpsertOne) ClearClosesAt() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.ClearClosesAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PollUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PollCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PollUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PollUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PollUpsertOne.ID is not supported by MySQL driver. Use PollUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PollUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PollCreateBulk is the builder for creating many Poll entities in bulk.
+type PollCreateBulk struct {
+	config
+	err      error
+	builders []*PollCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Poll entities in the database.
+func (_c *PollCreateBulk) Save(ctx context.Context) ([]*Poll, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Poll, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PollMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PollCreateBulk) SaveX(ctx context.Context) []*Poll {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PollCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PollCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Poll.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PollUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PollCreateBulk) OnConflict(opts ...sql.ConflictOption) *PollUpsertBulk {
+	_c.conflict = opts
+	return &PollUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Poll.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PollCreateBulk) OnConflictColumns(columns ...string) *PollUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PollUpsertBulk{
+		create: _c,
+	}
+}
+
+// PollUpsertBulk is the builder for "upsert"-ing
+// a bulk of Poll nodes.
+type PollUpsertBulk struct {
+	create *PollCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Poll.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(poll.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PollUpsertBulk) UpdateNewValues() *PollUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(poll.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(poll.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Poll.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PollUpsertBulk) Ignore() *PollUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PollUpsertBulk) DoNothing() *PollUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PollCreateBulk.OnConflict
+// documentation for more info.
+func (u *PollUpsertBulk) Update(set func(*PollUpsert)) *PollUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PollUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PollUpsertBulk) SetUpdatedAt(v time.Time) *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PollUpsertBulk) UpdateUpdatedAt() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *PollUpsertBulk) SetPostID(v xid.ID) *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *PollUpsertBulk) UpdatePostID() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetMaxChoices sets the "max_choices" field.
+func (u *PollUpsertBulk) SetMaxChoices(v int) *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.SetMaxChoices(v)
+	})
+}
+
+// AddMaxChoices adds v to the "max_choices" field.
+func (u *PollUpsertBulk) AddMaxChoices(v int) *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.AddMaxChoices(v)
+	})
+}
+
+// UpdateMaxChoices sets the "max_choices" field to the value that was provided on create.
+func (u *PollUpsertBulk) UpdateMaxChoices() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateMaxChoices()
+	})
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (u *PollUpsertBulk) SetAnonymous(v bool) *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.SetAnonymous(v)
+	})
+}
+
+// UpdateAnonymous sets the "anonymous" field to the value that was provided on create.
+func (u *PollUpsertBulk) UpdateAnonymous() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateAnonymous()
+	})
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (u *PollUpsertBulk) SetClosesAt(v time.Time) *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.SetClosesAt(v)
+	})
+}
+
+// UpdateClosesAt sets the "closes_at" field to the value that was provided on create.
+func (u *PollUpsertBulk) UpdateClosesAt() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateClosesAt()
+	})
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (u *PollUpsertBulk) ClearClosesAt() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.ClearClosesAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PollUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PollCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PollCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PollUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
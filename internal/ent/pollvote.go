@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/poll"
+	"github.com/Southclaws/storyden/internal/ent/polloption"
+	"github.com/Southclaws/storyden/internal/ent/pollvote"
+	"github.com/rs/xid"
+)
+
+// PollVote is the model entity for the PollVote schema.
+type PollVote struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// PollID holds the value of the "poll_id" field.
+	PollID xid.ID `json:"poll_id,omitempty"`
+	// OptionID holds the value of the "option_id" field.
+	OptionID xid.ID `json:"option_id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID xid.ID `json:"account_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PollVoteQuery when eager-loading is set.
+	Edges        PollVoteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PollVoteEdges holds the relations/edges for other nodes in the graph.
+type PollVoteEdges struct {
+	// Poll holds the value of the poll edge.
+	Poll *Poll `json:"poll,omitempty"`
+	// Option holds the value of the option edge.
+	Option *PollOption `json:"option,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// PollOrErr returns the Poll value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PollVoteEdges) PollOrErr() (*Poll, error) {
+	if e.Poll != nil {
+		return e.Poll, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: poll.Label}
+	}
+	return nil, &NotLoadedError{edge: "poll"}
+}
+
+// OptionOrErr returns the Option value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PollVoteEdges) OptionOrErr() (*PollOption, error) {
+	if e.Option != nil {
+		return e.Option, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: polloption.Label}
+	}
+	return nil, &NotLoadedError{edge: "option"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PollVoteEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PollVote) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pollvote.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case pollvote.FieldID, pollvote.FieldPollID, pollvote.FieldOptionID, pollvote.FieldAccountID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PollVote fields.
+func (_m *PollVote) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pollvote.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case pollvote.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case pollvote.FieldPollID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_id", values[i])
+			} else if value != nil {
+				_m.PollID = *value
+			}
+		case pollvote.FieldOptionID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field option_id", values[i])
+			} else if value != nil {
+				_m.OptionID = *value
+			}
+		case pollvote.FieldAccountID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PollVote.
+// This includes values selected through modifiers, order, etc.
+func (_m *PollVote) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPoll queries the "poll" edge of the PollVote entity.
+func (_m *PollVote) QueryPoll() *PollQuery {
+	return NewPollVoteClient(_m.config).QueryPoll(_m)
+}
+
+// QueryOption queries the "option" edge of the PollVote entity.
+func (_m *PollVote) QueryOption() *PollOptionQuery {
+	return NewPollVoteClient(_m.config).QueryOption(_m)
+}
+
+// QueryAccount queries the "account" edge of the PollVote entity.
+func (_m *PollVote) QueryAccount() *AccountQuery {
+	return NewPollVoteClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this PollVote.
+// Note that you need to call PollVote.Unwrap() before calling this method if this PollVote
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PollVote) Update() *PollVoteUpdateOne {
+	return NewPollVoteClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PollVote entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PollVote) Unwrap() *PollVote {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PollVote is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PollVote) String() string {
+	var builder strings.Builder
+	builder.WriteString("PollVote(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("poll_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PollID))
+	builder.WriteString(", ")
+	builder.WriteString("option_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OptionID))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PollVotes is a parsable slice of PollVote.
+type PollVotes []*PollVote
@@ -13,6 +13,7 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/account"
 	"github.com/Southclaws/storyden/internal/ent/category"
 	"github.com/Southclaws/storyden/internal/ent/link"
+	"github.com/Southclaws/storyden/internal/ent/poll"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/rs/xid"
 )
@@ -38,6 +39,14 @@ type Post struct {
 	Pinned bool `json:"pinned,omitempty"`
 	// LastReplyAt holds the value of the "last_reply_at" field.
 	LastReplyAt time.Time `json:"last_reply_at,omitempty"`
+	// When set, the thread no longer accepts replies or reactions from members.
+	LockedAt *time.Time `json:"locked_at,omitempty"`
+	// The account which locked the thread, empty when locked automatically.
+	LockedByID *xid.ID `json:"locked_by_id,omitempty"`
+	// LockReason holds the value of the "lock_reason" field.
+	LockReason *string `json:"lock_reason,omitempty"`
+	// The reply marked as the answer, only used in Q&A categories.
+	AcceptedAnswerID *xid.ID `json:"accepted_answer_id,omitempty"`
 	// RootPostID holds the value of the "root_post_id" field.
 	RootPostID *xid.ID `json:"root_post_id,omitempty"`
 	// ReplyToPostID holds the value of the "reply_to_post_id" field.
@@ -96,9 +105,13 @@ type PostEdges struct {
 	Event []*Event `json:"event,omitempty"`
 	// PostReads holds the value of the post_reads edge.
 	PostReads []*PostRead `json:"post_reads,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*PostRevision `json:"revisions,omitempty"`
+	// Polls are only attached to root posts.
+	Poll *Poll `json:"poll,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [16]bool
+	loadedTypes [18]bool
 }
 
 // AuthorOrErr returns the Author value or an error if the edge
@@ -255,20 +268,40 @@ func (e PostEdges) PostReadsOrErr() ([]*PostRead, error) {
 	return nil, &NotLoadedError{edge: "post_reads"}
 }
 
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) RevisionsOrErr() ([]*PostRevision, error) {
+	if e.loadedTypes[16] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// PollOrErr returns the Poll value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostEdges) PollOrErr() (*Poll, error) {
+	if e.Poll != nil {
+		return e.Poll, nil
+	} else if e.loadedTypes[17] {
+		return nil, &NotFoundError{label: poll.Label}
+	}
+	return nil, &NotLoadedError{edge: "poll"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Post) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case post.FieldRootPostID, post.FieldReplyToPostID:
+		case post.FieldLockedByID, post.FieldAcceptedAnswerID, post.FieldRootPostID, post.FieldReplyToPostID:
 			values[i] = &sql.NullScanner{S: new(xid.ID)}
 		case post.FieldMetadata:
 			values[i] = new([]byte)
 		case post.FieldPinned:
 			values[i] = new(sql.NullBool)
-		case post.FieldTitle, post.FieldSlug, post.FieldBody, post.FieldShort, post.FieldVisibility:
+		case post.FieldTitle, post.FieldSlug, post.FieldLockReason, post.FieldBody, post.FieldShort, post.FieldVisibility:
 			values[i] = new(sql.NullString)
-		case post.FieldCreatedAt, post.FieldUpdatedAt, post.FieldDeletedAt, post.FieldIndexedAt, post.FieldLastReplyAt:
+		case post.FieldCreatedAt, post.FieldUpdatedAt, post.FieldDeletedAt, post.FieldIndexedAt, post.FieldLastReplyAt, post.FieldLockedAt:
 			values[i] = new(sql.NullTime)
 		case post.FieldID, post.FieldAccountPosts, post.FieldCategoryID, post.FieldLinkID:
 			values[i] = new(xid.ID)
@@ -343,6 +376,34 @@ func (_m *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.LastReplyAt = value.Time
 			}
+		case post.FieldLockedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_at", values[i])
+			} else if value.Valid {
+				_m.LockedAt = new(time.Time)
+				*_m.LockedAt = value.Time
+			}
+		case post.FieldLockedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_by_id", values[i])
+			} else if value.Valid {
+				_m.LockedByID = new(xid.ID)
+				*_m.LockedByID = *value.S.(*xid.ID)
+			}
+		case post.FieldLockReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_reason", values[i])
+			} else if value.Valid {
+				_m.LockReason = new(string)
+				*_m.LockReason = value.String
+			}
+		case post.FieldAcceptedAnswerID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_answer_id", values[i])
+			} else if value.Valid {
+				_m.AcceptedAnswerID = new(xid.ID)
+				*_m.AcceptedAnswerID = *value.S.(*xid.ID)
+			}
 		case post.FieldRootPostID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field root_post_id", values[i])
@@ -494,6 +555,16 @@ func (_m *Post) QueryPostReads() *PostReadQuery {
 	return NewPostClient(_m.config).QueryPostReads(_m)
 }
 
+// QueryRevisions queries the "revisions" edge of the Post entity.
+func (_m *Post) QueryRevisions() *PostRevisionQuery {
+	return NewPostClient(_m.config).QueryRevisions(_m)
+}
+
+// QueryPoll queries the "poll" edge of the Post entity.
+func (_m *Post) QueryPoll() *PollQuery {
+	return NewPostClient(_m.config).QueryPoll(_m)
+}
+
 // Update returns a builder for updating this Post.
 // Note that you need to call Post.Unwrap() before calling this method if this Post
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -545,6 +616,26 @@ func (_m *Post) String() string {
 	builder.WriteString("last_reply_at=")
 	builder.WriteString(_m.LastReplyAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := _m.LockedAt; v != nil {
+		builder.WriteString("locked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LockedByID; v != nil {
+		builder.WriteString("locked_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LockReason; v != nil {
+		builder.WriteString("lock_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AcceptedAnswerID; v != nil {
+		builder.WriteString("accepted_answer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.RootPostID; v != nil {
 		builder.WriteString("root_post_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
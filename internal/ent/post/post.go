@@ -32,6 +32,14 @@ const (
 	FieldPinned = "pinned"
 	// FieldLastReplyAt holds the string denoting the last_reply_at field in the database.
 	FieldLastReplyAt = "last_reply_at"
+	// FieldLockedAt holds the string denoting the locked_at field in the database.
+	FieldLockedAt = "locked_at"
+	// FieldLockedByID holds the string denoting the locked_by_id field in the database.
+	FieldLockedByID = "locked_by_id"
+	// FieldLockReason holds the string denoting the lock_reason field in the database.
+	FieldLockReason = "lock_reason"
+	// FieldAcceptedAnswerID holds the string denoting the accepted_answer_id field in the database.
+	FieldAcceptedAnswerID = "accepted_answer_id"
 	// FieldRootPostID holds the string denoting the root_post_id field in the database.
 	FieldRootPostID = "root_post_id"
 	// FieldReplyToPostID holds the string denoting the reply_to_post_id field in the database.
@@ -82,6 +90,10 @@ const (
 	EdgeEvent = "event"
 	// EdgePostReads holds the string denoting the post_reads edge name in mutations.
 	EdgePostReads = "post_reads"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgePoll holds the string denoting the poll edge name in mutations.
+	EdgePoll = "poll"
 	// Table holds the table name of the post in the database.
 	Table = "posts"
 	// AuthorTable is the table that holds the author relation/edge.
@@ -176,6 +188,20 @@ const (
 	PostReadsInverseTable = "post_reads"
 	// PostReadsColumn is the table column denoting the post_reads relation/edge.
 	PostReadsColumn = "root_post_id"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "post_revisions"
+	// RevisionsInverseTable is the table name for the PostRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "postrevision" package.
+	RevisionsInverseTable = "post_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "post_id"
+	// PollTable is the table that holds the poll relation/edge.
+	PollTable = "polls"
+	// PollInverseTable is the table name for the Poll entity.
+	// It exists in this package in order to avoid circular dependency with the "poll" package.
+	PollInverseTable = "polls"
+	// PollColumn is the table column denoting the poll relation/edge.
+	PollColumn = "post_id"
 )
 
 // Columns holds all SQL columns for post fields.
@@ -189,6 +215,10 @@ var Columns = []string{
 	FieldSlug,
 	FieldPinned,
 	FieldLastReplyAt,
+	FieldLockedAt,
+	FieldLockedByID,
+	FieldLockReason,
+	FieldAcceptedAnswerID,
 	FieldRootPostID,
 	FieldReplyToPostID,
 	FieldBody,
@@ -312,6 +342,26 @@ func ByLastReplyAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastReplyAt, opts...).ToFunc()
 }
 
+// ByLockedAt orders the results by the locked_at field.
+func ByLockedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedAt, opts...).ToFunc()
+}
+
+// ByLockedByID orders the results by the locked_by_id field.
+func ByLockedByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedByID, opts...).ToFunc()
+}
+
+// ByLockReason orders the results by the lock_reason field.
+func ByLockReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockReason, opts...).ToFunc()
+}
+
+// ByAcceptedAnswerID orders the results by the accepted_answer_id field.
+func ByAcceptedAnswerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcceptedAnswerID, opts...).ToFunc()
+}
+
 // ByRootPostID orders the results by the root_post_id field.
 func ByRootPostID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRootPostID, opts...).ToFunc()
@@ -540,6 +590,27 @@ func ByPostReads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPostReadsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPollField orders the results by poll field.
+func ByPollField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPollStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newAuthorStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -652,3 +723,17 @@ func newPostReadsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PostReadsTable, PostReadsColumn),
 	)
 }
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newPollStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PollInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, PollTable, PollColumn),
+	)
+}
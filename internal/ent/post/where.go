@@ -96,6 +96,26 @@ func LastReplyAt(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldLastReplyAt, v))
 }
 
+// LockedAt applies equality check predicate on the "locked_at" field. It's identical to LockedAtEQ.
+func LockedAt(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldLockedAt, v))
+}
+
+// LockedByID applies equality check predicate on the "locked_by_id" field. It's identical to LockedByIDEQ.
+func LockedByID(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldLockedByID, v))
+}
+
+// LockReason applies equality check predicate on the "lock_reason" field. It's identical to LockReasonEQ.
+func LockReason(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldLockReason, v))
+}
+
+// AcceptedAnswerID applies equality check predicate on the "accepted_answer_id" field. It's identical to AcceptedAnswerIDEQ.
+func AcceptedAnswerID(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldAcceptedAnswerID, v))
+}
+
 // RootPostID applies equality check predicate on the "root_post_id" field. It's identical to RootPostIDEQ.
 func RootPostID(v xid.ID) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldRootPostID, v))
@@ -511,6 +531,291 @@ func LastReplyAtLTE(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldLTE(FieldLastReplyAt, v))
 }
 
+// LockedAtEQ applies the EQ predicate on the "locked_at" field.
+func LockedAtEQ(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldLockedAt, v))
+}
+
+// LockedAtNEQ applies the NEQ predicate on the "locked_at" field.
+func LockedAtNEQ(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldLockedAt, v))
+}
+
+// LockedAtIn applies the In predicate on the "locked_at" field.
+func LockedAtIn(vs ...time.Time) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldLockedAt, vs...))
+}
+
+// LockedAtNotIn applies the NotIn predicate on the "locked_at" field.
+func LockedAtNotIn(vs ...time.Time) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldLockedAt, vs...))
+}
+
+// LockedAtGT applies the GT predicate on the "locked_at" field.
+func LockedAtGT(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldLockedAt, v))
+}
+
+// LockedAtGTE applies the GTE predicate on the "locked_at" field.
+func LockedAtGTE(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldLockedAt, v))
+}
+
+// LockedAtLT applies the LT predicate on the "locked_at" field.
+func LockedAtLT(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldLockedAt, v))
+}
+
+// LockedAtLTE applies the LTE predicate on the "locked_at" field.
+func LockedAtLTE(v time.Time) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldLockedAt, v))
+}
+
+// LockedAtIsNil applies the IsNil predicate on the "locked_at" field.
+func LockedAtIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldLockedAt))
+}
+
+// LockedAtNotNil applies the NotNil predicate on the "locked_at" field.
+func LockedAtNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldLockedAt))
+}
+
+// LockedByIDEQ applies the EQ predicate on the "locked_by_id" field.
+func LockedByIDEQ(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldLockedByID, v))
+}
+
+// LockedByIDNEQ applies the NEQ predicate on the "locked_by_id" field.
+func LockedByIDNEQ(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldLockedByID, v))
+}
+
+// LockedByIDIn applies the In predicate on the "locked_by_id" field.
+func LockedByIDIn(vs ...xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldLockedByID, vs...))
+}
+
+// LockedByIDNotIn applies the NotIn predicate on the "locked_by_id" field.
+func LockedByIDNotIn(vs ...xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldLockedByID, vs...))
+}
+
+// LockedByIDGT applies the GT predicate on the "locked_by_id" field.
+func LockedByIDGT(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldLockedByID, v))
+}
+
+// LockedByIDGTE applies the GTE predicate on the "locked_by_id" field.
+func LockedByIDGTE(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldLockedByID, v))
+}
+
+// LockedByIDLT applies the LT predicate on the "locked_by_id" field.
+func LockedByIDLT(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldLockedByID, v))
+}
+
+// LockedByIDLTE applies the LTE predicate on the "locked_by_id" field.
+func LockedByIDLTE(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldLockedByID, v))
+}
+
+// LockedByIDContains applies the Contains predicate on the "locked_by_id" field.
+func LockedByIDContains(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldContains(FieldLockedByID, vc))
+}
+
+// LockedByIDHasPrefix applies the HasPrefix predicate on the "locked_by_id" field.
+func LockedByIDHasPrefix(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldHasPrefix(FieldLockedByID, vc))
+}
+
+// LockedByIDHasSuffix applies the HasSuffix predicate on the "locked_by_id" field.
+func LockedByIDHasSuffix(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldHasSuffix(FieldLockedByID, vc))
+}
+
+// LockedByIDIsNil applies the IsNil predicate on the "locked_by_id" field.
+func LockedByIDIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldLockedByID))
+}
+
+// LockedByIDNotNil applies the NotNil predicate on the "locked_by_id" field.
+func LockedByIDNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldLockedByID))
+}
+
+// LockedByIDEqualFold applies the EqualFold predicate on the "locked_by_id" field.
+func LockedByIDEqualFold(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldEqualFold(FieldLockedByID, vc))
+}
+
+// LockedByIDContainsFold applies the ContainsFold predicate on the "locked_by_id" field.
+func LockedByIDContainsFold(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldContainsFold(FieldLockedByID, vc))
+}
+
+// LockReasonEQ applies the EQ predicate on the "lock_reason" field.
+func LockReasonEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldLockReason, v))
+}
+
+// LockReasonNEQ applies the NEQ predicate on the "lock_reason" field.
+func LockReasonNEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldLockReason, v))
+}
+
+// LockReasonIn applies the In predicate on the "lock_reason" field.
+func LockReasonIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldLockReason, vs...))
+}
+
+// LockReasonNotIn applies the NotIn predicate on the "lock_reason" field.
+func LockReasonNotIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldLockReason, vs...))
+}
+
+// LockReasonGT applies the GT predicate on the "lock_reason" field.
+func LockReasonGT(v string) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldLockReason, v))
+}
+
+// LockReasonGTE applies the GTE predicate on the "lock_reason" field.
+func LockReasonGTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldLockReason, v))
+}
+
+// LockReasonLT applies the LT predicate on the "lock_reason" field.
+func LockReasonLT(v string) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldLockReason, v))
+}
+
+// LockReasonLTE applies the LTE predicate on the "lock_reason" field.
+func LockReasonLTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldLockReason, v))
+}
+
+// LockReasonContains applies the Contains predicate on the "lock_reason" field.
+func LockReasonContains(v string) predicate.Post {
+	return predicate.Post(sql.FieldContains(FieldLockReason, v))
+}
+
+// LockReasonHasPrefix applies the HasPrefix predicate on the "lock_reason" field.
+func LockReasonHasPrefix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasPrefix(FieldLockReason, v))
+}
+
+// LockReasonHasSuffix applies the HasSuffix predicate on the "lock_reason" field.
+func LockReasonHasSuffix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasSuffix(FieldLockReason, v))
+}
+
+// LockReasonIsNil applies the IsNil predicate on the "lock_reason" field.
+func LockReasonIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldLockReason))
+}
+
+// LockReasonNotNil applies the NotNil predicate on the "lock_reason" field.
+func LockReasonNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldLockReason))
+}
+
+// LockReasonEqualFold applies the EqualFold predicate on the "lock_reason" field.
+func LockReasonEqualFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldEqualFold(FieldLockReason, v))
+}
+
+// LockReasonContainsFold applies the ContainsFold predicate on the "lock_reason" field.
+func LockReasonContainsFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldContainsFold(FieldLockReason, v))
+}
+
+// AcceptedAnswerIDEQ applies the EQ predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDEQ(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldAcceptedAnswerID, v))
+}
+
+// AcceptedAnswerIDNEQ applies the NEQ predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDNEQ(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldAcceptedAnswerID, v))
+}
+
+// AcceptedAnswerIDIn applies the In predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDIn(vs ...xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldAcceptedAnswerID, vs...))
+}
+
+// AcceptedAnswerIDNotIn applies the NotIn predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDNotIn(vs ...xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldAcceptedAnswerID, vs...))
+}
+
+// AcceptedAnswerIDGT applies the GT predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDGT(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldAcceptedAnswerID, v))
+}
+
+// AcceptedAnswerIDGTE applies the GTE predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDGTE(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldAcceptedAnswerID, v))
+}
+
+// AcceptedAnswerIDLT applies the LT predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDLT(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldAcceptedAnswerID, v))
+}
+
+// AcceptedAnswerIDLTE applies the LTE predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDLTE(v xid.ID) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldAcceptedAnswerID, v))
+}
+
+// AcceptedAnswerIDContains applies the Contains predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDContains(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldContains(FieldAcceptedAnswerID, vc))
+}
+
+// AcceptedAnswerIDHasPrefix applies the HasPrefix predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDHasPrefix(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldHasPrefix(FieldAcceptedAnswerID, vc))
+}
+
+// AcceptedAnswerIDHasSuffix applies the HasSuffix predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDHasSuffix(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldHasSuffix(FieldAcceptedAnswerID, vc))
+}
+
+// AcceptedAnswerIDIsNil applies the IsNil predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldAcceptedAnswerID))
+}
+
+// AcceptedAnswerIDNotNil applies the NotNil predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldAcceptedAnswerID))
+}
+
+// AcceptedAnswerIDEqualFold applies the EqualFold predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDEqualFold(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldEqualFold(FieldAcceptedAnswerID, vc))
+}
+
+// AcceptedAnswerIDContainsFold applies the ContainsFold predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDContainsFold(v xid.ID) predicate.Post {
+	vc := v.String()
+	return predicate.Post(sql.FieldContainsFold(FieldAcceptedAnswerID, vc))
+}
+
 // RootPostIDEQ applies the EQ predicate on the "root_post_id" field.
 func RootPostIDEQ(v xid.ID) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldRootPostID, v))
@@ -1429,6 +1734,52 @@ func HasPostReadsWith(preds ...predicate.PostRead) predicate.Post {
 	})
 }
 
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.PostRevision) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPoll applies the HasEdge predicate on the "poll" edge.
+func HasPoll() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, PollTable, PollColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPollWith applies the HasEdge predicate on the "poll" edge with a given conditions (other predicates).
+func HasPollWith(preds ...predicate.Poll) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newPollStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Post) predicate.Post {
 	return predicate.Post(sql.AndPredicates(predicates...))
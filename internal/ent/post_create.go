@@ -20,8 +20,10 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/likepost"
 	"github.com/Southclaws/storyden/internal/ent/link"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
+	"github.com/Southclaws/storyden/internal/ent/poll"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
+	"github.com/Southclaws/storyden/internal/ent/postrevision"
 	"github.com/Southclaws/storyden/internal/ent/react"
 	"github.com/Southclaws/storyden/internal/ent/tag"
 	"github.com/rs/xid"
@@ -131,6 +133,62 @@ func (_c *PostCreate) SetLastReplyAt(v time.Time) *PostCreate {
 	return _c
 }
 
+// SetLockedAt sets the "locked_at" field.
+func (_c *PostCreate) SetLockedAt(v time.Time) *PostCreate {
+	_c.mutation.SetLockedAt(v)
+	return _c
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (_c *PostCreate) SetNillableLockedAt(v *time.Time) *PostCreate {
+	if v != nil {
+		_c.SetLockedAt(*v)
+	}
+	return _c
+}
+
+// SetLockedByID sets the "locked_by_id" field.
+func (_c *PostCreate) SetLockedByID(v xid.ID) *PostCreate {
+	_c.mutation.SetLockedByID(v)
+	return _c
+}
+
+// SetNillableLockedByID sets the "locked_by_id" field if the given value is not nil.
+func (_c *PostCreate) SetNillableLockedByID(v *xid.ID) *PostCreate {
+	if v != nil {
+		_c.SetLockedByID(*v)
+	}
+	return _c
+}
+
+// SetLockReason sets the "lock_reason" field.
+func (_c *PostCreate) SetLockReason(v string) *PostCreate {
+	_c.mutation.SetLockReason(v)
+	return _c
+}
+
+// SetNillableLockReason sets the "lock_reason" field if the given value is not nil.
+func (_c *PostCreate) SetNillableLockReason(v *string) *PostCreate {
+	if v != nil {
+		_c.SetLockReason(*v)
+	}
+	return _c
+}
+
+// SetAcceptedAnswerID sets the "accepted_answer_id" field.
+func (_c *PostCreate) SetAcceptedAnswerID(v xid.ID) *PostCreate {
+	_c.mutation.SetAcceptedAnswerID(v)
+	return _c
+}
+
+// SetNillableAcceptedAnswerID sets the "accepted_answer_id" field if the given value is not nil.
+func (_c *PostCreate) SetNillableAcceptedAnswerID(v *xid.ID) *PostCreate {
+	if v != nil {
+		_c.SetAcceptedAnswerID(*v)
+	}
+	return _c
+}
+
 // SetRootPostID sets the "root_post_id" field.
 func (_c *PostCreate) SetRootPostID(v xid.ID) *PostCreate {
 	_c.mutation.SetRootPostID(v)
@@ -463,6 +521,40 @@ func (_c *PostCreate) AddPostReads(v ...*PostRead) *PostCreate {
 	return _c.AddPostReadIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (_c *PostCreate) AddRevisionIDs(ids ...xid.ID) *PostCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (_c *PostCreate) AddRevisions(v ...*PostRevision) *PostCreate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
+// SetPollID sets the "poll" edge to the Poll entity by ID.
+func (_c *PostCreate) SetPollID(id xid.ID) *PostCreate {
+	_c.mutation.SetPollID(id)
+	return _c
+}
+
+// SetNillablePollID sets the "poll" edge to the Poll entity by ID if the given value is not nil.
+func (_c *PostCreate) SetNillablePollID(id *xid.ID) *PostCreate {
+	if id != nil {
+		_c = _c.SetPollID(*id)
+	}
+	return _c
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_c *PostCreate) SetPoll(v *Poll) *PostCreate {
+	return _c.SetPollID(v.ID)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (_c *PostCreate) Mutation() *PostMutation {
 	return _c.mutation
@@ -623,6 +715,22 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldLastReplyAt, field.TypeTime, value)
 		_node.LastReplyAt = value
 	}
+	if value, ok := _c.mutation.LockedAt(); ok {
+		_spec.SetField(post.FieldLockedAt, field.TypeTime, value)
+		_node.LockedAt = &value
+	}
+	if value, ok := _c.mutation.LockedByID(); ok {
+		_spec.SetField(post.FieldLockedByID, field.TypeString, value)
+		_node.LockedByID = &value
+	}
+	if value, ok := _c.mutation.LockReason(); ok {
+		_spec.SetField(post.FieldLockReason, field.TypeString, value)
+		_node.LockReason = &value
+	}
+	if value, ok := _c.mutation.AcceptedAnswerID(); ok {
+		_spec.SetField(post.FieldAcceptedAnswerID, field.TypeString, value)
+		_node.AcceptedAnswerID = &value
+	}
 	if value, ok := _c.mutation.Body(); ok {
 		_spec.SetField(post.FieldBody, field.TypeString, value)
 		_node.Body = value
@@ -900,6 +1008,38 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.PollTable,
+			Columns: []string{post.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -1060,6 +1200,78 @@ func (u *PostUpsert) UpdateLastReplyAt() *PostUpsert {
 	return u
 }
 
+// SetLockedAt sets the "locked_at" field.
+func (u *PostUpsert) SetLockedAt(v time.Time) *PostUpsert {
+	u.Set(post.FieldLockedAt, v)
+	return u
+}
+
+// UpdateLockedAt sets the "locked_at" field to the value that was provided on create.
+func (u *PostUpsert) UpdateLockedAt() *PostUpsert {
+	u.SetExcluded(post.FieldLockedAt)
+	return u
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (u *PostUpsert) ClearLockedAt() *PostUpsert {
+	u.SetNull(post.FieldLockedAt)
+	return u
+}
+
+// SetLockedByID sets the "locked_by_id" field.
+func (u *PostUpsert) SetLockedByID(v xid.ID) *PostUpsert {
+	u.Set(post.FieldLockedByID, v)
+	return u
+}
+
+// UpdateLockedByID sets the "locked_by_id" field to the value that was provided on create.
+func (u *PostUpsert) UpdateLockedByID() *PostUpsert {
+	u.SetExcluded(post.FieldLockedByID)
+	return u
+}
+
+// ClearLockedByID clears the value of the "locked_by_id" field.
+func (u *PostUpsert) ClearLockedByID() *PostUpsert {
+	u.SetNull(post.FieldLockedByID)
+	return u
+}
+
+// SetLockReason sets the "lock_reason" field.
+func (u *PostUpsert) SetLockReason(v string) *PostUpsert {
+	u.Set(post.FieldLockReason, v)
+	return u
+}
+
+// UpdateLockReason sets the "lock_reason" field to the value that was provided on create.
+func (u *PostUpsert) UpdateLockReason() *PostUpsert {
+	u.SetExcluded(post.FieldLockReason)
+	return u
+}
+
+// ClearLockReason clears the value of the "lock_reason" field.
+func (u *PostUpsert) ClearLockReason() *PostUpsert {
+	u.SetNull(post.FieldLockReason)
+	return u
+}
+
+// SetAcceptedAnswerID sets the "accepted_answer_id" field.
+func (u *PostUpsert) SetAcceptedAnswerID(v xid.ID) *PostUpsert {
+	u.Set(post.FieldAcceptedAnswerID, v)
+	return u
+}
+
+// UpdateAcceptedAnswerID sets the "accepted_answer_id" field to the value that was provided on create.
+func (u *PostUpsert) UpdateAcceptedAnswerID() *PostUpsert {
+	u.SetExcluded(post.FieldAcceptedAnswerID)
+	return u
+}
+
+// ClearAcceptedAnswerID clears the value of the "accepted_answer_id" field.
+func (u *PostUpsert) ClearAcceptedAnswerID() *PostUpsert {
+	u.SetNull(post.FieldAcceptedAnswerID)
+	return u
+}
+
 // SetRootPostID sets the "root_post_id" field.
 func (u *PostUpsert) SetRootPostID(v xid.ID) *PostUpsert {
 	u.Set(post.FieldRootPostID, v)
@@ -1375,6 +1587,90 @@ func (u *PostUpsertOne) UpdateLastReplyAt() *PostUpsertOne {
 	})
 }
 
+// SetLockedAt sets the "locked_at" field.
+func (u *PostUpsertOne) SetLockedAt(v time.Time) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetLockedAt(v)
+	})
+}
+
+// UpdateLockedAt sets the "locked_at" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateLockedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateLockedAt()
+	})
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (u *PostUpsertOne) ClearLockedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearLockedAt()
+	})
+}
+
+// SetLockedByID sets the "locked_by_id" field.
+func (u *PostUpsertOne) SetLockedByID(v xid.ID) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetLockedByID(v)
+	})
+}
+
+// UpdateLockedByID sets the "locked_by_id" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateLockedByID() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateLockedByID()
+	})
+}
+
+// ClearLockedByID clears the value of the "locked_by_id" field.
+func (u *PostUpsertOne) ClearLockedByID() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearLockedByID()
+	})
+}
+
+// SetLockReason sets the "lock_reason" field.
+func (u *PostUpsertOne) SetLockReason(v string) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetLockReason(v)
+	})
+}
+
+// UpdateLockReason sets the "lock_reason" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateLockReason() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateLockReason()
+	})
+}
+
+// ClearLockReason clears the value of the "lock_reason" field.
+func (u *PostUpsertOne) ClearLockReason() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearLockReason()
+	})
+}
+
+// SetAcceptedAnswerID sets the "accepted_answer_id" field.
+func (u *PostUpsertOne) SetAcceptedAnswerID(v xid.ID) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetAcceptedAnswerID(v)
+	})
+}
+
+// UpdateAcceptedAnswerID sets the "accepted_answer_id" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateAcceptedAnswerID() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateAcceptedAnswerID()
+	})
+}
+
+// ClearAcceptedAnswerID clears the value of the "accepted_answer_id" field.
+func (u *PostUpsertOne) ClearAcceptedAnswerID() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearAcceptedAnswerID()
+	})
+}
+
 // SetRootPostID sets the "root_post_id" field.
 func (u *PostUpsertOne) SetRootPostID(v xid.ID) *PostUpsertOne {
 	return u.Update(func(s *PostUpsert) {
@@ -1880,6 +2176,90 @@ func (u *PostUpsertBulk) UpdateLastReplyAt() *PostUpsertBulk {
 	})
 }
 
+// SetLockedAt sets the "locked_at" field.
+func (u *PostUpsertBulk) SetLockedAt(v time.Time) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetLockedAt(v)
+	})
+}
+
+// UpdateLockedAt sets the "locked_at" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateLockedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateLockedAt()
+	})
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (u *PostUpsertBulk) ClearLockedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearLockedAt()
+	})
+}
+
+// SetLockedByID sets the "locked_by_id" field.
+func (u *PostUpsertBulk) SetLockedByID(v xid.ID) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetLockedByID(v)
+	})
+}
+
+// UpdateLockedByID sets the "locked_by_id" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateLockedByID() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateLockedByID()
+	})
+}
+
+// ClearLockedByID clears the value of the "locked_by_id" field.
+func (u *PostUpsertBulk) ClearLockedByID() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearLockedByID()
+	})
+}
+
+// SetLockReason sets the "lock_reason" field.
+func (u *PostUpsertBulk) SetLockReason(v string) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetLockReason(v)
+	})
+}
+
+// UpdateLockReason sets the "lock_reason" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateLockReason() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateLockReason()
+	})
+}
+
+// ClearLockReason clears the value of the "lock_reason" field.
+func (u *PostUpsertBulk) ClearLockReason() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearLockReason()
+	})
+}
+
+// SetAcceptedAnswerID sets the "accepted_answer_id" field.
+func (u *PostUpsertBulk) SetAcceptedAnswerID(v xid.ID) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetAcceptedAnswerID(v)
+	})
+}
+
+// UpdateAcceptedAnswerID sets the "accepted_answer_id" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateAcceptedAnswerID() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateAcceptedAnswerID()
+	})
+}
+
+// ClearAcceptedAnswerID clears the value of the "accepted_answer_id" field.
+func (u *PostUpsertBulk) ClearAcceptedAnswerID() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearAcceptedAnswerID()
+	})
+}
+
 // SetRootPostID sets the "root_post_id" field.
 func (u *PostUpsertBulk) SetRootPostID(v xid.ID) *PostUpsertBulk {
 	return u.Update(func(s *PostUpsert) {
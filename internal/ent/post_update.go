@@ -19,8 +19,10 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/likepost"
 	"github.com/Southclaws/storyden/internal/ent/link"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
+	"github.com/Southclaws/storyden/internal/ent/poll"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
+	"github.com/Southclaws/storyden/internal/ent/postrevision"
 	"github.com/Southclaws/storyden/internal/ent/predicate"
 	"github.com/Southclaws/storyden/internal/ent/react"
 	"github.com/Southclaws/storyden/internal/ent/tag"
@@ -163,6 +165,86 @@ func (_u *PostUpdate) SetNillableLastReplyAt(v *time.Time) *PostUpdate {
 	return _u
 }
 
+// SetLockedAt sets the "locked_at" field.
+func (_u *PostUpdate) SetLockedAt(v time.Time) *PostUpdate {
+	_u.mutation.SetLockedAt(v)
+	return _u
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableLockedAt(v *time.Time) *PostUpdate {
+	if v != nil {
+		_u.SetLockedAt(*v)
+	}
+	return _u
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (_u *PostUpdate) ClearLockedAt() *PostUpdate {
+	_u.mutation.ClearLockedAt()
+	return _u
+}
+
+// SetLockedByID sets the "locked_by_id" field.
+func (_u *PostUpdate) SetLockedByID(v xid.ID) *PostUpdate {
+	_u.mutation.SetLockedByID(v)
+	return _u
+}
+
+// SetNillableLockedByID sets the "locked_by_id" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableLockedByID(v *xid.ID) *PostUpdate {
+	if v != nil {
+		_u.SetLockedByID(*v)
+	}
+	return _u
+}
+
+// ClearLockedByID clears the value of the "locked_by_id" field.
+func (_u *PostUpdate) ClearLockedByID() *PostUpdate {
+	_u.mutation.ClearLockedByID()
+	return _u
+}
+
+// SetLockReason sets the "lock_reason" field.
+func (_u *PostUpdate) SetLockReason(v string) *PostUpdate {
+	_u.mutation.SetLockReason(v)
+	return _u
+}
+
+// SetNillableLockReason sets the "lock_reason" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableLockReason(v *string) *PostUpdate {
+	if v != nil {
+		_u.SetLockReason(*v)
+	}
+	return _u
+}
+
+// ClearLockReason clears the value of the "lock_reason" field.
+func (_u *PostUpdate) ClearLockReason() *PostUpdate {
+	_u.mutation.ClearLockReason()
+	return _u
+}
+
+// SetAcceptedAnswerID sets the "accepted_answer_id" field.
+func (_u *PostUpdate) SetAcceptedAnswerID(v xid.ID) *PostUpdate {
+	_u.mutation.SetAcceptedAnswerID(v)
+	return _u
+}
+
+// SetNillableAcceptedAnswerID sets the "accepted_answer_id" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableAcceptedAnswerID(v *xid.ID) *PostUpdate {
+	if v != nil {
+		_u.SetAcceptedAnswerID(*v)
+	}
+	return _u
+}
+
+// ClearAcceptedAnswerID clears the value of the "accepted_answer_id" field.
+func (_u *PostUpdate) ClearAcceptedAnswerID() *PostUpdate {
+	_u.mutation.ClearAcceptedAnswerID()
+	return _u
+}
+
 // SetRootPostID sets the "root_post_id" field.
 func (_u *PostUpdate) SetRootPostID(v xid.ID) *PostUpdate {
 	_u.mutation.SetRootPostID(v)
@@ -535,6 +617,40 @@ func (_u *PostUpdate) AddPostReads(v ...*PostRead) *PostUpdate {
 	return _u.AddPostReadIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (_u *PostUpdate) AddRevisionIDs(ids ...xid.ID) *PostUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (_u *PostUpdate) AddRevisions(v ...*PostRevision) *PostUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// SetPollID sets the "poll" edge to the Poll entity by ID.
+func (_u *PostUpdate) SetPollID(id xid.ID) *PostUpdate {
+	_u.mutation.SetPollID(id)
+	return _u
+}
+
+// SetNillablePollID sets the "poll" edge to the Poll entity by ID if the given value is not nil.
+func (_u *PostUpdate) SetNillablePollID(id *xid.ID) *PostUpdate {
+	if id != nil {
+		_u = _u.SetPollID(*id)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *PostUpdate) SetPoll(v *Poll) *PostUpdate {
+	return _u.SetPollID(v.ID)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (_u *PostUpdate) Mutation() *PostMutation {
 	return _u.mutation
@@ -801,6 +917,33 @@ func (_u *PostUpdate) RemovePostReads(v ...*PostRead) *PostUpdate {
 	return _u.RemovePostReadIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (_u *PostUpdate) ClearRevisions() *PostUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (_u *PostUpdate) RemoveRevisionIDs(ids ...xid.ID) *PostUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (_u *PostUpdate) RemoveRevisions(v ...*PostRevision) *PostUpdate {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *PostUpdate) ClearPoll() *PostUpdate {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PostUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -892,6 +1035,30 @@ func (_u *PostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.LastReplyAt(); ok {
 		_spec.SetField(post.FieldLastReplyAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.LockedAt(); ok {
+		_spec.SetField(post.FieldLockedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LockedAtCleared() {
+		_spec.ClearField(post.FieldLockedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LockedByID(); ok {
+		_spec.SetField(post.FieldLockedByID, field.TypeString, value)
+	}
+	if _u.mutation.LockedByIDCleared() {
+		_spec.ClearField(post.FieldLockedByID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LockReason(); ok {
+		_spec.SetField(post.FieldLockReason, field.TypeString, value)
+	}
+	if _u.mutation.LockReasonCleared() {
+		_spec.ClearField(post.FieldLockReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.AcceptedAnswerID(); ok {
+		_spec.SetField(post.FieldAcceptedAnswerID, field.TypeString, value)
+	}
+	if _u.mutation.AcceptedAnswerIDCleared() {
+		_spec.ClearField(post.FieldAcceptedAnswerID, field.TypeString)
+	}
 	if value, ok := _u.mutation.Body(); ok {
 		_spec.SetField(post.FieldBody, field.TypeString, value)
 	}
@@ -1547,6 +1714,80 @@ func (_u *PostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.PollTable,
+			Columns: []string{post.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.PollTable,
+			Columns: []string{post.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -1691,6 +1932,86 @@ func (_u *PostUpdateOne) SetNillableLastReplyAt(v *time.Time) *PostUpdateOne {
 	return _u
 }
 
+// SetLockedAt sets the "locked_at" field.
+func (_u *PostUpdateOne) SetLockedAt(v time.Time) *PostUpdateOne {
+	_u.mutation.SetLockedAt(v)
+	return _u
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableLockedAt(v *time.Time) *PostUpdateOne {
+	if v != nil {
+		_u.SetLockedAt(*v)
+	}
+	return _u
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (_u *PostUpdateOne) ClearLockedAt() *PostUpdateOne {
+	_u.mutation.ClearLockedAt()
+	return _u
+}
+
+// SetLockedByID sets the "locked_by_id" field.
+func (_u *PostUpdateOne) SetLockedByID(v xid.ID) *PostUpdateOne {
+	_u.mutation.SetLockedByID(v)
+	return _u
+}
+
+// SetNillableLockedByID sets the "locked_by_id" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableLockedByID(v *xid.ID) *PostUpdateOne {
+	if v != nil {
+		_u.SetLockedByID(*v)
+	}
+	return _u
+}
+
+// ClearLockedByID clears the value of the "locked_by_id" field.
+func (_u *PostUpdateOne) ClearLockedByID() *PostUpdateOne {
+	_u.mutation.ClearLockedByID()
+	return _u
+}
+
+// SetLockReason sets the "lock_reason" field.
+func (_u *PostUpdateOne) SetLockReason(v string) *PostUpdateOne {
+	_u.mutation.SetLockReason(v)
+	return _u
+}
+
+// SetNillableLockReason sets the "lock_reason" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableLockReason(v *string) *PostUpdateOne {
+	if v != nil {
+		_u.SetLockReason(*v)
+	}
+	return _u
+}
+
+// ClearLockReason clears the value of the "lock_reason" field.
+func (_u *PostUpdateOne) ClearLockReason() *PostUpdateOne {
+	_u.mutation.ClearLockReason()
+	return _u
+}
+
+// SetAcceptedAnswerID sets the "accepted_answer_id" field.
+func (_u *PostUpdateOne) SetAcceptedAnswerID(v xid.ID) *PostUpdateOne {
+	_u.mutation.SetAcceptedAnswerID(v)
+	return _u
+}
+
+// SetNillableAcceptedAnswerID sets the "accepted_answer_id" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableAcceptedAnswerID(v *xid.ID) *PostUpdateOne {
+	if v != nil {
+		_u.SetAcceptedAnswerID(*v)
+	}
+	return _u
+}
+
+// ClearAcceptedAnswerID clears the value of the "accepted_answer_id" field.
+func (_u *PostUpdateOne) ClearAcceptedAnswerID() *PostUpdateOne {
+	_u.mutation.ClearAcceptedAnswerID()
+	return _u
+}
+
 // SetRootPostID sets the "root_post_id" field.
 func (_u *PostUpdateOne) SetRootPostID(v xid.ID) *PostUpdateOne {
 	_u.mutation.SetRootPostID(v)
@@ -2063,6 +2384,40 @@ func (_u *PostUpdateOne) AddPostReads(v ...*PostRead) *PostUpdateOne {
 	return _u.AddPostReadIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (_u *PostUpdateOne) AddRevisionIDs(ids ...xid.ID) *PostUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (_u *PostUpdateOne) AddRevisions(v ...*PostRevision) *PostUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// SetPollID sets the "poll" edge to the Poll entity by ID.
+func (_u *PostUpdateOne) SetPollID(id xid.ID) *PostUpdateOne {
+	_u.mutation.SetPollID(id)
+	return _u
+}
+
+// SetNillablePollID sets the "poll" edge to the Poll entity by ID if the given value is not nil.
+func (_u *PostUpdateOne) SetNillablePollID(id *xid.ID) *PostUpdateOne {
+	if id != nil {
+		_u = _u.SetPollID(*id)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *PostUpdateOne) SetPoll(v *Poll) *PostUpdateOne {
+	return _u.SetPollID(v.ID)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (_u *PostUpdateOne) Mutation() *PostMutation {
 	return _u.mutation
@@ -2329,6 +2684,33 @@ func (_u *PostUpdateOne) RemovePostReads(v ...*PostRead) *PostUpdateOne {
 	return _u.RemovePostReadIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (_u *PostUpdateOne) ClearRevisions() *PostUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (_u *PostUpdateOne) RemoveRevisionIDs(ids ...xid.ID) *PostUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (_u *PostUpdateOne) RemoveRevisions(v ...*PostRevision) *PostUpdateOne {
+	ids := make([]xid.ID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *PostUpdateOne) ClearPoll() *PostUpdateOne {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
 // Where appends a list predicates to the PostUpdate builder.
 func (_u *PostUpdateOne) Where(ps ...predicate.Post) *PostUpdateOne {
 	_u.mutation.Where(ps...)
@@ -2450,6 +2832,30 @@ func (_u *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 	if value, ok := _u.mutation.LastReplyAt(); ok {
 		_spec.SetField(post.FieldLastReplyAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.LockedAt(); ok {
+		_spec.SetField(post.FieldLockedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LockedAtCleared() {
+		_spec.ClearField(post.FieldLockedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LockedByID(); ok {
+		_spec.SetField(post.FieldLockedByID, field.TypeString, value)
+	}
+	if _u.mutation.LockedByIDCleared() {
+		_spec.ClearField(post.FieldLockedByID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LockReason(); ok {
+		_spec.SetField(post.FieldLockReason, field.TypeString, value)
+	}
+	if _u.mutation.LockReasonCleared() {
+		_spec.ClearField(post.FieldLockReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.AcceptedAnswerID(); ok {
+		_spec.SetField(post.FieldAcceptedAnswerID, field.TypeString, value)
+	}
+	if _u.mutation.AcceptedAnswerIDCleared() {
+		_spec.ClearField(post.FieldAcceptedAnswerID, field.TypeString)
+	}
 	if value, ok := _u.mutation.Body(); ok {
 		_spec.SetField(post.FieldBody, field.TypeString, value)
 	}
@@ -3105,6 +3511,80 @@ func (_u *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.PollTable,
+			Columns: []string{post.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.PollTable,
+			Columns: []string{post.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Post{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -9,6 +9,9 @@ import (
 // Account is the predicate function for account builders.
 type Account func(*sql.Selector)
 
+// AccountBlock is the predicate function for accountblock builders.
+type AccountBlock func(*sql.Selector)
+
 // AccountFollow is the predicate function for accountfollow builders.
 type AccountFollow func(*sql.Selector)
 
@@ -18,6 +21,9 @@ type AccountRoles func(*sql.Selector)
 // Asset is the predicate function for asset builders.
 type Asset func(*sql.Selector)
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // Authentication is the predicate function for authentication builders.
 type Authentication func(*sql.Selector)
 
@@ -33,6 +39,15 @@ type CollectionNode func(*sql.Selector)
 // CollectionPost is the predicate function for collectionpost builders.
 type CollectionPost func(*sql.Selector)
 
+// Conversation is the predicate function for conversation builders.
+type Conversation func(*sql.Selector)
+
+// ConversationMessage is the predicate function for conversationmessage builders.
+type ConversationMessage func(*sql.Selector)
+
+// ConversationParticipant is the predicate function for conversationparticipant builders.
+type ConversationParticipant func(*sql.Selector)
+
 // Email is the predicate function for email builders.
 type Email func(*sql.Selector)
 
@@ -57,15 +72,39 @@ type MentionProfile func(*sql.Selector)
 // Node is the predicate function for node builders.
 type Node func(*sql.Selector)
 
+// NodeRevision is the predicate function for noderevision builders.
+type NodeRevision func(*sql.Selector)
+
 // Notification is the predicate function for notification builders.
 type Notification func(*sql.Selector)
 
+// NotificationDigest is the predicate function for notificationdigest builders.
+type NotificationDigest func(*sql.Selector)
+
+// NotificationDigestItem is the predicate function for notificationdigestitem builders.
+type NotificationDigestItem func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
+// Poll is the predicate function for poll builders.
+type Poll func(*sql.Selector)
+
+// PollOption is the predicate function for polloption builders.
+type PollOption func(*sql.Selector)
+
+// PollVote is the predicate function for pollvote builders.
+type PollVote func(*sql.Selector)
+
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
 
 // PostRead is the predicate function for postread builders.
 type PostRead func(*sql.Selector)
 
+// PostRevision is the predicate function for postrevision builders.
+type PostRevision func(*sql.Selector)
+
 // Property is the predicate function for property builders.
 type Property func(*sql.Selector)
 
@@ -75,6 +114,9 @@ type PropertySchema func(*sql.Selector)
 // PropertySchemaField is the predicate function for propertyschemafield builders.
 type PropertySchemaField func(*sql.Selector)
 
+// PublishSchedule is the predicate function for publishschedule builders.
+type PublishSchedule func(*sql.Selector)
+
 // Question is the predicate function for question builders.
 type Question func(*sql.Selector)
 
@@ -95,3 +137,9 @@ type Setting func(*sql.Selector)
 
 // Tag is the predicate function for tag builders.
 type Tag func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
@@ -0,0 +1,1110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/publishschedule"
+	"github.com/rs/xid"
+)
+
+// PublishScheduleCreate is the builder for creating a PublishSchedule entity.
+type PublishScheduleCreate struct {
+	config
+	mutation *PublishScheduleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PublishScheduleCreate) SetCreatedAt(v time.Time) *PublishScheduleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PublishScheduleCreate) SetNillableCreatedAt(v *time.Time) *PublishScheduleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PublishScheduleCreate) SetUpdatedAt(v time.Time) *PublishScheduleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PublishScheduleCreate) SetNillableUpdatedAt(v *time.Time) *PublishScheduleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *PublishScheduleCreate) SetTargetID(v xid.ID) *PublishScheduleCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (_c *PublishScheduleCreate) SetTargetKind(v string) *PublishScheduleCreate {
+	_c.mutation.SetTargetKind(v)
+	return _c
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (_c *PublishScheduleCreate) SetPublishAt(v time.Time) *PublishScheduleCreate {
+	_c.mutation.SetPublishAt(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *PublishScheduleCreate) SetAccountID(v xid.ID) *PublishScheduleCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *PublishScheduleCreate) SetAttempts(v int) *PublishScheduleCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *PublishScheduleCreate) SetNillableAttempts(v *int) *PublishScheduleCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *PublishScheduleCreate) SetNextAttemptAt(v time.Time) *PublishScheduleCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *PublishScheduleCreate) SetNillableNextAttemptAt(v *time.Time) *PublishScheduleCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *PublishScheduleCreate) SetLastError(v string) *PublishScheduleCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *PublishScheduleCreate) SetNillableLastError(v *string) *PublishScheduleCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (_c *PublishScheduleCreate) SetFailedAt(v time.Time) *PublishScheduleCreate {
+	_c.mutation.SetFailedAt(v)
+	return _c
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (_c *PublishScheduleCreate) SetNillableFailedAt(v *time.Time) *PublishScheduleCreate {
+	if v != nil {
+		_c.SetFailedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PublishScheduleCreate) SetID(v xid.ID) *PublishScheduleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PublishScheduleCreate) SetNillableID(v *xid.ID) *PublishScheduleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *PublishScheduleCreate) SetAccount(v *Account) *PublishScheduleCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the PublishScheduleMutation object of the builder.
+func (_c *PublishScheduleCreate) Mutation() *PublishScheduleMutation {
+	return _c.mutation
+}
+
+// Save creates the PublishSchedule in the database.
+func (_c *PublishScheduleCreate) Save(ctx context.Context) (*PublishSchedule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PublishScheduleCreate) SaveX(ctx context.Context) *PublishSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PublishScheduleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PublishScheduleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PublishScheduleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := publishschedule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := publishschedule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := publishschedule.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := publishschedule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PublishScheduleCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PublishSchedule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PublishSchedule.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "PublishSchedule.target_id"`)}
+	}
+	if _, ok := _c.mutation.TargetKind(); !ok {
+		return &ValidationError{Name: "target_kind", err: errors.New(`ent: missing required field "PublishSchedule.target_kind"`)}
+	}
+	if _, ok := _c.mutation.PublishAt(); !ok {
+		return &ValidationError{Name: "publish_at", err: errors.New(`ent: missing required field "PublishSchedule.publish_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "PublishSchedule.account_id"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "PublishSchedule.attempts"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := publishschedule.IDValidator(v.String()); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PublishSchedule.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "PublishSchedule.account"`)}
+	}
+	return nil
+}
+
+func (_c *PublishScheduleCreate) sqlSave(ctx context.Context) (*PublishSchedule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PublishScheduleCreate) createSpec() (*PublishSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PublishSchedule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(publishschedule.Table, sqlgraph.NewFieldSpec(publishschedule.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(publishschedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(publishschedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TargetID(); ok {
+		_spec.SetField(publishschedule.FieldTargetID, field.TypeString, value)
+		_node.TargetID = value
+	}
+	if value, ok := _c.mutation.TargetKind(); ok {
+		_spec.SetField(publishschedule.FieldTargetKind, field.TypeString, value)
+		_node.TargetKind = value
+	}
+	if value, ok := _c.mutation.PublishAt(); ok {
+		_spec.SetField(publishschedule.FieldPublishAt, field.TypeTime, value)
+		_node.PublishAt = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(publishschedule.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(publishschedule.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(publishschedule.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := _c.mutation.FailedAt(); ok {
+		_spec.SetField(publishschedule.FieldFailedAt, field.TypeTime, value)
+		_node.FailedAt = &value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publishschedule.AccountTable,
+			Columns: []string{publishschedule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PublishSchedule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PublishScheduleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PublishScheduleCreate) OnConflict(opts ...sql.ConflictOption) *PublishScheduleUpsertOne {
+	_c.conflict = opts
+	return &PublishScheduleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PublishSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PublishScheduleCreate) OnConflictColumns(columns ...string) *PublishScheduleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PublishScheduleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PublishScheduleUpsertOne is the builder for "upsert"-ing
+	//  one PublishSchedule node.
+	PublishScheduleUpsertOne struct {
+		create *PublishScheduleCreate
+	}
+
+	// PublishScheduleUpsert is the "OnConflict" setter.
+	PublishScheduleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PublishScheduleUpsert) SetUpdatedAt(v time.Time) *PublishScheduleUpsert {
+	u.Set(publishschedule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PublishScheduleUpsert) UpdateUpdatedAt() *PublishScheduleUpsert {
+	u.SetExcluded(publishschedule.FieldUpdatedAt)
+	return u
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *PublishScheduleUpsert) SetTargetID(v xid.ID) *PublishScheduleUpsert {
+	u.Set(publishschedule.FieldTargetID, v)
+	return u
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *PublishScheduleUpsert) UpdateTargetID() *PublishScheduleUpsert {
+	u.SetExcluded(publishschedule.FieldTargetID)
+	return u
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *PublishScheduleUpsert) SetTargetKind(v string) *PublishScheduleUpsert {
+	u.Set(publishschedule.FieldTargetKind, v)
+	return u
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *PublishScheduleUpsert) UpdateTargetKind() *PublishScheduleUpsert {
+	u.SetExcluded(publishschedule.FieldTargetKind)
+	return u
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (u *PublishScheduleUpsert) SetPublishAt(v time.Time) *PublishScheduleUpsert {
+	u.Set(publishschedule.FieldPublishAt, v)
+	return u
+}
+
+// UpdatePublishAt sets the "publish_at" field to the value that was provided on create.
+func (u *PublishScheduleUpsert) UpdatePublishAt() *PublishScheduleUpsert {
+	u.SetExcluded(publishschedule.FieldPublishAt)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *PublishScheduleUpsert) SetAccountID(v xid.ID) *PublishScheduleUpsert {
+	u.Set(publishschedule.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *PublishScheduleUpsert) UpdateAccountID() *PublishScheduleUpsert {
+	u.SetExcluded(publishschedule.FieldAccountID)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *PublishScheduleUpsert) SetAttempts(v int) *PublishScheduleUpsert {
+	u.Set(publishschedule.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *PublishScheduleUpsert) UpdateAttempts() *PublishScheduleUpsert {
+	u.SetExcluded(publishschedule.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *PublishScheduleUpsert) AddAttempts(v int) *PublishScheduleUpsert {
+	u.Add(publishschedule.FieldAttempts, v)
+	return u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *PublishScheduleUpsert) SetNextAttemptAt(v time.Time) *PublishScheduleUpsert {
+	u.Set(publishschedule.FieldNextAttemptAt, v)
+	return u
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *PublishScheduleUpsert) UpdateNextAttemptAt() *PublishScheduleUpsert {
+	u.SetExcluded(publishschedule.FieldNextAttemptAt)
+	return u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *PublishScheduleUpsert) ClearNextAttemptAt() *PublishScheduleUpsert {
+	u.SetNull(publishschedule.FieldNextAttemptAt)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *PublishScheduleUpsert) SetLastError(v string) *PublishScheduleUpsert {
+	u.Set(publishschedule.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *PublishScheduleUpsert) UpdateLastError() *PublishScheduleUpsert {
+	u.SetExcluded(publishschedule.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *PublishScheduleUpsert) ClearLastError() *PublishScheduleUpsert {
+	u.SetNull(publishschedule.FieldLastError)
+	return u
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (u *PublishScheduleUpsert) SetFailedAt(v time.Time) *PublishScheduleUpsert {
+	u.Set(publishschedule.FieldFailedAt, v)
+	return u
+}
+
+// UpdateFailedAt sets the "failed_at" field to the value that was provided on create.
+func (u *PublishScheduleUpsert) UpdateFailedAt() *PublishScheduleUpsert {
+	u.SetExcluded(publishschedule.FieldFailedAt)
+	return u
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (u *PublishScheduleUpsert) ClearFailedAt() *PublishScheduleUpsert {
+	u.SetNull(publishschedule.FieldFailedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PublishSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(publishschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PublishScheduleUpsertOne) UpdateNewValues() *PublishScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(publishschedule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(publishschedule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PublishSchedule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PublishScheduleUpsertOne) Ignore() *PublishScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PublishScheduleUpsertOne) DoNothing() *PublishScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PublishScheduleCreate.OnConflict
+// documentation for more info.
+func (u *PublishScheduleUpsertOne) Update(set func(*PublishScheduleUpsert)) *PublishScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PublishScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PublishScheduleUpsertOne) SetUpdatedAt(v time.Time) *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PublishScheduleUpsertOne) UpdateUpdatedAt() *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *PublishScheduleUpsertOne) SetTargetID(v xid.ID) *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *PublishScheduleUpsertOne) UpdateTargetID() *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *PublishScheduleUpsertOne) SetTargetKind(v string) *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetTargetKind(v)
+	})
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *PublishScheduleUpsertOne) UpdateTargetKind() *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateTargetKind()
+	})
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (u *PublishScheduleUpsertOne) SetPublishAt(v time.Time) *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetPublishAt(v)
+	})
+}
+
+// UpdatePublishAt sets the "publish_at" field to the value that was provided on create.
+func (u *PublishScheduleUpsertOne) UpdatePublishAt() *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdatePublishAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *PublishScheduleUpsertOne) SetAccountID(v xid.ID) *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *PublishScheduleUpsertOne) UpdateAccountID() *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *PublishScheduleUpsertOne) SetAttempts(v int) *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *PublishScheduleUpsertOne) AddAttempts(v int) *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *PublishScheduleUpsertOne) UpdateAttempts() *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *PublishScheduleUpsertOne) SetNextAttemptAt(v time.Time) *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *PublishScheduleUpsertOne) UpdateNextAttemptAt() *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *PublishScheduleUpsertOne) ClearNextAttemptAt() *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.ClearNextAttemptAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *PublishScheduleUpsertOne) SetLastError(v string) *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *PublishScheduleUpsertOne) UpdateLastError() *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *PublishScheduleUpsertOne) ClearLastError() *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (u *PublishScheduleUpsertOne) SetFailedAt(v time.Time) *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetFailedAt(v)
+	})
+}
+
+// UpdateFailedAt sets the "failed_at" field to the value that was provided on create.
+func (u *PublishScheduleUpsertOne) UpdateFailedAt() *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateFailedAt()
+	})
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (u *PublishScheduleUpsertOne) ClearFailedAt() *PublishScheduleUpsertOne {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.ClearFailedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PublishScheduleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PublishScheduleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PublishScheduleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PublishScheduleUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PublishScheduleUpsertOne.ID is not supported by MySQL driver. Use PublishScheduleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PublishScheduleUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PublishScheduleCreateBulk is the builder for creating many PublishSchedule entities in bulk.
+type PublishScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*PublishScheduleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PublishSchedule entities in the database.
+func (_c *PublishScheduleCreateBulk) Save(ctx context.Context) ([]*PublishSchedule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PublishSchedule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PublishScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PublishScheduleCreateBulk) SaveX(ctx context.Context) []*PublishSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PublishScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PublishScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PublishSchedule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PublishScheduleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PublishScheduleCreateBulk) OnConflict(opts ...sql.ConflictOption) *PublishScheduleUpsertBulk {
+	_c.conflict = opts
+	return &PublishScheduleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PublishSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PublishScheduleCreateBulk) OnConflictColumns(columns ...string) *PublishScheduleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PublishScheduleUpsertBulk{
+		create: _c,
+	}
+}
+
+// PublishScheduleUpsertBulk is the builder for "upsert"-ing
+// a bulk of PublishSchedule nodes.
+type PublishScheduleUpsertBulk struct {
+	create *PublishScheduleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PublishSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(publishschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PublishScheduleUpsertBulk) UpdateNewValues() *PublishScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(publishschedule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(publishschedule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PublishSchedule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PublishScheduleUpsertBulk) Ignore() *PublishScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PublishScheduleUpsertBulk) DoNothing() *PublishScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PublishScheduleCreateBulk.OnConflict
+// documentation for more info.
+func (u *PublishScheduleUpsertBulk) Update(set func(*PublishScheduleUpsert)) *PublishScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PublishScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PublishScheduleUpsertBulk) SetUpdatedAt(v time.Time) *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PublishScheduleUpsertBulk) UpdateUpdatedAt() *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *PublishScheduleUpsertBulk) SetTargetID(v xid.ID) *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *PublishScheduleUpsertBulk) UpdateTargetID() *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *PublishScheduleUpsertBulk) SetTargetKind(v string) *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetTargetKind(v)
+	})
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *PublishScheduleUpsertBulk) UpdateTargetKind() *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateTargetKind()
+	})
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (u *PublishScheduleUpsertBulk) SetPublishAt(v time.Time) *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetPublishAt(v)
+	})
+}
+
+// UpdatePublishAt sets the "publish_at" field to the value that was provided on create.
+func (u *PublishScheduleUpsertBulk) UpdatePublishAt() *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdatePublishAt()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *PublishScheduleUpsertBulk) SetAccountID(v xid.ID) *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *PublishScheduleUpsertBulk) UpdateAccountID() *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *PublishScheduleUpsertBulk) SetAttempts(v int) *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *PublishScheduleUpsertBulk) AddAttempts(v int) *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *PublishScheduleUpsertBulk) UpdateAttempts() *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *PublishScheduleUpsertBulk) SetNextAttemptAt(v time.Time) *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *PublishScheduleUpsertBulk) UpdateNextAttemptAt() *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *PublishScheduleUpsertBulk) ClearNextAttemptAt() *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.ClearNextAttemptAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *PublishScheduleUpsertBulk) SetLastError(v string) *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *PublishScheduleUpsertBulk) UpdateLastError() *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *PublishScheduleUpsertBulk) ClearLastError() *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (u *PublishScheduleUpsertBulk) SetFailedAt(v time.Time) *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.SetFailedAt(v)
+	})
+}
+
+// UpdateFailedAt sets the "failed_at" field to the value that was provided on create.
+func (u *PublishScheduleUpsertBulk) UpdateFailedAt() *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.UpdateFailedAt()
+	})
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (u *PublishScheduleUpsertBulk) ClearFailedAt() *PublishScheduleUpsertBulk {
+	return u.Update(func(s *PublishScheduleUpsert) {
+		s.ClearFailedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PublishScheduleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PublishScheduleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PublishScheduleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PublishScheduleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -41,11 +41,13 @@ type Role struct {
 type RoleEdges struct {
 	// Accounts holds the value of the accounts edge.
 	Accounts []*Account `json:"accounts,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*Invitation `json:"invitations,omitempty"`
 	// AccountRoles holds the value of the account_roles edge.
 	AccountRoles []*AccountRoles `json:"account_roles,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // AccountsOrErr returns the Accounts value or an error if the edge
@@ -57,10 +59,19 @@ func (e RoleEdges) AccountsOrErr() ([]*Account, error) {
 	return nil, &NotLoadedError{edge: "accounts"}
 }
 
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) InvitationsOrErr() ([]*Invitation, error) {
+	if e.loadedTypes[1] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
 // AccountRolesOrErr returns the AccountRoles value or an error if the edge
 // was not loaded in eager-loading.
 func (e RoleEdges) AccountRolesOrErr() ([]*AccountRoles, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.AccountRoles, nil
 	}
 	return nil, &NotLoadedError{edge: "account_roles"}
@@ -158,6 +169,11 @@ func (_m *Role) QueryAccounts() *AccountQuery {
 	return NewRoleClient(_m.config).QueryAccounts(_m)
 }
 
+// QueryInvitations queries the "invitations" edge of the Role entity.
+func (_m *Role) QueryInvitations() *InvitationQuery {
+	return NewRoleClient(_m.config).QueryInvitations(_m)
+}
+
 // QueryAccountRoles queries the "account_roles" edge of the Role entity.
 func (_m *Role) QueryAccountRoles() *AccountRolesQuery {
 	return NewRoleClient(_m.config).QueryAccountRoles(_m)
@@ -7,14 +7,19 @@ import (
 
 	"github.com/Southclaws/lexorank"
 	"github.com/Southclaws/storyden/internal/ent/account"
+	"github.com/Southclaws/storyden/internal/ent/accountblock"
 	"github.com/Southclaws/storyden/internal/ent/accountfollow"
 	"github.com/Southclaws/storyden/internal/ent/accountroles"
 	"github.com/Southclaws/storyden/internal/ent/asset"
+	"github.com/Southclaws/storyden/internal/ent/auditlog"
 	"github.com/Southclaws/storyden/internal/ent/authentication"
 	"github.com/Southclaws/storyden/internal/ent/category"
 	"github.com/Southclaws/storyden/internal/ent/collection"
 	"github.com/Southclaws/storyden/internal/ent/collectionnode"
 	"github.com/Southclaws/storyden/internal/ent/collectionpost"
+	"github.com/Southclaws/storyden/internal/ent/conversation"
+	"github.com/Southclaws/storyden/internal/ent/conversationmessage"
+	"github.com/Southclaws/storyden/internal/ent/conversationparticipant"
 	"github.com/Southclaws/storyden/internal/ent/email"
 	"github.com/Southclaws/storyden/internal/ent/event"
 	"github.com/Southclaws/storyden/internal/ent/eventparticipant"
@@ -23,12 +28,21 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/link"
 	"github.com/Southclaws/storyden/internal/ent/mentionprofile"
 	"github.com/Southclaws/storyden/internal/ent/node"
+	"github.com/Southclaws/storyden/internal/ent/noderevision"
 	"github.com/Southclaws/storyden/internal/ent/notification"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigest"
+	"github.com/Southclaws/storyden/internal/ent/notificationdigestitem"
+	"github.com/Southclaws/storyden/internal/ent/notificationpreference"
+	"github.com/Southclaws/storyden/internal/ent/poll"
+	"github.com/Southclaws/storyden/internal/ent/polloption"
+	"github.com/Southclaws/storyden/internal/ent/pollvote"
 	"github.com/Southclaws/storyden/internal/ent/post"
 	"github.com/Southclaws/storyden/internal/ent/postread"
+	"github.com/Southclaws/storyden/internal/ent/postrevision"
 	"github.com/Southclaws/storyden/internal/ent/property"
 	"github.com/Southclaws/storyden/internal/ent/propertyschema"
 	"github.com/Southclaws/storyden/internal/ent/propertyschemafield"
+	"github.com/Southclaws/storyden/internal/ent/publishschedule"
 	"github.com/Southclaws/storyden/internal/ent/question"
 	"github.com/Southclaws/storyden/internal/ent/react"
 	"github.com/Southclaws/storyden/internal/ent/report"
@@ -37,6 +51,8 @@ import (
 	"github.com/Southclaws/storyden/internal/ent/session"
 	"github.com/Southclaws/storyden/internal/ent/setting"
 	"github.com/Southclaws/storyden/internal/ent/tag"
+	"github.com/Southclaws/storyden/internal/ent/webhook"
+	"github.com/Southclaws/storyden/internal/ent/webhookdelivery"
 	"github.com/rs/xid"
 )
 
@@ -95,6 +111,37 @@ func init() {
 			return nil
 		}
 	}()
+	accountblockMixin := schema.AccountBlock{}.Mixin()
+	accountblockMixinFields0 := accountblockMixin[0].Fields()
+	_ = accountblockMixinFields0
+	accountblockMixinFields1 := accountblockMixin[1].Fields()
+	_ = accountblockMixinFields1
+	accountblockFields := schema.AccountBlock{}.Fields()
+	_ = accountblockFields
+	// accountblockDescCreatedAt is the schema descriptor for created_at field.
+	accountblockDescCreatedAt := accountblockMixinFields1[0].Descriptor()
+	// accountblock.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accountblock.DefaultCreatedAt = accountblockDescCreatedAt.Default.(func() time.Time)
+	// accountblockDescID is the schema descriptor for id field.
+	accountblockDescID := accountblockMixinFields0[0].Descriptor()
+	// accountblock.DefaultID holds the default value on creation for the id field.
+	accountblock.DefaultID = accountblockDescID.Default.(func() xid.ID)
+	// accountblock.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	accountblock.IDValidator = func() func(string) error {
+		validators := accountblockDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	accountfollowMixin := schema.AccountFollow{}.Mixin()
 	accountfollowMixinFields0 := accountfollowMixin[0].Fields()
 	_ = accountfollowMixinFields0
@@ -196,6 +243,37 @@ func init() {
 			return nil
 		}
 	}()
+	auditlogMixin := schema.AuditLog{}.Mixin()
+	auditlogMixinFields0 := auditlogMixin[0].Fields()
+	_ = auditlogMixinFields0
+	auditlogMixinFields1 := auditlogMixin[1].Fields()
+	_ = auditlogMixinFields1
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogMixinFields1[0].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescID is the schema descriptor for id field.
+	auditlogDescID := auditlogMixinFields0[0].Descriptor()
+	// auditlog.DefaultID holds the default value on creation for the id field.
+	auditlog.DefaultID = auditlogDescID.Default.(func() xid.ID)
+	// auditlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	auditlog.IDValidator = func() func(string) error {
+		validators := auditlogDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	authenticationMixin := schema.Authentication{}.Mixin()
 	authenticationMixinFields0 := authenticationMixin[0].Fields()
 	_ = authenticationMixinFields0
@@ -278,6 +356,10 @@ func init() {
 	categoryDescAdmin := categoryFields[5].Descriptor()
 	// category.DefaultAdmin holds the default value on creation for the admin field.
 	category.DefaultAdmin = categoryDescAdmin.Default.(bool)
+	// categoryDescQaMode is the schema descriptor for qa_mode field.
+	categoryDescQaMode := categoryFields[6].Descriptor()
+	// category.DefaultQaMode holds the default value on creation for the qa_mode field.
+	category.DefaultQaMode = categoryDescQaMode.Default.(bool)
 	// categoryDescID is the schema descriptor for id field.
 	categoryDescID := categoryMixinFields0[0].Descriptor()
 	// category.DefaultID holds the default value on creation for the id field.
@@ -443,6 +525,119 @@ func init() {
 	collectionpostDescMembershipType := collectionpostFields[2].Descriptor()
 	// collectionpost.DefaultMembershipType holds the default value on creation for the membership_type field.
 	collectionpost.DefaultMembershipType = collectionpostDescMembershipType.Default.(string)
+	conversationMixin := schema.Conversation{}.Mixin()
+	conversationMixinFields0 := conversationMixin[0].Fields()
+	_ = conversationMixinFields0
+	conversationMixinFields1 := conversationMixin[1].Fields()
+	_ = conversationMixinFields1
+	conversationMixinFields2 := conversationMixin[2].Fields()
+	_ = conversationMixinFields2
+	conversationFields := schema.Conversation{}.Fields()
+	_ = conversationFields
+	// conversationDescCreatedAt is the schema descriptor for created_at field.
+	conversationDescCreatedAt := conversationMixinFields1[0].Descriptor()
+	// conversation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	conversation.DefaultCreatedAt = conversationDescCreatedAt.Default.(func() time.Time)
+	// conversationDescUpdatedAt is the schema descriptor for updated_at field.
+	conversationDescUpdatedAt := conversationMixinFields2[0].Descriptor()
+	// conversation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	conversation.DefaultUpdatedAt = conversationDescUpdatedAt.Default.(func() time.Time)
+	// conversation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	conversation.UpdateDefaultUpdatedAt = conversationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// conversationDescLastMessageAt is the schema descriptor for last_message_at field.
+	conversationDescLastMessageAt := conversationFields[1].Descriptor()
+	// conversation.DefaultLastMessageAt holds the default value on creation for the last_message_at field.
+	conversation.DefaultLastMessageAt = conversationDescLastMessageAt.Default.(func() time.Time)
+	// conversationDescID is the schema descriptor for id field.
+	conversationDescID := conversationMixinFields0[0].Descriptor()
+	// conversation.DefaultID holds the default value on creation for the id field.
+	conversation.DefaultID = conversationDescID.Default.(func() xid.ID)
+	// conversation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	conversation.IDValidator = func() func(string) error {
+		validators := conversationDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	conversationmessageMixin := schema.ConversationMessage{}.Mixin()
+	conversationmessageMixinFields0 := conversationmessageMixin[0].Fields()
+	_ = conversationmessageMixinFields0
+	conversationmessageMixinFields1 := conversationmessageMixin[1].Fields()
+	_ = conversationmessageMixinFields1
+	conversationmessageMixinFields2 := conversationmessageMixin[2].Fields()
+	_ = conversationmessageMixinFields2
+	conversationmessageFields := schema.ConversationMessage{}.Fields()
+	_ = conversationmessageFields
+	// conversationmessageDescCreatedAt is the schema descriptor for created_at field.
+	conversationmessageDescCreatedAt := conversationmessageMixinFields1[0].Descriptor()
+	// conversationmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	conversationmessage.DefaultCreatedAt = conversationmessageDescCreatedAt.Default.(func() time.Time)
+	// conversationmessageDescUpdatedAt is the schema descriptor for updated_at field.
+	conversationmessageDescUpdatedAt := conversationmessageMixinFields2[0].Descriptor()
+	// conversationmessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	conversationmessage.DefaultUpdatedAt = conversationmessageDescUpdatedAt.Default.(func() time.Time)
+	// conversationmessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	conversationmessage.UpdateDefaultUpdatedAt = conversationmessageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// conversationmessageDescID is the schema descriptor for id field.
+	conversationmessageDescID := conversationmessageMixinFields0[0].Descriptor()
+	// conversationmessage.DefaultID holds the default value on creation for the id field.
+	conversationmessage.DefaultID = conversationmessageDescID.Default.(func() xid.ID)
+	// conversationmessage.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	conversationmessage.IDValidator = func() func(string) error {
+		validators := conversationmessageDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	conversationparticipantMixin := schema.ConversationParticipant{}.Mixin()
+	conversationparticipantMixinFields0 := conversationparticipantMixin[0].Fields()
+	_ = conversationparticipantMixinFields0
+	conversationparticipantMixinFields1 := conversationparticipantMixin[1].Fields()
+	_ = conversationparticipantMixinFields1
+	conversationparticipantFields := schema.ConversationParticipant{}.Fields()
+	_ = conversationparticipantFields
+	// conversationparticipantDescCreatedAt is the schema descriptor for created_at field.
+	conversationparticipantDescCreatedAt := conversationparticipantMixinFields1[0].Descriptor()
+	// conversationparticipant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	conversationparticipant.DefaultCreatedAt = conversationparticipantDescCreatedAt.Default.(func() time.Time)
+	// conversationparticipantDescID is the schema descriptor for id field.
+	conversationparticipantDescID := conversationparticipantMixinFields0[0].Descriptor()
+	// conversationparticipant.DefaultID holds the default value on creation for the id field.
+	conversationparticipant.DefaultID = conversationparticipantDescID.Default.(func() xid.ID)
+	// conversationparticipant.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	conversationparticipant.IDValidator = func() func(string) error {
+		validators := conversationparticipantDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	emailMixin := schema.Email{}.Mixin()
 	emailMixinFields0 := emailMixin[0].Fields()
 	_ = emailMixinFields0
@@ -521,6 +716,10 @@ func init() {
 	event.DefaultUpdatedAt = eventDescUpdatedAt.Default.(func() time.Time)
 	// event.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	event.UpdateDefaultUpdatedAt = eventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// eventDescSequence is the schema descriptor for sequence field.
+	eventDescSequence := eventFields[15].Descriptor()
+	// event.DefaultSequence holds the default value on creation for the sequence field.
+	event.DefaultSequence = eventDescSequence.Default.(int)
 	// eventDescID is the schema descriptor for id field.
 	eventDescID := eventMixinFields0[0].Descriptor()
 	// event.DefaultID holds the default value on creation for the id field.
@@ -591,6 +790,10 @@ func init() {
 	invitation.DefaultUpdatedAt = invitationDescUpdatedAt.Default.(func() time.Time)
 	// invitation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	invitation.UpdateDefaultUpdatedAt = invitationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// invitationDescUses is the schema descriptor for uses field.
+	invitationDescUses := invitationFields[4].Descriptor()
+	// invitation.DefaultUses holds the default value on creation for the uses field.
+	invitation.DefaultUses = invitationDescUses.Default.(int)
 	// invitationDescID is the schema descriptor for id field.
 	invitationDescID := invitationMixinFields0[0].Descriptor()
 	// invitation.DefaultID holds the default value on creation for the id field.
@@ -751,6 +954,37 @@ func init() {
 			return nil
 		}
 	}()
+	noderevisionMixin := schema.NodeRevision{}.Mixin()
+	noderevisionMixinFields0 := noderevisionMixin[0].Fields()
+	_ = noderevisionMixinFields0
+	noderevisionMixinFields1 := noderevisionMixin[1].Fields()
+	_ = noderevisionMixinFields1
+	noderevisionFields := schema.NodeRevision{}.Fields()
+	_ = noderevisionFields
+	// noderevisionDescCreatedAt is the schema descriptor for created_at field.
+	noderevisionDescCreatedAt := noderevisionMixinFields1[0].Descriptor()
+	// noderevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	noderevision.DefaultCreatedAt = noderevisionDescCreatedAt.Default.(func() time.Time)
+	// noderevisionDescID is the schema descriptor for id field.
+	noderevisionDescID := noderevisionMixinFields0[0].Descriptor()
+	// noderevision.DefaultID holds the default value on creation for the id field.
+	noderevision.DefaultID = noderevisionDescID.Default.(func() xid.ID)
+	// noderevision.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	noderevision.IDValidator = func() func(string) error {
+		validators := noderevisionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	notificationMixin := schema.Notification{}.Mixin()
 	notificationMixinFields0 := notificationMixin[0].Fields()
 	_ = notificationMixinFields0
@@ -782,6 +1016,228 @@ func init() {
 			return nil
 		}
 	}()
+	notificationdigestMixin := schema.NotificationDigest{}.Mixin()
+	notificationdigestMixinFields0 := notificationdigestMixin[0].Fields()
+	_ = notificationdigestMixinFields0
+	notificationdigestMixinFields1 := notificationdigestMixin[1].Fields()
+	_ = notificationdigestMixinFields1
+	notificationdigestMixinFields2 := notificationdigestMixin[2].Fields()
+	_ = notificationdigestMixinFields2
+	notificationdigestFields := schema.NotificationDigest{}.Fields()
+	_ = notificationdigestFields
+	// notificationdigestDescCreatedAt is the schema descriptor for created_at field.
+	notificationdigestDescCreatedAt := notificationdigestMixinFields1[0].Descriptor()
+	// notificationdigest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationdigest.DefaultCreatedAt = notificationdigestDescCreatedAt.Default.(func() time.Time)
+	// notificationdigestDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationdigestDescUpdatedAt := notificationdigestMixinFields2[0].Descriptor()
+	// notificationdigest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationdigest.DefaultUpdatedAt = notificationdigestDescUpdatedAt.Default.(func() time.Time)
+	// notificationdigest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationdigest.UpdateDefaultUpdatedAt = notificationdigestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationdigestDescID is the schema descriptor for id field.
+	notificationdigestDescID := notificationdigestMixinFields0[0].Descriptor()
+	// notificationdigest.DefaultID holds the default value on creation for the id field.
+	notificationdigest.DefaultID = notificationdigestDescID.Default.(func() xid.ID)
+	// notificationdigest.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notificationdigest.IDValidator = func() func(string) error {
+		validators := notificationdigestDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	notificationdigestitemMixin := schema.NotificationDigestItem{}.Mixin()
+	notificationdigestitemMixinFields0 := notificationdigestitemMixin[0].Fields()
+	_ = notificationdigestitemMixinFields0
+	notificationdigestitemMixinFields1 := notificationdigestitemMixin[1].Fields()
+	_ = notificationdigestitemMixinFields1
+	notificationdigestitemFields := schema.NotificationDigestItem{}.Fields()
+	_ = notificationdigestitemFields
+	// notificationdigestitemDescCreatedAt is the schema descriptor for created_at field.
+	notificationdigestitemDescCreatedAt := notificationdigestitemMixinFields1[0].Descriptor()
+	// notificationdigestitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationdigestitem.DefaultCreatedAt = notificationdigestitemDescCreatedAt.Default.(func() time.Time)
+	// notificationdigestitemDescID is the schema descriptor for id field.
+	notificationdigestitemDescID := notificationdigestitemMixinFields0[0].Descriptor()
+	// notificationdigestitem.DefaultID holds the default value on creation for the id field.
+	notificationdigestitem.DefaultID = notificationdigestitemDescID.Default.(func() xid.ID)
+	// notificationdigestitem.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notificationdigestitem.IDValidator = func() func(string) error {
+		validators := notificationdigestitemDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	notificationpreferenceMixin := schema.NotificationPreference{}.Mixin()
+	notificationpreferenceMixinFields0 := notificationpreferenceMixin[0].Fields()
+	_ = notificationpreferenceMixinFields0
+	notificationpreferenceMixinFields1 := notificationpreferenceMixin[1].Fields()
+	_ = notificationpreferenceMixinFields1
+	notificationpreferenceMixinFields2 := notificationpreferenceMixin[2].Fields()
+	_ = notificationpreferenceMixinFields2
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescCreatedAt is the schema descriptor for created_at field.
+	notificationpreferenceDescCreatedAt := notificationpreferenceMixinFields1[0].Descriptor()
+	// notificationpreference.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationpreference.DefaultCreatedAt = notificationpreferenceDescCreatedAt.Default.(func() time.Time)
+	// notificationpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationpreferenceDescUpdatedAt := notificationpreferenceMixinFields2[0].Descriptor()
+	// notificationpreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationpreference.DefaultUpdatedAt = notificationpreferenceDescUpdatedAt.Default.(func() time.Time)
+	// notificationpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationpreference.UpdateDefaultUpdatedAt = notificationpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationpreferenceDescID is the schema descriptor for id field.
+	notificationpreferenceDescID := notificationpreferenceMixinFields0[0].Descriptor()
+	// notificationpreference.DefaultID holds the default value on creation for the id field.
+	notificationpreference.DefaultID = notificationpreferenceDescID.Default.(func() xid.ID)
+	// notificationpreference.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notificationpreference.IDValidator = func() func(string) error {
+		validators := notificationpreferenceDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	pollMixin := schema.Poll{}.Mixin()
+	pollMixinFields0 := pollMixin[0].Fields()
+	_ = pollMixinFields0
+	pollMixinFields1 := pollMixin[1].Fields()
+	_ = pollMixinFields1
+	pollMixinFields2 := pollMixin[2].Fields()
+	_ = pollMixinFields2
+	pollFields := schema.Poll{}.Fields()
+	_ = pollFields
+	// pollDescCreatedAt is the schema descriptor for created_at field.
+	pollDescCreatedAt := pollMixinFields1[0].Descriptor()
+	// poll.DefaultCreatedAt holds the default value on creation for the created_at field.
+	poll.DefaultCreatedAt = pollDescCreatedAt.Default.(func() time.Time)
+	// pollDescUpdatedAt is the schema descriptor for updated_at field.
+	pollDescUpdatedAt := pollMixinFields2[0].Descriptor()
+	// poll.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	poll.DefaultUpdatedAt = pollDescUpdatedAt.Default.(func() time.Time)
+	// poll.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	poll.UpdateDefaultUpdatedAt = pollDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pollDescMaxChoices is the schema descriptor for max_choices field.
+	pollDescMaxChoices := pollFields[1].Descriptor()
+	// poll.DefaultMaxChoices holds the default value on creation for the max_choices field.
+	poll.DefaultMaxChoices = pollDescMaxChoices.Default.(int)
+	// pollDescAnonymous is the schema descriptor for anonymous field.
+	pollDescAnonymous := pollFields[2].Descriptor()
+	// poll.DefaultAnonymous holds the default value on creation for the anonymous field.
+	poll.DefaultAnonymous = pollDescAnonymous.Default.(bool)
+	// pollDescID is the schema descriptor for id field.
+	pollDescID := pollMixinFields0[0].Descriptor()
+	// poll.DefaultID holds the default value on creation for the id field.
+	poll.DefaultID = pollDescID.Default.(func() xid.ID)
+	// poll.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	poll.IDValidator = func() func(string) error {
+		validators := pollDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	polloptionMixin := schema.PollOption{}.Mixin()
+	polloptionMixinFields0 := polloptionMixin[0].Fields()
+	_ = polloptionMixinFields0
+	polloptionMixinFields1 := polloptionMixin[1].Fields()
+	_ = polloptionMixinFields1
+	polloptionFields := schema.PollOption{}.Fields()
+	_ = polloptionFields
+	// polloptionDescCreatedAt is the schema descriptor for created_at field.
+	polloptionDescCreatedAt := polloptionMixinFields1[0].Descriptor()
+	// polloption.DefaultCreatedAt holds the default value on creation for the created_at field.
+	polloption.DefaultCreatedAt = polloptionDescCreatedAt.Default.(func() time.Time)
+	// polloptionDescSort is the schema descriptor for sort field.
+	polloptionDescSort := polloptionFields[2].Descriptor()
+	// polloption.DefaultSort holds the default value on creation for the sort field.
+	polloption.DefaultSort = polloptionDescSort.Default.(int)
+	// polloptionDescID is the schema descriptor for id field.
+	polloptionDescID := polloptionMixinFields0[0].Descriptor()
+	// polloption.DefaultID holds the default value on creation for the id field.
+	polloption.DefaultID = polloptionDescID.Default.(func() xid.ID)
+	// polloption.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	polloption.IDValidator = func() func(string) error {
+		validators := polloptionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	pollvoteMixin := schema.PollVote{}.Mixin()
+	pollvoteMixinFields0 := pollvoteMixin[0].Fields()
+	_ = pollvoteMixinFields0
+	pollvoteMixinFields1 := pollvoteMixin[1].Fields()
+	_ = pollvoteMixinFields1
+	pollvoteFields := schema.PollVote{}.Fields()
+	_ = pollvoteFields
+	// pollvoteDescCreatedAt is the schema descriptor for created_at field.
+	pollvoteDescCreatedAt := pollvoteMixinFields1[0].Descriptor()
+	// pollvote.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pollvote.DefaultCreatedAt = pollvoteDescCreatedAt.Default.(func() time.Time)
+	// pollvoteDescID is the schema descriptor for id field.
+	pollvoteDescID := pollvoteMixinFields0[0].Descriptor()
+	// pollvote.DefaultID holds the default value on creation for the id field.
+	pollvote.DefaultID = pollvoteDescID.Default.(func() xid.ID)
+	// pollvote.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	pollvote.IDValidator = func() func(string) error {
+		validators := pollvoteDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	postMixin := schema.Post{}.Mixin()
 	postMixinFields0 := postMixin[0].Fields()
 	_ = postMixinFields0
@@ -842,6 +1298,37 @@ func init() {
 			return nil
 		}
 	}()
+	postrevisionMixin := schema.PostRevision{}.Mixin()
+	postrevisionMixinFields0 := postrevisionMixin[0].Fields()
+	_ = postrevisionMixinFields0
+	postrevisionMixinFields1 := postrevisionMixin[1].Fields()
+	_ = postrevisionMixinFields1
+	postrevisionFields := schema.PostRevision{}.Fields()
+	_ = postrevisionFields
+	// postrevisionDescCreatedAt is the schema descriptor for created_at field.
+	postrevisionDescCreatedAt := postrevisionMixinFields1[0].Descriptor()
+	// postrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	postrevision.DefaultCreatedAt = postrevisionDescCreatedAt.Default.(func() time.Time)
+	// postrevisionDescID is the schema descriptor for id field.
+	postrevisionDescID := postrevisionMixinFields0[0].Descriptor()
+	// postrevision.DefaultID holds the default value on creation for the id field.
+	postrevision.DefaultID = postrevisionDescID.Default.(func() xid.ID)
+	// postrevision.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	postrevision.IDValidator = func() func(string) error {
+		validators := postrevisionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	propertyMixin := schema.Property{}.Mixin()
 	propertyMixinFields0 := propertyMixin[0].Fields()
 	_ = propertyMixinFields0
@@ -923,6 +1410,49 @@ func init() {
 			return nil
 		}
 	}()
+	publishscheduleMixin := schema.PublishSchedule{}.Mixin()
+	publishscheduleMixinFields0 := publishscheduleMixin[0].Fields()
+	_ = publishscheduleMixinFields0
+	publishscheduleMixinFields1 := publishscheduleMixin[1].Fields()
+	_ = publishscheduleMixinFields1
+	publishscheduleMixinFields2 := publishscheduleMixin[2].Fields()
+	_ = publishscheduleMixinFields2
+	publishscheduleFields := schema.PublishSchedule{}.Fields()
+	_ = publishscheduleFields
+	// publishscheduleDescCreatedAt is the schema descriptor for created_at field.
+	publishscheduleDescCreatedAt := publishscheduleMixinFields1[0].Descriptor()
+	// publishschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	publishschedule.DefaultCreatedAt = publishscheduleDescCreatedAt.Default.(func() time.Time)
+	// publishscheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	publishscheduleDescUpdatedAt := publishscheduleMixinFields2[0].Descriptor()
+	// publishschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	publishschedule.DefaultUpdatedAt = publishscheduleDescUpdatedAt.Default.(func() time.Time)
+	// publishschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	publishschedule.UpdateDefaultUpdatedAt = publishscheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// publishscheduleDescAttempts is the schema descriptor for attempts field.
+	publishscheduleDescAttempts := publishscheduleFields[4].Descriptor()
+	// publishschedule.DefaultAttempts holds the default value on creation for the attempts field.
+	publishschedule.DefaultAttempts = publishscheduleDescAttempts.Default.(int)
+	// publishscheduleDescID is the schema descriptor for id field.
+	publishscheduleDescID := publishscheduleMixinFields0[0].Descriptor()
+	// publishschedule.DefaultID holds the default value on creation for the id field.
+	publishschedule.DefaultID = publishscheduleDescID.Default.(func() xid.ID)
+	// publishschedule.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	publishschedule.IDValidator = func() func(string) error {
+		validators := publishscheduleDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	questionMixin := schema.Question{}.Mixin()
 	questionMixinFields0 := questionMixin[0].Fields()
 	_ = questionMixinFields0
@@ -1145,4 +1675,94 @@ func init() {
 			return nil
 		}
 	}()
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhookMixinFields0 := webhookMixin[0].Fields()
+	_ = webhookMixinFields0
+	webhookMixinFields1 := webhookMixin[1].Fields()
+	_ = webhookMixinFields1
+	webhookMixinFields2 := webhookMixin[2].Fields()
+	_ = webhookMixinFields2
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookMixinFields1[0].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookMixinFields2[0].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[4].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookMixinFields0[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() xid.ID)
+	// webhook.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhook.IDValidator = func() func(string) error {
+		validators := webhookDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryMixinFields1 := webhookdeliveryMixin[1].Fields()
+	_ = webhookdeliveryMixinFields1
+	webhookdeliveryMixinFields2 := webhookdeliveryMixin[2].Fields()
+	_ = webhookdeliveryMixinFields2
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryMixinFields1[0].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryMixinFields2[0].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescStatus is the schema descriptor for status field.
+	webhookdeliveryDescStatus := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.DefaultStatus holds the default value on creation for the status field.
+	webhookdelivery.DefaultStatus = webhookdeliveryDescStatus.Default.(string)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() xid.ID)
+	// webhookdelivery.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhookdelivery.IDValidator = func() func(string) error {
+		validators := webhookdeliveryDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 }
@@ -40,6 +40,32 @@ func (Account) Fields() []ent.Field {
 			GoType(xid.ID{}).
 			Optional().
 			Nillable(),
+
+		// Suspension details, only meaningful while deleted_at is set.
+		field.String("suspended_reason").
+			Optional().
+			Nillable(),
+		field.Time("suspended_until").
+			Optional().
+			Nillable(),
+		field.String("suspended_by_id").
+			GoType(xid.ID{}).
+			Optional().
+			Nillable(),
+
+		// Set when the member asks for their account to be deleted, it's
+		// deleted once this time passes unless the member cancels it first.
+		field.Time("deletion_scheduled_at").
+			Optional().
+			Nillable(),
+
+		// Secret for the account's personal calendar feed, which calendar
+		// clients fetch without a session. Rotating it revokes old links.
+		field.String("calendar_token").
+			Optional().
+			Nillable().
+			Unique().
+			Sensitive(),
 	}
 }
 
@@ -114,5 +140,39 @@ func (Account) Edges() []ent.Edge {
 
 		edge.To("handled_reports", Report.Type).
 			Annotations(entsql.OnDelete(entsql.SetNull)),
+
+		edge.To("audit_logs", AuditLog.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+
+		edge.To("post_revisions", PostRevision.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+
+		edge.To("node_revisions", NodeRevision.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+
+		edge.To("notification_preferences", NotificationPreference.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("notification_digest", NotificationDigest.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("poll_votes", PollVote.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("conversations", ConversationParticipant.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("conversation_messages", ConversationMessage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("blocks", AccountBlock.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("blocked_by", AccountBlock.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("publish_schedules", PublishSchedule.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("actor_id").
+			GoType(xid.ID{}).
+			Optional().
+			Nillable().
+			Comment("The account which performed the action, null when performed by the system."),
+
+		field.String("action"),
+
+		field.String("target_id").
+			GoType(xid.ID{}).
+			Optional().
+			Nillable().
+			Comment("The ID of the resource acted upon. This is not a foreign key as entries can refer to a variety of sources, discriminated by the 'target_kind' field."),
+
+		field.String("target_kind").
+			Optional().
+			Nillable().
+			Comment("The datagraph kind of resource acted upon."),
+
+		field.Text("before").
+			Optional().
+			Nillable(),
+
+		field.Text("after").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (AuditLog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("actor", Account.Type).
+			Field("actor_id").
+			Ref("audit_logs").
+			Unique(),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("action"),
+		index.Fields("target_id"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// AccountBlock records that one account does not want to be contacted by
+// another. Blocks are one-directional but enforced in both directions.
+type AccountBlock struct {
+	ent.Schema
+}
+
+func (AccountBlock) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (AccountBlock) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("blocked_account_id").GoType(xid.ID{}),
+	}
+}
+
+func (AccountBlock) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("blocks").
+			Field("account_id").
+			Unique().
+			Required(),
+
+		edge.From("blocked", Account.Type).
+			Ref("blocked_by").
+			Field("blocked_account_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (AccountBlock) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "blocked_account_id").
+			Unique().
+			StorageKey("unique_block_pair"),
+	}
+}
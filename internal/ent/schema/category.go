@@ -25,6 +25,8 @@ func (Category) Fields() []ent.Field {
 		field.String("colour").Default("#8577ce"),
 		field.Int("sort").Default(-1),
 		field.Bool("admin").Default(false),
+		field.Bool("qa_mode").Default(false).
+			Comment("Threads are treated as questions which may have an accepted answer."),
 		field.String("parent_category_id").
 			GoType(xid.ID{}).
 			Optional(),
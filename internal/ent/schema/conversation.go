@@ -0,0 +1,125 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// Conversation is a private message thread between a small set of accounts.
+type Conversation struct {
+	ent.Schema
+}
+
+func (Conversation) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (Conversation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title").Optional().Nillable(),
+		field.Time("last_message_at").Default(time.Now).
+			Comment("Denormalised for ordering conversation lists by recent activity."),
+	}
+}
+
+func (Conversation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("participants", ConversationParticipant.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("messages", ConversationMessage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Conversation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("last_message_at"),
+	}
+}
+
+type ConversationParticipant struct {
+	ent.Schema
+}
+
+func (ConversationParticipant) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (ConversationParticipant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("conversation_id").GoType(xid.ID{}),
+		field.String("account_id").GoType(xid.ID{}),
+		field.Time("last_read_at").Optional().Nillable(),
+	}
+}
+
+func (ConversationParticipant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("conversation", Conversation.Type).
+			Ref("participants").
+			Field("conversation_id").
+			Unique().
+			Required(),
+
+		edge.From("account", Account.Type).
+			Ref("conversations").
+			Field("account_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (ConversationParticipant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("conversation_id", "account_id").
+			Unique().
+			StorageKey("unique_conversation_participant"),
+		index.Fields("account_id"),
+	}
+}
+
+type ConversationMessage struct {
+	ent.Schema
+}
+
+func (ConversationMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (ConversationMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("conversation_id").GoType(xid.ID{}),
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("body"),
+		field.String("short"),
+	}
+}
+
+func (ConversationMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("conversation", Conversation.Type).
+			Ref("messages").
+			Field("conversation_id").
+			Unique().
+			Required(),
+
+		edge.From("author", Account.Type).
+			Ref("conversation_messages").
+			Field("account_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (ConversationMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("conversation_id", "created_at"),
+	}
+}
@@ -67,6 +67,11 @@ func (Event) Fields() []ent.Field {
 
 		field.JSON("metadata", map[string]any{}).
 			Optional(),
+
+		// Incremented on every change so calendar clients which subscribed
+		// to the event replace their copy instead of keeping a stale one.
+		field.Int("sequence").
+			Default(0),
 	}
 }
 
@@ -23,6 +23,21 @@ func (Invitation) Fields() []ent.Field {
 
 		field.String("creator_account_id").
 			GoType(xid.ID{}),
+
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+
+		field.Int("max_uses").
+			Optional().
+			Nillable(),
+
+		field.Int("uses").
+			Default(0),
+
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -35,5 +50,7 @@ func (Invitation) Edges() []ent.Edge {
 			Required(),
 
 		edge.To("invited", Account.Type),
+
+		edge.To("roles", Role.Type),
 	}
 }
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// NodeRevision is a snapshot of a library node's name and content as it was
+// before an edit was applied. The live node row always holds the current one.
+type NodeRevision struct {
+	ent.Schema
+}
+
+func (NodeRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (NodeRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("node_id").GoType(xid.ID{}),
+		field.String("account_id").
+			GoType(xid.ID{}).
+			Optional().
+			Nillable().
+			Comment("The account which made the edit that caused this snapshot, cleared if that account is deleted."),
+		field.String("name"),
+		field.Text("content").Optional().Nillable(),
+	}
+}
+
+func (NodeRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("node_id", "created_at"),
+	}
+}
+
+func (NodeRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("node", Node.Type).
+			Ref("revisions").
+			Field("node_id").
+			Unique().
+			Required(),
+
+		edge.From("author", Account.Type).
+			Ref("node_revisions").
+			Field("account_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// NotificationDigest is a member's subscription to the periodic digest email.
+// Accounts without one, or with a frequency of "off", are never sent digests.
+type NotificationDigest struct {
+	ent.Schema
+}
+
+func (NotificationDigest) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (NotificationDigest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}).Unique(),
+		field.String("frequency"),
+		field.Time("last_sent_at").Optional().Nillable(),
+	}
+}
+
+func (NotificationDigest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("notification_digest").
+			Field("account_id").
+			Unique().
+			Required(),
+
+		edge.To("items", NotificationDigestItem.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// NotificationDigestItem records a notification or thread which was included
+// in a digest so the same item is never sent to the same member twice.
+type NotificationDigestItem struct {
+	ent.Schema
+}
+
+func (NotificationDigestItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (NotificationDigestItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("digest_id").GoType(xid.ID{}),
+		field.String("item_kind"),
+		field.String("item_id").GoType(xid.ID{}),
+	}
+}
+
+func (NotificationDigestItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("digest", NotificationDigest.Type).
+			Ref("items").
+			Field("digest_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (NotificationDigestItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("digest_id", "item_kind", "item_id").
+			Unique().
+			StorageKey("unique_notification_digest_item"),
+	}
+}
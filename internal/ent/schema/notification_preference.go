@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// NotificationPreference stores how a member wants to receive one kind of
+// notification event. Events without a row use the default delivery method.
+type NotificationPreference struct {
+	ent.Schema
+}
+
+func (NotificationPreference) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_id").GoType(xid.ID{}),
+		field.String("event_type"),
+		field.String("delivery"),
+	}
+}
+
+func (NotificationPreference) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("notification_preferences").
+			Field("account_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (NotificationPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "event_type").
+			Unique().
+			StorageKey("unique_notification_preference"),
+	}
+}
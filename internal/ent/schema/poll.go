@@ -0,0 +1,122 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// Poll is attached to a thread and holds a set of options members vote on.
+type Poll struct {
+	ent.Schema
+}
+
+func (Poll) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (Poll) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("post_id").GoType(xid.ID{}).Unique(),
+		field.Int("max_choices").Default(1).
+			Comment("How many options each account may vote for, 1 is a single choice poll."),
+		field.Bool("anonymous").Default(false).
+			Comment("Anonymous polls only expose vote counts, not who voted."),
+		field.Time("closes_at").Optional().Nillable(),
+	}
+}
+
+func (Poll) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).
+			Ref("poll").
+			Field("post_id").
+			Unique().
+			Required(),
+
+		edge.To("options", PollOption.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("votes", PollVote.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+type PollOption struct {
+	ent.Schema
+}
+
+func (PollOption) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (PollOption) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("poll_id").GoType(xid.ID{}),
+		field.String("text"),
+		field.Int("sort").Default(0),
+	}
+}
+
+func (PollOption) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("poll", Poll.Type).
+			Ref("options").
+			Field("poll_id").
+			Unique().
+			Required(),
+
+		edge.To("votes", PollVote.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+type PollVote struct {
+	ent.Schema
+}
+
+func (PollVote) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (PollVote) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("poll_id").GoType(xid.ID{}),
+		field.String("option_id").GoType(xid.ID{}),
+		field.String("account_id").GoType(xid.ID{}),
+	}
+}
+
+func (PollVote) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("poll", Poll.Type).
+			Ref("votes").
+			Field("poll_id").
+			Unique().
+			Required(),
+
+		edge.From("option", PollOption.Type).
+			Ref("votes").
+			Field("option_id").
+			Unique().
+			Required(),
+
+		edge.From("account", Account.Type).
+			Ref("poll_votes").
+			Field("account_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (PollVote) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("option_id", "account_id").
+			Unique().
+			StorageKey("unique_poll_vote"),
+		index.Fields("poll_id", "account_id"),
+	}
+}
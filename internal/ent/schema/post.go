@@ -24,6 +24,13 @@ func (Post) Fields() []ent.Field {
 		field.String("slug").Optional(),
 		field.Bool("pinned").Default(false),
 		field.Time("last_reply_at"),
+		field.Time("locked_at").Optional().Nillable().
+			Comment("When set, the thread no longer accepts replies or reactions from members."),
+		field.String("locked_by_id").GoType(xid.ID{}).Optional().Nillable().
+			Comment("The account which locked the thread, empty when locked automatically."),
+		field.String("lock_reason").Optional().Nillable(),
+		field.String("accepted_answer_id").GoType(xid.ID{}).Optional().Nillable().
+			Comment("The reply marked as the answer, only used in Q&A categories."),
 
 		// child posts
 		field.String("root_post_id").GoType(xid.ID{}).Optional().Nillable(),
@@ -118,5 +125,13 @@ func (Post) Edges() []ent.Edge {
 
 		edge.To("post_reads", PostRead.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("revisions", PostRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("poll", Poll.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Polls are only attached to root posts."),
 	}
 }
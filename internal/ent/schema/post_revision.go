@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// PostRevision is a snapshot of a post's title and content as it was before an
+// edit was applied. The live post row always holds the current version.
+type PostRevision struct {
+	ent.Schema
+}
+
+func (PostRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}}
+}
+
+func (PostRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("post_id").GoType(xid.ID{}),
+		field.String("account_id").
+			GoType(xid.ID{}).
+			Optional().
+			Nillable().
+			Comment("The account which made the edit that caused this snapshot, cleared if that account is deleted."),
+		field.String("title").Optional(),
+		field.Text("body"),
+	}
+}
+
+func (PostRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("post_id", "created_at"),
+	}
+}
+
+func (PostRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).
+			Ref("revisions").
+			Field("post_id").
+			Unique().
+			Required(),
+
+		edge.From("author", Account.Type).
+			Ref("post_revisions").
+			Field("account_id").
+			Unique(),
+	}
+}
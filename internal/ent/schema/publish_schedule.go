@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// PublishSchedule is a pending request to publish a thread, library page or
+// event at a future time. Rows are removed once the item has been published.
+// Failed attempts are retried with a backoff until too many have failed, then
+// the row is kept with failed_at set so the member can see what happened.
+type PublishSchedule struct {
+	ent.Schema
+}
+
+func (PublishSchedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (PublishSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("target_id").GoType(xid.ID{}),
+		field.String("target_kind"),
+		field.Time("publish_at"),
+		field.String("account_id").GoType(xid.ID{}),
+		field.Int("attempts").Default(0),
+		field.Time("next_attempt_at").Optional().Nillable(),
+		field.String("last_error").Optional().Nillable(),
+		field.Time("failed_at").Optional().Nillable(),
+	}
+}
+
+func (PublishSchedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("publish_schedules").
+			Field("account_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (PublishSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_id").Unique(),
+		index.Fields("publish_at"),
+	}
+}
@@ -30,5 +30,8 @@ func (Role) Edges() []ent.Edge {
 		edge.To("accounts", Account.Type).
 			Through("account_roles", AccountRoles.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.From("invitations", Invitation.Type).
+			Ref("roles"),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/rs/xid"
 )
 
@@ -28,6 +29,24 @@ func (Session) Fields() []ent.Field {
 		field.Time("revoked_at").
 			Optional().
 			Nillable(),
+
+		field.String("user_agent").
+			Optional().
+			Nillable(),
+
+		field.String("ip_address").
+			Optional().
+			Nillable(),
+
+		field.Time("last_seen_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id"),
 	}
 }
 
@@ -17,8 +17,16 @@ func (Tag) Mixin() []ent.Mixin {
 func (Tag) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").
-			Unique().
-			Immutable(),
+			Unique(),
+
+		field.String("description").
+			Optional().
+			Nillable(),
+
+		// When unset, a colour is derived from the tag's name.
+		field.String("colour").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{Identifier{}, CreatedAt{}, UpdatedAt{}}
+}
+
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+
+		field.String("url"),
+
+		field.String("secret").
+			Sensitive().
+			Comment("The shared secret used to sign delivery payloads with HMAC-SHA256."),
+
+		field.JSON("event_types", []string{}).
+			Comment("The event types this webhook is subscribed to."),
+
+		field.Bool("enabled").
+			Default(true),
+	}
+}
+
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
@@ -66,6 +66,16 @@ func Name(v string) predicate.Tag {
 	return predicate.Tag(sql.FieldEQ(FieldName, v))
 }
 
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldDescription, v))
+}
+
+// Colour applies equality check predicate on the "colour" field. It's identical to ColourEQ.
+func Colour(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldColour, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Tag {
 	return predicate.Tag(sql.FieldEQ(FieldCreatedAt, v))
@@ -171,6 +181,156 @@ func NameContainsFold(v string) predicate.Tag {
 	return predicate.Tag(sql.FieldContainsFold(FieldName, v))
 }
 
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Tag {
+	return predicate.Tag(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Tag {
+	return predicate.Tag(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Tag {
+	return predicate.Tag(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Tag {
+	return predicate.Tag(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ColourEQ applies the EQ predicate on the "colour" field.
+func ColourEQ(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldColour, v))
+}
+
+// ColourNEQ applies the NEQ predicate on the "colour" field.
+func ColourNEQ(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldNEQ(FieldColour, v))
+}
+
+// ColourIn applies the In predicate on the "colour" field.
+func ColourIn(vs ...string) predicate.Tag {
+	return predicate.Tag(sql.FieldIn(FieldColour, vs...))
+}
+
+// ColourNotIn applies the NotIn predicate on the "colour" field.
+func ColourNotIn(vs ...string) predicate.Tag {
+	return predicate.Tag(sql.FieldNotIn(FieldColour, vs...))
+}
+
+// ColourGT applies the GT predicate on the "colour" field.
+func ColourGT(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldGT(FieldColour, v))
+}
+
+// ColourGTE applies the GTE predicate on the "colour" field.
+func ColourGTE(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldGTE(FieldColour, v))
+}
+
+// ColourLT applies the LT predicate on the "colour" field.
+func ColourLT(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldLT(FieldColour, v))
+}
+
+// ColourLTE applies the LTE predicate on the "colour" field.
+func ColourLTE(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldLTE(FieldColour, v))
+}
+
+// ColourContains applies the Contains predicate on the "colour" field.
+func ColourContains(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldContains(FieldColour, v))
+}
+
+// ColourHasPrefix applies the HasPrefix predicate on the "colour" field.
+func ColourHasPrefix(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldHasPrefix(FieldColour, v))
+}
+
+// ColourHasSuffix applies the HasSuffix predicate on the "colour" field.
+func ColourHasSuffix(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldHasSuffix(FieldColour, v))
+}
+
+// ColourIsNil applies the IsNil predicate on the "colour" field.
+func ColourIsNil() predicate.Tag {
+	return predicate.Tag(sql.FieldIsNull(FieldColour))
+}
+
+// ColourNotNil applies the NotNil predicate on the "colour" field.
+func ColourNotNil() predicate.Tag {
+	return predicate.Tag(sql.FieldNotNull(FieldColour))
+}
+
+// ColourEqualFold applies the EqualFold predicate on the "colour" field.
+func ColourEqualFold(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEqualFold(FieldColour, v))
+}
+
+// ColourContainsFold applies the ContainsFold predicate on the "colour" field.
+func ColourContainsFold(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldContainsFold(FieldColour, v))
+}
+
 // HasPosts applies the HasEdge predicate on the "posts" edge.
 func HasPosts() predicate.Tag {
 	return predicate.Tag(func(s *sql.Selector) {
@@ -47,6 +47,34 @@ func (_c *TagCreate) SetName(v string) *TagCreate {
 	return _c
 }
 
+// SetDescription sets the "description" field.
+func (_c *TagCreate) SetDescription(v string) *TagCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *TagCreate) SetNillableDescription(v *string) *TagCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetColour sets the "colour" field.
+func (_c *TagCreate) SetColour(v string) *TagCreate {
+	_c.mutation.SetColour(v)
+	return _c
+}
+
+// SetNillableColour sets the "colour" field if the given value is not nil.
+func (_c *TagCreate) SetNillableColour(v *string) *TagCreate {
+	if v != nil {
+		_c.SetColour(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TagCreate) SetID(v xid.ID) *TagCreate {
 	_c.mutation.SetID(v)
@@ -208,6 +236,14 @@ func (_c *TagCreate) createSpec() (*Tag, *sqlgraph.CreateSpec) {
 		_spec.SetField(tag.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(tag.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.Colour(); ok {
+		_spec.SetField(tag.FieldColour, field.TypeString, value)
+		_node.Colour = &value
+	}
 	if nodes := _c.mutation.PostsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -308,6 +344,54 @@ type (
 	}
 )
 
+// SetName sets the "name" field.
+func (u *TagUpsert) SetName(v string) *TagUpsert {
+	u.Set(tag.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagUpsert) UpdateName() *TagUpsert {
+	u.SetExcluded(tag.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *TagUpsert) SetDescription(v string) *TagUpsert {
+	u.Set(tag.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TagUpsert) UpdateDescription() *TagUpsert {
+	u.SetExcluded(tag.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TagUpsert) ClearDescription() *TagUpsert {
+	u.SetNull(tag.FieldDescription)
+	return u
+}
+
+// SetColour sets the "colour" field.
+func (u *TagUpsert) SetColour(v string) *TagUpsert {
+	u.Set(tag.FieldColour, v)
+	return u
+}
+
+// UpdateColour sets the "colour" field to the value that was provided on create.
+func (u *TagUpsert) UpdateColour() *TagUpsert {
+	u.SetExcluded(tag.FieldColour)
+	return u
+}
+
+// ClearColour clears the value of the "colour" field.
+func (u *TagUpsert) ClearColour() *TagUpsert {
+	u.SetNull(tag.FieldColour)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -328,9 +412,6 @@ func (u *TagUpsertOne) UpdateNewValues() *TagUpsertOne {
 		if _, exists := u.create.mutation.CreatedAt(); exists {
 			s.SetIgnore(tag.FieldCreatedAt)
 		}
-		if _, exists := u.create.mutation.Name(); exists {
-			s.SetIgnore(tag.FieldName)
-		}
 	}))
 	return u
 }
@@ -362,6 +443,62 @@ func (u *TagUpsertOne) Update(set func(*TagUpsert)) *TagUpsertOne {
 	return u
 }
 
+// SetName sets the "name" field.
+func (u *TagUpsertOne) SetName(v string) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdateName() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TagUpsertOne) SetDescription(v string) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdateDescription() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TagUpsertOne) ClearDescription() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetColour sets the "colour" field.
+func (u *TagUpsertOne) SetColour(v string) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetColour(v)
+	})
+}
+
+// UpdateColour sets the "colour" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdateColour() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateColour()
+	})
+}
+
+// ClearColour clears the value of the "colour" field.
+func (u *TagUpsertOne) ClearColour() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearColour()
+	})
+}
+
 // Exec executes the query.
 func (u *TagUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -548,9 +685,6 @@ func (u *TagUpsertBulk) UpdateNewValues() *TagUpsertBulk {
 			if _, exists := b.mutation.CreatedAt(); exists {
 				s.SetIgnore(tag.FieldCreatedAt)
 			}
-			if _, exists := b.mutation.Name(); exists {
-				s.SetIgnore(tag.FieldName)
-			}
 		}
 	}))
 	return u
@@ -583,6 +717,62 @@ func (u *TagUpsertBulk) Update(set func(*TagUpsert)) *TagUpsertBulk {
 	return u
 }
 
+// SetName sets the "name" field.
+func (u *TagUpsertBulk) SetName(v string) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdateName() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TagUpsertBulk) SetDescription(v string) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdateDescription() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TagUpsertBulk) ClearDescription() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetColour sets the "colour" field.
+func (u *TagUpsertBulk) SetColour(v string) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetColour(v)
+	})
+}
+
+// UpdateColour sets the "colour" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdateColour() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateColour()
+	})
+}
+
+// ClearColour clears the value of the "colour" field.
+func (u *TagUpsertBulk) ClearColour() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearColour()
+	})
+}
+
 // Exec executes the query.
 func (u *TagUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -32,6 +32,60 @@ func (_u *TagUpdate) Where(ps ...predicate.Tag) *TagUpdate {
 	return _u
 }
 
+// SetName sets the "name" field.
+func (_u *TagUpdate) SetName(v string) *TagUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TagUpdate) SetNillableName(v *string) *TagUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TagUpdate) SetDescription(v string) *TagUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TagUpdate) SetNillableDescription(v *string) *TagUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TagUpdate) ClearDescription() *TagUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetColour sets the "colour" field.
+func (_u *TagUpdate) SetColour(v string) *TagUpdate {
+	_u.mutation.SetColour(v)
+	return _u
+}
+
+// SetNillableColour sets the "colour" field if the given value is not nil.
+func (_u *TagUpdate) SetNillableColour(v *string) *TagUpdate {
+	if v != nil {
+		_u.SetColour(*v)
+	}
+	return _u
+}
+
+// ClearColour clears the value of the "colour" field.
+func (_u *TagUpdate) ClearColour() *TagUpdate {
+	_u.mutation.ClearColour()
+	return _u
+}
+
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
 func (_u *TagUpdate) AddPostIDs(ids ...xid.ID) *TagUpdate {
 	_u.mutation.AddPostIDs(ids...)
@@ -187,6 +241,21 @@ func (_u *TagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tag.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(tag.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(tag.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Colour(); ok {
+		_spec.SetField(tag.FieldColour, field.TypeString, value)
+	}
+	if _u.mutation.ColourCleared() {
+		_spec.ClearField(tag.FieldColour, field.TypeString)
+	}
 	if _u.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -344,6 +413,60 @@ type TagUpdateOne struct {
 	modifiers []func(*sql.UpdateBuilder)
 }
 
+// SetName sets the "name" field.
+func (_u *TagUpdateOne) SetName(v string) *TagUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableName(v *string) *TagUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TagUpdateOne) SetDescription(v string) *TagUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableDescription(v *string) *TagUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TagUpdateOne) ClearDescription() *TagUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetColour sets the "colour" field.
+func (_u *TagUpdateOne) SetColour(v string) *TagUpdateOne {
+	_u.mutation.SetColour(v)
+	return _u
+}
+
+// SetNillableColour sets the "colour" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableColour(v *string) *TagUpdateOne {
+	if v != nil {
+		_u.SetColour(*v)
+	}
+	return _u
+}
+
+// ClearColour clears the value of the "colour" field.
+func (_u *TagUpdateOne) ClearColour() *TagUpdateOne {
+	_u.mutation.ClearColour()
+	return _u
+}
+
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
 func (_u *TagUpdateOne) AddPostIDs(ids ...xid.ID) *TagUpdateOne {
 	_u.mutation.AddPostIDs(ids...)
@@ -529,6 +652,21 @@ func (_u *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tag.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(tag.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(tag.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Colour(); ok {
+		_spec.SetField(tag.FieldColour, field.TypeString, value)
+	}
+	if _u.mutation.ColourCleared() {
+		_spec.ClearField(tag.FieldColour, field.TypeString)
+	}
 	if _u.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -0,0 +1,309 @@
+// Package bbcode converts the BBCode markup used by classic forum software to
+// HTML. It supports the common subset shared by phpBB, vBulletin, SMF and MyBB.
+// Styling that has no equivalent in Storyden's content model (colours, sizes,
+// fonts, alignment) is dropped and the text inside it is kept. Anything that
+// doesn't parse as a known, balanced tag is rendered as literal text.
+package bbcode
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var tagPattern = regexp.MustCompile(`\[(/?)([a-zA-Z*][a-zA-Z0-9]*)(?:=("[^"\]]*"|[^\]]*))?\]`)
+
+type tagKind int
+
+const (
+	kindInline tagKind = iota // wraps children in an element
+	kindBlock                 // wraps children in a block element
+	kindStrip                 // renders children without any element
+	kindRaw                   // renders the verbatim inner text
+)
+
+type tagDef struct {
+	kind tagKind
+	open func(arg string, inner string) string
+	end  string
+}
+
+var tags = map[string]tagDef{
+	"b":      {kind: kindInline, open: static("<strong>"), end: "</strong>"},
+	"i":      {kind: kindInline, open: static("<em>"), end: "</em>"},
+	"u":      {kind: kindInline, open: static("<u>"), end: "</u>"},
+	"s":      {kind: kindInline, open: static("<s>"), end: "</s>"},
+	"strike": {kind: kindInline, open: static("<s>"), end: "</s>"},
+	"sub":    {kind: kindInline, open: static("<sub>"), end: "</sub>"},
+	"sup":    {kind: kindInline, open: static("<sup>"), end: "</sup>"},
+	"url":    {kind: kindInline, open: openLink, end: "</a>"},
+	"email":  {kind: kindInline, open: openEmail, end: "</a>"},
+	"quote":  {kind: kindBlock, open: openQuote, end: "</blockquote>"},
+	"list":   {kind: kindBlock, open: openList},
+	"*":      {kind: kindBlock, open: static("<li>"), end: "</li>"},
+	"code":   {kind: kindRaw},
+	"img":    {kind: kindRaw},
+	"color":  {kind: kindStrip},
+	"colour": {kind: kindStrip},
+	"size":   {kind: kindStrip},
+	"font":   {kind: kindStrip},
+	"center": {kind: kindStrip},
+	"left":   {kind: kindStrip},
+	"right":  {kind: kindStrip},
+	"align":  {kind: kindStrip},
+}
+
+type node struct {
+	tag      string
+	arg      string
+	raw      string // the source text of the opening tag
+	text     string
+	children []*node
+}
+
+// ToHTML converts a BBCode document to an HTML fragment.
+func ToHTML(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+
+	root := parse(s)
+
+	var sb strings.Builder
+	render(&sb, root)
+	return sb.String()
+}
+
+func parse(s string) *node {
+	root := &node{}
+	stack := []*node{root}
+	top := func() *node { return stack[len(stack)-1] }
+
+	for len(s) > 0 {
+		loc := tagPattern.FindStringSubmatchIndex(s)
+		if loc == nil {
+			top().children = append(top().children, &node{text: s})
+			break
+		}
+
+		if loc[0] > 0 {
+			top().children = append(top().children, &node{text: s[:loc[0]]})
+		}
+
+		raw := s[loc[0]:loc[1]]
+		closing := s[loc[2]:loc[3]] == "/"
+		name := strings.ToLower(s[loc[4]:loc[5]])
+		arg := ""
+		if loc[6] >= 0 {
+			arg = strings.Trim(s[loc[6]:loc[7]], `"`)
+		}
+		s = s[loc[1]:]
+
+		def, known := tags[name]
+		if !known {
+			top().children = append(top().children, &node{text: raw})
+			continue
+		}
+
+		if closing {
+			idx := -1
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].tag == name {
+					idx = i
+					break
+				}
+			}
+			if idx == -1 {
+				top().children = append(top().children, &node{text: raw})
+				continue
+			}
+			stack = stack[:idx]
+			continue
+		}
+
+		// A list item implicitly ends the previous one.
+		if name == "*" {
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].tag == "*" {
+					stack = stack[:i]
+					break
+				}
+				if stack[i].tag == "list" {
+					break
+				}
+			}
+		}
+
+		n := &node{tag: name, arg: arg, raw: raw}
+		top().children = append(top().children, n)
+
+		if def.kind == kindRaw {
+			// Raw tags swallow everything up to their closing tag verbatim.
+			end := "[/" + name + "]"
+			i := strings.Index(strings.ToLower(s), end)
+			if i == -1 {
+				n.tag = ""
+				n.text = raw
+				continue
+			}
+			n.children = []*node{{text: s[:i]}}
+			s = s[i+len(end):]
+			continue
+		}
+
+		stack = append(stack, n)
+	}
+
+	return root
+}
+
+func render(sb *strings.Builder, n *node) {
+	if n.tag == "" && n.children == nil {
+		sb.WriteString(text(n.text))
+		return
+	}
+
+	def := tags[n.tag]
+
+	switch def.kind {
+	case kindRaw:
+		inner := innerText(n)
+		switch n.tag {
+		case "code":
+			sb.WriteString("<pre><code>" + html.EscapeString(strings.Trim(inner, "\n")) + "</code></pre>")
+		case "img":
+			src := strings.TrimSpace(inner)
+			if safeURL(src) {
+				sb.WriteString(`<img src="` + html.EscapeString(src) + `" />`)
+			} else {
+				sb.WriteString(text(src))
+			}
+		}
+		return
+
+	case kindStrip:
+		renderChildren(sb, n, false)
+		return
+	}
+
+	if n.tag == "" {
+		renderChildren(sb, n, false)
+		return
+	}
+
+	open := def.open(n.arg, innerText(n))
+	if open == "" {
+		// The tag can't be represented, such as a link to an unsafe scheme.
+		renderChildren(sb, n, false)
+		return
+	}
+
+	sb.WriteString(open)
+	renderChildren(sb, n, def.kind == kindBlock)
+	if n.tag == "list" {
+		sb.WriteString(listEnd(n.arg))
+	} else {
+		sb.WriteString(def.end)
+	}
+}
+
+func renderChildren(sb *strings.Builder, n *node, block bool) {
+	for i, c := range n.children {
+		if c.tag == "" && c.children == nil {
+			t := c.text
+			if block {
+				// Line breaks directly inside block elements are formatting
+				// of the source rather than part of the content.
+				if i == 0 {
+					t = strings.TrimLeft(t, "\n")
+				}
+				if i == len(n.children)-1 {
+					t = strings.TrimRight(t, "\n")
+				}
+				if n.tag == "list" && strings.TrimSpace(t) == "" {
+					continue
+				}
+			}
+			sb.WriteString(text(t))
+			continue
+		}
+		render(sb, c)
+	}
+}
+
+func innerText(n *node) string {
+	var sb strings.Builder
+	for _, c := range n.children {
+		if c.tag == "" {
+			sb.WriteString(c.text)
+		} else {
+			sb.WriteString(innerText(c))
+		}
+	}
+	return sb.String()
+}
+
+func text(s string) string {
+	return strings.ReplaceAll(html.EscapeString(s), "\n", "<br />")
+}
+
+func static(s string) func(string, string) string {
+	return func(string, string) string { return s }
+}
+
+func openLink(arg, inner string) string {
+	href := arg
+	if href == "" {
+		href = strings.TrimSpace(inner)
+	}
+	if !safeURL(href) {
+		return ""
+	}
+	return `<a href="` + html.EscapeString(href) + `">`
+}
+
+func openEmail(arg, inner string) string {
+	addr := arg
+	if addr == "" {
+		addr = strings.TrimSpace(inner)
+	}
+	if !strings.Contains(addr, "@") || strings.ContainsAny(addr, ` "<>`) {
+		return ""
+	}
+	return `<a href="mailto:` + html.EscapeString(addr) + `">`
+}
+
+func openQuote(arg, _ string) string {
+	if arg == "" {
+		return "<blockquote>"
+	}
+	// Some platforms append post references to the author: "name, post:1".
+	author, _, _ := strings.Cut(arg, ",")
+	return "<blockquote><p><strong>" + html.EscapeString(strings.TrimSpace(author)) + "</strong> wrote:</p>"
+}
+
+func openList(arg, _ string) string {
+	if arg == "" {
+		return "<ul>"
+	}
+	return "<ol>"
+}
+
+func listEnd(arg string) string {
+	if arg == "" {
+		return "</ul>"
+	}
+	return "</ol>"
+}
+
+func safeURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	switch u.Scheme {
+	case "http", "https", "mailto", "":
+		return s != ""
+	default:
+		return false
+	}
+}
@@ -0,0 +1,40 @@
+package bbcode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToHTML(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", "hello <world> & friends", "hello &lt;world&gt; &amp; friends"},
+		{"newlines", "one\ntwo", "one<br />two"},
+		{"inline", "[b]bold[/b] [I]italic[/I] [u]under[/u]", "<strong>bold</strong> <em>italic</em> <u>under</u>"},
+		{"nested", "[b]bold [i]both[/i][/b]", "<strong>bold <em>both</em></strong>"},
+		{"url_arg", "[url=https://storyden.org]Storyden[/url]", `<a href="https://storyden.org">Storyden</a>`},
+		{"url_bare", "[url]https://storyden.org[/url]", `<a href="https://storyden.org">https://storyden.org</a>`},
+		{"url_unsafe", "[url=javascript:alert(1)]click[/url]", "click"},
+		{"img", "[img]https://example.com/a.png[/img]", `<img src="https://example.com/a.png" />`},
+		{"code_is_verbatim", "[code]\n[b]not bold[/b] <tag>\n[/code]", "<pre><code>[b]not bold[/b] &lt;tag&gt;</code></pre>"},
+		{"quote", "[quote]hi[/quote]", "<blockquote>hi</blockquote>"},
+		{"quote_author", `[quote="odin, post:3"]hi[/quote]`, "<blockquote><p><strong>odin</strong> wrote:</p>hi</blockquote>"},
+		{"list", "[list]\n[*]one\n[*]two\n[/list]", "<ul><li>one</li><li>two</li></ul>"},
+		{"ordered_list", "[list=1][*]one[*]two[/list]", "<ol><li>one</li><li>two</li></ol>"},
+		{"styling_dropped", "[color=red]red[/color] [size=150]big[/size]", "red big"},
+		{"unknown_tag", "[spoiler]x[/spoiler]", "[spoiler]x[/spoiler]"},
+		{"unbalanced_close", "text[/b]", "text[/b]"},
+		{"unclosed_open", "[b]bold", "<strong>bold</strong>"},
+		{"unclosed_code", "[code]x", "[code]x"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, ToHTML(c.in))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package importer
+
+import (
+	"html"
+	"strings"
+
+	"github.com/Southclaws/fault"
+
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/post/reaction"
+	"github.com/Southclaws/storyden/internal/importer/bbcode"
+)
+
+func convert(format Format, body string) (datagraph.Content, error) {
+	switch format {
+	case FormatHTML:
+		return datagraph.NewRichText(body)
+
+	case FormatMarkdown:
+		return datagraph.NewRichTextFromMarkdown(body)
+
+	case FormatBBCode:
+		return datagraph.NewRichText(paragraphs(bbcode.ToHTML(body)))
+
+	case FormatText, "":
+		return datagraph.NewRichText(paragraphs(strings.ReplaceAll(html.EscapeString(body), "\n", "<br />")))
+
+	default:
+		return datagraph.Content{}, fault.Newf("unknown content format %q", format)
+	}
+}
+
+// paragraphs splits an HTML fragment where blank lines were turned into double
+// line breaks back into paragraphs, this gives a much better summary text.
+func paragraphs(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	parts := strings.Split(s, "<br /><br />")
+
+	var sb strings.Builder
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		for strings.HasPrefix(p, "<br />") {
+			p = strings.TrimSpace(strings.TrimPrefix(p, "<br />"))
+		}
+		for strings.HasSuffix(p, "<br />") {
+			p = strings.TrimSpace(strings.TrimSuffix(p, "<br />"))
+		}
+		if p == "" {
+			continue
+		}
+		sb.WriteString("<p>" + p + "</p>")
+	}
+	return "<body>" + sb.String() + "</body>"
+}
+
+// shortcodes covers the reactions that forum platforms offer by default, most
+// store the shortcode rather than the emoji character itself.
+var shortcodes = map[string]string{
+	"+1":               "👍",
+	"thumbsup":         "👍",
+	"-1":               "👎",
+	"thumbsdown":       "👎",
+	"heart":            "❤️",
+	"laughing":         "😆",
+	"joy":              "😂",
+	"smile":            "😄",
+	"slight_smile":     "🙂",
+	"grinning":         "😀",
+	"wink":             "😉",
+	"heart_eyes":       "😍",
+	"open_mouth":       "😮",
+	"astonished":       "😲",
+	"cry":              "😢",
+	"sob":              "😭",
+	"angry":            "😠",
+	"rage":             "😡",
+	"confused":         "😕",
+	"thinking":         "🤔",
+	"hugs":             "🤗",
+	"clap":             "👏",
+	"pray":             "🙏",
+	"wave":             "👋",
+	"tada":             "🎉",
+	"rocket":           "🚀",
+	"fire":             "🔥",
+	"eyes":             "👀",
+	"star":             "⭐",
+	"100":              "💯",
+	"white_check_mark": "✅",
+	"point_up":         "☝️",
+}
+
+// emoji resolves a reaction to an emoji character Storyden accepts.
+func emoji(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+
+	if e, ok := shortcodes[strings.ToLower(strings.Trim(s, ":"))]; ok {
+		return e, true
+	}
+
+	if len(s) < 2 {
+		return "", false
+	}
+
+	return reaction.IsValidEmoji(s)
+}
@@ -0,0 +1,259 @@
+// Package discourse reads a Discourse database export. Either a plain-format
+// pg_dump of the Discourse database or a JSON object mapping table names to
+// arrays of rows is accepted, the format is detected from the first byte.
+//
+// Topics become threads, the first post of a topic becomes the thread's body
+// and every other regular post becomes a reply. Private messages, whispers,
+// deleted content and small action posts ("closed this topic") are skipped.
+// Likes come from post_actions and reactions from the discourse-reactions
+// plugin tables when present.
+package discourse
+
+import (
+	"bufio"
+	"context"
+	"io"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/internal/importer"
+	"github.com/Southclaws/storyden/internal/importer/sqldump"
+)
+
+const SourceName = "discourse"
+
+const (
+	postTypeRegular    = 1
+	postActionTypeLike = 2
+)
+
+var tables = map[string]bool{
+	"users":                              true,
+	"user_emails":                        true,
+	"user_profiles":                      true,
+	"categories":                         true,
+	"topics":                             true,
+	"posts":                              true,
+	"tags":                               true,
+	"topic_tags":                         true,
+	"post_actions":                       true,
+	"discourse_reactions_reactions":      true,
+	"discourse_reactions_reaction_users": true,
+}
+
+type Source struct{}
+
+func New() *Source {
+	return &Source{}
+}
+
+func (s *Source) Read(ctx context.Context, r io.Reader) (*importer.Dump, error) {
+	br := bufio.NewReader(r)
+	keep := func(t string) bool { return tables[t] }
+
+	var (
+		t   sqldump.Tables
+		err error
+	)
+	if isJSON(br) {
+		t, err = sqldump.ReadJSON(br, keep)
+	} else {
+		t, err = sqldump.ReadPostgres(br, keep)
+	}
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return convert(t), nil
+}
+
+func isJSON(br *bufio.Reader) bool {
+	for {
+		b, err := br.Peek(1)
+		if err != nil {
+			return false
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			_, _ = br.ReadByte()
+			continue
+		}
+		return b[0] == '{'
+	}
+}
+
+func convert(t sqldump.Tables) *importer.Dump {
+	d := &importer.Dump{Source: SourceName}
+
+	emails := map[string]string{}
+	for _, e := range t["user_emails"] {
+		if e.Bool("primary") {
+			emails[e.String("user_id")] = e.String("email")
+		}
+	}
+
+	bios := map[string]string{}
+	for _, p := range t["user_profiles"] {
+		bios[p.String("user_id")] = p.String("bio_raw")
+	}
+
+	for _, u := range t["users"] {
+		id := u.String("id")
+		d.Accounts = append(d.Accounts, importer.Account{
+			ID:     id,
+			Handle: u.String("username"),
+			Name:   u.String("name"),
+			Email:  emails[id],
+			// Discourse only activates an account once its email is confirmed.
+			EmailVerified: u.Bool("active"),
+			Admin:         u.Bool("admin"),
+			Bio:           bios[id],
+			Format:        importer.FormatMarkdown,
+			CreatedAt:     u.Time("created_at"),
+		})
+	}
+
+	for _, c := range t["categories"] {
+		d.Categories = append(d.Categories, importer.Category{
+			ID:          c.String("id"),
+			Parent:      c.String("parent_category_id"),
+			Name:        c.String("name"),
+			Description: c.String("description"),
+			Colour:      c.String("color"),
+			Sort:        int(c.Int("position")),
+		})
+	}
+
+	tagNames := map[string]string{}
+	for _, tg := range t["tags"] {
+		tagNames[tg.String("id")] = tg.String("name")
+	}
+	topicTags := map[string][]string{}
+	for _, tt := range t["topic_tags"] {
+		if name, ok := tagNames[tt.String("tag_id")]; ok {
+			topic := tt.String("topic_id")
+			topicTags[topic] = append(topicTags[topic], name)
+		}
+	}
+
+	topics := map[string]sqldump.Row{}
+	for _, tp := range t["topics"] {
+		if tp.String("archetype") == "private_message" || !tp.Null("deleted_at") {
+			continue
+		}
+		topics[tp.String("id")] = tp
+	}
+
+	// Discourse refers to posts within a topic by their post number, which
+	// replies and likes need translating to post IDs.
+	type postKey struct{ topic, number string }
+	postIDs := map[postKey]string{}
+	firstPosts := map[string]sqldump.Row{}
+	postTopic := map[string]string{}
+	posts := []sqldump.Row{}
+
+	for _, p := range t["posts"] {
+		topic := p.String("topic_id")
+		if _, ok := topics[topic]; !ok || !p.Null("deleted_at") || p.Int("post_type") != postTypeRegular {
+			continue
+		}
+
+		postIDs[postKey{topic, p.String("post_number")}] = p.String("id")
+		postTopic[p.String("id")] = topic
+
+		if p.Int("post_number") == 1 {
+			firstPosts[topic] = p
+		} else {
+			posts = append(posts, p)
+		}
+	}
+
+	for _, tp := range t["topics"] {
+		id := tp.String("id")
+		if _, ok := topics[id]; !ok {
+			continue
+		}
+
+		body, format := "", importer.FormatMarkdown
+		if first, ok := firstPosts[id]; ok {
+			body, format = postBody(first)
+		}
+
+		d.Threads = append(d.Threads, importer.Thread{
+			ID:        id,
+			Category:  tp.String("category_id"),
+			Author:    tp.String("user_id"),
+			Title:     tp.String("title"),
+			Body:      body,
+			Format:    format,
+			Tags:      topicTags[id],
+			CreatedAt: tp.Time("created_at"),
+		})
+	}
+
+	for _, p := range posts {
+		topic := p.String("topic_id")
+
+		replyTo := ""
+		if n := p.String("reply_to_post_number"); n != "" && n != "1" {
+			replyTo = postIDs[postKey{topic, n}]
+		}
+
+		body, format := postBody(p)
+		d.Replies = append(d.Replies, importer.Reply{
+			ID:        p.String("id"),
+			Thread:    topic,
+			ReplyTo:   replyTo,
+			Author:    p.String("user_id"),
+			Body:      body,
+			Format:    format,
+			CreatedAt: p.Time("created_at"),
+		})
+	}
+
+	target := func(postID string) (importer.Target, bool) {
+		topic, ok := postTopic[postID]
+		if !ok {
+			return importer.Target{}, false
+		}
+		if first, ok := firstPosts[topic]; ok && first.String("id") == postID {
+			return importer.Target{Thread: topic}, true
+		}
+		return importer.Target{Reply: postID}, true
+	}
+
+	for _, a := range t["post_actions"] {
+		if a.Int("post_action_type_id") != postActionTypeLike || !a.Null("deleted_at") {
+			continue
+		}
+		if tg, ok := target(a.String("post_id")); ok {
+			d.Likes = append(d.Likes, importer.Like{Account: a.String("user_id"), Target: tg})
+		}
+	}
+
+	reactions := map[string]string{}
+	for _, re := range t["discourse_reactions_reactions"] {
+		reactions[re.String("id")] = re.String("reaction_value")
+	}
+	for _, ru := range t["discourse_reactions_reaction_users"] {
+		value, ok := reactions[ru.String("reaction_id")]
+		if !ok {
+			continue
+		}
+		if tg, ok := target(ru.String("post_id")); ok {
+			d.Reactions = append(d.Reactions, importer.Reaction{Account: ru.String("user_id"), Emoji: value, Target: tg})
+		}
+	}
+
+	return d
+}
+
+// postBody prefers the raw Markdown a post was written in, falling back to the
+// rendered HTML for posts created by integrations that only set "cooked".
+func postBody(p sqldump.Row) (string, importer.Format) {
+	if raw := p.String("raw"); raw != "" {
+		return raw, importer.FormatMarkdown
+	}
+	return p.String("cooked"), importer.FormatHTML
+}
@@ -0,0 +1,107 @@
+package importer
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Source reads an export from another forum platform into a Dump. Adapters
+// only need to understand their own platform's data, the Importer handles
+// everything about writing it to Storyden.
+type Source interface {
+	Read(ctx context.Context, r io.Reader) (*Dump, error)
+}
+
+// Dump is a platform-neutral snapshot of a community. Every record carries the
+// identifier it had on the original platform, references between records use
+// those original identifiers rather than Storyden IDs.
+//
+// Records only need to be unique within their own kind: a thread and a reply
+// may share an ID, which is common as many platforms number topics and posts
+// in separate sequences.
+type Dump struct {
+	// Source names the platform the dump came from. It's stored alongside the
+	// original ID of every imported item so subsequent runs of the same dump
+	// skip items that were already imported.
+	Source string
+
+	Accounts   []Account
+	Categories []Category
+	Threads    []Thread
+	Replies    []Reply
+	Likes      []Like
+	Reactions  []Reaction
+}
+
+// Format describes how a piece of content is written.
+type Format string
+
+const (
+	FormatHTML     Format = "html"
+	FormatMarkdown Format = "markdown"
+	FormatBBCode   Format = "bbcode"
+	FormatText     Format = "text"
+)
+
+type Account struct {
+	ID            string    `json:"id"`
+	Handle        string    `json:"handle"`
+	Name          string    `json:"name,omitempty"`
+	Email         string    `json:"email,omitempty"`
+	EmailVerified bool      `json:"email_verified,omitempty"`
+	Admin         bool      `json:"admin,omitempty"`
+	Bio           string    `json:"bio,omitempty"`
+	Format        Format    `json:"format,omitempty"`
+	CreatedAt     time.Time `json:"created_at,omitempty"`
+}
+
+type Category struct {
+	ID          string `json:"id"`
+	Parent      string `json:"parent,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Colour      string `json:"colour,omitempty"`
+	Sort        int    `json:"sort,omitempty"`
+}
+
+type Thread struct {
+	ID        string    `json:"id"`
+	Category  string    `json:"category,omitempty"`
+	Author    string    `json:"author"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	Format    Format    `json:"format,omitempty"`
+	Tags      []string  `json:"tags,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+type Reply struct {
+	ID        string    `json:"id"`
+	Thread    string    `json:"thread"`
+	ReplyTo   string    `json:"reply_to,omitempty"`
+	Author    string    `json:"author"`
+	Body      string    `json:"body"`
+	Format    Format    `json:"format,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// Target points at the post a like or reaction was left on, which is either
+// the opening post of a thread or a reply. Exactly one should be set.
+type Target struct {
+	Thread string `json:"thread,omitempty"`
+	Reply  string `json:"reply,omitempty"`
+}
+
+type Like struct {
+	Account string `json:"account"`
+	Target
+}
+
+// Reaction holds an emoji either as the character itself or as a shortcode
+// such as "heart" or ":+1:", as most platforms store the latter.
+type Reaction struct {
+	Account string `json:"account"`
+	Emoji   string `json:"emoji"`
+	Target
+}
@@ -0,0 +1,719 @@
+// Package importer brings an existing community over from another platform.
+// Source adapters read a platform's export into a neutral Dump and the
+// Importer writes that to the database using the same resource writers the
+// rest of Storyden uses.
+//
+// Every imported account, category, thread and reply keeps the identifier it
+// had on the original platform in its metadata under the "import" key. These
+// are looked up at the start of each run so running the same import again, or
+// a newer export of the same community, only adds what's missing.
+package importer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/rs/xid"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/email"
+	"github.com/Southclaws/storyden/app/resources/like/like_writer"
+	"github.com/Southclaws/storyden/app/resources/mark"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/category"
+	"github.com/Southclaws/storyden/app/resources/post/reaction"
+	"github.com/Southclaws/storyden/app/resources/post/reply_writer"
+	"github.com/Southclaws/storyden/app/resources/post/thread_writer"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_writer"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_category "github.com/Southclaws/storyden/internal/ent/category"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/otp"
+)
+
+const (
+	kindAccount  = "account"
+	kindCategory = "category"
+	kindThread   = "thread"
+	kindReply    = "reply"
+)
+
+const defaultCategoryColour = "#8a8a8a"
+
+// Counts summarises what happened to one kind of record.
+type Counts struct {
+	Created  int
+	Existing int
+	Skipped  int
+}
+
+func (c Counts) String() string {
+	return fmt.Sprintf("%d created, %d already imported, %d skipped", c.Created, c.Existing, c.Skipped)
+}
+
+type Report struct {
+	Accounts   Counts
+	Categories Counts
+	Threads    Counts
+	Replies    Counts
+	Likes      Counts
+	Reactions  Counts
+}
+
+type Importer struct {
+	logger         *slog.Logger
+	db             *ent.Client
+	accountQuerier *account_querier.Querier
+	accountWriter  *account_writer.Writer
+	emailRepo      *email.Repository
+	categoryRepo   *category.Repository
+	threadWriter   *thread_writer.Writer
+	replyWriter    *reply_writer.Writer
+	tagWriter      *tag_writer.Writer
+	likeWriter     *like_writer.LikeWriter
+	reactWriter    *reaction.Writer
+}
+
+func New(
+	logger *slog.Logger,
+	db *ent.Client,
+	accountQuerier *account_querier.Querier,
+	accountWriter *account_writer.Writer,
+	emailRepo *email.Repository,
+	categoryRepo *category.Repository,
+	threadWriter *thread_writer.Writer,
+	replyWriter *reply_writer.Writer,
+	tagWriter *tag_writer.Writer,
+	likeWriter *like_writer.LikeWriter,
+	reactWriter *reaction.Writer,
+) *Importer {
+	return &Importer{
+		logger:         logger,
+		db:             db,
+		accountQuerier: accountQuerier,
+		accountWriter:  accountWriter,
+		emailRepo:      emailRepo,
+		categoryRepo:   categoryRepo,
+		threadWriter:   threadWriter,
+		replyWriter:    replyWriter,
+		tagWriter:      tagWriter,
+		likeWriter:     likeWriter,
+		reactWriter:    reactWriter,
+	}
+}
+
+type Option func(*run)
+
+// WithAdmins makes accounts which were administrators on the original platform
+// administrators here too. This is off by default as an export is rarely
+// reviewed in full before it's imported and admins have full control over the
+// instance, they can always be promoted by hand afterwards.
+func WithAdmins(admins bool) Option {
+	return func(r *run) {
+		r.admins = admins
+	}
+}
+
+// run holds the state of a single import, mapping original identifiers to the
+// Storyden IDs of the items created for them or found from a previous run.
+type run struct {
+	*Importer
+	source string
+	admins bool
+	report Report
+
+	imported   map[string]xid.ID // kind/original ID, from previous runs
+	accounts   map[string]account.AccountID
+	categories map[string]category.CategoryID
+	threads    map[string]post.ID
+	replies    map[string]post.ID
+	tags       map[string]tag_ref.ID
+}
+
+// Run writes the contents of a dump. Records that can't be imported, such as
+// a reply whose thread is missing from the dump, are logged and skipped while
+// failures to write to the database stop the import. As imports are resumable
+// the import can simply be run again once the problem is resolved.
+func (i *Importer) Run(ctx context.Context, d *Dump, opts ...Option) (*Report, error) {
+	if d.Source == "" {
+		return nil, fault.New("dump has no source name", fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	r := &run{
+		Importer:   i,
+		source:     d.Source,
+		accounts:   map[string]account.AccountID{},
+		categories: map[string]category.CategoryID{},
+		threads:    map[string]post.ID{},
+		replies:    map[string]post.ID{},
+		tags:       map[string]tag_ref.ID{},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	imported, err := r.loadImported(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	r.imported = imported
+
+	steps := []func(context.Context, *Dump) error{
+		r.importAccounts,
+		r.importCategories,
+		r.importThreads,
+		r.importReplies,
+		r.importLikes,
+		r.importReactions,
+	}
+	for _, step := range steps {
+		if err := step(ctx, d); err != nil {
+			return &r.report, fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return &r.report, nil
+}
+
+func importMeta(source, kind, id string) map[string]any {
+	return map[string]any{
+		"import": map[string]any{
+			"source": source,
+			"kind":   kind,
+			"id":     id,
+		},
+	}
+}
+
+func importKey(kind, id string) string {
+	return kind + "/" + id
+}
+
+// loadImported finds everything previously imported from the same source.
+func (r *run) loadImported(ctx context.Context) (map[string]xid.ID, error) {
+	imported := map[string]xid.ID{}
+
+	add := func(id xid.ID, meta map[string]any) {
+		m, ok := meta["import"].(map[string]any)
+		if !ok {
+			return
+		}
+		kind, _ := m["kind"].(string)
+		original, _ := m["id"].(string)
+		if kind == "" || original == "" {
+			return
+		}
+		imported[importKey(kind, original)] = id
+	}
+
+	fromSource := func(field string) func(*sql.Selector) {
+		return func(s *sql.Selector) {
+			s.Where(sqljson.ValueEQ(field, r.source, sqljson.Path("import", "source")))
+		}
+	}
+
+	accounts, err := r.db.Account.Query().
+		Where(fromSource(ent_account.FieldMetadata)).
+		Select(ent_account.FieldID, ent_account.FieldMetadata).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	for _, a := range accounts {
+		add(a.ID, a.Metadata)
+	}
+
+	categories, err := r.db.Category.Query().
+		Where(fromSource(ent_category.FieldMetadata)).
+		Select(ent_category.FieldID, ent_category.FieldMetadata).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	for _, c := range categories {
+		add(c.ID, c.Metadata)
+	}
+
+	posts, err := r.db.Post.Query().
+		Where(fromSource(ent_post.FieldMetadata)).
+		Select(ent_post.FieldID, ent_post.FieldMetadata).
+		All(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+	for _, p := range posts {
+		add(p.ID, p.Metadata)
+	}
+
+	return imported, nil
+}
+
+func (r *run) skip(kind, id, reason string) {
+	r.logger.Warn("skipping record", slog.String("kind", kind), slog.String("id", id), slog.String("reason", reason))
+}
+
+// newID creates IDs that sort in the same order as the original content.
+func newID(t time.Time) xid.ID {
+	if t.IsZero() {
+		return xid.New()
+	}
+	return xid.NewWithTime(t)
+}
+
+func (r *run) importAccounts(ctx context.Context, d *Dump) error {
+	for _, a := range d.Accounts {
+		if id, ok := r.imported[importKey(kindAccount, a.ID)]; ok {
+			r.accounts[a.ID] = account.AccountID(id)
+			r.report.Accounts.Existing++
+			continue
+		}
+
+		// Members who already signed up with the same email address are
+		// linked to their history rather than given a second account. This is
+		// only done when the original platform verified the address, otherwise
+		// anyone could claim another member's history.
+		if addr, err := mail.ParseAddress(a.Email); err == nil && a.EmailVerified {
+			existing, exists, err := r.emailRepo.LookupAccount(ctx, *addr)
+			if err != nil {
+				return fault.Wrap(err, fctx.With(ctx))
+			}
+			if exists {
+				r.accounts[a.ID] = existing.ID
+				r.report.Accounts.Existing++
+				continue
+			}
+		}
+
+		handle, err := r.availableHandle(ctx, a)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		opts := []account_writer.Option{
+			account_writer.WithID(account.AccountID(newID(a.CreatedAt))),
+			account_writer.WithAdmin(r.admins && a.Admin),
+			account_writer.WithMeta(importMeta(r.source, kindAccount, a.ID)),
+		}
+		if a.Name != "" {
+			opts = append(opts, account_writer.WithName(a.Name))
+		}
+		if !a.CreatedAt.IsZero() {
+			opts = append(opts, account_writer.WithCreatedAt(a.CreatedAt))
+		}
+		if strings.TrimSpace(a.Bio) != "" {
+			bio, err := convert(a.Format, a.Bio)
+			if err != nil {
+				r.logger.Warn("dropping unreadable bio", slog.String("id", a.ID), slog.String("error", err.Error()))
+			} else {
+				opts = append(opts, account_writer.WithBio(bio))
+			}
+		}
+
+		acc, err := r.accountWriter.Create(ctx, handle, opts...)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		r.accounts[a.ID] = acc.ID
+		r.report.Accounts.Created++
+
+		if addr, err := mail.ParseAddress(a.Email); err == nil {
+			if err := r.addEmail(ctx, acc.ID, *addr, a.EmailVerified); err != nil {
+				r.logger.Warn("could not add email address", slog.String("id", a.ID), slog.String("error", err.Error()))
+			}
+		}
+	}
+
+	return nil
+}
+
+// availableHandle turns a username into a valid handle, adding a number to
+// the end when the handle is already used by an existing member.
+func (r *run) availableHandle(ctx context.Context, a Account) (string, error) {
+	base := mark.Slugify(a.Handle)
+	if base == "" {
+		base = "member-" + mark.Slugify(a.ID)
+	}
+	base = truncate(base, 30)
+
+	handle := base
+	for n := 2; ; n++ {
+		_, exists, err := r.accountQuerier.LookupByHandle(ctx, handle)
+		if err != nil {
+			return "", fault.Wrap(err, fctx.With(ctx))
+		}
+		if !exists {
+			return handle, nil
+		}
+
+		suffix := fmt.Sprintf("-%d", n)
+		handle = truncate(base, 30-len(suffix)) + suffix
+	}
+}
+
+// addEmail attaches an address to an imported account. It's only marked as
+// verified when the original platform had already verified it, otherwise the
+// member must verify it as with any other newly added address.
+func (r *run) addEmail(ctx context.Context, accountID account.AccountID, addr mail.Address, verified bool) error {
+	code, err := otp.Generate()
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if _, err := r.emailRepo.Add(ctx, accountID, addr, code); err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if !verified {
+		return nil
+	}
+
+	return r.emailRepo.Verify(ctx, accountID, addr)
+}
+
+func (r *run) importCategories(ctx context.Context, d *Dump) error {
+	byID := map[string]Category{}
+	for _, c := range d.Categories {
+		byID[c.ID] = c
+	}
+
+	// Parents must exist before their children, resolve depth first.
+	resolving := map[string]bool{}
+	var resolve func(c Category) error
+	resolve = func(c Category) error {
+		if _, done := r.categories[c.ID]; done {
+			return nil
+		}
+		if resolving[c.ID] {
+			return fault.Newf("category %s is its own ancestor", c.ID)
+		}
+		resolving[c.ID] = true
+
+		var parent *category.CategoryID
+		if c.Parent != "" {
+			p, ok := byID[c.Parent]
+			if ok {
+				if err := resolve(p); err != nil {
+					return err
+				}
+			}
+			if id, ok := r.categories[c.Parent]; ok {
+				parent = &id
+			} else {
+				r.logger.Warn("category parent missing, importing at the top level", slog.String("id", c.ID), slog.String("parent", c.Parent))
+			}
+		}
+
+		return r.importCategory(ctx, c, parent)
+	}
+
+	for _, c := range d.Categories {
+		if err := resolve(c); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+	}
+
+	return nil
+}
+
+func (r *run) importCategory(ctx context.Context, c Category, parent *category.CategoryID) error {
+	if id, ok := r.imported[importKey(kindCategory, c.ID)]; ok {
+		r.categories[c.ID] = category.CategoryID(id)
+		r.report.Categories.Existing++
+		return nil
+	}
+
+	slug := mark.Slugify(c.Name)
+	if slug == "" {
+		r.skip(kindCategory, c.ID, "category has no name")
+		r.report.Categories.Skipped++
+		return nil
+	}
+
+	// Categories set up by hand before importing are reused when the names
+	// match, which allows the structure to be prepared ahead of time.
+	existing, err := r.categoryRepo.Get(ctx, slug)
+	if err == nil {
+		r.categories[c.ID] = existing.ID
+		r.report.Categories.Existing++
+		return nil
+	}
+	if ftag.Get(err) != ftag.NotFound {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	colour := c.Colour
+	if colour == "" {
+		colour = defaultCategoryColour
+	} else if !strings.HasPrefix(colour, "#") {
+		colour = "#" + colour
+	}
+
+	created, err := r.categoryRepo.CreateCategory(ctx, c.Name, c.Description, colour, c.Sort, false,
+		category.WithParent(parent),
+		category.WithMeta(importMeta(r.source, kindCategory, c.ID)),
+	)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	r.categories[c.ID] = created.ID
+	r.report.Categories.Created++
+
+	return nil
+}
+
+func (r *run) importThreads(ctx context.Context, d *Dump) error {
+	threads := append([]Thread(nil), d.Threads...)
+	sort.SliceStable(threads, func(a, b int) bool { return threads[a].CreatedAt.Before(threads[b].CreatedAt) })
+
+	for _, t := range threads {
+		if id, ok := r.imported[importKey(kindThread, t.ID)]; ok {
+			r.threads[t.ID] = post.ID(id)
+			r.report.Threads.Existing++
+			continue
+		}
+
+		author, ok := r.accounts[t.Author]
+		if !ok {
+			r.skip(kindThread, t.ID, "author not found")
+			r.report.Threads.Skipped++
+			continue
+		}
+
+		title := strings.TrimSpace(t.Title)
+		if title == "" {
+			r.skip(kindThread, t.ID, "thread has no title")
+			r.report.Threads.Skipped++
+			continue
+		}
+
+		content, err := convert(t.Format, t.Body)
+		if err != nil {
+			r.skip(kindThread, t.ID, err.Error())
+			r.report.Threads.Skipped++
+			continue
+		}
+
+		opts := []thread_writer.Option{
+			thread_writer.WithID(post.ID(newID(t.CreatedAt))),
+			thread_writer.WithContent(content),
+			thread_writer.WithVisibility(visibility.VisibilityPublished),
+			thread_writer.WithMeta(importMeta(r.source, kindThread, t.ID)),
+		}
+		if !t.CreatedAt.IsZero() {
+			opts = append(opts, thread_writer.WithCreatedAt(t.CreatedAt))
+		}
+		if t.Category != "" {
+			if cat, ok := r.categories[t.Category]; ok {
+				opts = append(opts, thread_writer.WithCategory(xid.ID(cat)))
+			} else {
+				r.logger.Warn("thread category missing, importing without a category", slog.String("id", t.ID), slog.String("category", t.Category))
+			}
+		}
+
+		tags, err := r.tagIDs(ctx, t.Tags)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		if len(tags) > 0 {
+			opts = append(opts, thread_writer.WithTagsAdd(tags...))
+		}
+
+		created, err := r.threadWriter.Create(ctx, title, author, opts...)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		r.threads[t.ID] = created.ID
+		r.report.Threads.Created++
+	}
+
+	return nil
+}
+
+func (r *run) tagIDs(ctx context.Context, names []string) ([]tag_ref.ID, error) {
+	ids := []tag_ref.ID{}
+	missing := []tag_ref.Name{}
+	seen := map[string]bool{}
+
+	for _, n := range names {
+		name := tag_ref.NewName(n)
+		key := name.String()
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if id, ok := r.tags[key]; ok {
+			ids = append(ids, id)
+		} else {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) == 0 {
+		return ids, nil
+	}
+
+	added, err := r.tagWriter.Add(ctx, missing...)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	for _, t := range added {
+		r.tags[t.Name.String()] = t.ID
+		ids = append(ids, t.ID)
+	}
+
+	return ids, nil
+}
+
+func (r *run) importReplies(ctx context.Context, d *Dump) error {
+	replies := append([]Reply(nil), d.Replies...)
+	sort.SliceStable(replies, func(a, b int) bool { return replies[a].CreatedAt.Before(replies[b].CreatedAt) })
+
+	for _, p := range replies {
+		if id, ok := r.imported[importKey(kindReply, p.ID)]; ok {
+			r.replies[p.ID] = post.ID(id)
+			r.report.Replies.Existing++
+			continue
+		}
+
+		thread, ok := r.threads[p.Thread]
+		if !ok {
+			r.skip(kindReply, p.ID, "thread not found")
+			r.report.Replies.Skipped++
+			continue
+		}
+
+		author, ok := r.accounts[p.Author]
+		if !ok {
+			r.skip(kindReply, p.ID, "author not found")
+			r.report.Replies.Skipped++
+			continue
+		}
+
+		content, err := convert(p.Format, p.Body)
+		if err != nil {
+			r.skip(kindReply, p.ID, err.Error())
+			r.report.Replies.Skipped++
+			continue
+		}
+		if content.IsEmpty() {
+			r.skip(kindReply, p.ID, "reply is empty")
+			r.report.Replies.Skipped++
+			continue
+		}
+
+		opts := []reply_writer.Option{
+			reply_writer.WithID(post.ID(newID(p.CreatedAt))),
+			reply_writer.WithContent(content),
+			reply_writer.WithVisibility(visibility.VisibilityPublished),
+			reply_writer.WithMeta(importMeta(r.source, kindReply, p.ID)),
+		}
+		if !p.CreatedAt.IsZero() {
+			opts = append(opts, reply_writer.WithCreatedAt(p.CreatedAt))
+		}
+		if p.ReplyTo != "" {
+			if to, ok := r.replies[p.ReplyTo]; ok {
+				opts = append(opts, reply_writer.WithReplyTo(to))
+			}
+		}
+
+		created, err := r.replyWriter.Create(ctx, author, thread, opts...)
+		if err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+
+		r.replies[p.ID] = created.ID
+		r.report.Replies.Created++
+	}
+
+	return nil
+}
+
+func (r *run) target(t Target) (post.ID, bool) {
+	if t.Reply != "" {
+		id, ok := r.replies[t.Reply]
+		return id, ok
+	}
+	id, ok := r.threads[t.Thread]
+	return id, ok
+}
+
+// Likes and reactions are unique per member and post so writing them again
+// is harmless, which is what makes them safe to re-run without metadata.
+func (r *run) importLikes(ctx context.Context, d *Dump) error {
+	for _, l := range d.Likes {
+		accountID, ok := r.accounts[l.Account]
+		if !ok {
+			r.report.Likes.Skipped++
+			continue
+		}
+
+		postID, ok := r.target(l.Target)
+		if !ok {
+			r.report.Likes.Skipped++
+			continue
+		}
+
+		if err := r.likeWriter.AddPostLike(ctx, accountID, postID); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		r.report.Likes.Created++
+	}
+
+	return nil
+}
+
+func (r *run) importReactions(ctx context.Context, d *Dump) error {
+	for _, re := range d.Reactions {
+		accountID, ok := r.accounts[re.Account]
+		if !ok {
+			r.report.Reactions.Skipped++
+			continue
+		}
+
+		postID, ok := r.target(re.Target)
+		if !ok {
+			r.report.Reactions.Skipped++
+			continue
+		}
+
+		e, ok := emoji(re.Emoji)
+		if !ok {
+			r.logger.Warn("skipping reaction with unknown emoji", slog.String("emoji", re.Emoji))
+			r.report.Reactions.Skipped++
+			continue
+		}
+
+		if _, err := r.reactWriter.Add(ctx, accountID, xid.ID(postID), e); err != nil {
+			return fault.Wrap(err, fctx.With(ctx))
+		}
+		r.report.Reactions.Created++
+	}
+
+	return nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.TrimRight(s[:n], "-")
+}
@@ -0,0 +1,120 @@
+// Package jsonl reads Storyden's neutral import format: a JSON Lines file with
+// one record per line, each tagged with a "type". It exists so communities on
+// platforms without a dedicated adapter can write a small script to export
+// into it rather than needing a new adapter.
+//
+//	{"type":"source","name":"my-forum"}
+//	{"type":"account","id":"1","handle":"odin","email":"odin@example.com","email_verified":true,"admin":true}
+//	{"type":"category","id":"1","name":"General","colour":"#4ec9b0"}
+//	{"type":"thread","id":"1","category":"1","author":"1","title":"Hello","body":"**Hi**","format":"markdown","tags":["intro"]}
+//	{"type":"reply","id":"1","thread":"1","author":"1","body":"Welcome!"}
+//	{"type":"like","account":"1","reply":"1"}
+//	{"type":"reaction","account":"1","thread":"1","emoji":"heart"}
+//
+// Records may appear in any order. Blank lines and lines starting with "//"
+// are ignored. The field reference is in the operation documentation.
+package jsonl
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/internal/importer"
+)
+
+// DefaultSource names dumps that don't include a source record.
+const DefaultSource = "jsonl"
+
+type Source struct{}
+
+func New() *Source {
+	return &Source{}
+}
+
+type header struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+func (s *Source) Read(ctx context.Context, r io.Reader) (*importer.Dump, error) {
+	d := &importer.Dump{Source: DefaultSource}
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 64*1024*1024)
+
+	line := 0
+	for sc.Scan() {
+		line++
+		raw := strings.TrimSpace(sc.Text())
+		if raw == "" || strings.HasPrefix(raw, "//") {
+			continue
+		}
+
+		if err := decode(d, []byte(raw)); err != nil {
+			return nil, fault.Wrap(err, fctx.With(fctx.WithMeta(ctx, "line", strconv.Itoa(line))))
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return d, nil
+}
+
+func decode(d *importer.Dump, raw []byte) error {
+	var h header
+	if err := json.Unmarshal(raw, &h); err != nil {
+		return fault.Wrap(err)
+	}
+
+	var err error
+	switch h.Type {
+	case "source":
+		if h.Name == "" {
+			return fault.New("source record has no name")
+		}
+		d.Source = h.Name
+
+	case "account":
+		var v importer.Account
+		err = json.Unmarshal(raw, &v)
+		d.Accounts = append(d.Accounts, v)
+
+	case "category":
+		var v importer.Category
+		err = json.Unmarshal(raw, &v)
+		d.Categories = append(d.Categories, v)
+
+	case "thread":
+		var v importer.Thread
+		err = json.Unmarshal(raw, &v)
+		d.Threads = append(d.Threads, v)
+
+	case "reply":
+		var v importer.Reply
+		err = json.Unmarshal(raw, &v)
+		d.Replies = append(d.Replies, v)
+
+	case "like":
+		var v importer.Like
+		err = json.Unmarshal(raw, &v)
+		d.Likes = append(d.Likes, v)
+
+	case "reaction":
+		var v importer.Reaction
+		err = json.Unmarshal(raw, &v)
+		d.Reactions = append(d.Reactions, v)
+
+	default:
+		return fault.Newf("unknown record type %q", h.Type)
+	}
+
+	return fault.Wrap(err)
+}
@@ -0,0 +1,201 @@
+// Package phpbb reads a mysqldump of a phpBB 3.x database. Forums become
+// categories, with forum categories becoming parents of the forums inside
+// them, topics become threads and posts become replies. Post text is read in
+// both the legacy BBCode storage format and the XML format used since 3.2.
+//
+// phpBB has no likes, reactions or tags without extensions so none are read.
+// Bots are skipped, while posts made by guests are kept and attributed to the
+// built-in anonymous account.
+package phpbb
+
+import (
+	"context"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/internal/importer"
+	"github.com/Southclaws/storyden/internal/importer/sqldump"
+)
+
+const (
+	SourceName    = "phpbb"
+	DefaultPrefix = "phpbb_"
+)
+
+const (
+	anonymousUserID  = "1"
+	userTypeInactive = 1 // not yet activated by email, or deactivated
+	userTypeIgnore   = 2 // bots and the anonymous user
+	userTypeFounder  = 3
+	forumTypeLink    = 2
+	itemApproved     = 1
+)
+
+type Source struct {
+	prefix string
+}
+
+// New creates a phpBB source for tables with the given prefix, which is set
+// during phpBB's installation and is "phpbb_" unless it was changed.
+func New(prefix string) *Source {
+	return &Source{prefix: prefix}
+}
+
+func (s *Source) Read(ctx context.Context, r io.Reader) (*importer.Dump, error) {
+	keep := func(t string) bool {
+		switch strings.TrimPrefix(t, s.prefix) {
+		case "users", "forums", "topics", "posts":
+			return strings.HasPrefix(t, s.prefix)
+		}
+		return false
+	}
+
+	t, err := sqldump.ReadMySQL(r, keep)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return s.convert(t), nil
+}
+
+func (s *Source) convert(t sqldump.Tables) *importer.Dump {
+	d := &importer.Dump{Source: SourceName}
+
+	for _, u := range t[s.prefix+"users"] {
+		id := u.String("user_id")
+		if u.Int("user_type") == userTypeIgnore && id != anonymousUserID {
+			continue
+		}
+
+		d.Accounts = append(d.Accounts, importer.Account{
+			ID:            id,
+			Handle:        html.UnescapeString(u.String("username")),
+			Email:         u.String("user_email"),
+			EmailVerified: u.Int("user_type") != userTypeInactive,
+			Admin:         u.Int("user_type") == userTypeFounder,
+			CreatedAt:     u.Time("user_regdate"),
+		})
+	}
+
+	for _, f := range t[s.prefix+"forums"] {
+		if f.Int("forum_type") == forumTypeLink {
+			continue
+		}
+
+		parent := f.String("parent_id")
+		if parent == "0" {
+			parent = ""
+		}
+
+		d.Categories = append(d.Categories, importer.Category{
+			ID:          f.String("forum_id"),
+			Parent:      parent,
+			Name:        html.UnescapeString(f.String("forum_name")),
+			Description: plainText(f.String("forum_desc"), f.String("forum_desc_uid")),
+			Sort:        int(f.Int("left_id")),
+		})
+	}
+
+	firstPosts := map[string]sqldump.Row{}
+	topics := map[string]bool{}
+	for _, tp := range t[s.prefix+"topics"] {
+		// Moved topics leave a shadow topic behind pointing to the new one.
+		if tp.Int("topic_moved_id") != 0 || tp.Int("topic_visibility") != itemApproved {
+			continue
+		}
+		topics[tp.String("topic_id")] = true
+		firstPosts[tp.String("topic_first_post_id")] = nil
+	}
+
+	replies := []sqldump.Row{}
+	for _, p := range t[s.prefix+"posts"] {
+		if !topics[p.String("topic_id")] || p.Int("post_visibility") != itemApproved {
+			continue
+		}
+		if _, first := firstPosts[p.String("post_id")]; first {
+			firstPosts[p.String("post_id")] = p
+			continue
+		}
+		replies = append(replies, p)
+	}
+
+	for _, tp := range t[s.prefix+"topics"] {
+		id := tp.String("topic_id")
+		if !topics[id] {
+			continue
+		}
+
+		body := ""
+		if first := firstPosts[tp.String("topic_first_post_id")]; first != nil {
+			body = postText(first.String("post_text"), first.String("bbcode_uid"))
+		}
+
+		d.Threads = append(d.Threads, importer.Thread{
+			ID:        id,
+			Category:  tp.String("forum_id"),
+			Author:    tp.String("topic_poster"),
+			Title:     html.UnescapeString(tp.String("topic_title")),
+			Body:      body,
+			Format:    importer.FormatBBCode,
+			CreatedAt: tp.Time("topic_time"),
+		})
+	}
+
+	for _, p := range replies {
+		d.Replies = append(d.Replies, importer.Reply{
+			ID:        p.String("post_id"),
+			Thread:    p.String("topic_id"),
+			Author:    p.String("poster_id"),
+			Body:      postText(p.String("post_text"), p.String("bbcode_uid")),
+			Format:    importer.FormatBBCode,
+			CreatedAt: p.Time("post_time"),
+		})
+	}
+
+	return d
+}
+
+var (
+	xmlTag        = regexp.MustCompile(`<[^>]+>`)
+	legacySmiley  = regexp.MustCompile(`<!-- s(.*?) --><img[^>]*><!-- s.*? -->`)
+	legacyLink    = regexp.MustCompile(`<!-- [mwle] --><a[^>]*href="([^"]*)"[^>]*>.*?</a><!-- [mwle] -->`)
+	legacyListEnd = regexp.MustCompile(`\[/list:[uo]\]`)
+	legacyItemEnd = regexp.MustCompile(`\[/\*:m\]`)
+	bbcodeMarkup  = regexp.MustCompile(`\[/?[a-zA-Z*][^\]]*\]`)
+)
+
+// postText recovers the BBCode a post was written with from how phpBB stores
+// it. Since phpBB 3.2 posts are stored as XML where the original markup is
+// kept as text inside the elements. Before that, BBCode tags were suffixed
+// with a per-post identifier and smilies and links were stored as HTML.
+func postText(text, uid string) string {
+	if strings.HasPrefix(text, "<r>") || strings.HasPrefix(text, "<t>") {
+		text = strings.ReplaceAll(text, "<br/>\n", "\n")
+		text = strings.ReplaceAll(text, "<br/>", "\n")
+		return html.UnescapeString(xmlTag.ReplaceAllString(text, ""))
+	}
+
+	text = legacySmiley.ReplaceAllString(text, "$1")
+	text = legacyLink.ReplaceAllStringFunc(text, func(s string) string {
+		href := legacyLink.FindStringSubmatch(s)[1]
+		return strings.TrimPrefix(href, "mailto:")
+	})
+	if uid != "" {
+		text = strings.ReplaceAll(text, ":"+uid, "")
+	}
+	text = legacyListEnd.ReplaceAllString(text, "[/list]")
+	text = legacyItemEnd.ReplaceAllString(text, "")
+	text = strings.ReplaceAll(text, "<br />", "\n")
+
+	return html.UnescapeString(text)
+}
+
+// plainText is used for forum descriptions which are shown as plain text.
+func plainText(text, uid string) string {
+	return strings.TrimSpace(bbcodeMarkup.ReplaceAllString(postText(text, uid), ""))
+}
@@ -0,0 +1,72 @@
+package sqldump
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/Southclaws/fault"
+)
+
+// ReadJSON reads an export where each key is a table name holding an array of
+// row objects, such as one assembled from `SELECT json_agg(t) FROM table t`
+// for each table. Numbers and booleans are converted to their text form, so
+// rows read the same as they would from a SQL dump.
+func ReadJSON(r io.Reader, keep Filter) (Tables, error) {
+	var raw map[string][]map[string]json.RawMessage
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	if err := dec.Decode(&raw); err != nil {
+		return nil, fault.Wrap(err)
+	}
+
+	tables := Tables{}
+	for name, rows := range raw {
+		name = tableName(name)
+		if !keep(name) {
+			continue
+		}
+
+		out := make([]Row, 0, len(rows))
+		for _, in := range rows {
+			row := Row{}
+			for col, v := range in {
+				s, ok, err := jsonScalar(v)
+				if err != nil {
+					return nil, fault.Wrap(err)
+				}
+				if ok {
+					row[col] = s
+				}
+			}
+			out = append(out, row)
+		}
+		tables[name] = out
+	}
+
+	return tables, nil
+}
+
+func jsonScalar(v json.RawMessage) (string, bool, error) {
+	var x any
+	dec := json.NewDecoder(bytes.NewReader(v))
+	dec.UseNumber()
+	if err := dec.Decode(&x); err != nil {
+		return "", false, err
+	}
+
+	switch t := x.(type) {
+	case nil:
+		return "", false, nil
+	case string:
+		return t, true, nil
+	case json.Number:
+		return t.String(), true, nil
+	case bool:
+		return strconv.FormatBool(t), true, nil
+	default:
+		// Nested values such as arrays are kept as their JSON encoding.
+		return string(v), true, nil
+	}
+}
@@ -0,0 +1,202 @@
+package sqldump
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/Southclaws/fault"
+)
+
+var (
+	createTablePattern = regexp.MustCompile("^CREATE TABLE\\s+(?:IF NOT EXISTS\\s+)?(\\S+)\\s*\\(")
+	columnPattern      = regexp.MustCompile("^\\s*`([^`]+)`\\s")
+	insertPattern      = regexp.MustCompile("^(?:INSERT|REPLACE)(?:\\s+IGNORE)?\\s+INTO\\s+(\\S+)\\s*(?:\\(([^)]*)\\))?\\s*VALUES\\s*")
+)
+
+// ReadMySQL reads the INSERT statements of a mysqldump file. Column names are
+// taken from the INSERT itself when present, otherwise from the preceding
+// CREATE TABLE statement, which mysqldump always writes before the data.
+func ReadMySQL(r io.Reader, keep Filter) (Tables, error) {
+	br := bufio.NewReader(r)
+	tables := Tables{}
+	schemas := map[string][]string{}
+
+	var creating string
+
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, fault.Wrap(err)
+		}
+		if line == "" && err == io.EOF {
+			break
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+
+		switch {
+		case creating != "":
+			if strings.HasPrefix(line, ")") {
+				creating = ""
+			} else if m := columnPattern.FindStringSubmatch(line); m != nil {
+				schemas[creating] = append(schemas[creating], m[1])
+			}
+
+		case createTablePattern.MatchString(line):
+			name := tableName(createTablePattern.FindStringSubmatch(line)[1])
+			if keep(name) {
+				creating = name
+				schemas[name] = nil
+			}
+
+		default:
+			loc := insertPattern.FindStringSubmatchIndex(line)
+			if loc == nil {
+				break
+			}
+
+			name := tableName(line[loc[2]:loc[3]])
+			if !keep(name) {
+				break
+			}
+
+			columns := schemas[name]
+			if loc[4] >= 0 {
+				columns = splitColumns(line[loc[4]:loc[5]])
+			}
+			if len(columns) == 0 {
+				return nil, fault.Newf("no columns known for table %s, the dump must include CREATE TABLE statements or complete inserts", name)
+			}
+
+			rows, perr := parseValues(line[loc[1]:], columns)
+			if perr != nil {
+				return nil, fault.Wrap(perr)
+			}
+			tables[name] = append(tables[name], rows...)
+		}
+
+		if err == io.EOF {
+			break
+		}
+	}
+
+	return tables, nil
+}
+
+// parseValues reads the tuples of a VALUES list: (1,'a',NULL),(2,'b\'s',3);
+func parseValues(s string, columns []string) ([]Row, error) {
+	rows := []Row{}
+	i := 0
+
+	for i < len(s) {
+		switch s[i] {
+		case ' ', '\t', ',':
+			i++
+			continue
+		case ';':
+			return rows, nil
+		case '(':
+		default:
+			return nil, fault.Newf("unexpected %q in VALUES list", s[i])
+		}
+
+		i++ // (
+		row := Row{}
+		col := 0
+
+		for {
+			for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+				i++
+			}
+			if i >= len(s) {
+				return nil, fault.New("unterminated VALUES tuple")
+			}
+
+			var (
+				value  string
+				isNull bool
+			)
+
+			if s[i] == '\'' {
+				var sb strings.Builder
+				i++
+				for {
+					if i >= len(s) {
+						return nil, fault.New("unterminated string in VALUES tuple")
+					}
+					c := s[i]
+					if c == '\\' && i+1 < len(s) {
+						sb.WriteByte(unescapeMySQL(s[i+1]))
+						i += 2
+						continue
+					}
+					if c == '\'' {
+						if i+1 < len(s) && s[i+1] == '\'' {
+							sb.WriteByte('\'')
+							i += 2
+							continue
+						}
+						i++
+						break
+					}
+					sb.WriteByte(c)
+					i++
+				}
+				value = sb.String()
+			} else {
+				start := i
+				for i < len(s) && s[i] != ',' && s[i] != ')' {
+					i++
+				}
+				value = strings.TrimSpace(s[start:i])
+				isNull = strings.EqualFold(value, "NULL")
+			}
+
+			if col < len(columns) && !isNull {
+				row[columns[col]] = value
+			}
+			col++
+
+			for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+				i++
+			}
+			if i >= len(s) {
+				return nil, fault.New("unterminated VALUES tuple")
+			}
+			if s[i] == ',' {
+				i++
+				continue
+			}
+			if s[i] == ')' {
+				i++
+				break
+			}
+			return nil, fault.Newf("unexpected %q in VALUES tuple", s[i])
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func unescapeMySQL(c byte) byte {
+	switch c {
+	case '0':
+		return 0
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	case 'b':
+		return '\b'
+	case 'Z':
+		return 26
+	default:
+		return c
+	}
+}
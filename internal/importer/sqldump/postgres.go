@@ -0,0 +1,141 @@
+package sqldump
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Southclaws/fault"
+)
+
+var copyPattern = regexp.MustCompile(`^COPY\s+(\S+)\s*\(([^)]*)\)\s+FROM\s+stdin;`)
+
+// ReadPostgres reads the COPY blocks of a plain-format pg_dump, which is the
+// default output of pg_dump when no --format is given.
+func ReadPostgres(r io.Reader, keep Filter) (Tables, error) {
+	br := bufio.NewReader(r)
+	tables := Tables{}
+
+	var (
+		table   string
+		columns []string
+		inCopy  bool
+	)
+
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, fault.Wrap(err)
+		}
+		if line == "" && err == io.EOF {
+			break
+		}
+
+		line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+
+		if inCopy {
+			if line == `\.` {
+				inCopy = false
+				continue
+			}
+			if table == "" {
+				continue
+			}
+
+			fields := strings.Split(line, "\t")
+			row := Row{}
+			for i, c := range columns {
+				if i >= len(fields) || fields[i] == `\N` {
+					continue
+				}
+				row[c] = unescapeCopy(fields[i])
+			}
+			tables[table] = append(tables[table], row)
+			continue
+		}
+
+		if m := copyPattern.FindStringSubmatch(line); m != nil {
+			inCopy = true
+			table = tableName(m[1])
+			if !keep(table) {
+				table = ""
+				continue
+			}
+			columns = splitColumns(m[2])
+		}
+
+		if err == io.EOF {
+			break
+		}
+	}
+
+	return tables, nil
+}
+
+func splitColumns(s string) []string {
+	parts := strings.Split(s, ",")
+	for i, p := range parts {
+		parts[i] = strings.Trim(strings.TrimSpace(p), "`\"")
+	}
+	return parts
+}
+
+// unescapeCopy decodes the backslash escapes used by COPY's text format.
+func unescapeCopy(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i == len(s)-1 {
+			sb.WriteByte(c)
+			continue
+		}
+
+		i++
+		switch n := s[i]; n {
+		case 'n':
+			sb.WriteByte('\n')
+		case 't':
+			sb.WriteByte('\t')
+		case 'r':
+			sb.WriteByte('\r')
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'v':
+			sb.WriteByte('\v')
+		case 'x':
+			end := i + 1
+			for end < len(s) && end < i+3 && isHex(s[end]) {
+				end++
+			}
+			if v, err := strconv.ParseUint(s[i+1:end], 16, 8); err == nil && end > i+1 {
+				sb.WriteByte(byte(v))
+				i = end - 1
+			} else {
+				sb.WriteByte(n)
+			}
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			end := i
+			for end < len(s) && end < i+3 && s[end] >= '0' && s[end] <= '7' {
+				end++
+			}
+			v, _ := strconv.ParseUint(s[i:end], 8, 8)
+			sb.WriteByte(byte(v))
+			i = end - 1
+		default:
+			sb.WriteByte(n)
+		}
+	}
+	return sb.String()
+}
+
+func isHex(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
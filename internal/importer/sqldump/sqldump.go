@@ -0,0 +1,94 @@
+// Package sqldump reads table rows out of database dumps without needing the
+// original database server. It understands the plain-text formats produced by
+// pg_dump and mysqldump as well as JSON exports keyed by table name.
+//
+// Only rows are read, schema statements other than column lists are ignored,
+// and all values are kept as strings as the caller knows what they mean.
+package sqldump
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Row maps column names to values, NULL columns are absent.
+type Row map[string]string
+
+// Tables maps table names to their rows. Schema qualifiers such as "public."
+// and quoting are removed from table names.
+type Tables map[string][]Row
+
+// Filter decides which tables to keep, large dumps hold many tables that an
+// importer has no use for and holding them all in memory is wasteful.
+type Filter func(table string) bool
+
+func All(string) bool { return true }
+
+func (r Row) String(col string) string {
+	return r[col]
+}
+
+func (r Row) Null(col string) bool {
+	_, ok := r[col]
+	return !ok
+}
+
+func (r Row) Int(col string) int64 {
+	v, err := strconv.ParseInt(strings.TrimSpace(r[col]), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+func (r Row) Bool(col string) bool {
+	switch strings.ToLower(strings.TrimSpace(r[col])) {
+	case "t", "true", "1", "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// Time reads either a timestamp or a unix time in seconds. Timestamps without
+// a zone are assumed to be UTC, which is how both Postgres and MySQL dumps
+// write them by default.
+func (r Row) Time(col string) time.Time {
+	v := strings.TrimSpace(r[col])
+	if v == "" {
+		return time.Time{}
+	}
+
+	if unix, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if unix <= 0 {
+			return time.Time{}
+		}
+		return time.Unix(unix, 0).UTC()
+	}
+
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t.UTC()
+		}
+	}
+
+	return time.Time{}
+}
+
+func tableName(s string) string {
+	s = strings.Trim(strings.TrimSpace(s), "`\"")
+	if i := strings.LastIndex(s, "."); i >= 0 {
+		s = strings.Trim(s[i+1:], "`\"")
+	}
+	return s
+}
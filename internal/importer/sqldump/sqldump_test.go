@@ -0,0 +1,108 @@
+package sqldump
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadPostgres(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	dump := `--
+-- PostgreSQL database dump
+--
+
+COPY public.users (id, username, admin, created_at) FROM stdin;
+1	odin	t	2014-03-01 10:20:30.123
+2	loki\tthe\\trickster	f	\N
+\.
+
+COPY public.ignored (id) FROM stdin;
+1
+\.
+
+COPY public.posts (id, raw) FROM stdin;
+1	line one\nline two
+\.
+`
+
+	tables, err := ReadPostgres(strings.NewReader(dump), func(t string) bool { return t != "ignored" })
+	r.NoError(err)
+
+	r.Len(tables["users"], 2)
+	a.NotContains(tables, "ignored")
+
+	odin := tables["users"][0]
+	a.Equal("odin", odin.String("username"))
+	a.True(odin.Bool("admin"))
+	a.Equal(time.Date(2014, 3, 1, 10, 20, 30, 123000000, time.UTC), odin.Time("created_at"))
+
+	loki := tables["users"][1]
+	a.Equal("loki\tthe\\trickster", loki.String("username"))
+	a.False(loki.Bool("admin"))
+	a.True(loki.Null("created_at"))
+
+	a.Equal("line one\nline two", tables["posts"][0].String("raw"))
+}
+
+func TestReadMySQL(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	dump := "-- MySQL dump\n" +
+		"DROP TABLE IF EXISTS `phpbb_users`;\n" +
+		"CREATE TABLE `phpbb_users` (\n" +
+		"  `user_id` int unsigned NOT NULL AUTO_INCREMENT,\n" +
+		"  `username` varchar(255) NOT NULL DEFAULT '',\n" +
+		"  `user_regdate` int unsigned NOT NULL DEFAULT '0',\n" +
+		"  `user_sig` mediumtext,\n" +
+		"  PRIMARY KEY (`user_id`)\n" +
+		") ENGINE=InnoDB;\n" +
+		"INSERT INTO `phpbb_users` VALUES (1,'Anonymous',0,NULL),(2,'O\\'Dinn, the \"allfather\"',1394000000,'sig with ) and ('),(3,'it''s',5,'a\\nb');\n" +
+		"INSERT INTO `phpbb_other` (`id`, `name`) VALUES (1,'x');\n" +
+		"INSERT INTO `phpbb_posts` (`post_id`, `post_text`) VALUES (10,'[b:abc]hi[/b:abc]');\n"
+
+	tables, err := ReadMySQL(strings.NewReader(dump), func(t string) bool { return t != "phpbb_other" })
+	r.NoError(err)
+
+	users := tables["phpbb_users"]
+	r.Len(users, 3)
+	a.NotContains(tables, "phpbb_other")
+
+	a.Equal("Anonymous", users[0].String("username"))
+	a.True(users[0].Null("user_sig"))
+	a.True(users[0].Time("user_regdate").IsZero())
+
+	a.Equal(`O'Dinn, the "allfather"`, users[1].String("username"))
+	a.Equal("sig with ) and (", users[1].String("user_sig"))
+	a.Equal(time.Unix(1394000000, 0).UTC(), users[1].Time("user_regdate"))
+	a.Equal(int64(2), users[1].Int("user_id"))
+
+	a.Equal("it's", users[2].String("username"))
+	a.Equal("a\nb", users[2].String("user_sig"))
+
+	a.Equal("[b:abc]hi[/b:abc]", tables["phpbb_posts"][0].String("post_text"))
+}
+
+func TestReadJSON(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	tables, err := ReadJSON(strings.NewReader(`{
+		"users": [{"id": 1, "username": "odin", "admin": true, "name": null}],
+		"public.posts": [{"id": 12345678901, "raw": "hello"}]
+	}`), All)
+	r.NoError(err)
+
+	u := tables["users"][0]
+	a.Equal("1", u.String("id"))
+	a.True(u.Bool("admin"))
+	a.True(u.Null("name"))
+
+	a.Equal(int64(12345678901), tables["posts"][0].Int("id"))
+}
@@ -20,7 +20,7 @@ func Build() fx.Option {
 	)
 }
 
-func newMailer(logger *slog.Logger, cfg config.Config) (Sender, error) {
+func newMailer(lc fx.Lifecycle, logger *slog.Logger, cfg config.Config) (Sender, error) {
 	if cfg.EmailProvider != "" && len(cfg.JWTSecret) == 0 {
 		return nil, fault.New("JWT secret must be provided when enabling email features, set JWT_SECRET in the environment")
 	}
@@ -32,6 +32,14 @@ func newMailer(logger *slog.Logger, cfg config.Config) (Sender, error) {
 	case "sendgrid":
 		return newSendgridMailer(logger, cfg)
 
+	case "smtp":
+		m, err := newSMTPMailer(logger, cfg)
+		if err != nil {
+			return nil, err
+		}
+		lc.Append(fx.StopHook(m.Close))
+		return m, nil
+
 	case "mock":
 		return &Mock{}, nil
 
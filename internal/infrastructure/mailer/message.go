@@ -32,6 +32,10 @@ type Message struct {
 	Name    string
 	Subject string
 	Content Content
+
+	// Headers are additional headers, such as List-Unsubscribe, which are set
+	// on the message by providers that support them.
+	Headers map[string]string
 }
 
 func NewMessage(
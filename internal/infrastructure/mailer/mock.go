@@ -13,6 +13,7 @@ type MockEmail struct {
 	Subject string
 	Html    string
 	Plain   string
+	Headers map[string]string
 }
 
 type Mock struct {
@@ -42,6 +43,7 @@ func (m *Mock) Send(
 		Subject: msg.Subject,
 		Html:    msg.Content.HTML,
 		Plain:   msg.Content.Plain,
+		Headers: msg.Headers,
 	})
 
 	return nil
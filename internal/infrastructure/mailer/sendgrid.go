@@ -42,6 +42,9 @@ func (m *SendGrid) Send(
 	from := mail.NewEmail(m.fromName, m.fromAddress)
 	to := mail.NewEmail(msg.Name, msg.Address.Address)
 	message := mail.NewSingleEmail(from, msg.Subject, to, msg.Content.Plain, msg.Content.HTML)
+	for k, v := range msg.Headers {
+		message.SetHeader(k, v)
+	}
 
 	m.logger.Info("sending live email",
 		slog.String("email", to.Address),
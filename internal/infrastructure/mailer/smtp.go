@@ -0,0 +1,403 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+var (
+	ErrSMTPMissingHost        = fault.New("SMTP_HOST must be set when using the smtp email provider")
+	ErrSMTPMissingFromAddress = fault.New("SMTP_FROM_ADDRESS must be set when using the smtp email provider")
+	ErrSMTPNoStartTLS         = fault.New("SMTP server does not support STARTTLS, set SMTP_TLS_MODE to 'implicit' or 'none'")
+)
+
+type smtpTLSMode string
+
+const (
+	smtpTLSModeStartTLS smtpTLSMode = "starttls"
+	smtpTLSModeImplicit smtpTLSMode = "implicit"
+	smtpTLSModeNone     smtpTLSMode = "none"
+)
+
+const (
+	smtpDialTimeout = 10 * time.Second
+
+	// smtpIOTimeout bounds each conversation with the server, from the
+	// handshake to the end of a message, so a server which stops responding
+	// fails the send rather than leaving it hanging forever.
+	smtpIOTimeout = 30 * time.Second
+)
+
+// SMTP sends emails via any SMTP server. A connection is kept open between
+// messages and reused by the next send, it's re-established if the server has
+// dropped it. Sends which happen at the same time use their own connections.
+type SMTP struct {
+	logger    *slog.Logger
+	addr      string
+	host      string
+	mode      smtpTLSMode
+	tlsConfig *tls.Config
+	auth      smtp.Auth
+	from      mail.Address
+	timeout   time.Duration
+
+	// mu only guards handing out and returning the idle connection, it's never
+	// held while talking to the server so a slow server can't block every send.
+	mu   sync.Mutex
+	idle *smtpConn
+}
+
+type smtpConn struct {
+	conn   net.Conn
+	client *smtp.Client
+}
+
+func newSMTPMailer(logger *slog.Logger, cfg config.Config) (*SMTP, error) {
+	if cfg.SMTPHost == "" {
+		return nil, ErrSMTPMissingHost
+	}
+
+	if cfg.SMTPFromAddress == "" {
+		return nil, ErrSMTPMissingFromAddress
+	}
+
+	mode := smtpTLSMode(cfg.SMTPTLSMode)
+	switch mode {
+	case "":
+		mode = smtpTLSModeStartTLS
+	case smtpTLSModeStartTLS, smtpTLSModeImplicit, smtpTLSModeNone:
+	default:
+		return nil, fault.Newf("unknown SMTP_TLS_MODE: '%s'", cfg.SMTPTLSMode)
+	}
+
+	port := cfg.SMTPPort
+	if port == 0 {
+		port = 587
+	}
+
+	var auth smtp.Auth
+	if cfg.SMTPUsername != "" {
+		auth = smtp.PlainAuth("", cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPHost)
+	}
+
+	return &SMTP{
+		logger:    logger.With(slog.String("mailer", "smtp")),
+		addr:      net.JoinHostPort(cfg.SMTPHost, strconv.Itoa(port)),
+		host:      cfg.SMTPHost,
+		mode:      mode,
+		tlsConfig: &tls.Config{ServerName: cfg.SMTPHost},
+		auth:      auth,
+		from:      mail.Address{Name: cfg.SMTPFromName, Address: cfg.SMTPFromAddress},
+		timeout:   smtpIOTimeout,
+	}, nil
+}
+
+func (m *SMTP) Send(
+	ctx context.Context,
+	msg Message,
+) error {
+	body, err := m.build(msg)
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	m.logger.Info("sending live email",
+		slog.String("email", msg.Address.Address),
+		slog.String("name", msg.Name),
+		slog.String("subject", msg.Subject),
+	)
+
+	// A reused connection may have been closed by the server due to inactivity
+	// and there's no way to know until it's used, so a failure on a reused one
+	// is retried once on a fresh connection before giving up.
+	c := m.take()
+	err = m.send(ctx, c, msg.Address.Address, body)
+	if err != nil && c != nil {
+		err = m.send(ctx, nil, msg.Address.Address, body)
+	}
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+// Close terminates the idle connection to the SMTP server, if there is one.
+func (m *SMTP) Close() error {
+	c := m.take()
+	if c == nil {
+		return nil
+	}
+
+	c.conn.SetDeadline(time.Now().Add(m.timeout))
+
+	return c.client.Quit()
+}
+
+// take hands out the idle connection, if there is one, for the caller to use
+// exclusively until it's returned with put.
+func (m *SMTP) take() *smtpConn {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c := m.idle
+	m.idle = nil
+	return c
+}
+
+// put keeps a connection which is in a good state for the next send. If one is
+// already being kept, because sends overlapped, this one is closed instead.
+func (m *SMTP) put(c *smtpConn) {
+	m.mu.Lock()
+	if m.idle == nil {
+		m.idle = c
+		c = nil
+	}
+	m.mu.Unlock()
+
+	if c != nil {
+		c.client.Quit()
+	}
+}
+
+// send delivers one message on the given connection or, if it's nil, on a new
+// one. The connection is only kept for reuse if the message was accepted.
+func (m *SMTP) send(ctx context.Context, c *smtpConn, to string, body []byte) error {
+	if c == nil {
+		var err error
+		c, err = m.dial(ctx)
+		if err != nil {
+			return err
+		}
+	} else {
+		c.conn.SetDeadline(m.deadline(ctx))
+	}
+
+	err := func() error {
+		if err := c.client.Mail(m.from.Address); err != nil {
+			return err
+		}
+
+		if err := c.client.Rcpt(to); err != nil {
+			return err
+		}
+
+		w, err := c.client.Data()
+		if err != nil {
+			return err
+		}
+
+		if _, err := w.Write(body); err != nil {
+			return err
+		}
+
+		return w.Close()
+	}()
+	if err != nil {
+		// The connection is in an unknown state, throw it away.
+		c.client.Close()
+		return err
+	}
+
+	m.put(c)
+
+	return nil
+}
+
+func (m *SMTP) dial(ctx context.Context) (*smtpConn, error) {
+	dialer := &net.Dialer{Timeout: smtpDialTimeout}
+
+	var conn net.Conn
+	var err error
+	if m.mode == smtpTLSModeImplicit {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: m.tlsConfig}).DialContext(ctx, "tcp", m.addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", m.addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Covers the greeting, STARTTLS and authentication as well as the message.
+	conn.SetDeadline(m.deadline(ctx))
+
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if m.mode == smtpTLSModeStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			c.Close()
+			return nil, ErrSMTPNoStartTLS
+		}
+
+		if err := c.StartTLS(m.tlsConfig); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+
+	if m.auth != nil {
+		if err := c.Auth(m.auth); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+
+	return &smtpConn{conn: conn, client: c}, nil
+}
+
+// deadline is when the current conversation with the server must be over by,
+// the I/O timeout from now or the context's deadline if that's sooner.
+func (m *SMTP) deadline(ctx context.Context) time.Time {
+	d := time.Now().Add(m.timeout)
+	if cd, ok := ctx.Deadline(); ok && cd.Before(d) {
+		return cd
+	}
+	return d
+}
+
+// build renders the message as a MIME email. When both HTML and plain text are
+// provided, a multipart/alternative body is produced so clients can choose.
+func (m *SMTP) build(msg Message) ([]byte, error) {
+	to := mail.Address{Name: msg.Name, Address: msg.Address.Address}
+
+	var buf bytes.Buffer
+
+	header := textproto.MIMEHeader{}
+	header.Set("From", m.from.String())
+	header.Set("To", to.String())
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header.Set("Date", time.Now().Format(time.RFC1123Z))
+	header.Set("Message-ID", m.messageID())
+	header.Set("MIME-Version", "1.0")
+
+	for k, v := range msg.Headers {
+		header.Set(k, v)
+	}
+
+	switch {
+	case msg.Content.HTML != "" && msg.Content.Plain != "":
+		mw := multipart.NewWriter(&buf)
+		header.Set("Content-Type", "multipart/alternative; boundary="+mw.Boundary())
+		writeHeader(&buf, header)
+
+		if err := writePart(mw, "text/plain", msg.Content.Plain); err != nil {
+			return nil, err
+		}
+
+		if err := writePart(mw, "text/html", msg.Content.HTML); err != nil {
+			return nil, err
+		}
+
+		if err := mw.Close(); err != nil {
+			return nil, err
+		}
+
+	case msg.Content.HTML != "":
+		header.Set("Content-Type", `text/html; charset="utf-8"`)
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		writeHeader(&buf, header)
+		if err := writeQuotedPrintable(&buf, msg.Content.HTML); err != nil {
+			return nil, err
+		}
+
+	default:
+		header.Set("Content-Type", `text/plain; charset="utf-8"`)
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		writeHeader(&buf, header)
+		if err := writeQuotedPrintable(&buf, msg.Content.Plain); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (m *SMTP) messageID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	domain := m.host
+	if at := strings.LastIndex(m.from.Address, "@"); at != -1 {
+		domain = m.from.Address[at+1:]
+	}
+
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), domain)
+}
+
+var standardHeaders = []string{"From", "To", "Subject", "Date", "Message-ID", "MIME-Version", "Content-Type", "Content-Transfer-Encoding"}
+
+// writeHeader writes the standard headers in a fixed order followed by any
+// additional headers in key order, so the output is deterministic.
+func writeHeader(w io.Writer, header textproto.MIMEHeader) {
+	for _, k := range standardHeaders {
+		if v := header.Get(k); v != "" {
+			fmt.Fprintf(w, "%s: %s\r\n", k, v)
+		}
+	}
+
+	extra := []string{}
+	for k := range header {
+		// Keys in the header are canonicalised, which spells some of the
+		// standard headers differently to how they're written above.
+		standard := slices.ContainsFunc(standardHeaders, func(s string) bool {
+			return textproto.CanonicalMIMEHeaderKey(s) == k
+		})
+		if !standard {
+			extra = append(extra, k)
+		}
+	}
+	slices.Sort(extra)
+
+	for _, k := range extra {
+		fmt.Fprintf(w, "%s: %s\r\n", k, header.Get(k))
+	}
+
+	fmt.Fprint(w, "\r\n")
+}
+
+func writePart(mw *multipart.Writer, contentType string, content string) error {
+	pw, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType + `; charset="utf-8"`},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeQuotedPrintable(pw, content)
+}
+
+func writeQuotedPrintable(w io.Writer, content string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
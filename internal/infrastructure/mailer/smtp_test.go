@@ -0,0 +1,309 @@
+package mailer
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+// fakeSMTPServer is a minimal in-process SMTP server which accepts any mail
+// and records the raw DATA of each message along with the connection count.
+type fakeSMTPServer struct {
+	ln net.Listener
+
+	mu          sync.Mutex
+	connections int
+	messages    []string
+
+	// dropAfterMessage closes the connection after each message is accepted
+	// to simulate a server timing out an idle connection.
+	dropAfterMessage bool
+
+	// hangFirstConnection never responds on the first connection to simulate
+	// a server which has stopped responding part way through.
+	hangFirstConnection bool
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeSMTPServer{ln: ln}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+
+			s.mu.Lock()
+			s.connections++
+			hang := s.hangFirstConnection && s.connections == 1
+			s.mu.Unlock()
+
+			if hang {
+				t.Cleanup(func() { conn.Close() })
+				continue
+			}
+
+			go s.handle(conn)
+		}
+	}()
+
+	return s
+}
+
+func (s *fakeSMTPServer) config() config.Config {
+	host, port, _ := net.SplitHostPort(s.ln.Addr().String())
+	p, _ := strconv.Atoi(port)
+
+	return config.Config{
+		SMTPHost:        host,
+		SMTPPort:        p,
+		SMTPTLSMode:     "none",
+		SMTPFromName:    "Storyden",
+		SMTPFromAddress: "no-reply@storyden.test",
+	}
+}
+
+func (s *fakeSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 fake ESMTP")
+
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch verb {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250-fake")
+			tp.PrintfLine("250 8BITMIME")
+
+		case "MAIL", "RCPT", "RSET", "NOOP":
+			tp.PrintfLine("250 OK")
+
+		case "DATA":
+			tp.PrintfLine("354 go ahead")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+
+			s.mu.Lock()
+			s.messages = append(s.messages, string(data))
+			s.mu.Unlock()
+
+			tp.PrintfLine("250 OK")
+
+			if s.dropAfterMessage {
+				return
+			}
+
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+
+		default:
+			tp.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func (s *fakeSMTPServer) stats() (int, []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.connections, append([]string{}, s.messages...)
+}
+
+func testMessage(t *testing.T, subject string) Message {
+	content, err := NewContent("<p>Hello <b>Odin</b></p>", "Hello Odin")
+	require.NoError(t, err)
+
+	msg, err := NewMessage(mail.Address{Name: "Odin", Address: "odin@storyden.test"}, "", subject, *content)
+	require.NoError(t, err)
+
+	return *msg
+}
+
+func TestSMTP_MultipartAndConnectionReuse(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+	ctx := context.Background()
+
+	srv := newFakeSMTPServer(t)
+
+	m, err := newSMTPMailer(slog.Default(), srv.config())
+	r.NoError(err)
+	defer m.Close()
+
+	first := testMessage(t, "Welcome to Ásgarðr")
+	first.Headers = map[string]string{"List-Unsubscribe": "<https://storyden.test/unsubscribe>"}
+
+	r.NoError(m.Send(ctx, first))
+	r.NoError(m.Send(ctx, testMessage(t, "Second")))
+
+	connections, messages := srv.stats()
+	a.Equal(1, connections, "the connection should be reused between messages")
+	r.Len(messages, 2)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(messages[0]))
+	r.NoError(err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	r.NoError(err)
+	a.Equal("Welcome to Ásgarðr", subject)
+	a.Contains(parsed.Header.Get("From"), "no-reply@storyden.test")
+	a.Contains(parsed.Header.Get("To"), "odin@storyden.test")
+	a.True(strings.HasSuffix(parsed.Header.Get("Message-ID"), "@storyden.test>"))
+	a.Equal("<https://storyden.test/unsubscribe>", parsed.Header.Get("List-Unsubscribe"))
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	r.NoError(err)
+	a.Equal("multipart/alternative", mediaType)
+
+	parts := map[string]string{}
+	mr := multipart.NewReader(parsed.Body, params["boundary"])
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		r.NoError(err)
+
+		ct, _, _ := mime.ParseMediaType(p.Header.Get("Content-Type"))
+		b, err := io.ReadAll(p)
+		r.NoError(err)
+		parts[ct] = string(b)
+	}
+
+	a.Equal("Hello Odin", parts["text/plain"])
+	a.Equal("<p>Hello <b>Odin</b></p>", parts["text/html"])
+}
+
+func TestSMTP_HeadersWrittenOnce(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+
+	m, err := newSMTPMailer(slog.Default(), config.Config{SMTPHost: "localhost", SMTPFromAddress: "no-reply@storyden.test"})
+	r.NoError(err)
+
+	msg := testMessage(t, "Headers")
+	msg.Headers = map[string]string{"List-Unsubscribe": "<https://storyden.test/unsubscribe>"}
+
+	b, err := m.build(msg)
+	r.NoError(err)
+
+	head, _, ok := strings.Cut(string(b), "\r\n\r\n")
+	r.True(ok)
+
+	// Parsed headers are merged by key, so count the raw lines instead.
+	counts := map[string]int{}
+	for _, line := range strings.Split(head, "\r\n") {
+		name, _, ok := strings.Cut(line, ":")
+		if !ok || strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			continue
+		}
+		counts[textproto.CanonicalMIMEHeaderKey(name)]++
+	}
+
+	for _, k := range []string{"From", "To", "Subject", "Date", "Message-ID", "MIME-Version", "Content-Type", "List-Unsubscribe"} {
+		a.Equal(1, counts[textproto.CanonicalMIMEHeaderKey(k)], k)
+	}
+	for k, n := range counts {
+		a.Equal(1, n, k)
+	}
+}
+
+func TestSMTP_ReconnectsWhenConnectionDropped(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+	ctx := context.Background()
+
+	srv := newFakeSMTPServer(t)
+	srv.dropAfterMessage = true
+
+	m, err := newSMTPMailer(slog.Default(), srv.config())
+	r.NoError(err)
+	defer m.Close()
+
+	r.NoError(m.Send(ctx, testMessage(t, "First")))
+	r.NoError(m.Send(ctx, testMessage(t, "Second")))
+
+	connections, messages := srv.stats()
+	a.Equal(2, connections)
+	a.Len(messages, 2)
+}
+
+func TestSMTP_UnresponsiveServer(t *testing.T) {
+	r := require.New(t)
+	a := assert.New(t)
+	ctx := context.Background()
+
+	srv := newFakeSMTPServer(t)
+	srv.mu.Lock()
+	srv.hangFirstConnection = true
+	srv.mu.Unlock()
+
+	m, err := newSMTPMailer(slog.Default(), srv.config())
+	r.NoError(err)
+	m.timeout = time.Second
+	defer m.Close()
+
+	hung := make(chan error)
+	go func() { hung <- m.Send(ctx, testMessage(t, "Hung")) }()
+
+	// Wait for the first send to be stuck on the hung connection.
+	r.Eventually(func() bool {
+		connections, _ := srv.stats()
+		return connections == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// Other sends aren't held up by the one waiting on the hung server.
+	start := time.Now()
+	r.NoError(m.Send(ctx, testMessage(t, "Second")))
+	a.Less(time.Since(start), m.timeout)
+
+	select {
+	case err := <-hung:
+		a.Error(err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("send to an unresponsive server did not time out")
+	}
+
+	_, messages := srv.stats()
+	a.Len(messages, 1)
+}
+
+func TestSMTP_Config(t *testing.T) {
+	_, err := newSMTPMailer(slog.Default(), config.Config{SMTPFromAddress: "a@b.c"})
+	assert.ErrorIs(t, err, ErrSMTPMissingHost)
+
+	_, err = newSMTPMailer(slog.Default(), config.Config{SMTPHost: "localhost"})
+	assert.ErrorIs(t, err, ErrSMTPMissingFromAddress)
+
+	_, err = newSMTPMailer(slog.Default(), config.Config{SMTPHost: "localhost", SMTPFromAddress: "a@b.c", SMTPTLSMode: "ssl"})
+	assert.Error(t, err)
+}
@@ -94,3 +94,52 @@ func (s *localStorer) Write(ctx context.Context, path string, r io.Reader, size
 
 	return nil
 }
+
+func (s *localStorer) Delete(ctx context.Context, path string) error {
+	err := os.Remove(filepath.Join(s.path, path))
+	if err != nil && !os.IsNotExist(err) {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (s *localStorer) List(ctx context.Context, prefix string) ([]Object, error) {
+	objects := []Object{}
+
+	err := filepath.WalkDir(filepath.Join(s.path, prefix), func(fullpath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(s.path, fullpath)
+		if err != nil {
+			return err
+		}
+
+		objects = append(objects, Object{
+			Path:     filepath.ToSlash(rel),
+			Size:     info.Size(),
+			Modified: info.ModTime(),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return objects, nil
+}
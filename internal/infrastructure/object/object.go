@@ -3,6 +3,7 @@ package object
 import (
 	"context"
 	"io"
+	"time"
 
 	"go.uber.org/fx"
 
@@ -13,6 +14,20 @@ type Storer interface {
 	Exists(ctx context.Context, path string) (bool, error)
 	Read(ctx context.Context, path string) (io.Reader, int64, error)
 	Write(ctx context.Context, path string, w io.Reader, size int64) error
+
+	// Delete removes the object at path. Deleting an object which does not
+	// exist is not an error.
+	Delete(ctx context.Context, path string) error
+
+	// List returns every object whose path begins with prefix, recursively.
+	List(ctx context.Context, prefix string) ([]Object, error)
+}
+
+// Object describes a single stored file as returned by List.
+type Object struct {
+	Path     string
+	Size     int64
+	Modified time.Time
 }
 
 func Build() fx.Option {
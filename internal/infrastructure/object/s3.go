@@ -95,3 +95,35 @@ func (s *s3Storer) Write(ctx context.Context, path string, stream io.Reader, siz
 
 	return nil
 }
+
+func (s *s3Storer) Delete(ctx context.Context, path string) error {
+	// S3 treats deleting a missing key as a success so there's no need to
+	// special-case not-found errors here.
+	err := s.minioClient.RemoveObject(ctx, s.bucket, path, minio.RemoveObjectOptions{})
+	if err != nil {
+		return fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return nil
+}
+
+func (s *s3Storer) List(ctx context.Context, prefix string) ([]Object, error) {
+	objects := []Object{}
+
+	for obj := range s.minioClient.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	}) {
+		if obj.Err != nil {
+			return nil, fault.Wrap(obj.Err, fctx.With(ctx))
+		}
+
+		objects = append(objects, Object{
+			Path:     obj.Key,
+			Size:     obj.Size,
+			Modified: obj.LastModified,
+		})
+	}
+
+	return objects, nil
+}
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"os"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/ThreeDotsLabs/watermill/components/cqrs"
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/rs/xid"
 	"go.uber.org/fx"
 
 	"github.com/Southclaws/storyden/internal/config"
@@ -33,6 +35,10 @@ type Bus struct {
 	eventProcessor   *cqrs.EventProcessor
 	commandProcessor *cqrs.CommandProcessor
 
+	// instanceID distinguishes this process from other replicas so broadcast
+	// subscriptions get their own queue instead of sharing one.
+	instanceID string
+
 	mu            sync.RWMutex
 	subscriptions map[subscriptionKey]*Subscription
 }
@@ -126,6 +132,20 @@ func newBus(
 			// NOTE 2: This is durable and needs to be optionally durable based
 			// on parameters passed to Subscribe. But that's really awkward...
 			if cfg.QueueType == "amqp" {
+				// Broadcast subscriptions hold no state worth keeping when
+				// the instance goes away, so their queues are transient.
+				if strings.HasPrefix(params.HandlerName, broadcastPrefix) {
+					apsc := amqp.NewNonDurablePubSubConfig(cfg.AmqpURL, func(topic string) string {
+						return topic + "." + params.HandlerName
+					})
+					apsc.Queue.AutoDelete = true
+					subscriber, err := amqp.NewSubscriber(apsc, logger)
+					if err != nil {
+						return nil, err
+					}
+					return subscriber, nil
+				}
+
 				apsc := amqp.NewDurablePubSubConfig(cfg.AmqpURL, func(topic string) string {
 					return topic + "." + params.HandlerName
 				})
@@ -202,6 +222,7 @@ func newBus(
 		commandBus:       commandBus,
 		eventProcessor:   eventProcessor,
 		commandProcessor: commandProcessor,
+		instanceID:       xid.New().String(),
 		subscriptions:    make(map[subscriptionKey]*Subscription),
 	}, nil
 }
@@ -334,6 +355,15 @@ func Subscribe[T any](ctx context.Context, bus *Bus, handlerName string, handler
 	return sub, nil
 }
 
+const broadcastPrefix = "broadcast."
+
+// SubscribeBroadcast is like Subscribe except every running instance receives
+// every event instead of one instance handling each event. Use this for state
+// held in memory by each instance, such as open streaming connections.
+func SubscribeBroadcast[T any](ctx context.Context, bus *Bus, handlerName string, handler HandlerFunc[T]) (*Subscription, error) {
+	return Subscribe(ctx, bus, broadcastPrefix+handlerName+"."+bus.instanceID, handler)
+}
+
 func SubscribeCommand[T any](ctx context.Context, bus *Bus, handlerName string, handler CommandHandlerFunc[T]) (*Subscription, error) {
 	var zero T
 	topic := topicFromValue(zero)
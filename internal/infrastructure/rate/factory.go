@@ -25,5 +25,5 @@ func (f *LimiterFactory) NewLimiter(
 	period time.Duration,
 	expiry time.Duration,
 ) Limiter {
-	return wrap(swirl.New(f.store, limit, period, expiry))
+	return wrap(f.store, limit, period, expiry)
 }
@@ -2,6 +2,9 @@ package rate
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
@@ -12,15 +15,26 @@ import (
 type Limiter interface {
 	Increment(ctx context.Context, key string, cost int) (*swirl.Status, bool, error)
 	Check(ctx context.Context, key string, cost int) error
+
+	// Peek reports whether cost could be charged to key without charging it,
+	// so a request can be checked against several limits before any of them
+	// are consumed.
+	Peek(ctx context.Context, key string, cost int) (*swirl.Status, bool, error)
 }
 
 type swirlLimiter struct {
-	rl *swirl.Limiter
+	rl     *swirl.Limiter
+	store  swirl.Store
+	limit  int
+	period time.Duration
 }
 
-func wrap(rl *swirl.Limiter) Limiter {
+func wrap(store swirl.Store, limit int, period, expiry time.Duration) Limiter {
 	return &swirlLimiter{
-		rl: rl,
+		rl:     swirl.New(store, limit, period, expiry),
+		store:  store,
+		limit:  limit,
+		period: period,
 	}
 }
 
@@ -45,3 +59,38 @@ func (l *swirlLimiter) Check(ctx context.Context, key string, cost int) error {
 
 	return nil
 }
+
+// Peek mirrors the sliding window sum used by swirl's Increment, without
+// writing to the store.
+func (l *swirlLimiter) Peek(ctx context.Context, key string, cost int) (*swirl.Status, bool, error) {
+	now := time.Now()
+
+	vals, err := l.store.HGetAll(ctx, key)
+	if err != nil {
+		return nil, false, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	threshold := fmt.Sprint(now.Add(-l.period).Unix())
+
+	total := 0
+	for k, v := range vals {
+		if k <= threshold {
+			continue
+		}
+
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, false, fault.Wrap(err, fctx.With(ctx))
+		}
+		total += i
+	}
+
+	status := &swirl.Status{
+		Remaining: max(0, l.limit-total-cost),
+		Limit:     l.limit,
+		Period:    l.period,
+		Reset:     now.Add(l.period),
+	}
+
+	return status, total+cost < l.limit, nil
+}
@@ -0,0 +1,69 @@
+// Package schedule runs background jobs on a fixed interval for the lifetime of
+// the process.
+//
+// Every instance of Storyden runs every job, there's no leader election. So a
+// job must be idempotent and safe to run at the same time as itself on another
+// instance, usually by claiming the rows it works on with a conditional update
+// and skipping any row another instance claimed first.
+package schedule
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.uber.org/fx"
+)
+
+// Job is a single run of a scheduled job.
+type Job func(ctx context.Context) error
+
+// Every runs job each interval between the application starting and stopping,
+// a non-positive interval disables it. Runs never overlap on one instance, a
+// tick which arrives while the previous run is still going is dropped. Errors
+// are logged and the job runs again on the next tick.
+func Every(ctx context.Context, lc fx.Lifecycle, logger *slog.Logger, name string, interval time.Duration, job Job) {
+	if interval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+
+				ticker := time.NewTicker(interval)
+				defer ticker.Stop()
+
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+						if err := job(ctx); err != nil {
+							logger.Error("scheduled job failed",
+								slog.String("job", name),
+								slog.String("error", err.Error()))
+						}
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+
+			// Let an in-progress run finish its current step before the
+			// database and other dependencies are shut down.
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+
+			return nil
+		},
+	})
+}
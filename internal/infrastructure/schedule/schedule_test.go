@@ -0,0 +1,59 @@
+package schedule_test
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx/fxtest"
+
+	"github.com/Southclaws/storyden/internal/infrastructure/schedule"
+)
+
+func TestEvery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("runs_until_stopped", func(t *testing.T) {
+		r := require.New(t)
+		a := assert.New(t)
+
+		lc := fxtest.NewLifecycle(t)
+		var runs atomic.Int32
+
+		schedule.Every(context.Background(), lc, slog.Default(), "test", 10*time.Millisecond, func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		})
+
+		r.NoError(lc.Start(context.Background()))
+		r.Eventually(func() bool { return runs.Load() >= 2 }, time.Second, 5*time.Millisecond)
+		r.NoError(lc.Stop(context.Background()))
+
+		stopped := runs.Load()
+		time.Sleep(50 * time.Millisecond)
+		a.Equal(stopped, runs.Load())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		r := require.New(t)
+		a := assert.New(t)
+
+		lc := fxtest.NewLifecycle(t)
+		var runs atomic.Int32
+
+		schedule.Every(context.Background(), lc, slog.Default(), "test", 0, func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		})
+
+		r.NoError(lc.Start(context.Background()))
+		time.Sleep(50 * time.Millisecond)
+		r.NoError(lc.Stop(context.Background()))
+
+		a.Zero(runs.Load())
+	})
+}
@@ -71,7 +71,7 @@ func newSessionHelper(
 func (h *SessionHelper) WithSession(ctx context.Context) openapi.RequestEditorFn {
 	accountID := ctx.Value(contextKey).(account.AccountID)
 
-	t, err := h.tr.Issue(context.Background(), accountID)
+	t, err := h.tr.Issue(context.Background(), accountID, token.Device{})
 	if err != nil {
 		panic(err)
 	}
@@ -71,13 +71,15 @@ func Test(t *testing.T, cfg *config.Config, o ...fx.Option) {
 		cf()
 	})
 
-	o = append(o,
+	// The application comes first so its start hooks, such as the pubsub
+	// consumers subscribing to their topics, run before those of the test.
+	o = append([]fx.Option{
 		// main application dependencies
 		application(),
 
 		// provide a global context
 		fx.Provide(func() context.Context { return ctx }),
-	)
+	}, o...)
 
 	// if this test has a custom config, merge+overwrite with the defaults.
 	if cfg != nil {
@@ -1,7 +1,9 @@
 package otp
 
 import (
+	"encoding/base32"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -11,3 +13,39 @@ func Test_generateCode(t *testing.T) {
 	c2, _ := Generate()
 	assert.NotEqual(t, c1, c2)
 }
+
+func TestTOTP(t *testing.T) {
+	// RFC 6238 appendix B test vectors, truncated to six digits.
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	for ts, want := range map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	} {
+		got, err := TOTP(secret, TOTPStep(time.Unix(ts, 0)))
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	secret, err := GenerateSecret()
+	assert.NoError(t, err)
+
+	now := time.Now()
+
+	code, err := TOTP(secret, TOTPStep(now.Add(-30*time.Second)))
+	assert.NoError(t, err)
+
+	step, ok := ValidateTOTP(secret, code, now)
+	assert.True(t, ok)
+	assert.Equal(t, TOTPStep(now)-1, step)
+
+	code, err = TOTP(secret, TOTPStep(now.Add(-2*time.Minute)))
+	assert.NoError(t, err)
+
+	_, ok = ValidateTOTP(secret, code, now)
+	assert.False(t, ok)
+}
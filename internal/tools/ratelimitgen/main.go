@@ -0,0 +1,145 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/pb33f/libopenapi"
+)
+
+// extension is the name of the OpenAPI operation extension that declares how
+// an operation is rate limited. For example:
+//
+//	x-rate-limit:
+//	  cost: 10     # charged against the global rate limit, defaults to 1
+//	  limit: 30    # optional, a separate limit for only this operation...
+//	  period: 1h   # ...over this period, required if limit is set
+const extension = "x-rate-limit"
+
+func main() {
+	schemaFlag := flag.String("schema", "api/openapi.yaml", "path to openapi schema")
+	outputFlag := flag.String("output", "app/transports/http/middleware/limiter/limiter_gen.go", "path to output file")
+
+	flag.Parse()
+
+	if err := run(*schemaFlag, *outputFlag); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+type RateLimit struct {
+	Cost   int    `yaml:"cost"`
+	Limit  int    `yaml:"limit"`
+	Period string `yaml:"period"`
+}
+
+type Operation struct {
+	Name   string
+	Cost   int
+	Limit  int
+	Period time.Duration
+}
+
+func run(filename, outfile string) error {
+	spec, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	document, err := libopenapi.NewDocument(spec)
+	if err != nil {
+		return fmt.Errorf("cannot create new document: %w", err)
+	}
+
+	docModel, err := document.BuildV3Model()
+	if err != nil {
+		return fmt.Errorf("cannot create v3 model from document: %w", err)
+	}
+
+	ops := []Operation{}
+
+	for _, path := range docModel.Model.Paths.PathItems.FromOldest() {
+		for _, op := range path.GetOperations().FromOldest() {
+			node, ok := op.Extensions.Get(extension)
+			if !ok {
+				continue
+			}
+
+			var rl RateLimit
+			if err := node.Decode(&rl); err != nil {
+				return fmt.Errorf("%s: cannot decode %s: %w", op.OperationId, extension, err)
+			}
+
+			o, err := validate(op.OperationId, rl)
+			if err != nil {
+				return err
+			}
+
+			ops = append(ops, o)
+		}
+	}
+
+	sort.Slice(ops, func(i, j int) bool { return ops[i].Name < ops[j].Name })
+
+	f := jen.NewFile("limiter")
+
+	f.HeaderComment("Code generated by ratelimitgen. DO NOT EDIT.")
+
+	values := jen.Dict{}
+	for _, op := range ops {
+		fields := jen.Dict{
+			jen.Id("Cost"): jen.Lit(op.Cost),
+		}
+		if op.Limit > 0 {
+			fields[jen.Id("Limit")] = jen.Lit(op.Limit)
+			fields[jen.Id("Period")] = jen.Lit(int(op.Period.Seconds())).Op("*").Qual("time", "Second")
+		}
+
+		values[jen.Lit(op.Name)] = jen.Values(fields)
+	}
+
+	f.Comment("operations holds the rate limit parameters for every operation which")
+	f.Comment("declares the " + extension + " extension, all other operations cost 1.")
+	f.Var().Id("operations").Op("=").Map(jen.String()).Id("Operation").Values(values)
+
+	return f.Save(outfile)
+}
+
+func validate(name string, rl RateLimit) (Operation, error) {
+	op := Operation{Name: name, Cost: rl.Cost, Limit: rl.Limit}
+
+	if op.Cost == 0 {
+		op.Cost = 1
+	}
+	if op.Cost < 0 {
+		return Operation{}, fmt.Errorf("%s: %s cost must be positive", name, extension)
+	}
+
+	if rl.Limit == 0 {
+		if rl.Period != "" {
+			return Operation{}, fmt.Errorf("%s: %s period requires a limit", name, extension)
+		}
+		return op, nil
+	}
+
+	if rl.Period == "" {
+		return Operation{}, fmt.Errorf("%s: %s limit requires a period", name, extension)
+	}
+
+	period, err := time.ParseDuration(rl.Period)
+	if err != nil {
+		return Operation{}, fmt.Errorf("%s: %s period: %w", name, extension, err)
+	}
+	if period < time.Second {
+		return Operation{}, fmt.Errorf("%s: %s period must be at least one second", name, extension)
+	}
+
+	op.Period = period
+
+	return op, nil
+}
@@ -58,21 +58,21 @@ func TestAccountAdmin(t *testing.T) {
 
 			// Try to suspend the account without being logged in - fails
 
-			suspend1, err := cl.AdminAccountBanCreateWithResponse(root, victim.JSON200.Id)
+			suspend1, err := cl.AdminAccountBanCreateWithResponse(root, victim.JSON200.Id, openapi.AccountSuspensionInitialProps{})
 			r.NoError(err)
 			r.NotNil(suspend1)
 			r.Equal(http.StatusUnauthorized, suspend1.StatusCode())
 
 			// Try to suspend the account as a non-admin - fails
 
-			suspend2, err := cl.AdminAccountBanCreateWithResponse(root, victim.JSON200.Id, randomSession)
+			suspend2, err := cl.AdminAccountBanCreateWithResponse(root, victim.JSON200.Id, openapi.AccountSuspensionInitialProps{}, randomSession)
 			r.NoError(err)
 			r.NotNil(suspend2)
 			r.Equal(http.StatusForbidden, suspend2.StatusCode())
 
 			// Try to suspend the account as an admin - succeeds
 
-			suspend3, err := cl.AdminAccountBanCreateWithResponse(root, victim.JSON200.Id, adminSession)
+			suspend3, err := cl.AdminAccountBanCreateWithResponse(root, victim.JSON200.Id, openapi.AccountSuspensionInitialProps{}, adminSession)
 			r.NoError(err)
 			r.NotNil(suspend3)
 			r.Equal(http.StatusOK, suspend3.StatusCode())
@@ -0,0 +1,295 @@
+package totp_test
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/internal/otp"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestTOTPAuth(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		mail mailer.Sender,
+	) {
+		inbox := mail.(*mailer.Mock)
+
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			signup := func(t *testing.T) (string, openapi.RequestEditorFn) {
+				handle := "totp-" + xid.New().String()
+
+				res, err := cl.AuthPasswordSignupWithResponse(root, nil, openapi.AuthPair{Identifier: handle, Token: "password"})
+				tests.Ok(t, err, res)
+
+				accountID := account.AccountID(openapi.GetAccountID(res.JSON200.Id))
+
+				return handle, sh.WithSession(e2e.WithAccountID(root, accountID))
+			}
+
+			signin := func(t *testing.T, handle string) *openapi.AuthPasswordSigninResponse {
+				res, err := cl.AuthPasswordSigninWithResponse(root, openapi.AuthPair{Identifier: handle, Token: "password"})
+				require.NoError(t, err)
+				return res
+			}
+
+			// Codes are single use, so each verification uses a later step.
+			code := func(t *testing.T, secret string, offset int64) string {
+				c, err := otp.TOTP(secret, otp.TOTPStep(time.Now())+offset)
+				require.NoError(t, err)
+				return c
+			}
+
+			t.Run("enrol_and_signin", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				handle, session := signup(t)
+
+				// Without a second factor, sign in issues a session immediately.
+				res := signin(t, handle)
+				tests.Ok(t, nil, res)
+
+				enrol, err := cl.AuthTOTPEnrolWithResponse(root, openapi.AuthTOTPEnrolProps{}, session)
+				tests.Ok(t, err, enrol)
+				secret := enrol.JSON200.Secret
+				a.Contains(enrol.JSON200.Uri, "otpauth://totp/")
+				a.Contains(enrol.JSON200.Uri, handle)
+
+				// Not active until confirmed.
+				res = signin(t, handle)
+				tests.Ok(t, nil, res)
+
+				bad, err := cl.AuthTOTPConfirmWithResponse(root, openapi.AuthTOTPConfirmProps{Code: "000000"}, session)
+				tests.Status(t, err, bad, http.StatusForbidden)
+
+				confirm, err := cl.AuthTOTPConfirmWithResponse(root, openapi.AuthTOTPConfirmProps{Code: code(t, secret, -1)}, session)
+				tests.Ok(t, err, confirm)
+				r.Len(confirm.JSON200.RecoveryCodes, 10)
+
+				// Now signing in is challenged, no session is issued.
+				res = signin(t, handle)
+				tests.Status(t, nil, res, http.StatusAccepted)
+				r.NotNil(res.JSON202)
+				a.False(res.JSON202.EnrolmentRequired)
+				a.Empty(res.HTTPResponse.Header.Get("Set-Cookie"))
+				challenge := res.JSON202.Challenge
+
+				wrong, err := cl.AuthTOTPVerifyWithResponse(root, openapi.AuthTOTPVerifyProps{Challenge: challenge, Code: "000000"})
+				tests.Status(t, err, wrong, http.StatusForbidden)
+
+				// The code used to confirm the enrolment cannot be replayed.
+				replay, err := cl.AuthTOTPVerifyWithResponse(root, openapi.AuthTOTPVerifyProps{Challenge: challenge, Code: code(t, secret, -1)})
+				tests.Status(t, err, replay, http.StatusForbidden)
+
+				verify, err := cl.AuthTOTPVerifyWithResponse(root, openapi.AuthTOTPVerifyProps{Challenge: challenge, Code: code(t, secret, 0)})
+				tests.Ok(t, err, verify)
+				a.NotEmpty(verify.HTTPResponse.Header.Get("Set-Cookie"))
+				a.Nil(verify.JSON200.RecoveryCodes)
+
+				// A challenge is consumed once met.
+				again, err := cl.AuthTOTPVerifyWithResponse(root, openapi.AuthTOTPVerifyProps{Challenge: challenge, Code: code(t, secret, 1)})
+				tests.Status(t, err, again, http.StatusUnauthorized)
+
+				// Recovery codes work in place of the app, once each.
+				res = signin(t, handle)
+				tests.Status(t, nil, res, http.StatusAccepted)
+
+				recovery, err := cl.AuthTOTPVerifyWithResponse(root, openapi.AuthTOTPVerifyProps{Challenge: res.JSON202.Challenge, Code: confirm.JSON200.RecoveryCodes[0]})
+				tests.Ok(t, err, recovery)
+
+				res = signin(t, handle)
+				tests.Status(t, nil, res, http.StatusAccepted)
+
+				reused, err := cl.AuthTOTPVerifyWithResponse(root, openapi.AuthTOTPVerifyProps{Challenge: res.JSON202.Challenge, Code: confirm.JSON200.RecoveryCodes[0]})
+				tests.Status(t, err, reused, http.StatusForbidden)
+
+				// Second factors are not offered as a way to sign in.
+				providers, err := cl.AuthProviderListWithResponse(root)
+				tests.Ok(t, err, providers)
+				for _, p := range providers.JSON200.Providers {
+					a.NotEqual("totp", p.Provider)
+				}
+
+				// Removing the method turns the second factor off.
+				methods, err := cl.AccountAuthProviderListWithResponse(root, session)
+				tests.Ok(t, err, methods)
+				var methodID string
+				for _, m := range methods.JSON200.Active {
+					if m.Provider.Provider == "totp" {
+						methodID = m.Id
+					}
+				}
+				r.NotEmpty(methodID)
+
+				del, err := cl.AccountAuthMethodDeleteWithResponse(root, methodID, session)
+				tests.Ok(t, err, del)
+
+				res = signin(t, handle)
+				tests.Ok(t, nil, res)
+			})
+
+			t.Run("password_reset_is_challenged", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				email := xid.New().String() + "@storyden.org"
+
+				signup, err := cl.AuthEmailPasswordSignupWithResponse(root, nil, openapi.AuthEmailPasswordSignupJSONRequestBody{Email: email, Password: "password"})
+				tests.Ok(t, err, signup)
+				session := e2e.WithSessionFromHeader(t, root, signup.HTTPResponse.Header)
+
+				enrol, err := cl.AuthTOTPEnrolWithResponse(root, openapi.AuthTOTPEnrolProps{}, session)
+				tests.Ok(t, err, enrol)
+				secret := enrol.JSON200.Secret
+
+				confirm, err := cl.AuthTOTPConfirmWithResponse(root, openapi.AuthTOTPConfirmProps{Code: code(t, secret, -1)}, session)
+				tests.Ok(t, err, confirm)
+
+				request, err := cl.AuthPasswordResetRequestEmailWithResponse(root, openapi.AuthEmailPasswordReset{
+					Email: email,
+					TokenUrl: struct {
+						Query string `json:"query"`
+						Url   string `json:"url"`
+					}{
+						Url:   "http://localhost:3000/reset",
+						Query: "token",
+					},
+				})
+				tests.Ok(t, err, request)
+
+				// HACK: because I haven't set up proper queue tooling for tests
+				time.Sleep(time.Millisecond * 100)
+
+				token := regexp.MustCompile(`\?token=(.+)`).FindStringSubmatch(inbox.GetLast().Plain)[1]
+
+				// Resetting the password alone doesn't sign the member in.
+				reset, err := cl.AuthPasswordResetWithResponse(root, openapi.AuthPasswordResetJSONRequestBody{
+					Token: token,
+					New:   "newpassword",
+				})
+				tests.Status(t, err, reset, http.StatusAccepted)
+				r.NotNil(reset.JSON202)
+				a.False(reset.JSON202.EnrolmentRequired)
+				a.Empty(reset.HTTPResponse.Header.Get("Set-Cookie"))
+
+				// Nor does signing in with the new password.
+				signin, err := cl.AuthEmailPasswordSigninWithResponse(root, openapi.AuthEmailPasswordSigninJSONRequestBody{Email: email, Password: "newpassword"})
+				tests.Status(t, err, signin, http.StatusAccepted)
+				a.Empty(signin.HTTPResponse.Header.Get("Set-Cookie"))
+
+				verify, err := cl.AuthTOTPVerifyWithResponse(root, openapi.AuthTOTPVerifyProps{Challenge: signin.JSON202.Challenge, Code: code(t, secret, 0)})
+				tests.Ok(t, err, verify)
+				a.NotEmpty(verify.HTTPResponse.Header.Get("Set-Cookie"))
+			})
+
+			t.Run("too_many_attempts", func(t *testing.T) {
+				handle, session := signup(t)
+
+				enrol, err := cl.AuthTOTPEnrolWithResponse(root, openapi.AuthTOTPEnrolProps{}, session)
+				tests.Ok(t, err, enrol)
+				secret := enrol.JSON200.Secret
+
+				confirm, err := cl.AuthTOTPConfirmWithResponse(root, openapi.AuthTOTPConfirmProps{Code: code(t, secret, -1)}, session)
+				tests.Ok(t, err, confirm)
+
+				res := signin(t, handle)
+				tests.Status(t, nil, res, http.StatusAccepted)
+				challenge := res.JSON202.Challenge
+
+				for range 5 {
+					wrong, err := cl.AuthTOTPVerifyWithResponse(root, openapi.AuthTOTPVerifyProps{Challenge: challenge, Code: "000000"})
+					tests.Status(t, err, wrong, http.StatusForbidden)
+				}
+
+				verify, err := cl.AuthTOTPVerifyWithResponse(root, openapi.AuthTOTPVerifyProps{Challenge: challenge, Code: code(t, secret, 0)})
+				tests.Status(t, err, verify, http.StatusUnauthorized)
+			})
+
+			t.Run("required_for_privileged_roles", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				handle, session := signup(t)
+
+				role, err := cl.RoleCreateWithResponse(root, openapi.RoleInitialProps{
+					Name:        "Moderator " + xid.New().String(),
+					Colour:      "#ff0000",
+					Permissions: []openapi.Permission{openapi.MANAGEPOSTS},
+				}, adminSession)
+				tests.Ok(t, err, role)
+
+				add, err := cl.AccountAddRoleWithResponse(root, handle, role.JSON200.Id, adminSession)
+				tests.Ok(t, err, add)
+
+				// Not required until the setting is turned on.
+				res := signin(t, handle)
+				tests.Ok(t, nil, res)
+
+				required := true
+				upd, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{TwoFactorRequired: &required}, adminSession)
+				tests.Ok(t, err, upd)
+				r.NotNil(upd.JSON200.TwoFactorRequired)
+				a.True(*upd.JSON200.TwoFactorRequired)
+
+				// Members without privileged roles are unaffected.
+				memberHandle, _ := signup(t)
+				res = signin(t, memberHandle)
+				tests.Ok(t, nil, res)
+
+				// The moderator must enrol as part of signing in.
+				res = signin(t, handle)
+				tests.Status(t, nil, res, http.StatusAccepted)
+				r.True(res.JSON202.EnrolmentRequired)
+				challenge := res.JSON202.Challenge
+
+				enrol, err := cl.AuthTOTPEnrolWithResponse(root, openapi.AuthTOTPEnrolProps{Challenge: &challenge})
+				tests.Ok(t, err, enrol)
+
+				verify, err := cl.AuthTOTPVerifyWithResponse(root, openapi.AuthTOTPVerifyProps{Challenge: challenge, Code: code(t, enrol.JSON200.Secret, 0)})
+				tests.Ok(t, err, verify)
+				a.NotEmpty(verify.HTTPResponse.Header.Get("Set-Cookie"))
+				r.NotNil(verify.JSON200.RecoveryCodes)
+				a.Len(*verify.JSON200.RecoveryCodes, 10)
+
+				// And cannot remove it while the requirement applies.
+				methods, err := cl.AccountAuthProviderListWithResponse(root, session)
+				tests.Ok(t, err, methods)
+				var methodID string
+				for _, m := range methods.JSON200.Active {
+					if m.Provider.Provider == "totp" {
+						methodID = m.Id
+					}
+				}
+				r.NotEmpty(methodID)
+
+				del, err := cl.AccountAuthMethodDeleteWithResponse(root, methodID, session)
+				tests.Status(t, err, del, http.StatusForbidden)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,119 @@
+package account_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/mail"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	email_repo "github.com/Southclaws/storyden/app/resources/account/email"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestAccountDataExport(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		emails *email_repo.Repository,
+		sender mailer.Sender,
+	) {
+		inbox := sender.(*mailer.Mock)
+
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			email := xid.New().String() + "@storyden.org"
+			signup, err := cl.AuthEmailPasswordSignupWithResponse(root, nil, openapi.AuthEmailPasswordSignupJSONRequestBody{Email: email, Password: "password"})
+			tests.Ok(t, err, signup)
+			r.NoError(emails.Verify(root, account.AccountID(openapi.GetAccountID(signup.JSON200.Id)), mail.Address{Address: email}))
+			session := e2e.WithSessionFromHeader(t, root, signup.HTTPResponse.Header)
+
+			none, err := cl.AccountDataExportGetWithResponse(root, session)
+			tests.Status(t, err, none, http.StatusNotFound)
+
+			title := "exported " + xid.New().String()
+			thread, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				Title:      title,
+				Body:       opt.New("<p>my words</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+			}, session)
+			tests.Ok(t, err, thread)
+
+			req, err := cl.AccountDataExportRequestWithResponse(root, session)
+			tests.Status(t, err, req, http.StatusAccepted)
+
+			var archive []byte
+			for range 50 {
+				res, err := cl.AccountDataExportGetWithResponse(root, session)
+				r.NoError(err)
+				if res.StatusCode() == http.StatusOK {
+					archive = res.Body
+					break
+				}
+				time.Sleep(time.Millisecond * 100)
+			}
+			r.NotEmpty(archive, "export was never built")
+
+			zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+			r.NoError(err)
+
+			files := map[string][]byte{}
+			for _, f := range zr.File {
+				rc, err := f.Open()
+				r.NoError(err)
+				b, err := io.ReadAll(rc)
+				r.NoError(err)
+				rc.Close()
+				files[f.Name] = b
+			}
+
+			profile := map[string]any{}
+			r.NoError(json.Unmarshal(files["profile.json"], &profile))
+			a.Equal(signup.JSON200.Id, profile["id"])
+			a.Contains(string(files["profile.json"]), email)
+
+			auths := []map[string]any{}
+			r.NoError(json.Unmarshal(files["authentication.json"], &auths))
+			r.Len(auths, 1)
+			a.Equal("password", auths[0]["service"])
+			a.NotContains(auths[0], "token", "secrets are never exported")
+
+			posts := []map[string]any{}
+			r.NoError(json.Unmarshal(files["posts.json"], &posts))
+			r.Len(posts, 1)
+			a.Equal(title, posts[0]["title"])
+			a.Contains(posts[0]["body"], "my words")
+
+			for _, name := range []string{"reacts.json", "likes.json", "collections.json", "nodes.json", "notifications.json", "messages.json", "assets.json"} {
+				a.Contains(files, name)
+			}
+
+			// HACK: because I haven't set up proper queue tooling for tests
+			time.Sleep(time.Millisecond * 100)
+
+			sent := inbox.GetLast()
+			a.Equal(email, sent.Address.Address)
+			a.Contains(sent.Plain, "data export is ready")
+		}))
+	}))
+}
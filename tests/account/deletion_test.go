@@ -0,0 +1,302 @@
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"net/mail"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_data"
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	email_repo "github.com/Southclaws/storyden/app/resources/account/email"
+	"github.com/Southclaws/storyden/app/resources/account/token"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/account/account_deletion"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/session_cookie"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestAccountDeletion(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aq *account_querier.Querier,
+		aw *account_writer.Writer,
+		ad *account_deletion.Manager,
+		emails *email_repo.Repository,
+		sender mailer.Sender,
+		db *ent.Client,
+		cj *session_cookie.Jar,
+	) {
+		inbox := sender.(*mailer.Mock)
+
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			configure := func(t *testing.T, days int, content openapi.AccountDeletionContent) {
+				res, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					AccountDeletion: &openapi.AccountDeletionSettings{
+						GracePeriodDays: &days,
+						Content:         &content,
+					},
+				}, adminSession)
+				tests.Ok(t, err, res)
+			}
+
+			signup := func(t *testing.T) (string, string, openapi.RequestEditorFn) {
+				email := xid.New().String() + "@storyden.org"
+				res, err := cl.AuthEmailPasswordSignupWithResponse(root, nil, openapi.AuthEmailPasswordSignupJSONRequestBody{Email: email, Password: "password"})
+				tests.Ok(t, err, res)
+				require.NoError(t, emails.Verify(root, account.AccountID(openapi.GetAccountID(res.JSON200.Id)), mail.Address{Address: email}))
+				return res.JSON200.Id, email, e2e.WithSessionFromHeader(t, root, res.HTTPResponse.Header)
+			}
+
+			signin := func(email string) int {
+				res, err := cl.AuthEmailPasswordSigninWithResponse(root, openapi.AuthEmailPasswordSigninJSONRequestBody{Email: email, Password: "password"})
+				require.NoError(t, err)
+				return res.StatusCode()
+			}
+
+			post := func(t *testing.T, session openapi.RequestEditorFn) *openapi.ThreadCreateResponse {
+				res, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+					Title:      "leaving " + xid.New().String(),
+					Body:       opt.New("<p>goodbye</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+				}, session)
+				tests.Ok(t, err, res)
+				return res
+			}
+
+			t.Run("schedule_and_cancel", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				configure(t, 7, openapi.Anonymise)
+				_, email, session := signup(t)
+
+				req, err := cl.AccountDeletionRequestWithResponse(root, session)
+				tests.Ok(t, err, req)
+				r.NotNil(req.JSON200.DeletionScheduledAt)
+				a.WithinDuration(time.Now().AddDate(0, 0, 7), *req.JSON200.DeletionScheduledAt, time.Minute)
+
+				// HACK: because I haven't set up proper queue tooling for tests
+				time.Sleep(time.Millisecond * 100)
+
+				sent := inbox.GetLast()
+				a.Equal(email, sent.Address.Address)
+				a.Contains(sent.Plain, "will be deleted")
+
+				// Not due until the grace period is over.
+				_, err = ad.DeleteDue(root)
+				r.NoError(err)
+				a.Equal(http.StatusOK, signin(email))
+
+				cancel, err := cl.AccountDeletionCancelWithResponse(root, session)
+				tests.Ok(t, err, cancel)
+				a.Nil(cancel.JSON200.DeletionScheduledAt)
+			})
+
+			t.Run("requires_recent_sign_in", func(t *testing.T) {
+				r := require.New(t)
+
+				configure(t, 7, openapi.Anonymise)
+				id, email, _ := signup(t)
+
+				// A session which was signed in long enough ago to be outside
+				// the window, as if it was left open or taken from the member.
+				stale, err := db.Session.Create().
+					SetAccountID(openapi.ParseID(id)).
+					SetCreatedAt(time.Now().Add(-time.Hour)).
+					SetExpiresAt(time.Now().Add(time.Hour)).
+					Save(root)
+				r.NoError(err)
+				staleSession := openapi.RequestEditorFn(func(ctx context.Context, req *http.Request) error {
+					req.AddCookie(cj.Create(token.Token{ID: stale.ID}))
+					return nil
+				})
+
+				req, err := cl.AccountDeletionRequestWithResponse(root, staleSession)
+				tests.Status(t, err, req, http.StatusForbidden)
+
+				cancel, err := cl.AccountDeletionCancelWithResponse(root, staleSession)
+				tests.Status(t, err, cancel, http.StatusForbidden)
+
+				// Signing in again confirms it's the member.
+				signedIn, err := cl.AuthEmailPasswordSigninWithResponse(root, openapi.AuthEmailPasswordSigninJSONRequestBody{Email: email, Password: "password"})
+				tests.Ok(t, err, signedIn)
+				session := e2e.WithSessionFromHeader(t, root, signedIn.HTTPResponse.Header)
+
+				req, err = cl.AccountDeletionRequestWithResponse(root, session)
+				tests.Ok(t, err, req)
+				r.NotNil(req.JSON200.DeletionScheduledAt)
+
+				cancel, err = cl.AccountDeletionCancelWithResponse(root, session)
+				tests.Ok(t, err, cancel)
+			})
+
+			t.Run("anonymise", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				configure(t, 0, openapi.Anonymise)
+				id, email, session := signup(t)
+				thread := post(t, session)
+
+				req, err := cl.AccountDeletionRequestWithResponse(root, session)
+				tests.Ok(t, err, req)
+
+				n, err := ad.DeleteDue(root)
+				r.NoError(err)
+				a.GreaterOrEqual(n, 1)
+
+				a.NotEqual(http.StatusOK, signin(email))
+
+				get, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil)
+				tests.Ok(t, err, get)
+				a.Equal(id, get.JSON200.Author.Id)
+				a.Equal(account_data.AnonymousName, get.JSON200.Author.Name)
+				a.NotContains(get.JSON200.Author.Handle, "leaving")
+			})
+
+			t.Run("remove", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				configure(t, 0, openapi.Remove)
+				_, email, session := signup(t)
+				thread := post(t, session)
+
+				// Replies by others go with the thread.
+				reply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, openapi.ReplyInitialProps{
+					Body: "<p>farewell</p>",
+				}, adminSession)
+				tests.Ok(t, err, reply)
+
+				req, err := cl.AccountDeletionRequestWithResponse(root, session)
+				tests.Ok(t, err, req)
+
+				n, err := ad.DeleteDue(root)
+				r.NoError(err)
+				a.GreaterOrEqual(n, 1)
+
+				a.NotEqual(http.StatusOK, signin(email))
+
+				get, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil)
+				tests.Status(t, err, get, http.StatusNotFound)
+			})
+
+			t.Run("edits_outlive_editor", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				configure(t, 0, openapi.Remove)
+				_, _, session := signup(t)
+
+				me, err := cl.AccountGetWithResponse(root, session)
+				tests.Ok(t, err, me)
+
+				role, err := cl.RoleCreateWithResponse(root, openapi.RoleInitialProps{
+					Name:        "Editor " + xid.New().String(),
+					Colour:      "#00ff00",
+					Permissions: []openapi.Permission{openapi.MANAGEPOSTS},
+				}, adminSession)
+				tests.Ok(t, err, role)
+
+				add, err := cl.AccountAddRoleWithResponse(root, me.JSON200.Handle, role.JSON200.Id, adminSession)
+				tests.Ok(t, err, add)
+
+				// Someone else's thread, edited by the member who then leaves.
+				thread := post(t, adminSession)
+				edit, err := cl.ThreadUpdateWithResponse(root, thread.JSON200.Slug, openapi.ThreadMutableProps{
+					Title: opt.New("edited " + xid.New().String()).Ptr(),
+				}, session)
+				tests.Ok(t, err, edit)
+
+				req, err := cl.AccountDeletionRequestWithResponse(root, session)
+				tests.Ok(t, err, req)
+
+				n, err := ad.DeleteDue(root)
+				r.NoError(err)
+				a.GreaterOrEqual(n, 1)
+
+				// The thread's history is intact, only the editor is gone.
+				revs, err := cl.PostRevisionListWithResponse(root, thread.JSON200.Id, nil, adminSession)
+				tests.Ok(t, err, revs)
+				r.Len(revs.JSON200.Revisions, 1)
+				a.Equal(thread.JSON200.Title, revs.JSON200.Revisions[0].Title)
+				a.Nil(revs.JSON200.Revisions[0].Author)
+			})
+
+			t.Run("claimed_once", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				configure(t, 0, openapi.Anonymise)
+				id, email, session := signup(t)
+				accountID := account.AccountID(openapi.ParseID(id))
+
+				scheduled := func() time.Time {
+					acc, err := aq.GetByID(root, accountID)
+					r.NoError(err)
+					at, ok := acc.DeletionScheduledAt.Get()
+					r.True(ok)
+					return at
+				}
+
+				req, err := cl.AccountDeletionRequestWithResponse(root, session)
+				tests.Ok(t, err, req)
+				at := scheduled()
+
+				// A deletion cancelled after it was listed as due is left alone.
+				cancel, err := cl.AccountDeletionCancelWithResponse(root, session)
+				tests.Ok(t, err, cancel)
+
+				claimed, err := aw.ClaimDeletion(root, accountID, at, time.Now().Add(time.Hour))
+				r.NoError(err)
+				a.False(claimed)
+
+				// Only the first of several instances to list it may delete it.
+				req, err = cl.AccountDeletionRequestWithResponse(root, session)
+				tests.Ok(t, err, req)
+				at = scheduled()
+
+				claimed, err = aw.ClaimDeletion(root, accountID, at, time.Now().Add(time.Hour))
+				r.NoError(err)
+				a.True(claimed)
+
+				claimed, err = aw.ClaimDeletion(root, accountID, at, time.Now().Add(time.Hour))
+				r.NoError(err)
+				a.False(claimed)
+
+				// Claimed elsewhere, so not deleted here until the claim lapses.
+				_, err = ad.DeleteDue(root)
+				r.NoError(err)
+				a.Equal(http.StatusOK, signin(email))
+			})
+
+			t.Run("admin", func(t *testing.T) {
+				res, err := cl.AccountDeletionRequestWithResponse(root, adminSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,198 @@
+package invitation_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/internal/utils"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestInvitationLimits(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			signup := func(invitationID *string) (*openapi.AuthPasswordSignupResponse, error) {
+				return cl.AuthPasswordSignupWithResponse(root, &openapi.AuthPasswordSignupParams{
+					InvitationId: invitationID,
+				}, openapi.AuthPair{Identifier: "invitee-" + xid.New().String(), Token: "password"})
+			}
+
+			t.Run("max_uses", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				inv, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{
+					MaxUses: opt.New(1).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, inv)
+				a.Equal(0, inv.JSON200.Uses)
+
+				first, err := signup(&inv.JSON200.Id)
+				tests.Ok(t, err, first)
+
+				second, err := signup(&inv.JSON200.Id)
+				tests.Status(t, err, second, http.StatusForbidden)
+
+				list, err := cl.InvitationListWithResponse(root, nil, adminSession)
+				tests.Ok(t, err, list)
+				listed, ok := lo.Find(list.JSON200.Invitations, func(i openapi.Invitation) bool { return i.Id == inv.JSON200.Id })
+				r.True(ok)
+				a.Equal(1, listed.Uses)
+				r.NotNil(listed.Invited)
+				r.Len(*listed.Invited, 1)
+				a.Equal(first.JSON200.Id, (*listed.Invited)[0].Id)
+
+				// The public read does not expose which accounts were invited.
+				public, err := cl.InvitationGetWithResponse(root, inv.JSON200.Id)
+				tests.Ok(t, err, public)
+				a.Nil(public.JSON200.Invited)
+
+				zero, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{
+					MaxUses: opt.New(0).Ptr(),
+				}, adminSession)
+				tests.Status(t, err, zero, http.StatusBadRequest)
+			})
+
+			t.Run("expiry", func(t *testing.T) {
+				past, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{
+					ExpiresAt: opt.New(time.Now().Add(-time.Hour)).Ptr(),
+				}, adminSession)
+				tests.Status(t, err, past, http.StatusBadRequest)
+
+				inv, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{
+					ExpiresAt: opt.New(time.Now().Add(time.Second)).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, inv)
+
+				time.Sleep(1100 * time.Millisecond)
+
+				res, err := signup(&inv.JSON200.Id)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+
+			t.Run("revoke", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				inv, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{}, adminSession)
+				tests.Ok(t, err, inv)
+
+				before, err := signup(&inv.JSON200.Id)
+				tests.Ok(t, err, before)
+
+				memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+				denied, err := cl.InvitationRevokeWithResponse(root, inv.JSON200.Id, sh.WithSession(memberCtx))
+				tests.Status(t, err, denied, http.StatusForbidden)
+
+				revoked, err := cl.InvitationRevokeWithResponse(root, inv.JSON200.Id, adminSession)
+				tests.Ok(t, err, revoked)
+				r.NotNil(revoked.JSON200.RevokedAt)
+				a.Equal(1, revoked.JSON200.Uses)
+
+				after, err := signup(&inv.JSON200.Id)
+				tests.Status(t, err, after, http.StatusForbidden)
+			})
+
+			t.Run("role_grants", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				granted, err := cl.RoleCreateWithResponse(root, openapi.RoleInitialProps{
+					Name:        "Founder " + xid.New().String(),
+					Permissions: []openapi.Permission{openapi.CREATEINVITATION},
+				}, adminSession)
+				tests.Ok(t, err, granted)
+
+				inv, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{
+					Roles: &[]openapi.Identifier{granted.JSON200.Id},
+				}, adminSession)
+				tests.Ok(t, err, inv)
+				r.Len(inv.JSON200.Roles, 1)
+				a.Equal(granted.JSON200.Id, inv.JSON200.Roles[0].Id)
+
+				res, err := signup(&inv.JSON200.Id)
+				tests.Ok(t, err, res)
+
+				inviteeID := account.AccountID(utils.Must(xid.FromString(res.JSON200.Id)))
+				inviteeSession := sh.WithSession(e2e.WithAccountID(root, inviteeID))
+
+				acc, err := cl.AccountGetWithResponse(root, inviteeSession)
+				tests.Ok(t, err, acc)
+				_, hasRole := lo.Find(acc.JSON200.Roles, func(r openapi.AccountRole) bool { return r.Id == granted.JSON200.Id })
+				a.True(hasRole)
+
+				// The granted role permits creating invitations, but not ones
+				// which grant roles without the permission to manage roles.
+				plain, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{}, inviteeSession)
+				tests.Ok(t, err, plain)
+
+				escalate, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{
+					Roles: &[]openapi.Identifier{granted.JSON200.Id},
+				}, inviteeSession)
+				tests.Status(t, err, escalate, http.StatusForbidden)
+
+				builtin, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{
+					Roles: &[]openapi.Identifier{role.DefaultRoleAdminID.String()},
+				}, adminSession)
+				tests.Status(t, err, builtin, http.StatusBadRequest)
+			})
+
+			t.Run("invite_only", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				upd, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{InviteOnly: opt.New(true).Ptr()}, adminSession)
+				tests.Ok(t, err, upd)
+				r.NotNil(upd.JSON200.InviteOnly)
+				a.True(*upd.JSON200.InviteOnly)
+
+				info, err := cl.GetInfoWithResponse(root)
+				tests.Ok(t, err, info)
+				r.NotNil(info.JSON200.InviteOnly)
+				a.True(*info.JSON200.InviteOnly)
+
+				uninvited, err := signup(nil)
+				tests.Status(t, err, uninvited, http.StatusForbidden)
+
+				inv, err := cl.InvitationCreateWithResponse(root, openapi.InvitationInitialProps{}, adminSession)
+				tests.Ok(t, err, inv)
+
+				invited, err := signup(&inv.JSON200.Id)
+				tests.Ok(t, err, invited)
+
+				upd, err = cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{InviteOnly: opt.New(false).Ptr()}, adminSession)
+				tests.Ok(t, err, upd)
+
+				open, err := signup(nil)
+				tests.Ok(t, err, open)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,173 @@
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+const (
+	laptopUA = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+	phoneUA  = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1"
+)
+
+func TestAccountSessions(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+
+			withUserAgent := func(ua string) openapi.RequestEditorFn {
+				return func(ctx context.Context, req *http.Request) error {
+					req.Header.Set("User-Agent", ua)
+					return nil
+				}
+			}
+
+			withCookie := func(c *http.Cookie) openapi.RequestEditorFn {
+				return func(ctx context.Context, req *http.Request) error {
+					req.AddCookie(c)
+					return nil
+				}
+			}
+
+			sessionCookie := func(t *testing.T, res *http.Response) *http.Cookie {
+				cookies := res.Cookies()
+				require.NotEmpty(t, cookies)
+				return cookies[0]
+			}
+
+			signedIn := func(c *http.Cookie) int {
+				res, err := cl.AccountGetWithResponse(root, withCookie(c))
+				require.NoError(t, err)
+				return res.StatusCode()
+			}
+
+			handle := xid.New().String()
+			creds := openapi.AuthPair{Identifier: handle, Token: "password"}
+
+			signup, err := cl.AuthPasswordSignupWithResponse(root, nil, creds, withUserAgent(laptopUA))
+			tests.Ok(t, err, signup)
+			laptop := sessionCookie(t, signup.HTTPResponse)
+
+			signin := func(t *testing.T, ua string) *http.Cookie {
+				res, err := cl.AuthPasswordSigninWithResponse(root, creds, withUserAgent(ua))
+				tests.Ok(t, err, res)
+				return sessionCookie(t, res.HTTPResponse)
+			}
+
+			phone := signin(t, phoneUA)
+			tablet := signin(t, phoneUA)
+
+			t.Run("list", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res, err := cl.AuthSessionListWithResponse(root, withCookie(laptop))
+				tests.Ok(t, err, res)
+				r.Len(res.JSON200.Sessions, 3)
+
+				current := 0
+				for _, s := range res.JSON200.Sessions {
+					r.NotNil(s.UserAgent)
+					r.NotNil(s.IpAddress)
+					a.Equal("127.0.0.1", *s.IpAddress)
+					a.NotEqual(laptop.Value, string(s.Id), "session IDs must not expose tokens")
+
+					if s.Current {
+						current++
+						a.Equal(laptopUA, *s.UserAgent)
+						r.NotNil(s.DeviceName)
+						a.Contains(*s.DeviceName, "Chrome")
+						r.NotNil(s.LastSeenAt, "the current session has just been used")
+					}
+				}
+				a.Equal(1, current)
+			})
+
+			t.Run("revoke_one", func(t *testing.T) {
+				r := require.New(t)
+
+				res, err := cl.AuthSessionListWithResponse(root, withCookie(phone))
+				tests.Ok(t, err, res)
+
+				var id openapi.AuthSessionID
+				for _, s := range res.JSON200.Sessions {
+					if s.Current {
+						id = s.Id
+					}
+				}
+				r.NotEmpty(id)
+
+				rev, err := cl.AuthSessionRevokeWithResponse(root, id, withCookie(laptop))
+				tests.Status(t, err, rev, http.StatusNoContent)
+
+				assert.Equal(t, http.StatusUnauthorized, signedIn(phone))
+				assert.Equal(t, http.StatusOK, signedIn(laptop))
+
+				missing, err := cl.AuthSessionRevokeWithResponse(root, id, withCookie(laptop))
+				tests.Status(t, err, missing, http.StatusNotFound)
+			})
+
+			t.Run("other_accounts_sessions", func(t *testing.T) {
+				res, err := cl.AuthSessionListWithResponse(root, withCookie(laptop))
+				tests.Ok(t, err, res)
+
+				rev, err := cl.AuthSessionRevokeWithResponse(root, res.JSON200.Sessions[0].Id, memberSession)
+				tests.Status(t, err, rev, http.StatusNotFound)
+			})
+
+			t.Run("revoke_others", func(t *testing.T) {
+				rev, err := cl.AuthSessionRevokeOthersWithResponse(root, withCookie(laptop))
+				tests.Status(t, err, rev, http.StatusNoContent)
+
+				assert.Equal(t, http.StatusUnauthorized, signedIn(tablet))
+				assert.Equal(t, http.StatusOK, signedIn(laptop))
+
+				res, err := cl.AuthSessionListWithResponse(root, withCookie(laptop))
+				tests.Ok(t, err, res)
+				assert.Len(t, res.JSON200.Sessions, 1)
+			})
+
+			t.Run("admin_revoke_all", func(t *testing.T) {
+				forbidden, err := cl.AdminAccountSessionsRevokeWithResponse(root, handle, memberSession)
+				tests.Status(t, err, forbidden, http.StatusForbidden)
+
+				rev, err := cl.AdminAccountSessionsRevokeWithResponse(root, handle, adminSession)
+				tests.Status(t, err, rev, http.StatusNoContent)
+
+				assert.Equal(t, http.StatusUnauthorized, signedIn(laptop))
+
+				targetID := openapi.Identifier(signup.JSON200.Id)
+				audit, err := cl.AdminAuditLogListWithResponse(root, &openapi.AdminAuditLogListParams{
+					TargetId: &targetID,
+				}, adminSession)
+				tests.Ok(t, err, audit)
+				require.Len(t, audit.JSON200.Entries, 1)
+				assert.Equal(t, openapi.AuditLogActionSessionsRevoked, audit.JSON200.Entries[0].Action)
+			})
+		}))
+	}))
+}
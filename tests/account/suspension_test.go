@@ -0,0 +1,190 @@
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	email_repo "github.com/Southclaws/storyden/app/resources/account/email"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/account/account_suspension"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestAccountSuspension(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		as account_suspension.Service,
+		emails *email_repo.Repository,
+		sender mailer.Sender,
+	) {
+		inbox := sender.(*mailer.Mock)
+
+		lc.Append(fx.StartHook(func() {
+			adminCtx, admin := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			signupUnverified := func(t *testing.T) (string, string) {
+				email := xid.New().String() + "@storyden.org"
+				password := "password"
+				res, err := cl.AuthEmailPasswordSignupWithResponse(root, nil, openapi.AuthEmailPasswordSignupJSONRequestBody{Email: email, Password: password})
+				tests.Ok(t, err, res)
+				return res.JSON200.Id, email
+			}
+
+			signup := func(t *testing.T) (string, string) {
+				id, email := signupUnverified(t)
+				require.NoError(t, emails.Verify(root, account.AccountID(openapi.GetAccountID(id)), mail.Address{Address: email}))
+				return id, email
+			}
+
+			signin := func(email string) int {
+				res, err := cl.AuthEmailPasswordSigninWithResponse(root, openapi.AuthEmailPasswordSigninJSONRequestBody{Email: email, Password: "password"})
+				require.NoError(t, err)
+				return res.StatusCode()
+			}
+
+			t.Run("with_reason_and_expiry", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				id, email := signup(t)
+				until := time.Now().Add(time.Second).UTC().Truncate(time.Millisecond)
+
+				res, err := cl.AdminAccountBanCreateWithResponse(root, id, openapi.AccountSuspensionInitialProps{
+					Reason:    opt.New("spamming the forum").Ptr(),
+					ExpiresAt: &until,
+				}, adminSession)
+				tests.Ok(t, err, res)
+				r.NotNil(res.JSON200.Suspended)
+				r.NotNil(res.JSON200.Suspension)
+				a.Equal("spamming the forum", *res.JSON200.Suspension.Reason)
+				a.WithinDuration(until, *res.JSON200.Suspension.ExpiresAt, time.Millisecond)
+				a.Equal(admin.ID.String(), string(*res.JSON200.Suspension.SuspendedBy))
+
+				a.Equal(http.StatusForbidden, signin(email))
+
+				// HACK: because I haven't set up proper queue tooling for tests
+				time.Sleep(time.Millisecond * 100)
+
+				sent := inbox.GetLast()
+				a.Equal(email, sent.Address.Address)
+				a.Contains(sent.Plain, "spamming the forum")
+				a.Contains(sent.Plain, until.Format(time.RFC1123))
+
+				// Nothing has expired yet.
+				_, err = as.ReinstateExpired(root)
+				r.NoError(err)
+				a.Equal(http.StatusForbidden, signin(email))
+
+				time.Sleep(time.Until(until) + time.Millisecond*10)
+
+				n, err := as.ReinstateExpired(root)
+				r.NoError(err)
+				a.GreaterOrEqual(n, 1)
+				a.Equal(http.StatusOK, signin(email))
+			})
+
+			t.Run("reinstated_once", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				id, email := signup(t)
+				accountID := account.AccountID(openapi.ParseID(id))
+				until := time.Now().Add(time.Second).UTC().Truncate(time.Millisecond)
+
+				res, err := cl.AdminAccountBanCreateWithResponse(root, id, openapi.AccountSuspensionInitialProps{ExpiresAt: &until}, adminSession)
+				tests.Ok(t, err, res)
+
+				time.Sleep(time.Until(until) + time.Millisecond*10)
+
+				// A suspension which was changed after being listed as expired
+				// is left alone.
+				lifted, err := aw.ReinstateExpired(root, accountID, until.Add(-time.Minute))
+				r.NoError(err)
+				a.False(lifted)
+				a.Equal(http.StatusForbidden, signin(email))
+
+				// Only the first of several instances lifts it.
+				lifted, err = aw.ReinstateExpired(root, accountID, until)
+				r.NoError(err)
+				a.True(lifted)
+
+				lifted, err = aw.ReinstateExpired(root, accountID, until)
+				r.NoError(err)
+				a.False(lifted)
+
+				a.Equal(http.StatusOK, signin(email))
+			})
+
+			t.Run("indefinite", func(t *testing.T) {
+				a := assert.New(t)
+
+				id, email := signup(t)
+
+				res, err := cl.AdminAccountBanCreateWithResponse(root, id, openapi.AccountSuspensionInitialProps{}, adminSession)
+				tests.Ok(t, err, res)
+				a.Nil(res.JSON200.Suspension.Reason)
+				a.Nil(res.JSON200.Suspension.ExpiresAt)
+
+				_, err = as.ReinstateExpired(root)
+				require.NoError(t, err)
+				a.Equal(http.StatusForbidden, signin(email))
+
+				lift, err := cl.AdminAccountBanRemoveWithResponse(root, id, adminSession)
+				tests.Ok(t, err, lift)
+				a.Nil(lift.JSON200.Suspended)
+				a.Nil(lift.JSON200.Suspension)
+				a.Equal(http.StatusOK, signin(email))
+			})
+
+			t.Run("unverified_address_not_emailed", func(t *testing.T) {
+				a := assert.New(t)
+
+				id, email := signupUnverified(t)
+
+				res, err := cl.AdminAccountBanCreateWithResponse(root, id, openapi.AccountSuspensionInitialProps{
+					Reason: opt.New("unconfirmed").Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, res)
+
+				// HACK: because I haven't set up proper queue tooling for tests
+				time.Sleep(time.Millisecond * 100)
+
+				sent := inbox.GetLast()
+				a.False(sent.Address.Address == email && strings.Contains(sent.Subject, "suspended"))
+			})
+
+			t.Run("expiry_in_past", func(t *testing.T) {
+				id, _ := signup(t)
+				past := time.Now().Add(-time.Hour)
+
+				res, err := cl.AdminAccountBanCreateWithResponse(root, id, openapi.AccountSuspensionInitialProps{
+					ExpiresAt: &past,
+				}, adminSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,161 @@
+package asset_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestAssetCleanup(t *testing.T) {
+	t.Parallel()
+
+	// No grace period so everything created during the test is eligible.
+	cfg := &config.Config{AssetGCGracePeriod: 0, AssetGCInterval: 0}
+
+	integration.Test(t, cfg, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		objects object.Storer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+
+			upload := func(name string) openapi.Asset {
+				content := []byte("content of " + name)
+				res, err := cl.AssetUploadWithBodyWithResponse(root, &openapi.AssetUploadParams{
+					Filename:      &name,
+					ContentLength: int64(len(content)),
+				}, "application/octet-stream", bytes.NewReader(content), adminSession)
+				tests.Ok(t, err, res)
+				return *res.JSON200
+			}
+
+			removed := upload("removed")
+			kept := upload("kept")
+
+			published := openapi.Published
+			node, err := cl.NodeCreateWithResponse(root, openapi.NodeCreateJSONRequestBody{
+				Name:       "cleanup " + xid.New().String(),
+				Content:    opt.New("<p>hi</p>").Ptr(),
+				Visibility: &published,
+			}, adminSession)
+			tests.Ok(t, err, node)
+
+			for _, id := range []string{removed.Id, kept.Id} {
+				res, err := cl.NodeAddAssetWithResponse(root, node.JSON200.Slug, id, adminSession)
+				tests.Ok(t, err, res)
+			}
+
+			res, err := cl.NodeRemoveAssetWithResponse(root, node.JSON200.Slug, removed.Id, adminSession)
+			tests.Ok(t, err, res)
+
+			// Only embedded in an earlier revision of a page, restoring it must
+			// not leave a broken image.
+			revised := upload("revised")
+			page, err := cl.NodeCreateWithResponse(root, openapi.NodeCreateJSONRequestBody{
+				Name:       "cleanup " + xid.New().String(),
+				Content:    opt.New(`<p>before</p><img src="` + revised.Path + `" />`).Ptr(),
+				Visibility: &published,
+			}, adminSession)
+			tests.Ok(t, err, page)
+
+			update, err := cl.NodeUpdateWithResponse(root, page.JSON200.Slug, openapi.NodeUpdateJSONRequestBody{
+				Content: opt.New("<p>after</p>").Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, update)
+
+			// Embedded in a profile bio, which has no edge to its assets.
+			avatar := upload("in-bio")
+			bio, err := cl.AccountUpdateWithResponse(root, openapi.AccountMutableProps{
+				Bio: opt.New(`<p>hello</p><img src="` + avatar.Path + `" />`).Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, bio)
+
+			// A file with no asset record at all, such as from a failed upload.
+			stray := "assets/" + xid.New().String() + "-stray"
+			r.NoError(objects.Write(root, stray, bytes.NewReader([]byte("stray")), 5))
+
+			t.Run("member_cannot_run", func(t *testing.T) {
+				res, err := cl.AdminAssetCleanupWithResponse(root, openapi.AssetCleanupProps{DryRun: true}, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+
+			t.Run("dry_run", func(t *testing.T) {
+				res, err := cl.AdminAssetCleanupWithResponse(root, openapi.AssetCleanupProps{DryRun: true}, adminSession)
+				tests.Ok(t, err, res)
+
+				ids := dt.Map(res.JSON200.Assets, func(a openapi.Asset) string { return a.Id })
+				paths := dt.Map(res.JSON200.Objects, func(o openapi.StoredObject) string { return o.Path })
+
+				a.True(res.JSON200.DryRun)
+				a.Contains(ids, removed.Id)
+				a.NotContains(ids, kept.Id)
+				a.NotContains(ids, revised.Id)
+				a.NotContains(ids, avatar.Id)
+				a.Contains(paths, stray)
+				a.NotContains(paths, "assets/"+removed.Filename, "files of orphaned assets are only reported once")
+
+				get, err := cl.AssetGetWithResponse(root, removed.Filename)
+				tests.Ok(t, err, get)
+
+				exists, err := objects.Exists(root, stray)
+				r.NoError(err)
+				a.True(exists)
+			})
+
+			t.Run("collect", func(t *testing.T) {
+				res, err := cl.AdminAssetCleanupWithResponse(root, openapi.AssetCleanupProps{DryRun: false}, adminSession)
+				tests.Ok(t, err, res)
+				a.False(res.JSON200.DryRun)
+				a.Positive(res.JSON200.TotalSize)
+
+				get, err := cl.AssetGetWithResponse(root, removed.Filename)
+				tests.Status(t, err, get, http.StatusNotFound)
+
+				exists, err := objects.Exists(root, "assets/"+removed.Filename)
+				r.NoError(err)
+				a.False(exists)
+
+				exists, err = objects.Exists(root, stray)
+				r.NoError(err)
+				a.False(exists)
+
+				get, err = cl.AssetGetWithResponse(root, kept.Filename)
+				tests.Ok(t, err, get)
+			})
+
+			t.Run("nothing_left", func(t *testing.T) {
+				res, err := cl.AdminAssetCleanupWithResponse(root, openapi.AssetCleanupProps{DryRun: true}, adminSession)
+				tests.Ok(t, err, res)
+				a.Empty(res.JSON200.Assets)
+				a.Empty(res.JSON200.Objects)
+			})
+		}))
+	}))
+}
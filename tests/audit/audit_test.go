@@ -0,0 +1,197 @@
+package audit_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestAuditLog(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, admin := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+
+			adminHandle := openapi.AccountHandle(admin.Handle)
+
+			t.Run("thread_deleted", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				thread, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+					Title:      "Audit " + xid.New().String(),
+					Body:       opt.New("<p>to be removed</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+				}, memberSession)
+				tests.Ok(t, err, thread)
+
+				del, err := cl.ThreadDeleteWithResponse(root, thread.JSON200.Slug, adminSession)
+				tests.Ok(t, err, del)
+
+				targetID := thread.JSON200.Id
+				res, err := cl.AdminAuditLogListWithResponse(root, &openapi.AdminAuditLogListParams{
+					TargetId: &targetID,
+				}, adminSession)
+				tests.Ok(t, err, res)
+				r.Len(res.JSON200.Entries, 1)
+
+				entry := res.JSON200.Entries[0]
+				a.Equal(openapi.AuditLogActionThreadDeleted, entry.Action)
+				r.NotNil(entry.Actor)
+				a.Equal(admin.ID.String(), string(entry.Actor.Id))
+				r.NotNil(entry.Target)
+				a.Equal(openapi.DatagraphItemKindThread, entry.Target.Kind)
+				r.NotNil(entry.Before)
+				a.Contains(*entry.Before, thread.JSON200.Title)
+			})
+
+			t.Run("own_content_not_audited", func(t *testing.T) {
+				thread, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+					Title:      "Audit " + xid.New().String(),
+					Body:       opt.New("<p>removed by author</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+				}, memberSession)
+				tests.Ok(t, err, thread)
+
+				del, err := cl.ThreadDeleteWithResponse(root, thread.JSON200.Slug, memberSession)
+				tests.Ok(t, err, del)
+
+				targetID := thread.JSON200.Id
+				res, err := cl.AdminAuditLogListWithResponse(root, &openapi.AdminAuditLogListParams{
+					TargetId: &targetID,
+				}, adminSession)
+				tests.Ok(t, err, res)
+				assert.Empty(t, res.JSON200.Entries)
+			})
+
+			t.Run("role_assigned", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				roleName := "Audited " + xid.New().String()
+				role, err := cl.RoleCreateWithResponse(root, openapi.RoleInitialProps{
+					Name:        roleName,
+					Colour:      "#ff0000",
+					Permissions: []openapi.Permission{openapi.MANAGEREPORTS},
+				}, adminSession)
+				tests.Ok(t, err, role)
+
+				add, err := cl.AccountAddRoleWithResponse(root, member.Handle, role.JSON200.Id, adminSession)
+				tests.Ok(t, err, add)
+
+				remove, err := cl.AccountRemoveRoleWithResponse(root, member.Handle, role.JSON200.Id, adminSession)
+				tests.Ok(t, err, remove)
+
+				targetID := openapi.Identifier(member.ID.String())
+				res, err := cl.AdminAuditLogListWithResponse(root, &openapi.AdminAuditLogListParams{
+					Action:   &openapi.AuditLogActionQuery{openapi.AuditLogActionRoleAssigned, openapi.AuditLogActionRoleRemoved},
+					Actor:    &adminHandle,
+					TargetId: &targetID,
+				}, adminSession)
+				tests.Ok(t, err, res)
+				r.Len(res.JSON200.Entries, 2)
+
+				// Most recent first.
+				a.Equal(openapi.AuditLogActionRoleRemoved, res.JSON200.Entries[0].Action)
+				a.Equal("role: "+roleName, *res.JSON200.Entries[0].Before)
+				a.Equal(openapi.AuditLogActionRoleAssigned, res.JSON200.Entries[1].Action)
+				a.Equal("role: "+roleName, *res.JSON200.Entries[1].After)
+			})
+
+			t.Run("settings_updated", func(t *testing.T) {
+				r := require.New(t)
+
+				title := "Audited " + xid.New().String()
+				upd, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+					Title: &title,
+				}, adminSession)
+				tests.Ok(t, err, upd)
+
+				res, err := cl.AdminAuditLogListWithResponse(root, &openapi.AdminAuditLogListParams{
+					Action: &openapi.AuditLogActionQuery{openapi.AuditLogActionSettingsUpdated},
+					Actor:  &adminHandle,
+				}, adminSession)
+				tests.Ok(t, err, res)
+				r.NotEmpty(res.JSON200.Entries)
+				r.NotNil(res.JSON200.Entries[0].After)
+				assert.Contains(t, *res.JSON200.Entries[0].After, title)
+			})
+
+			t.Run("invite_only_changed", func(t *testing.T) {
+				r := require.New(t)
+
+				for _, v := range []bool{true, false} {
+					upd, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+						InviteOnly: &v,
+					}, adminSession)
+					tests.Ok(t, err, upd)
+				}
+
+				res, err := cl.AdminAuditLogListWithResponse(root, &openapi.AdminAuditLogListParams{
+					Action: &openapi.AuditLogActionQuery{openapi.AuditLogActionSettingsUpdated},
+					Actor:  &adminHandle,
+				}, adminSession)
+				tests.Ok(t, err, res)
+				r.NotEmpty(res.JSON200.Entries)
+				r.NotNil(res.JSON200.Entries[0].After)
+				assert.Equal(t, "invite_only: false", *res.JSON200.Entries[0].After)
+			})
+
+			t.Run("two_factor_requirement_lifted", func(t *testing.T) {
+				r := require.New(t)
+
+				for _, v := range []bool{true, false} {
+					upd, err := cl.AdminSettingsUpdateWithResponse(root, openapi.AdminSettingsMutableProps{
+						TwoFactorRequired: &v,
+					}, adminSession)
+					tests.Ok(t, err, upd)
+				}
+
+				res, err := cl.AdminAuditLogListWithResponse(root, &openapi.AdminAuditLogListParams{
+					Action: &openapi.AuditLogActionQuery{openapi.AuditLogActionSettingsUpdated},
+					Actor:  &adminHandle,
+				}, adminSession)
+				tests.Ok(t, err, res)
+				r.NotEmpty(res.JSON200.Entries)
+				r.NotNil(res.JSON200.Entries[0].After)
+				assert.Equal(t, "two_factor_required: false", *res.JSON200.Entries[0].After)
+			})
+
+			t.Run("unknown_actor", func(t *testing.T) {
+				missing := openapi.AccountHandle("missing-" + xid.New().String())
+				res, err := cl.AdminAuditLogListWithResponse(root, &openapi.AdminAuditLogListParams{
+					Actor: &missing,
+				}, adminSession)
+				tests.Status(t, err, res, http.StatusNotFound)
+			})
+
+			t.Run("members_forbidden", func(t *testing.T) {
+				res, err := cl.AdminAuditLogListWithResponse(root, &openapi.AdminAuditLogListParams{}, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,228 @@
+package backup_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/backup"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/infrastructure/object"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestBackupRestore(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Provide(backup.New), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		archiver *backup.Archiver,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, admin := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			name := "backup.txt"
+			content := []byte("backed up " + xid.New().String())
+			upload, err := cl.AssetUploadWithBodyWithResponse(root, &openapi.AssetUploadParams{
+				Filename:      &name,
+				ContentLength: int64(len(content)),
+			}, "application/octet-stream", bytes.NewReader(content), adminSession)
+			tests.Ok(t, err, upload)
+
+			title := "backup " + xid.New().String()
+			thread, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				Title:      title,
+				Body:       opt.New("<p>first post</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, thread)
+
+			reply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, openapi.ReplyInitialProps{
+				Body: "<p>a reply</p>",
+			}, adminSession)
+			tests.Ok(t, err, reply)
+
+			buf := &bytes.Buffer{}
+			manifest, err := archiver.Backup(root, buf)
+			r.NoError(err)
+			a.Equal(backup.FormatVersion, manifest.Format)
+
+			archive := buf.Bytes()
+
+			// Restores into a fresh SQLite database regardless of the database
+			// the test instance runs on, so this also covers PostgreSQL to
+			// SQLite when the tests are run against PostgreSQL.
+			restoreTo := func(t *testing.T) (*backup.Archiver, *ent.Client, object.Storer) {
+				dir := t.TempDir()
+
+				db, err := sqlx.Connect("sqlite", filepath.Join(dir, "data.db")+"?_pragma=foreign_keys(1)")
+				require.NoError(t, err)
+				t.Cleanup(func() { db.Close() })
+
+				client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db.DB)))
+				require.NoError(t, client.Schema.Create(root))
+
+				store := object.NewLocalStorer(config.Config{AssetStorageLocalPath: filepath.Join(dir, "assets")})
+
+				return backup.New(slog.Default(), db, store), client, store
+			}
+
+			t.Run("restore", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				restorer, client, store := restoreTo(t)
+
+				_, err := restorer.Restore(root, bytes.NewReader(archive), int64(len(archive)), false)
+				r.NoError(err)
+
+				acc, err := client.Account.Get(root, xid.ID(admin.ID))
+				r.NoError(err)
+				a.Equal(admin.Handle, acc.Handle)
+
+				p, err := client.Post.Query().Where(ent_post.TitleEQ(title)).Only(root)
+				r.NoError(err)
+				a.Equal(thread.JSON200.Id, p.ID.String())
+				a.Equal(thread.JSON200.CreatedAt.UTC(), p.CreatedAt.UTC())
+				a.Nil(p.IndexedAt, "restored content is indexed again on start")
+
+				// Replies reference their thread in the same table.
+				rp, err := client.Post.Get(root, lo.Must(xid.FromString(reply.JSON200.Id)))
+				r.NoError(err)
+				a.Equal(&p.ID, rp.RootPostID)
+
+				var path string
+				for _, o := range manifest.Objects {
+					if strings.Contains(o.Name, upload.JSON200.Id) {
+						path = strings.TrimPrefix(o.Name, "objects/")
+					}
+				}
+				r.NotEmpty(path)
+
+				obj, _, err := store.Read(root, path)
+				r.NoError(err)
+				restored, err := io.ReadAll(obj)
+				r.NoError(err)
+				a.Equal(content, restored)
+
+				_, err = restorer.Restore(root, bytes.NewReader(archive), int64(len(archive)), false)
+				r.Error(err)
+				a.Equal(ftag.AlreadyExists, ftag.Get(err))
+
+				_, err = restorer.Restore(root, bytes.NewReader(archive), int64(len(archive)), true)
+				r.NoError(err)
+
+				count, err := client.Post.Query().Where(ent_post.TitleEQ(title)).Count(root)
+				r.NoError(err)
+				a.Equal(1, count)
+			})
+
+			t.Run("schema_mismatch", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				restorer, client, _ := restoreTo(t)
+
+				changed := rewrite(t, archive, func(name string, b []byte) []byte {
+					if name != "manifest.json" {
+						return b
+					}
+					m := map[string]any{}
+					require.NoError(t, json.Unmarshal(b, &m))
+					m["schema"] = "0000"
+					out, err := json.Marshal(m)
+					require.NoError(t, err)
+					return out
+				})
+
+				_, err := restorer.Restore(root, bytes.NewReader(changed), int64(len(changed)), false)
+				r.Error(err)
+				a.Equal(ftag.InvalidArgument, ftag.Get(err))
+
+				count, err := client.Account.Query().Count(root)
+				r.NoError(err)
+				a.Zero(count)
+			})
+
+			t.Run("corrupt", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				restorer, client, _ := restoreTo(t)
+
+				changed := rewrite(t, archive, func(name string, b []byte) []byte {
+					if name != "database/posts.jsonl" {
+						return b
+					}
+					return bytes.ReplaceAll(b, []byte("first post"), []byte("other post"))
+				})
+
+				_, err := restorer.Restore(root, bytes.NewReader(changed), int64(len(changed)), false)
+				r.Error(err)
+				a.Equal(ftag.InvalidArgument, ftag.Get(err))
+
+				count, err := client.Account.Query().Count(root)
+				r.NoError(err)
+				a.Zero(count)
+			})
+		}))
+	}))
+}
+
+// rewrite copies a zip archive, passing the contents of each file through fn.
+func rewrite(t *testing.T, archive []byte, fn func(name string, b []byte) []byte) []byte {
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+
+	out := &bytes.Buffer{}
+	zw := zip.NewWriter(out)
+
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		b, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+
+		w, err := zw.Create(f.Name)
+		require.NoError(t, err)
+		_, err = w.Write(fn(f.Name, b))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, zw.Close())
+
+	return out.Bytes()
+}
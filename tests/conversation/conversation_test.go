@@ -0,0 +1,264 @@
+package conversation_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestConversations(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			senderCtx, sender := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			senderSession := sh.WithSession(senderCtx)
+			recipientCtx, recipient := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			recipientSession := sh.WithSession(recipientCtx)
+			outsiderCtx, outsider := e2e.WithAccount(root, aw, seed.Account_005_Þórr)
+			outsiderSession := sh.WithSession(outsiderCtx)
+
+			direct, err := cl.ConversationCreateWithResponse(root, openapi.ConversationInitialProps{
+				Recipients: []string{recipient.ID.String()},
+				Body:       "<p>hello loki</p>",
+			}, senderSession)
+			tests.Ok(t, err, direct)
+			require.Len(t, direct.JSON200.Participants, 2)
+			require.Nil(t, direct.JSON200.Title)
+
+			t.Run("unauthenticated", func(t *testing.T) {
+				res, err := cl.ConversationListWithResponse(root, &openapi.ConversationListParams{})
+				tests.Status(t, err, res, http.StatusUnauthorized)
+			})
+
+			t.Run("no_recipients", func(t *testing.T) {
+				res, err := cl.ConversationCreateWithResponse(root, openapi.ConversationInitialProps{
+					Recipients: []string{sender.ID.String()},
+					Body:       "<p>talking to myself</p>",
+				}, senderSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("direct_conversation_reused", func(t *testing.T) {
+				a := assert.New(t)
+
+				again, err := cl.ConversationCreateWithResponse(root, openapi.ConversationInitialProps{
+					Recipients: []string{recipient.ID.String()},
+					Body:       "<p>are you there?</p>",
+				}, senderSession)
+				tests.Ok(t, err, again)
+				a.Equal(direct.JSON200.Id, again.JSON200.Id)
+			})
+
+			t.Run("unread_and_read_state", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				list, err := cl.ConversationListWithResponse(root, &openapi.ConversationListParams{}, recipientSession)
+				tests.Ok(t, err, list)
+				r.Len(list.JSON200.Conversations, 1)
+				a.Equal(2, list.JSON200.Conversations[0].Unread)
+
+				// The sender has read everything they sent.
+				sent, err := cl.ConversationListWithResponse(root, &openapi.ConversationListParams{}, senderSession)
+				tests.Ok(t, err, sent)
+				r.Len(sent.JSON200.Conversations, 1)
+				a.Equal(0, sent.JSON200.Conversations[0].Unread)
+
+				get, err := cl.ConversationGetWithResponse(root, direct.JSON200.Id, &openapi.ConversationGetParams{}, recipientSession)
+				tests.Ok(t, err, get)
+				r.Len(get.JSON200.Messages.Messages, 2)
+				a.Contains(get.JSON200.Messages.Messages[0].Body, "are you there?")
+				a.Equal(sender.ID.String(), get.JSON200.Messages.Messages[0].Author.Id)
+
+				list, err = cl.ConversationListWithResponse(root, &openapi.ConversationListParams{}, recipientSession)
+				tests.Ok(t, err, list)
+				a.Equal(0, list.JSON200.Conversations[0].Unread)
+
+				reply, err := cl.ConversationMessageSendWithResponse(root, direct.JSON200.Id, openapi.ConversationMessageInitialProps{
+					Body: "<p>yes</p>",
+				}, recipientSession)
+				tests.Ok(t, err, reply)
+
+				sent, err = cl.ConversationListWithResponse(root, &openapi.ConversationListParams{}, senderSession)
+				tests.Ok(t, err, sent)
+				a.Equal(1, sent.JSON200.Conversations[0].Unread)
+			})
+
+			t.Run("recipient_notified", func(t *testing.T) {
+				r := require.New(t)
+
+				r.Eventually(func() bool {
+					list, err := cl.NotificationListWithResponse(root, &openapi.NotificationListParams{}, recipientSession)
+					if err != nil || list.JSON200 == nil {
+						return false
+					}
+					for _, n := range list.JSON200.Notifications {
+						if n.Event == openapi.NotificationEventDirectMessage {
+							return true
+						}
+					}
+					return false
+				}, 5*time.Second, 20*time.Millisecond)
+			})
+
+			t.Run("outsider_cannot_read_or_send", func(t *testing.T) {
+				get, err := cl.ConversationGetWithResponse(root, direct.JSON200.Id, &openapi.ConversationGetParams{}, outsiderSession)
+				tests.Status(t, err, get, http.StatusNotFound)
+
+				send, err := cl.ConversationMessageSendWithResponse(root, direct.JSON200.Id, openapi.ConversationMessageInitialProps{
+					Body: "<p>let me in</p>",
+				}, outsiderSession)
+				tests.Status(t, err, send, http.StatusNotFound)
+
+				list, err := cl.ConversationListWithResponse(root, &openapi.ConversationListParams{}, outsiderSession)
+				tests.Ok(t, err, list)
+				assert.Empty(t, list.JSON200.Conversations)
+			})
+
+			t.Run("group_conversation", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				group, err := cl.ConversationCreateWithResponse(root, openapi.ConversationInitialProps{
+					Recipients: []string{recipient.ID.String(), outsider.ID.String()},
+					Title:      opt.New("Planning").Ptr(),
+					Body:       "<p>hello everyone</p>",
+				}, senderSession)
+				tests.Ok(t, err, group)
+				r.Len(group.JSON200.Participants, 3)
+				a.NotEqual(direct.JSON200.Id, group.JSON200.Id)
+
+				get, err := cl.ConversationGetWithResponse(root, group.JSON200.Id, &openapi.ConversationGetParams{}, outsiderSession)
+				tests.Ok(t, err, get)
+				a.Len(get.JSON200.Messages.Messages, 1)
+			})
+
+			t.Run("report_message", func(t *testing.T) {
+				get, err := cl.ConversationGetWithResponse(root, direct.JSON200.Id, &openapi.ConversationGetParams{}, recipientSession)
+				tests.Ok(t, err, get)
+				messageID := get.JSON200.Messages.Messages[1].Id
+
+				outsiderReport, err := cl.ReportCreateWithResponse(root, openapi.ReportInitialProps{
+					TargetId:   messageID,
+					TargetKind: openapi.DatagraphItemKindMessage,
+				}, outsiderSession)
+				tests.Status(t, err, outsiderReport, http.StatusNotFound)
+
+				rep, err := cl.ReportCreateWithResponse(root, openapi.ReportInitialProps{
+					TargetId:   messageID,
+					TargetKind: openapi.DatagraphItemKindMessage,
+					Comment:    opt.New("unwanted").Ptr(),
+				}, recipientSession)
+				tests.Ok(t, err, rep)
+				assert.Equal(t, openapi.DatagraphItemKindMessage, rep.JSON200.TargetKind)
+			})
+
+			t.Run("admin_inspect_is_audited", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				get, err := cl.ConversationGetWithResponse(root, direct.JSON200.Id, &openapi.ConversationGetParams{}, recipientSession)
+				tests.Ok(t, err, get)
+				messageID := get.JSON200.Messages.Messages[0].Id
+
+				// Even administrators can't read conversations nobody reported.
+				unreported, err := cl.AdminConversationInspectWithResponse(root, messageID, &openapi.AdminConversationInspectParams{}, adminSession)
+				tests.Status(t, err, unreported, http.StatusForbidden)
+
+				rep, err := cl.ReportCreateWithResponse(root, openapi.ReportInitialProps{
+					TargetId:   messageID,
+					TargetKind: openapi.DatagraphItemKindMessage,
+				}, recipientSession)
+				tests.Ok(t, err, rep)
+
+				forbidden, err := cl.AdminConversationInspectWithResponse(root, messageID, &openapi.AdminConversationInspectParams{}, outsiderSession)
+				tests.Status(t, err, forbidden, http.StatusForbidden)
+
+				inspect, err := cl.AdminConversationInspectWithResponse(root, messageID, &openapi.AdminConversationInspectParams{}, adminSession)
+				tests.Ok(t, err, inspect)
+				a.Equal(direct.JSON200.Id, inspect.JSON200.Id)
+				a.Len(inspect.JSON200.Messages.Messages, 3)
+
+				// Access ends once the report is resolved.
+				resolved := openapi.Resolved
+				update, err := cl.ReportUpdateWithResponse(root, rep.JSON200.Id, openapi.ReportMutableProps{Status: &resolved}, adminSession)
+				tests.Ok(t, err, update)
+
+				closed, err := cl.AdminConversationInspectWithResponse(root, messageID, &openapi.AdminConversationInspectParams{}, adminSession)
+				tests.Status(t, err, closed, http.StatusForbidden)
+
+				// Only the access while the report was open is recorded.
+				log, err := cl.AdminAuditLogListWithResponse(root, &openapi.AdminAuditLogListParams{
+					TargetId: &messageID,
+					Action:   &openapi.AuditLogActionQuery{openapi.AuditLogActionConversationAccessed},
+				}, adminSession)
+				tests.Ok(t, err, log)
+				r.Len(log.JSON200.Entries, 1)
+				a.Equal(openapi.AuditLogActionConversationAccessed, log.JSON200.Entries[0].Action)
+			})
+
+			t.Run("blocking", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				self, err := cl.ProfileBlockAddWithResponse(root, recipient.Handle, recipientSession)
+				tests.Status(t, err, self, http.StatusBadRequest)
+
+				block, err := cl.ProfileBlockAddWithResponse(root, sender.Handle, recipientSession)
+				tests.Ok(t, err, block)
+
+				blocks, err := cl.AccountBlockListWithResponse(root, recipientSession)
+				tests.Ok(t, err, blocks)
+				r.Len(blocks.JSON200.Profiles, 1)
+				a.Equal(sender.ID.String(), blocks.JSON200.Profiles[0].Id)
+
+				// Blocks apply both ways, neither can message the other.
+				send, err := cl.ConversationMessageSendWithResponse(root, direct.JSON200.Id, openapi.ConversationMessageInitialProps{
+					Body: "<p>hello?</p>",
+				}, senderSession)
+				tests.Status(t, err, send, http.StatusForbidden)
+
+				send, err = cl.ConversationMessageSendWithResponse(root, direct.JSON200.Id, openapi.ConversationMessageInitialProps{
+					Body: "<p>go away</p>",
+				}, recipientSession)
+				tests.Status(t, err, send, http.StatusForbidden)
+
+				start, err := cl.ConversationCreateWithResponse(root, openapi.ConversationInitialProps{
+					Recipients: []string{recipient.ID.String(), outsider.ID.String()},
+					Body:       "<p>new group</p>",
+				}, senderSession)
+				tests.Status(t, err, start, http.StatusForbidden)
+
+				unblock, err := cl.ProfileBlockRemoveWithResponse(root, sender.Handle, recipientSession)
+				tests.Ok(t, err, unblock)
+
+				send, err = cl.ConversationMessageSendWithResponse(root, direct.JSON200.Id, openapi.ConversationMessageInitialProps{
+					Body: "<p>hello again</p>",
+				}, senderSession)
+				tests.Ok(t, err, send)
+			})
+		}))
+	}))
+}
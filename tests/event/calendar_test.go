@@ -0,0 +1,234 @@
+package event_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/event/event_ref"
+	"github.com/Southclaws/storyden/app/resources/event/participation"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/event/event_management"
+	"github.com/Southclaws/storyden/app/services/event/event_participation"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestEventCalendar(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		eventManager *event_management.Manager,
+		participationManager event_participation.Manager,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, adminAcc := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, memberAcc := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			// Updates, cancellations and participation changes aren't exposed
+			// over HTTP yet so they go straight to the services.
+			adminService := session.WithAccount(root, *adminAcc, nil)
+			memberService := session.WithAccount(root, *memberAcc, nil)
+			otherCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			otherSession := sh.WithSession(otherCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Colour: "#fe4efd", Description: "calendar testing", Name: "Category " + uuid.NewString()}, adminSession)
+			tests.Ok(t, err, cat)
+
+			createEvent := func(t *testing.T, vis openapi.Visibility) *openapi.Event {
+				create, err := cl.EventCreateWithResponse(root, openapi.EventInitialProps{
+					Name:                "Midsummer feast " + xid.New().String(),
+					Description:         opt.New("Bring food; drinks, and friends").Ptr(),
+					Content:             "<body><p>feast</p></body>",
+					TimeRange:           openapi.EventTimeRange{Start: time.Now().Add(time.Hour * 72), End: time.Now().Add(time.Hour * 76)},
+					ParticipationPolicy: openapi.Open,
+					Visibility:          vis,
+					ThreadCategoryId:    cat.JSON200.Id,
+				}, adminSession)
+				tests.Ok(t, err, create)
+				return create.JSON200
+			}
+
+			// vevent extracts the iCalendar component for the given event.
+			vevent := func(t *testing.T, body []byte, id string) string {
+				t.Helper()
+				unfolded := strings.ReplaceAll(string(body), "\r\n ", "")
+				for _, c := range strings.Split(unfolded, "BEGIN:VEVENT\r\n")[1:] {
+					if strings.Contains(c, "UID:"+id+"@") {
+						return c
+					}
+				}
+				return ""
+			}
+
+			t.Run("public_feed", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				evt := createEvent(t, openapi.Published)
+				draft := createEvent(t, openapi.Draft)
+				mk := event_ref.NewKey(evt.Id)
+
+				feed, err := cl.FeedEventsWithResponse(root)
+				tests.Ok(t, err, feed)
+				a.Contains(feed.HTTPResponse.Header.Get("Content-Type"), "text/calendar")
+				a.True(strings.HasPrefix(string(feed.Body), "BEGIN:VCALENDAR\r\n"))
+
+				v := vevent(t, feed.Body, evt.Id)
+				r.NotEmpty(v)
+				a.Contains(v, "SUMMARY:"+evt.Name)
+				a.Contains(v, `DESCRIPTION:Bring food\; drinks\, and friends`)
+				a.Contains(v, "SEQUENCE:0\r\n")
+				a.Contains(v, "STATUS:CONFIRMED\r\n")
+				a.Empty(vevent(t, feed.Body, draft.Id))
+
+				_, err = eventManager.Update(adminService, mk, event_management.Partial{
+					Name: opt.New(evt.Name + " (moved)"),
+				})
+				r.NoError(err)
+
+				feed, err = cl.FeedEventsWithResponse(root)
+				tests.Ok(t, err, feed)
+				v = vevent(t, feed.Body, evt.Id)
+				r.NotEmpty(v)
+				a.Contains(v, "SUMMARY:"+evt.Name+" (moved)")
+				a.Contains(v, "SEQUENCE:1\r\n")
+
+				_, err = eventManager.Delete(adminService, mk, event_management.Partial{})
+				r.NoError(err)
+
+				feed, err = cl.FeedEventsWithResponse(root)
+				tests.Ok(t, err, feed)
+				v = vevent(t, feed.Body, evt.Id)
+				r.NotEmpty(v, "cancelled events stay in the feed")
+				a.Contains(v, "SEQUENCE:2\r\n")
+				a.Contains(v, "STATUS:CANCELLED\r\n")
+
+				cached, err := cl.FeedEventsWithResponse(root, func(ctx context.Context, req *http.Request) error {
+					req.Header.Set("If-None-Match", feed.HTTPResponse.Header.Get("ETag"))
+					return nil
+				})
+				r.NoError(err)
+				a.Equal(http.StatusNotModified, cached.StatusCode())
+			})
+
+			t.Run("event_download", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				evt := createEvent(t, openapi.Published)
+				draft := createEvent(t, openapi.Draft)
+
+				ics, err := cl.EventGetICSWithResponse(root, evt.Slug)
+				tests.Ok(t, err, ics)
+				r.NotEmpty(vevent(t, ics.Body, evt.Id))
+
+				hidden, err := cl.EventGetICSWithResponse(root, draft.Slug, otherSession)
+				tests.Status(t, err, hidden, http.StatusNotFound)
+
+				host, err := cl.EventGetICSWithResponse(root, draft.Slug, adminSession)
+				tests.Ok(t, err, host)
+				a.NotEmpty(vevent(t, host.Body, draft.Id))
+			})
+
+			t.Run("attending_feed", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				attending := createEvent(t, openapi.Published)
+				declined := createEvent(t, openapi.Published)
+
+				_, err := participationManager.Update(memberService, event_ref.NewKey(attending.Id), event_participation.Change{
+					AccountID: memberAcc.ID,
+					Status:    opt.New(participation.StatusAttending),
+				})
+				r.NoError(err)
+
+				_, err = participationManager.Update(memberService, event_ref.NewKey(declined.Id), event_participation.Change{
+					AccountID: memberAcc.ID,
+					Status:    opt.New(participation.StatusDeclined),
+				})
+				r.NoError(err)
+
+				sub, err := cl.AccountCalendarGetWithResponse(root, memberSession)
+				tests.Ok(t, err, sub)
+				r.NotEmpty(sub.JSON200.Token)
+				a.Contains(sub.JSON200.Url, "token="+sub.JSON200.Token)
+
+				again, err := cl.AccountCalendarGetWithResponse(root, memberSession)
+				tests.Ok(t, err, again)
+				a.Equal(sub.JSON200.Token, again.JSON200.Token)
+
+				feed, err := cl.FeedEventsAttendingWithResponse(root, &openapi.FeedEventsAttendingParams{Token: sub.JSON200.Token})
+				tests.Ok(t, err, feed)
+				a.NotEmpty(vevent(t, feed.Body, attending.Id))
+				a.Empty(vevent(t, feed.Body, declined.Id))
+
+				invalid, err := cl.FeedEventsAttendingWithResponse(root, &openapi.FeedEventsAttendingParams{Token: fmt.Sprintf("%x", xid.New().Bytes())})
+				tests.Status(t, err, invalid, http.StatusForbidden)
+
+				reset, err := cl.AccountCalendarResetWithResponse(root, memberSession)
+				tests.Ok(t, err, reset)
+				a.NotEqual(sub.JSON200.Token, reset.JSON200.Token)
+
+				revoked, err := cl.FeedEventsAttendingWithResponse(root, &openapi.FeedEventsAttendingParams{Token: sub.JSON200.Token})
+				tests.Status(t, err, revoked, http.StatusForbidden)
+
+				renewed, err := cl.FeedEventsAttendingWithResponse(root, &openapi.FeedEventsAttendingParams{Token: reset.JSON200.Token})
+				tests.Ok(t, err, renewed)
+				a.NotEmpty(vevent(t, renewed.Body, attending.Id))
+
+				// Leaving an event that isn't the most recently changed one
+				// must still invalidate cached copies of the feed.
+				later := createEvent(t, openapi.Published)
+				_, err = participationManager.Update(memberService, event_ref.NewKey(later.Id), event_participation.Change{
+					AccountID: memberAcc.ID,
+					Status:    opt.New(participation.StatusAttending),
+				})
+				r.NoError(err)
+
+				both, err := cl.FeedEventsAttendingWithResponse(root, &openapi.FeedEventsAttendingParams{Token: reset.JSON200.Token})
+				tests.Ok(t, err, both)
+				a.NotEmpty(vevent(t, both.Body, attending.Id))
+				etag := both.HTTPResponse.Header.Get("ETag")
+				r.NotEmpty(etag)
+
+				_, err = participationManager.Update(memberService, event_ref.NewKey(attending.Id), event_participation.Change{
+					AccountID: memberAcc.ID,
+					Status:    opt.New(participation.StatusDeclined),
+				})
+				r.NoError(err)
+
+				left, err := cl.FeedEventsAttendingWithResponse(root, &openapi.FeedEventsAttendingParams{Token: reset.JSON200.Token}, func(ctx context.Context, req *http.Request) error {
+					req.Header.Set("If-None-Match", etag)
+					return nil
+				})
+				tests.Ok(t, err, left)
+				a.Empty(vevent(t, left.Body, attending.Id))
+				a.NotEmpty(vevent(t, left.Body, later.Id))
+				a.NotEqual(etag, left.HTTPResponse.Header.Get("ETag"))
+			})
+		}))
+	}))
+}
@@ -0,0 +1,92 @@
+package event_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/event/event_ref"
+	"github.com/Southclaws/storyden/app/resources/event/participation"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/event/event_participation"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestEventParticipationSelf(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		participationManager event_participation.Manager,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			_, memberAcc := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+
+			// Participation changes aren't exposed over HTTP yet.
+			memberService := session.WithAccount(root, *memberAcc, nil)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Colour: "#fe4efd", Description: "participation testing", Name: "Category " + uuid.NewString()}, adminSession)
+			tests.Ok(t, err, cat)
+
+			create, err := cl.EventCreateWithResponse(root, openapi.EventInitialProps{
+				Name:                "Open event " + uuid.NewString(),
+				Content:             "<body><p>all welcome</p></body>",
+				TimeRange:           openapi.EventTimeRange{Start: time.Now().Add(time.Hour * 24), End: time.Now().Add(time.Hour * 26)},
+				ParticipationPolicy: openapi.Open,
+				Visibility:          openapi.Published,
+				ThreadCategoryId:    cat.JSON200.Id,
+			}, adminSession)
+			tests.Ok(t, err, create)
+			mk := event_ref.NewKey(create.JSON200.Id)
+
+			self := func(participants participation.EventParticipants) *participation.EventParticipant {
+				p, _ := lo.Find(participants, func(p *participation.EventParticipant) bool { return p.Account.ID == memberAcc.ID })
+				return p
+			}
+
+			// A member who has never taken part has no participation record yet.
+			evt, err := participationManager.Update(memberService, mk, event_participation.Change{
+				AccountID: memberAcc.ID,
+				Status:    opt.New(participation.StatusAttending),
+			})
+			r.NoError(err)
+
+			joined := self(evt.Participants)
+			r.NotNil(joined)
+			a.Equal(participation.RoleAttendee, joined.Role)
+			a.Equal(participation.StatusAttending, joined.Status)
+
+			evt, err = participationManager.Update(memberService, mk, event_participation.Change{
+				AccountID: memberAcc.ID,
+				Status:    opt.New(participation.StatusDeclined),
+			})
+			r.NoError(err)
+
+			declined := self(evt.Participants)
+			r.NotNil(declined)
+			a.Equal(participation.RoleAttendee, declined.Role)
+			a.Equal(participation.StatusDeclined, declined.Status)
+		}))
+	}))
+}
@@ -0,0 +1,230 @@
+package feed_test
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+type rss struct {
+	Channel struct {
+		Title string `xml:"title"`
+		Items []struct {
+			Title string `xml:"title"`
+			Link  string `xml:"link"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+type atom struct {
+	Title   string `xml:"title"`
+	Entries []struct {
+		Title string `xml:"title"`
+	} `xml:"entry"`
+}
+
+func TestFeeds(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, member := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Colour:      "#fe4efd",
+				Description: "feed testing",
+				Name:        "Feed " + uuid.NewString(),
+			}, adminSession)
+			tests.Ok(t, err, cat)
+
+			tag := openapi.TagName("feed-" + uuid.NewString()[:8])
+
+			published, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				Title:      "Published " + uuid.NewString(),
+				Body:       opt.New("<p>feed body</p>").Ptr(),
+				Category:   opt.New(cat.JSON200.Id).Ptr(),
+				Tags:       &openapi.TagNameList{tag},
+				Visibility: opt.New(openapi.Published).Ptr(),
+			}, memberSession)
+			tests.Ok(t, err, published)
+
+			draft, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				Title:      "Draft " + uuid.NewString(),
+				Body:       opt.New("<p>draft body</p>").Ptr(),
+				Category:   opt.New(cat.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Draft).Ptr(),
+			}, memberSession)
+			tests.Ok(t, err, draft)
+
+			titles := func(f rss) []string {
+				out := []string{}
+				for _, i := range f.Channel.Items {
+					out = append(out, i.Title)
+				}
+				return out
+			}
+
+			t.Run("rss", func(t *testing.T) {
+				res, err := cl.FeedThreadsWithResponse(root, &openapi.FeedThreadsParams{})
+				tests.Ok(t, err, res)
+				a.Contains(res.HTTPResponse.Header.Get("Content-Type"), "application/rss+xml")
+				a.NotEmpty(res.HTTPResponse.Header.Get("ETag"))
+
+				var f rss
+				r.NoError(xml.Unmarshal(res.Body, &f))
+				a.Contains(titles(f), published.JSON200.Title)
+				a.NotContains(titles(f), draft.JSON200.Title, "drafts are never syndicated")
+			})
+
+			t.Run("atom", func(t *testing.T) {
+				format := openapi.Atom
+				res, err := cl.FeedThreadsWithResponse(root, &openapi.FeedThreadsParams{Format: &format})
+				tests.Ok(t, err, res)
+				a.Contains(res.HTTPResponse.Header.Get("Content-Type"), "application/atom+xml")
+
+				var f atom
+				r.NoError(xml.Unmarshal(res.Body, &f))
+				a.NotEmpty(f.Entries)
+				a.Equal(published.JSON200.Title, f.Entries[0].Title)
+			})
+
+			t.Run("filtered", func(t *testing.T) {
+				res, err := cl.FeedThreadsWithResponse(root, &openapi.FeedThreadsParams{Category: &cat.JSON200.Slug})
+				tests.Ok(t, err, res)
+				var byCategory rss
+				r.NoError(xml.Unmarshal(res.Body, &byCategory))
+				a.Equal([]string{published.JSON200.Title}, titles(byCategory))
+				a.Contains(byCategory.Channel.Title, cat.JSON200.Name)
+
+				res, err = cl.FeedThreadsWithResponse(root, &openapi.FeedThreadsParams{Tag: &tag})
+				tests.Ok(t, err, res)
+				var byTag rss
+				r.NoError(xml.Unmarshal(res.Body, &byTag))
+				a.Equal([]string{published.JSON200.Title}, titles(byTag))
+
+				handle := openapi.AccountHandle(member.Handle)
+				res, err = cl.FeedThreadsWithResponse(root, &openapi.FeedThreadsParams{Author: &handle})
+				tests.Ok(t, err, res)
+				var byAuthor rss
+				r.NoError(xml.Unmarshal(res.Body, &byAuthor))
+				a.Contains(titles(byAuthor), published.JSON200.Title)
+			})
+
+			t.Run("not_found", func(t *testing.T) {
+				missing := "missing-" + uuid.NewString()
+				res, err := cl.FeedThreadsWithResponse(root, &openapi.FeedThreadsParams{Category: &missing})
+				tests.Status(t, err, res, http.StatusNotFound)
+
+				handle := openapi.AccountHandle(missing)
+				res, err = cl.FeedThreadsWithResponse(root, &openapi.FeedThreadsParams{Author: &handle})
+				tests.Status(t, err, res, http.StatusNotFound)
+			})
+
+			t.Run("not_modified", func(t *testing.T) {
+				res, err := cl.FeedThreadsWithResponse(root, &openapi.FeedThreadsParams{Category: &cat.JSON200.Slug})
+				tests.Ok(t, err, res)
+				etag := res.HTTPResponse.Header.Get("ETag")
+				r.NotEmpty(etag)
+
+				res, err = cl.FeedThreadsWithResponse(root, &openapi.FeedThreadsParams{Category: &cat.JSON200.Slug}, func(ctx context.Context, req *http.Request) error {
+					req.Header.Set("If-None-Match", etag)
+					return nil
+				})
+				tests.Status(t, err, res, http.StatusNotModified)
+
+				ifNoneMatch := func(ctx context.Context, req *http.Request) error {
+					req.Header.Set("If-None-Match", etag)
+					return nil
+				}
+
+				// The same entries requested differently aren't the same feed.
+				res, err = cl.FeedThreadsWithResponse(root, &openapi.FeedThreadsParams{Tag: &tag}, ifNoneMatch)
+				tests.Ok(t, err, res)
+				a.NotEqual(etag, res.HTTPResponse.Header.Get("ETag"))
+
+				format := openapi.Atom
+				res, err = cl.FeedThreadsWithResponse(root, &openapi.FeedThreadsParams{Category: &cat.JSON200.Slug, Format: &format}, ifNoneMatch)
+				tests.Ok(t, err, res)
+			})
+
+			t.Run("modified_by_deletion", func(t *testing.T) {
+				tag := openapi.TagName("gone-" + uuid.NewString()[:8])
+				create := func(title string) *openapi.ThreadCreateResponse {
+					res, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+						Title:      title + " " + uuid.NewString(),
+						Body:       opt.New("<p>feed body</p>").Ptr(),
+						Tags:       &openapi.TagNameList{tag},
+						Visibility: opt.New(openapi.Published).Ptr(),
+					}, memberSession)
+					tests.Ok(t, err, res)
+					return res
+				}
+				older := create("Older")
+				create("Newer")
+
+				res, err := cl.FeedThreadsWithResponse(root, &openapi.FeedThreadsParams{Tag: &tag})
+				tests.Ok(t, err, res)
+				etag := res.HTTPResponse.Header.Get("ETag")
+
+				// Removing an entry other than the newest leaves the most recent
+				// update as it was, the feed has still changed.
+				del, err := cl.ThreadDeleteWithResponse(root, older.JSON200.Slug, memberSession)
+				tests.Ok(t, err, del)
+
+				res, err = cl.FeedThreadsWithResponse(root, &openapi.FeedThreadsParams{Tag: &tag}, func(ctx context.Context, req *http.Request) error {
+					req.Header.Set("If-None-Match", etag)
+					return nil
+				})
+				tests.Ok(t, err, res)
+
+				var f rss
+				r.NoError(xml.Unmarshal(res.Body, &f))
+				a.NotContains(titles(f), older.JSON200.Title)
+			})
+
+			t.Run("library", func(t *testing.T) {
+				visibility := openapi.Published
+				node, err := cl.NodeCreateWithResponse(root, openapi.NodeCreateJSONRequestBody{
+					Name:       "Feed page " + uuid.NewString(),
+					Content:    opt.New("<p>library feed</p>").Ptr(),
+					Visibility: &visibility,
+				}, adminSession)
+				tests.Ok(t, err, node)
+
+				format := openapi.FeedLibraryParamsFormatRss
+				res, err := cl.FeedLibraryWithResponse(root, &openapi.FeedLibraryParams{Format: &format})
+				tests.Ok(t, err, res)
+
+				var f rss
+				r.NoError(xml.Unmarshal(res.Body, &f))
+				a.Contains(titles(f), node.JSON200.Name)
+			})
+		}))
+	}))
+}
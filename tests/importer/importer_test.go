@@ -0,0 +1,317 @@
+package importer_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_account "github.com/Southclaws/storyden/internal/ent/account"
+	ent_email "github.com/Southclaws/storyden/internal/ent/email"
+	ent_post "github.com/Southclaws/storyden/internal/ent/post"
+	"github.com/Southclaws/storyden/internal/importer"
+	"github.com/Southclaws/storyden/internal/importer/discourse"
+	"github.com/Southclaws/storyden/internal/importer/jsonl"
+	"github.com/Southclaws/storyden/internal/importer/phpbb"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestImporter(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Provide(importer.New), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		db *ent.Client,
+		imp *importer.Importer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			getThread := func(t *testing.T, title string) *openapi.ThreadGet {
+				p, err := db.Post.Query().Where(ent_post.TitleEQ(title)).Only(root)
+				require.NoError(t, err)
+
+				get, err := cl.ThreadGetWithResponse(root, p.Slug, nil, adminSession)
+				tests.Ok(t, err, get)
+				return get.JSON200
+			}
+
+			t.Run("jsonl", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				id := xid.New().String()
+				lines := []string{
+					fmt.Sprintf(`{"type":"source","name":"test-%s"}`, id),
+					fmt.Sprintf(`{"type":"account","id":"1","handle":"Odin.%s","name":"Odin Allfather","admin":true,"created_at":"2014-03-01T10:00:00Z"}`, id),
+					fmt.Sprintf(`{"type":"account","id":"2","handle":"frigg-%s","email":"frigg-%s@example.com","bio":"Queen of **Asgard**","format":"markdown"}`, id, id),
+					`// categories may appear before their parents`,
+					fmt.Sprintf(`{"type":"category","id":"2","parent":"1","name":"Child %s"}`, id),
+					fmt.Sprintf(`{"type":"category","id":"1","name":"Parent %s","colour":"4ec9b0"}`, id),
+					fmt.Sprintf(`{"type":"thread","id":"1","category":"2","author":"1","title":"Markdown %s","body":"Hello **everyone**","format":"markdown","tags":["Go Lang %s"],"created_at":"2015-06-01T12:00:00Z"}`, id, id),
+					fmt.Sprintf(`{"type":"thread","id":"2","category":"1","author":"2","title":"BBCode %s","body":"[b]bold[/b] and [url=https://storyden.org]a link[/url]","format":"bbcode","created_at":"2015-06-02T12:00:00Z"}`, id),
+					`{"type":"reply","id":"1","thread":"1","author":"2","body":"<p>First!</p>","format":"html","created_at":"2015-06-01T13:00:00Z"}`,
+					`{"type":"reply","id":"2","thread":"1","reply_to":"1","author":"1","body":"Welcome","created_at":"2015-06-01T14:00:00Z"}`,
+					`{"type":"reply","id":"3","thread":"404","author":"1","body":"orphan"}`,
+					`{"type":"like","account":"2","thread":"1"}`,
+					`{"type":"reaction","account":"1","reply":"1","emoji":":heart:"}`,
+					`{"type":"reaction","account":"1","reply":"1","emoji":"partyparrot"}`,
+				}
+
+				dump, err := jsonl.New().Read(root, strings.NewReader(strings.Join(lines, "\n")))
+				r.NoError(err)
+				a.Equal("test-"+id, dump.Source)
+
+				report, err := imp.Run(root, dump)
+				r.NoError(err)
+				a.Equal(importer.Counts{Created: 2}, report.Accounts)
+				a.Equal(importer.Counts{Created: 2}, report.Categories)
+				a.Equal(importer.Counts{Created: 2}, report.Threads)
+				a.Equal(importer.Counts{Created: 2, Skipped: 1}, report.Replies)
+				a.Equal(importer.Counts{Created: 1}, report.Likes)
+				a.Equal(importer.Counts{Created: 1, Skipped: 1}, report.Reactions)
+
+				th := getThread(t, "Markdown "+id)
+				a.Equal(2015, th.CreatedAt.Year())
+				a.Equal(strings.ToLower("odin-"+id), th.Author.Handle)
+				a.Equal("Odin Allfather", th.Author.Name)
+				a.Contains(th.Body, "<strong>everyone</strong>")
+				r.NotNil(th.Category)
+				a.Equal("Child "+id, th.Category.Name)
+				a.Equal([]string{"go-lang-" + id}, lo.Map(th.Tags, func(t openapi.TagReference, _ int) string { return t.Name }))
+				a.Equal(1, th.Likes.Likes)
+
+				replies := th.Replies.Replies
+				r.Len(replies, 2)
+				a.Equal("frigg-"+id, replies[0].Author.Handle)
+				a.Equal(2015, replies[0].CreatedAt.Year())
+				r.Len(replies[0].Reacts, 1)
+				a.Equal("❤️", replies[0].Reacts[0].Emoji)
+				r.NotNil(replies[1].ReplyTo)
+				a.Equal(replies[0].Id, replies[1].ReplyTo.Id)
+
+				bb := getThread(t, "BBCode "+id)
+				a.Contains(bb.Body, "<strong>bold</strong>")
+				a.Contains(bb.Body, `href="https://storyden.org"`)
+				a.Contains(bb.Body, ">a link</a>")
+				a.Equal("Parent "+id, bb.Category.Name)
+
+				// Running the same import again creates nothing new.
+
+				again, err := imp.Run(root, dump)
+				r.NoError(err)
+				a.Equal(importer.Counts{Existing: 2}, again.Accounts)
+				a.Equal(importer.Counts{Existing: 2}, again.Categories)
+				a.Equal(importer.Counts{Existing: 2}, again.Threads)
+				a.Equal(importer.Counts{Existing: 2, Skipped: 1}, again.Replies)
+
+				th = getThread(t, "Markdown "+id)
+				a.Len(th.Replies.Replies, 2)
+				a.Equal(1, th.Likes.Likes)
+				a.Len(th.Replies.Replies[0].Reacts, 1)
+			})
+
+			t.Run("admins_and_emails", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				id := xid.New().String()
+				read := func(source string, lines ...string) *importer.Dump {
+					dump, err := jsonl.New().Read(root, strings.NewReader(strings.Join(append([]string{
+						fmt.Sprintf(`{"type":"source","name":"%s-%s"}`, source, id),
+					}, lines...), "\n")))
+					r.NoError(err)
+					return dump
+				}
+
+				getAccount := func(handle string) *ent.Account {
+					acc, err := db.Account.Query().Where(ent_account.Handle(handle)).WithEmails().Only(root)
+					r.NoError(err)
+					return acc
+				}
+
+				verified := func(address string) bool {
+					e, err := db.Email.Query().Where(ent_email.EmailAddress(address)).Only(root)
+					r.NoError(err)
+					return e.Verified
+				}
+
+				_, err := imp.Run(root, read("defaults",
+					fmt.Sprintf(`{"type":"account","id":"1","handle":"heimdall-%s","email":"heimdall-%s@example.com","email_verified":true,"admin":true}`, id, id),
+					fmt.Sprintf(`{"type":"account","id":"2","handle":"loki-%s","email":"loki-%s@example.com"}`, id, id),
+				))
+				r.NoError(err)
+
+				// Admin mapping is opt-in.
+				a.False(getAccount("heimdall-" + id).Admin)
+				a.True(verified("heimdall-" + id + "@example.com"))
+				a.False(verified("loki-" + id + "@example.com"))
+
+				// An unverified address doesn't link to an existing account.
+				report, err := imp.Run(root, read("impostor",
+					fmt.Sprintf(`{"type":"account","id":"1","handle":"impostor-%s","email":"heimdall-%s@example.com"}`, id, id),
+				))
+				r.NoError(err)
+				a.Equal(importer.Counts{Created: 1}, report.Accounts)
+				a.Empty(getAccount("impostor-" + id).Edges.Emails)
+
+				_, err = imp.Run(root, read("admins",
+					fmt.Sprintf(`{"type":"account","id":"1","handle":"tyr-%s","admin":true}`, id),
+				), importer.WithAdmins(true))
+				r.NoError(err)
+				a.True(getAccount("tyr-" + id).Admin)
+			})
+
+			t.Run("discourse", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				id := xid.New().String()
+				dump := strings.Join([]string{
+					`COPY public.users (id, username, name, admin, created_at) FROM stdin;`,
+					fmt.Sprintf("1\tthor_%s\tThor\tf\t2016-01-01 00:00:00", id),
+					fmt.Sprintf("2\tsif_%s\t\\N\tf\t2016-01-02 00:00:00", id),
+					`\.`,
+					`COPY public.categories (id, name, color, description, parent_category_id, position) FROM stdin;`,
+					fmt.Sprintf("5\tDiscourse %s\t0088CC\tImported\t\\N\t1", id),
+					`\.`,
+					`COPY public.topics (id, title, created_at, user_id, category_id, archetype, deleted_at) FROM stdin;`,
+					fmt.Sprintf("10\tTopic %s\t2016-02-01 00:00:00\t1\t5\tregular\t\\N", id),
+					fmt.Sprintf("11\tPrivate %s\t2016-02-01 00:00:00\t1\t\\N\tprivate_message\t\\N", id),
+					`\.`,
+					`COPY public.posts (id, topic_id, post_number, user_id, raw, cooked, created_at, deleted_at, reply_to_post_number, post_type) FROM stdin;`,
+					"100\t10\t1\t1\tOpening *post*\t<p>Opening <em>post</em></p>\t2016-02-01 00:00:00\t\\N\t\\N\t1",
+					"101\t10\t2\t2\tA reply\t<p>A reply</p>\t2016-02-02 00:00:00\t\\N\t\\N\t1",
+					"102\t10\t3\t1\tReplying to Sif\t<p>Replying to Sif</p>\t2016-02-03 00:00:00\t\\N\t2\t1",
+					"103\t10\t4\t1\t\t<p>closed</p>\t2016-02-04 00:00:00\t\\N\t\\N\t3",
+					"104\t11\t1\t1\tsecret\t<p>secret</p>\t2016-02-01 00:00:00\t\\N\t\\N\t1",
+					`\.`,
+					`COPY public.tags (id, name) FROM stdin;`,
+					fmt.Sprintf("1\tdiscourse-%s", id),
+					`\.`,
+					`COPY public.topic_tags (topic_id, tag_id) FROM stdin;`,
+					"10\t1",
+					`\.`,
+					`COPY public.post_actions (post_id, user_id, post_action_type_id, deleted_at) FROM stdin;`,
+					"100\t2\t2\t\\N",
+					"101\t1\t2\t\\N",
+					"101\t1\t3\t\\N",
+					`\.`,
+				}, "\n")
+
+				d, err := discourse.New().Read(root, strings.NewReader(dump))
+				r.NoError(err)
+				d.Source = "discourse-" + id
+
+				report, err := imp.Run(root, d)
+				r.NoError(err)
+				a.Equal(2, report.Accounts.Created)
+				a.Equal(1, report.Threads.Created)
+				a.Equal(2, report.Replies.Created)
+				a.Equal(2, report.Likes.Created)
+
+				th := getThread(t, "Topic "+id)
+				a.Contains(th.Body, "<em>post</em>")
+				a.Equal("Discourse "+id, th.Category.Name)
+				a.Equal(1, th.Likes.Likes)
+				r.Len(th.Tags, 1)
+
+				replies := th.Replies.Replies
+				r.Len(replies, 2)
+				a.Equal("sif_"+id, replies[0].Author.Handle)
+				a.Equal(1, replies[0].Likes.Likes)
+				r.NotNil(replies[1].ReplyTo)
+				a.Equal(replies[0].Id, replies[1].ReplyTo.Id)
+
+				exists, err := db.Post.Query().Where(ent_post.TitleEQ("Private " + id)).Exist(root)
+				r.NoError(err)
+				a.False(exists)
+			})
+
+			t.Run("phpbb", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				id := xid.New().String()
+				dump := strings.Join([]string{
+					"CREATE TABLE `phpbb_users` (",
+					"  `user_id` int unsigned NOT NULL,",
+					"  `user_type` tinyint NOT NULL,",
+					"  `username` varchar(255) NOT NULL,",
+					"  `user_email` varchar(100) NOT NULL,",
+					"  `user_regdate` int unsigned NOT NULL",
+					") ENGINE=InnoDB;",
+					fmt.Sprintf("INSERT INTO `phpbb_users` VALUES (1,2,'Anonymous','',0),(2,3,'Baldr %s','baldr-%s@example.com',1200000000),(3,2,'Googlebot','',1200000000);", id, id),
+					"CREATE TABLE `phpbb_forums` (",
+					"  `forum_id` int NOT NULL,",
+					"  `parent_id` int NOT NULL,",
+					"  `left_id` int NOT NULL,",
+					"  `forum_name` varchar(255) NOT NULL,",
+					"  `forum_desc` text NOT NULL,",
+					"  `forum_desc_uid` varchar(8) NOT NULL,",
+					"  `forum_type` tinyint NOT NULL",
+					");",
+					fmt.Sprintf("INSERT INTO `phpbb_forums` VALUES (1,0,1,'phpBB Category %s','',' ',0),(2,1,2,'phpBB Forum %s','The [b:x1]main[/b:x1] forum','x1',1);", id, id),
+					"CREATE TABLE `phpbb_topics` (",
+					"  `topic_id` int NOT NULL,",
+					"  `forum_id` int NOT NULL,",
+					"  `topic_title` varchar(255) NOT NULL,",
+					"  `topic_poster` int NOT NULL,",
+					"  `topic_time` int NOT NULL,",
+					"  `topic_first_post_id` int NOT NULL,",
+					"  `topic_visibility` tinyint NOT NULL,",
+					"  `topic_moved_id` int NOT NULL",
+					");",
+					fmt.Sprintf("INSERT INTO `phpbb_topics` VALUES (7,2,'Legacy &amp; new %s',2,1200000100,70,1,0);", id),
+					"CREATE TABLE `phpbb_posts` (",
+					"  `post_id` int NOT NULL,",
+					"  `topic_id` int NOT NULL,",
+					"  `poster_id` int NOT NULL,",
+					"  `post_time` int NOT NULL,",
+					"  `post_text` mediumtext NOT NULL,",
+					"  `bbcode_uid` varchar(8) NOT NULL,",
+					"  `post_visibility` tinyint NOT NULL",
+					");",
+					`INSERT INTO ` + "`phpbb_posts`" + ` VALUES (70,7,2,1200000100,'[b:3k2j1h]Legacy[/b:3k2j1h] post <!-- s:) --><img src=\"{SMILIES_PATH}/icon_e_smile.gif\" alt=\":)\" /><!-- s:) -->','3k2j1h',1),(71,7,1,1200000200,'<r><B><s>[b]</s>Modern<e>[/b]</e></B> reply &amp; more</r>','',1),(72,7,3,1200000300,'bot spam','',1),(73,7,2,1200000400,'unapproved','',0);`,
+				}, "\n")
+
+				d, err := phpbb.New(phpbb.DefaultPrefix).Read(root, strings.NewReader(dump))
+				r.NoError(err)
+				d.Source = "phpbb-" + id
+
+				report, err := imp.Run(root, d)
+				r.NoError(err)
+				a.Equal(2, report.Accounts.Created) // anonymous and Baldr, not the bot
+				a.Equal(2, report.Categories.Created)
+				a.Equal(1, report.Threads.Created)
+				a.Equal(importer.Counts{Created: 1, Skipped: 1}, report.Replies)
+
+				th := getThread(t, "Legacy & new "+id)
+				a.Contains(th.Body, "<strong>Legacy</strong> post :)")
+				a.Equal("phpBB Forum "+id, th.Category.Name)
+				a.Equal(2008, th.CreatedAt.Year())
+
+				replies := th.Replies.Replies
+				r.Len(replies, 1)
+				a.Contains(replies[0].Body, "<strong>Modern</strong> reply &amp; more")
+			})
+		}))
+	}))
+}
@@ -0,0 +1,134 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"path"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+	"github.com/Southclaws/storyden/tests/library"
+)
+
+func TestNodeExport(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			ctx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			session := sh.WithSession(ctx)
+
+			name := "diagram.png"
+			content := []byte("not really a png")
+			upload, err := cl.AssetUploadWithBodyWithResponse(root, &openapi.AssetUploadParams{
+				Filename:      &name,
+				ContentLength: int64(len(content)),
+			}, "application/octet-stream", bytes.NewReader(content), session)
+			tests.Ok(t, err, upload)
+			img := upload.JSON200
+
+			published := opt.New(openapi.Published).Ptr()
+
+			parentProps := library.UniqueNode("handbook")
+			parentProps.Visibility = published
+			parentProps.Description = opt.New("Everything we know").Ptr()
+			parentProps.Tags = &openapi.TagNameList{"docs"}
+			parentProps.PrimaryImageAssetId = &img.Id
+			parentProps.Properties = &openapi.PropertyMutationList{
+				{Name: "status", Value: "stable", Type: opt.New(openapi.Text).Ptr()},
+			}
+			parent, err := cl.NodeCreateWithResponse(root, parentProps, session)
+			tests.Ok(t, err, parent)
+
+			childProps := library.UniqueNode("onboarding")
+			childProps.Visibility = published
+			childProps.Parent = &parent.JSON200.Slug
+			childProps.AssetIds = &openapi.AssetIDs{img.Id}
+			childProps.Content = opt.New(`<p>Back to <a href="sdr:node/` + parent.JSON200.Id + `">the handbook</a>.</p><img src="` + img.Path + `" />`).Ptr()
+			child, err := cl.NodeCreateWithResponse(root, childProps, session)
+			tests.Ok(t, err, child)
+
+			draftProps := library.UniqueNode("unfinished")
+			draftProps.Parent = &parent.JSON200.Slug
+			draft, err := cl.NodeCreateWithResponse(root, draftProps, session)
+			tests.Ok(t, err, draft)
+
+			parentDir := parent.JSON200.Slug
+			childDir := path.Join(parentDir, child.JSON200.Slug)
+			draftDir := path.Join(parentDir, draft.JSON200.Slug)
+			assetPath := path.Join("assets", img.Filename)
+
+			export := func(t *testing.T, opts ...openapi.RequestEditorFn) map[string]string {
+				res, err := cl.NodeExportWithResponse(root, parent.JSON200.Slug, opts...)
+				tests.Ok(t, err, res)
+
+				zr, err := zip.NewReader(bytes.NewReader(res.Body), int64(len(res.Body)))
+				r.NoError(err)
+
+				files := map[string]string{}
+				for _, f := range zr.File {
+					rc, err := f.Open()
+					r.NoError(err)
+					b, err := io.ReadAll(rc)
+					r.NoError(err)
+					rc.Close()
+					files[f.Name] = string(b)
+				}
+
+				return files
+			}
+
+			t.Run("layout_and_front_matter", func(t *testing.T) {
+				files := export(t)
+
+				r.Contains(files, path.Join(parentDir, "index.md"))
+				r.Contains(files, path.Join(childDir, "index.md"))
+				a.NotContains(files, path.Join(draftDir, "index.md"), "guests can't see drafts")
+				a.Equal(string(content), files[assetPath])
+
+				index := files[path.Join(parentDir, "index.md")]
+				a.Contains(index, "title: handbook\n")
+				a.Contains(index, "description: Everything we know\n")
+				a.Contains(index, "tags:\n    - docs\n")
+				a.Contains(index, "properties:\n    status: stable\n")
+				a.Contains(index, "primary_image: ../"+assetPath+"\n")
+			})
+
+			t.Run("rewritten_links", func(t *testing.T) {
+				files := export(t)
+
+				page := files[path.Join(childDir, "index.md")]
+				a.Contains(page, "[the handbook](../index.md)")
+				a.Contains(page, "](../../"+assetPath+")")
+				a.NotContains(page, "sdr:")
+			})
+
+			t.Run("owner_sees_drafts", func(t *testing.T) {
+				files := export(t, session)
+
+				a.Contains(files, path.Join(draftDir, "index.md"))
+			})
+		}))
+	}))
+}
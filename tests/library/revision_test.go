@@ -0,0 +1,93 @@
+package library_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+	"github.com/Southclaws/storyden/tests/library"
+)
+
+func TestNodeRevisions(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+
+			props := library.UniqueNode("revisions")
+			props.Name = "Original name"
+			props.Content = opt.New("<p>first version</p>").Ptr()
+			nodeCreate, err := cl.NodeCreateWithResponse(root, props, adminSession)
+			tests.Ok(t, err, nodeCreate)
+			slug := nodeCreate.JSON200.Slug
+
+			t.Run("no_revisions_before_edit", func(t *testing.T) {
+				list, err := cl.NodeRevisionListWithResponse(root, slug, nil, adminSession)
+				tests.Ok(t, err, list)
+				a.Empty(list.JSON200.Revisions)
+			})
+
+			update, err := cl.NodeUpdateWithResponse(root, slug, openapi.NodeMutableProps{
+				Name:    opt.New("Edited name").Ptr(),
+				Content: opt.New("<p>second version</p>").Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, update)
+
+			t.Run("non_librarian_cannot_list", func(t *testing.T) {
+				list, err := cl.NodeRevisionListWithResponse(root, slug, nil, memberSession)
+				tests.Status(t, err, list, http.StatusForbidden)
+			})
+
+			list, err := cl.NodeRevisionListWithResponse(root, slug, nil, adminSession)
+			tests.Ok(t, err, list)
+			r.Len(list.JSON200.Revisions, 1)
+			rev := list.JSON200.Revisions[0]
+			a.Equal("Original name", rev.Name)
+			r.NotNil(rev.Content)
+			a.Contains(*rev.Content, "first version")
+			a.Equal(nodeCreate.JSON200.Id, rev.NodeId)
+
+			t.Run("restore", func(t *testing.T) {
+				restore, err := cl.NodeRevisionRestoreWithResponse(root, slug, rev.Id, adminSession)
+				tests.Ok(t, err, restore)
+				a.Equal("Original name", restore.JSON200.Name)
+
+				get, err := cl.NodeGetWithResponse(root, slug, nil, adminSession)
+				tests.Ok(t, err, get)
+				a.Equal("Original name", get.JSON200.Name)
+				r.NotNil(get.JSON200.Content)
+				a.Contains(*get.JSON200.Content, "first version")
+
+				// Restoring is itself an edit, so the replaced version is kept.
+				list, err := cl.NodeRevisionListWithResponse(root, slug, nil, adminSession)
+				tests.Ok(t, err, list)
+				r.Len(list.JSON200.Revisions, 2)
+				a.Equal("Edited name", list.JSON200.Revisions[0].Name)
+			})
+		}))
+	}))
+}
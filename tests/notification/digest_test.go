@@ -0,0 +1,189 @@
+package notification_test
+
+import (
+	"context"
+	"net/mail"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	email_repo "github.com/Southclaws/storyden/app/resources/account/email"
+	"github.com/Southclaws/storyden/app/resources/account/notification/notify_digest"
+	"github.com/Southclaws/storyden/app/resources/post"
+	"github.com/Southclaws/storyden/app/resources/post/post_read_state"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/notification/digest_job"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestNotificationDigest(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{
+		JWTSecret: []byte("07d422e512b23a056ccc953994d1593f"),
+	}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		digests *digest_job.Sender,
+		digestRepo *notify_digest.Repository,
+		readState *post_read_state.Writer,
+		emails *email_repo.Repository,
+		sender mailer.Sender,
+	) {
+		inbox := sender.(*mailer.Mock)
+
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			otherCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			otherSession := sh.WithSession(otherCtx)
+
+			email := xid.New().String() + "@storyden.org"
+			signup, err := cl.AuthEmailPasswordSignupWithResponse(root, nil, openapi.AuthEmailPasswordSignupJSONRequestBody{Email: email, Password: "password"})
+			tests.Ok(t, err, signup)
+			memberID := account.AccountID(openapi.GetAccountID(signup.JSON200.Id))
+			r.NoError(emails.Verify(root, memberID, mail.Address{Address: email}))
+			memberSession := sh.WithSession(e2e.WithAccountID(root, memberID))
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Name:   "Digest " + xid.New().String(),
+				Colour: "#123456",
+			}, adminSession)
+			tests.Ok(t, err, cat)
+
+			createThread := func(title string, session openapi.RequestEditorFn) *openapi.Thread {
+				res, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+					Title:      title,
+					Body:       opt.New("<p>" + title + "</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+				}, session)
+				tests.Ok(t, err, res)
+				return res.JSON200
+			}
+
+			// The member posts in the category, then someone else replies to
+			// them and starts a new thread in the same category.
+			own := createThread("Member thread "+xid.New().String(), memberSession)
+
+			reply, err := cl.ReplyCreateWithResponse(root, own.Slug, openapi.ReplyInitialProps{Body: "<p>reply</p>"}, otherSession)
+			tests.Ok(t, err, reply)
+
+			other := createThread("Other thread "+xid.New().String(), otherSession)
+
+			// Threads the member has already read aren't worth a mention.
+			read := createThread("Read thread "+xid.New().String(), otherSession)
+			r.NoError(readState.UpsertReadState(root, memberID, post.ID(openapi.ParseID(read.Id))))
+
+			// HACK: because I haven't set up proper queue tooling for tests
+			time.Sleep(time.Millisecond * 100)
+
+			// Not opted in, nothing is sent.
+			n, err := digests.SendDue(root, time.Now())
+			r.NoError(err)
+			a.Equal(0, n)
+
+			daily := openapi.Daily
+			prefs, err := cl.AccountNotificationPreferencesUpdateWithResponse(root, openapi.NotificationPreferences{
+				Preferences:     openapi.NotificationPreferenceList{},
+				DigestFrequency: &daily,
+			}, memberSession)
+			tests.Ok(t, err, prefs)
+			r.NotNil(prefs.JSON200.DigestFrequency)
+			a.Equal(openapi.Daily, *prefs.JSON200.DigestFrequency)
+
+			now := time.Now()
+			n, err = digests.SendDue(root, now)
+			r.NoError(err)
+			a.Equal(1, n)
+
+			time.Sleep(time.Millisecond * 100)
+
+			sent := inbox.GetLast()
+			r.Equal(email, sent.Address.Address)
+			a.Contains(sent.Subject, "daily digest")
+			a.Contains(sent.Plain, "1 unread notifications")
+			a.Contains(sent.Plain, "replied to your thread")
+			a.Contains(sent.Plain, other.Title)
+			a.Contains(sent.Plain, own.Title, "the member's thread was the most active")
+			a.NotContains(sent.Plain, read.Title)
+			a.NotEmpty(sent.Headers["List-Unsubscribe"])
+
+			// Not due again until a day has passed.
+			n, err = digests.SendDue(root, now.Add(time.Hour))
+			r.NoError(err)
+			a.Equal(0, n)
+
+			// Once due again only what's new is sent.
+			later := createThread("Later thread "+xid.New().String(), otherSession)
+
+			n, err = digests.SendDue(root, now.Add(25*time.Hour))
+			r.NoError(err)
+			a.Equal(1, n)
+
+			time.Sleep(time.Millisecond * 100)
+
+			sent = inbox.GetLast()
+			a.Contains(sent.Plain, later.Title)
+			a.NotContains(sent.Plain, other.Title)
+			a.NotContains(sent.Plain, "unread notifications")
+
+			// The next one has nothing left to send, so nothing is repeated.
+			n, err = digests.SendDue(root, now.Add(26*time.Hour))
+			r.NoError(err)
+			a.Equal(0, n)
+
+			n, err = digests.SendDue(root, now.Add(50*time.Hour))
+			r.NoError(err)
+			a.Equal(0, n)
+
+			// When several instances list the same due digest only the first
+			// to claim it may send it.
+			due, err := digestRepo.ListDue(root, now.Add(75*time.Hour), 100)
+			r.NoError(err)
+			d, found := lo.Find(due, func(d *notify_digest.Digest) bool { return d.AccountID == memberID })
+			r.True(found)
+
+			claimed, err := digestRepo.Claim(root, d, now.Add(75*time.Hour))
+			r.NoError(err)
+			a.True(claimed)
+
+			claimed, err = digestRepo.Claim(root, d, now.Add(75*time.Hour+time.Second))
+			r.NoError(err)
+			a.False(claimed)
+
+			// The unsubscribe link in a digest stops the digest.
+			oneClick, err := url.Parse(strings.Trim(sent.Headers["List-Unsubscribe"], "<>"))
+			r.NoError(err)
+
+			unsub, err := cl.NotificationUnsubscribeWithResponse(root, &openapi.NotificationUnsubscribeParams{Token: oneClick.Query().Get("token")})
+			tests.Ok(t, err, unsub)
+
+			get, err := cl.AccountNotificationPreferencesGetWithResponse(root, memberSession)
+			tests.Ok(t, err, get)
+			r.NotNil(get.JSON200.DigestFrequency)
+			a.Equal(openapi.Off, *get.JSON200.DigestFrequency)
+		}))
+	}))
+}
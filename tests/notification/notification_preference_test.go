@@ -0,0 +1,222 @@
+package notification_test
+
+import (
+	"context"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/account/email"
+	"github.com/Southclaws/storyden/app/resources/account/notification"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/notification/notify"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/mailer"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestNotificationPreferences(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, &config.Config{
+		JWTSecret: []byte("07d422e512b23a056ccc953994d1593f"),
+	}, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		notifier *notify.Notifier,
+		emails *email.Repository,
+		sender mailer.Sender,
+	) {
+		inbox := sender.(*mailer.Mock)
+
+		lc.Append(fx.StartHook(func() {
+			_, source := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+
+			signupUnverified := func(t *testing.T) (account.AccountID, string, openapi.RequestEditorFn) {
+				email := xid.New().String() + "@storyden.org"
+
+				res, err := cl.AuthEmailPasswordSignupWithResponse(root, nil, openapi.AuthEmailPasswordSignupJSONRequestBody{Email: email, Password: "password"})
+				tests.Ok(t, err, res)
+
+				accountID := account.AccountID(openapi.GetAccountID(res.JSON200.Id))
+
+				return accountID, email, sh.WithSession(e2e.WithAccountID(root, accountID))
+			}
+
+			signup := func(t *testing.T) (account.AccountID, string, openapi.RequestEditorFn) {
+				accountID, address, session := signupUnverified(t)
+
+				err := emails.Verify(root, accountID, mail.Address{Address: address})
+				require.NoError(t, err)
+
+				return accountID, address, session
+			}
+
+			follow := func(t *testing.T, target account.AccountID) {
+				err := notifier.Send(root, target, opt.New(source.ID), notification.EventFollow, &datagraph.Ref{ID: xid.ID(source.ID), Kind: datagraph.KindProfile})
+				require.NoError(t, err)
+
+				// HACK: because I haven't set up proper queue tooling for tests
+				time.Sleep(time.Millisecond * 100)
+			}
+
+			set := func(t *testing.T, session openapi.RequestEditorFn, event openapi.NotificationEvent, delivery openapi.NotificationDelivery) {
+				res, err := cl.AccountNotificationPreferencesUpdateWithResponse(root, openapi.NotificationPreferences{
+					Preferences: openapi.NotificationPreferenceList{{Event: event, Delivery: delivery}},
+				}, session)
+				tests.Ok(t, err, res)
+			}
+
+			deliveryFor := func(t *testing.T, session openapi.RequestEditorFn, event openapi.NotificationEvent) openapi.NotificationDelivery {
+				res, err := cl.AccountNotificationPreferencesGetWithResponse(root, session)
+				tests.Ok(t, err, res)
+				for _, p := range res.JSON200.Preferences {
+					if p.Event == event {
+						return p.Delivery
+					}
+				}
+				t.Fatalf("event %s missing from preferences", event)
+				return ""
+			}
+
+			t.Run("defaults", func(t *testing.T) {
+				a := assert.New(t)
+
+				_, _, session := signup(t)
+
+				res, err := cl.AccountNotificationPreferencesGetWithResponse(root, session)
+				tests.Ok(t, err, res)
+				a.Len(res.JSON200.Preferences, len(notification.Events))
+				for _, p := range res.JSON200.Preferences {
+					a.Equal(openapi.NotificationDelivery("in_app"), p.Delivery)
+				}
+			})
+
+			t.Run("email_and_unsubscribe", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				accountID, email, session := signup(t)
+
+				set(t, session, "follow", "email")
+				a.Equal(openapi.NotificationDelivery("email"), deliveryFor(t, session, "follow"))
+
+				follow(t, accountID)
+
+				sent := inbox.GetLast()
+				r.Equal(email, sent.Address.Address)
+				a.Contains(sent.Subject, "followed you")
+				a.Contains(sent.Plain, "/m/"+source.Handle)
+				a.Equal("List-Unsubscribe=One-Click", sent.Headers["List-Unsubscribe-Post"])
+
+				// Still written to the in-app list too.
+				list, err := cl.NotificationListWithResponse(root, &openapi.NotificationListParams{}, session)
+				tests.Ok(t, err, list)
+				a.Len(list.JSON200.Notifications, 1)
+
+				// The link in the body is opened in a browser and only asks to
+				// confirm, link scanners following it must not unsubscribe.
+				match := regexp.MustCompile(`(http\S+/api/notifications/unsubscribe\?token=\S+)`).FindStringSubmatch(sent.Plain)
+				r.Len(match, 2)
+				link, err := url.Parse(match[1])
+				r.NoError(err)
+				token := link.Query().Get("token")
+				r.NotEmpty(token)
+
+				page, err := cl.NotificationUnsubscribeLinkWithResponse(root, &openapi.NotificationUnsubscribeLinkParams{Token: token})
+				tests.Ok(t, err, page)
+				a.Contains(page.HTTPResponse.Header.Get("Content-Type"), "text/html")
+				a.Contains(string(page.Body), `<form method="post"`)
+				a.Contains(string(page.Body), url.Values{"token": {token}}.Encode())
+
+				a.Equal(openapi.NotificationDelivery("email"), deliveryFor(t, session, "follow"))
+
+				badPage, err := cl.NotificationUnsubscribeLinkWithResponse(root, &openapi.NotificationUnsubscribeLinkParams{Token: "nope"})
+				tests.Status(t, err, badPage, 400)
+				a.Contains(string(badPage.Body), "invalid or has expired")
+
+				// Submitting the confirmation page posts the same token.
+				confirm, err := cl.NotificationUnsubscribeWithResponse(root, &openapi.NotificationUnsubscribeParams{Token: token})
+				tests.Ok(t, err, confirm)
+				a.Contains(confirm.HTTPResponse.Header.Get("Content-Type"), "text/html")
+				a.Contains(string(confirm.Body), "Unsubscribed")
+
+				a.Equal(openapi.NotificationDelivery("in_app"), deliveryFor(t, session, "follow"))
+
+				// The one-click header targets the same endpoint with a POST.
+				oneClick, err := url.Parse(strings.Trim(sent.Headers["List-Unsubscribe"], "<>"))
+				r.NoError(err)
+				a.Equal(link.Path, oneClick.Path)
+
+				set(t, session, "follow", "email")
+
+				unsub, err := cl.NotificationUnsubscribeWithResponse(root, &openapi.NotificationUnsubscribeParams{Token: oneClick.Query().Get("token")})
+				tests.Ok(t, err, unsub)
+
+				a.Equal(openapi.NotificationDelivery("in_app"), deliveryFor(t, session, "follow"))
+
+				bad, err := cl.NotificationUnsubscribeWithResponse(root, &openapi.NotificationUnsubscribeParams{Token: "nope"})
+				tests.Status(t, err, bad, 400)
+			})
+
+			t.Run("unverified_address_not_emailed", func(t *testing.T) {
+				a := assert.New(t)
+
+				accountID, email, session := signupUnverified(t)
+
+				set(t, session, "follow", "email")
+
+				follow(t, accountID)
+
+				sent := inbox.GetLast()
+				a.False(sent.Address.Address == email && strings.Contains(sent.Subject, "followed you"))
+
+				// The notification is still shown in-app.
+				list, err := cl.NotificationListWithResponse(root, &openapi.NotificationListParams{}, session)
+				tests.Ok(t, err, list)
+				a.Len(list.JSON200.Notifications, 1)
+			})
+
+			t.Run("off", func(t *testing.T) {
+				a := assert.New(t)
+
+				accountID, _, session := signup(t)
+
+				set(t, session, "follow", "off")
+
+				follow(t, accountID)
+
+				list, err := cl.NotificationListWithResponse(root, &openapi.NotificationListParams{}, session)
+				tests.Ok(t, err, list)
+				a.Empty(list.JSON200.Notifications)
+			})
+
+			t.Run("invalid", func(t *testing.T) {
+				_, _, session := signup(t)
+
+				res, err := cl.AccountNotificationPreferencesUpdateWithResponse(root, openapi.NotificationPreferences{
+					Preferences: openapi.NotificationPreferenceList{{Event: "follow", Delivery: "carrier_pigeon"}},
+				}, session)
+				tests.Status(t, err, res, 400)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,175 @@
+package notification_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+type streamEvent struct {
+	name string
+	data string
+}
+
+// readStream parses server-sent events from the body until it's closed.
+func readStream(body *bufio.Scanner, out chan<- streamEvent) {
+	defer close(out)
+
+	current := streamEvent{}
+	for body.Scan() {
+		line := body.Text()
+		switch {
+		case line == "":
+			if current.name != "" {
+				out <- current
+			}
+			current = streamEvent{}
+		case strings.HasPrefix(line, "event: "):
+			current.name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			current.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func TestNotificationStream(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Name:   "Stream " + xid.New().String(),
+				Colour: "#123456",
+			}, adminSession)
+			tests.Ok(t, err, cat)
+
+			thread, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				Title:      "Streamed thread",
+				Body:       opt.New("<p>hello</p>").Ptr(),
+				Category:   opt.New(cat.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+			}, memberSession)
+			tests.Ok(t, err, thread)
+
+			t.Run("unauthenticated", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res, err := cl.NotificationStream(root, &openapi.NotificationStreamParams{})
+				r.NoError(err)
+				defer res.Body.Close()
+				a.Equal(http.StatusUnauthorized, res.StatusCode)
+			})
+
+			t.Run("unreadable_thread", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				draft, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+					Title:      "Draft thread",
+					Body:       opt.New("<p>draft</p>").Ptr(),
+					Category:   opt.New(cat.JSON200.Id).Ptr(),
+					Visibility: opt.New(openapi.Draft).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, draft)
+
+				res, err := cl.NotificationStream(root, &openapi.NotificationStreamParams{
+					Thread: &openapi.NotificationStreamThreadQuery{draft.JSON200.Slug},
+				}, memberSession)
+				r.NoError(err)
+				defer res.Body.Close()
+				a.Equal(http.StatusForbidden, res.StatusCode)
+			})
+
+			t.Run("notifications_and_thread_activity", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				ctx, cancel := context.WithCancel(root)
+				defer cancel()
+
+				res, err := cl.NotificationStream(ctx, &openapi.NotificationStreamParams{
+					Thread: &openapi.NotificationStreamThreadQuery{thread.JSON200.Slug},
+				}, memberSession)
+				r.NoError(err)
+				defer res.Body.Close()
+				r.Equal(http.StatusOK, res.StatusCode)
+				a.Contains(res.Header.Get("Content-Type"), "text/event-stream")
+
+				events := make(chan streamEvent, 16)
+				go readStream(bufio.NewScanner(res.Body), events)
+
+				next := func() streamEvent {
+					select {
+					case e, ok := <-events:
+						r.True(ok, "stream closed unexpectedly")
+						return e
+					case <-time.After(5 * time.Second):
+						r.FailNow("timed out waiting for stream event")
+						return streamEvent{}
+					}
+				}
+
+				// Someone else replies to the member's thread, which produces
+				// both thread activity and a notification for the member.
+				reply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, openapi.ReplyInitialProps{Body: "<p>reply</p>"}, adminSession)
+				tests.Ok(t, err, reply)
+
+				received := map[string]string{}
+				for range 2 {
+					e := next()
+					received[e.name] = e.data
+				}
+
+				r.Contains(received, "reply_created")
+				var activity openapi.NotificationStreamThreadActivity
+				r.NoError(json.Unmarshal([]byte(received["reply_created"]), &activity))
+				a.Equal(thread.JSON200.Id, activity.ThreadId)
+				a.Equal(reply.JSON200.Id, activity.PostId)
+
+				r.Contains(received, "notification")
+				var notif openapi.Notification
+				r.NoError(json.Unmarshal([]byte(received["notification"]), &notif))
+				a.Equal(openapi.NotificationEvent("thread_reply"), notif.Event)
+				a.Equal(openapi.Unread, notif.Status)
+
+				react, err := cl.PostReactAddWithResponse(root, reply.JSON200.Id, openapi.ReactInitialProps{Emoji: "🔥"}, adminSession)
+				tests.Ok(t, err, react)
+
+				e := next()
+				a.Equal("react_added", e.name)
+				r.NoError(json.Unmarshal([]byte(e.data), &activity))
+				a.Equal(thread.JSON200.Id, activity.ThreadId)
+				a.Equal(reply.JSON200.Id, activity.PostId)
+			})
+		}))
+	}))
+}
@@ -0,0 +1,270 @@
+package publish_schedule_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/schedule"
+	"github.com/Southclaws/storyden/app/resources/schedule/schedule_querier"
+	"github.com/Southclaws/storyden/app/resources/schedule/schedule_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/services/publish_schedule"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestPublishSchedule(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		publisher *publish_schedule.Publisher,
+		querier *schedule_querier.Querier,
+		writer *schedule_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			authorCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			authorSession := sh.WithSession(authorCtx)
+			otherCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			otherSession := sh.WithSession(otherCtx)
+
+			publishAt := time.Now().Add(time.Hour)
+
+			isListed := func(list []openapi.PublishSchedule, id string) bool {
+				_, ok := lo.Find(list, func(s openapi.PublishSchedule) bool { return s.Id == id })
+				return ok
+			}
+
+			t.Run("thread", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				draft, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+					Title:      "Scheduled announcement",
+					Body:       opt.New("<p>coming soon</p>").Ptr(),
+					Visibility: opt.New(openapi.Draft).Ptr(),
+				}, authorSession)
+				tests.Ok(t, err, draft)
+
+				past, err := cl.PublishScheduleCreateWithResponse(root, openapi.PublishScheduleInitialProps{
+					TargetId:   draft.JSON200.Id,
+					TargetKind: openapi.DatagraphItemKindThread,
+					PublishAt:  time.Now().Add(-time.Hour),
+				}, authorSession)
+				tests.Status(t, err, past, http.StatusBadRequest)
+
+				denied, err := cl.PublishScheduleCreateWithResponse(root, openapi.PublishScheduleInitialProps{
+					TargetId:   draft.JSON200.Id,
+					TargetKind: openapi.DatagraphItemKindThread,
+					PublishAt:  publishAt,
+				}, otherSession)
+				tests.Status(t, err, denied, http.StatusForbidden)
+
+				sched, err := cl.PublishScheduleCreateWithResponse(root, openapi.PublishScheduleInitialProps{
+					TargetId:   draft.JSON200.Id,
+					TargetKind: openapi.DatagraphItemKindThread,
+					PublishAt:  publishAt,
+				}, authorSession)
+				tests.Ok(t, err, sched)
+				a.Equal(draft.JSON200.Id, sched.JSON200.TargetId)
+				a.Equal(openapi.DatagraphItemKindThread, sched.JSON200.TargetKind)
+				a.WithinDuration(publishAt, sched.JSON200.PublishAt, time.Second)
+
+				// Scheduling again moves the existing schedule.
+				later := publishAt.Add(time.Hour)
+				moved, err := cl.PublishScheduleCreateWithResponse(root, openapi.PublishScheduleInitialProps{
+					TargetId:   draft.JSON200.Id,
+					TargetKind: openapi.DatagraphItemKindThread,
+					PublishAt:  later,
+				}, authorSession)
+				tests.Ok(t, err, moved)
+				a.Equal(sched.JSON200.Id, moved.JSON200.Id)
+				a.WithinDuration(later, moved.JSON200.PublishAt, time.Second)
+
+				own, err := cl.PublishScheduleListWithResponse(root, authorSession)
+				tests.Ok(t, err, own)
+				a.True(isListed(own.JSON200.Schedules, sched.JSON200.Id))
+
+				others, err := cl.PublishScheduleListWithResponse(root, otherSession)
+				tests.Ok(t, err, others)
+				a.False(isListed(others.JSON200.Schedules, sched.JSON200.Id))
+
+				all, err := cl.PublishScheduleListWithResponse(root, adminSession)
+				tests.Ok(t, err, all)
+				a.True(isListed(all.JSON200.Schedules, sched.JSON200.Id))
+
+				n, err := publisher.PublishDue(root, later.Add(time.Minute))
+				r.NoError(err)
+				a.GreaterOrEqual(n, 1)
+
+				thread, err := cl.ThreadGetWithResponse(root, draft.JSON200.Slug, nil, authorSession)
+				tests.Ok(t, err, thread)
+				a.Equal(openapi.Published, thread.JSON200.Visibility)
+
+				after, err := cl.PublishScheduleListWithResponse(root, authorSession)
+				tests.Ok(t, err, after)
+				a.False(isListed(after.JSON200.Schedules, sched.JSON200.Id))
+
+				published, err := cl.PublishScheduleCreateWithResponse(root, openapi.PublishScheduleInitialProps{
+					TargetId:   draft.JSON200.Id,
+					TargetKind: openapi.DatagraphItemKindThread,
+					PublishAt:  publishAt,
+				}, authorSession)
+				tests.Status(t, err, published, http.StatusBadRequest)
+			})
+
+			t.Run("cancel", func(t *testing.T) {
+				a := assert.New(t)
+
+				draft, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+					Title:      "Cancelled announcement",
+					Body:       opt.New("<p>never mind</p>").Ptr(),
+					Visibility: opt.New(openapi.Draft).Ptr(),
+				}, authorSession)
+				tests.Ok(t, err, draft)
+
+				sched, err := cl.PublishScheduleCreateWithResponse(root, openapi.PublishScheduleInitialProps{
+					TargetId:   draft.JSON200.Id,
+					TargetKind: openapi.DatagraphItemKindThread,
+					PublishAt:  publishAt,
+				}, authorSession)
+				tests.Ok(t, err, sched)
+
+				denied, err := cl.PublishScheduleCancelWithResponse(root, sched.JSON200.Id, otherSession)
+				tests.Status(t, err, denied, http.StatusForbidden)
+
+				cancelled, err := cl.PublishScheduleCancelWithResponse(root, sched.JSON200.Id, authorSession)
+				tests.Ok(t, err, cancelled)
+
+				list, err := cl.PublishScheduleListWithResponse(root, authorSession)
+				tests.Ok(t, err, list)
+				a.False(isListed(list.JSON200.Schedules, sched.JSON200.Id))
+
+				thread, err := cl.ThreadGetWithResponse(root, draft.JSON200.Slug, nil, authorSession)
+				tests.Ok(t, err, thread)
+				a.Equal(openapi.Draft, thread.JSON200.Visibility)
+			})
+
+			t.Run("claimed_and_failed", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				draft, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+					Title:      "Troubled announcement",
+					Body:       opt.New("<p>third time lucky</p>").Ptr(),
+					Visibility: opt.New(openapi.Draft).Ptr(),
+				}, authorSession)
+				tests.Ok(t, err, draft)
+
+				sched, err := cl.PublishScheduleCreateWithResponse(root, openapi.PublishScheduleInitialProps{
+					TargetId:   draft.JSON200.Id,
+					TargetKind: openapi.DatagraphItemKindThread,
+					PublishAt:  publishAt,
+				}, authorSession)
+				tests.Ok(t, err, sched)
+				a.Zero(sched.JSON200.Attempts)
+
+				due, err := querier.List(root, schedule_querier.WithDueBefore(publishAt.Add(time.Minute)))
+				r.NoError(err)
+				s, found := lo.Find(due, func(s *schedule.Schedule) bool { return s.ID.String() == sched.JSON200.Id })
+				r.True(found)
+
+				// Only the first of several instances to list it may attempt it.
+				claimed, err := writer.Claim(root, s, publishAt.Add(5*time.Minute))
+				r.NoError(err)
+				a.True(claimed)
+
+				claimed, err = writer.Claim(root, s, publishAt.Add(5*time.Minute))
+				r.NoError(err)
+				a.False(claimed)
+
+				// An attempt in progress, or one which failed, holds off retries.
+				_, err = publisher.PublishDue(root, publishAt.Add(2*time.Minute))
+				r.NoError(err)
+
+				thread, err := cl.ThreadGetWithResponse(root, draft.JSON200.Slug, nil, authorSession)
+				tests.Ok(t, err, thread)
+				a.Equal(openapi.Draft, thread.JSON200.Visibility)
+
+				// Once too many attempts have failed, it's never tried again.
+				err = writer.Failed(root, s.ID, "The item could not be published.", opt.New(publishAt.Add(3*time.Minute)))
+				r.NoError(err)
+
+				_, err = publisher.PublishDue(root, publishAt.Add(time.Hour))
+				r.NoError(err)
+
+				thread, err = cl.ThreadGetWithResponse(root, draft.JSON200.Slug, nil, authorSession)
+				tests.Ok(t, err, thread)
+				a.Equal(openapi.Draft, thread.JSON200.Visibility)
+
+				list, err := cl.PublishScheduleListWithResponse(root, authorSession)
+				tests.Ok(t, err, list)
+				failed, found := lo.Find(list.JSON200.Schedules, func(s openapi.PublishSchedule) bool { return s.Id == sched.JSON200.Id })
+				r.True(found)
+				a.Equal(1, failed.Attempts)
+				a.NotNil(failed.FailedAt)
+				a.Equal("The item could not be published.", opt.NewPtr(failed.LastError).OrZero())
+
+				// Scheduling it again starts afresh.
+				again, err := cl.PublishScheduleCreateWithResponse(root, openapi.PublishScheduleInitialProps{
+					TargetId:   draft.JSON200.Id,
+					TargetKind: openapi.DatagraphItemKindThread,
+					PublishAt:  publishAt,
+				}, authorSession)
+				tests.Ok(t, err, again)
+				a.Zero(again.JSON200.Attempts)
+				a.Nil(again.JSON200.FailedAt)
+				a.Nil(again.JSON200.LastError)
+
+				_, err = publisher.PublishDue(root, publishAt.Add(time.Minute))
+				r.NoError(err)
+
+				thread, err = cl.ThreadGetWithResponse(root, draft.JSON200.Slug, nil, authorSession)
+				tests.Ok(t, err, thread)
+				a.Equal(openapi.Published, thread.JSON200.Visibility)
+			})
+
+			t.Run("library_node", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				node, err := cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{
+					Name:       "Scheduled page",
+					Visibility: opt.New(openapi.Draft).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, node)
+
+				sched, err := cl.PublishScheduleCreateWithResponse(root, openapi.PublishScheduleInitialProps{
+					TargetId:   node.JSON200.Id,
+					TargetKind: openapi.DatagraphItemKindNode,
+					PublishAt:  publishAt,
+				}, adminSession)
+				tests.Ok(t, err, sched)
+
+				_, err = publisher.PublishDue(root, publishAt.Add(time.Minute))
+				r.NoError(err)
+
+				got, err := cl.NodeGetWithResponse(root, node.JSON200.Slug, nil, adminSession)
+				tests.Ok(t, err, got)
+				a.Equal(openapi.Published, got.JSON200.Visibility)
+			})
+		}))
+	}))
+}
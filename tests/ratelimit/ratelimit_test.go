@@ -0,0 +1,140 @@
+package ratelimit_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/limiter"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			otherCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			trustedCtx, trusted := e2e.WithAccount(root, aw, seed.Account_005_Þórr)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+			otherSession := sh.WithSession(otherCtx)
+			trustedSession := sh.WithSession(trustedCtx)
+
+			remaining := func(t *testing.T, h http.Header) int {
+				n, err := strconv.Atoi(h.Get(limiter.RateLimitRemaining))
+				require.NoError(t, err)
+				return n
+			}
+
+			createThread := func(session openapi.RequestEditorFn) (*openapi.ThreadCreateResponse, error) {
+				return cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+					Title:      "Limited " + xid.New().String(),
+					Body:       opt.New("<p>rate limited</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+				}, session)
+			}
+
+			t.Run("default_cost", func(t *testing.T) {
+				first, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{})
+				tests.Ok(t, err, first)
+				second, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{})
+				tests.Ok(t, err, second)
+
+				assert.Equal(t, remaining(t, first.HTTPResponse.Header)-1, remaining(t, second.HTTPResponse.Header))
+			})
+
+			t.Run("keyed_by_account", func(t *testing.T) {
+				guest, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{})
+				tests.Ok(t, err, guest)
+
+				member, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{}, memberSession)
+				tests.Ok(t, err, member)
+				assert.Equal(t, "1000", member.HTTPResponse.Header.Get(limiter.RateLimitLimit))
+				assert.Equal(t, 999, remaining(t, member.HTTPResponse.Header))
+
+				// The member's request did not count towards the guest's address.
+				after, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{})
+				tests.Ok(t, err, after)
+				assert.Equal(t, remaining(t, guest.HTTPResponse.Header)-1, remaining(t, after.HTTPResponse.Header))
+			})
+
+			t.Run("operation_limit", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res, err := createThread(otherSession)
+				tests.Ok(t, err, res)
+
+				// The operation's own limit is closer than the global limit.
+				a.Equal("60", res.HTTPResponse.Header.Get(limiter.RateLimitLimit))
+				a.Equal(59, remaining(t, res.HTTPResponse.Header))
+
+				// A request which reaches the limit is rejected.
+				for range 58 {
+					res, err = createThread(otherSession)
+					tests.Ok(t, err, res)
+				}
+
+				res, err = createThread(otherSession)
+				r.NoError(err)
+				a.Equal(http.StatusTooManyRequests, res.StatusCode())
+				a.NotEmpty(res.HTTPResponse.Header.Get(limiter.RetryAfter))
+
+				// Other operations are still subject to the global limit only,
+				// which the 59 accepted requests at a cost of 10 each have not
+				// yet exhausted. The rejected attempt isn't charged to it.
+				list, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{}, otherSession)
+				tests.Ok(t, err, list)
+				a.Equal("1000", list.HTTPResponse.Header.Get(limiter.RateLimitLimit))
+				a.Equal(1000-590-1, remaining(t, list.HTTPResponse.Header))
+
+				// And other members have their own separate limit.
+				res, err = createThread(memberSession)
+				tests.Ok(t, err, res)
+			})
+
+			t.Run("exempt", func(t *testing.T) {
+				a := assert.New(t)
+
+				res, err := createThread(adminSession)
+				tests.Ok(t, err, res)
+				a.Empty(res.HTTPResponse.Header.Get(limiter.RateLimitLimit))
+
+				role, err := cl.RoleCreateWithResponse(root, openapi.RoleInitialProps{
+					Name:        "Trusted " + xid.New().String(),
+					Colour:      "#00ff00",
+					Permissions: []openapi.Permission{openapi.CREATEPOST, openapi.BYPASSRATELIMITS},
+				}, adminSession)
+				tests.Ok(t, err, role)
+
+				add, err := cl.AccountAddRoleWithResponse(root, trusted.Handle, role.JSON200.Id, adminSession)
+				tests.Ok(t, err, add)
+
+				res, err = createThread(trustedSession)
+				tests.Ok(t, err, res)
+				a.Empty(res.HTTPResponse.Header.Get(limiter.RateLimitLimit))
+			})
+		}))
+	}))
+}
@@ -0,0 +1,224 @@
+package tag_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/rs/xid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestTagAdministration(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{Colour: "#fe4efd", Description: "tag admin testing", Name: "Category " + uuid.NewString()}, adminSession)
+			tests.Ok(t, err, cat)
+
+			createThread := func(t *testing.T, tags ...string) *openapi.Thread {
+				create, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+					Title:      "tagged thread",
+					Body:       opt.New("<p>tagged</p>").Ptr(),
+					Category:   &cat.JSON200.Id,
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Tags:       &tags,
+				}, memberSession)
+				tests.Ok(t, err, create)
+				return create.JSON200
+			}
+
+			threadTags := func(t *testing.T, slug string) []string {
+				get, err := cl.ThreadGetWithResponse(root, slug, nil, adminSession)
+				tests.Ok(t, err, get)
+				return tagNames(get.JSON200.Tags)
+			}
+
+			t.Run("merge", func(t *testing.T) {
+				a := assert.New(t)
+				r := require.New(t)
+
+				id := xid.New().String()
+				golang := "golang-" + id
+				gol := "go-lang-" + id
+				gotag := "go-" + id
+
+				t1 := createThread(t, golang)
+				t2 := createThread(t, gol, "other-"+id)
+				t3 := createThread(t, gotag, golang)
+
+				node, err := cl.NodeCreateWithResponse(root, openapi.NodeInitialProps{
+					Name:       "tagged page " + id,
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Tags:       &[]string{gol},
+				}, adminSession)
+				tests.Ok(t, err, node)
+
+				merge, err := cl.TagMergeWithResponse(root, golang, openapi.TagMergeProps{
+					Tags: []string{gol, gotag},
+				}, adminSession)
+				tests.Ok(t, err, merge)
+				a.Equal(golang, merge.JSON200.Name)
+				a.Equal(4, merge.JSON200.ItemCount)
+
+				get, err := cl.TagGetWithResponse(root, golang)
+				tests.Ok(t, err, get)
+				a.Equal(4, get.JSON200.ItemCount)
+				a.Len(get.JSON200.Items, 4)
+
+				for _, name := range []string{gol, gotag} {
+					gone, err := cl.TagGetWithResponse(root, name)
+					tests.Status(t, err, gone, http.StatusNotFound)
+				}
+
+				a.ElementsMatch([]string{golang}, threadTags(t, t1.Slug))
+				a.ElementsMatch([]string{golang, "other-" + id}, threadTags(t, t2.Slug))
+				a.ElementsMatch([]string{golang}, threadTags(t, t3.Slug))
+
+				nodeGet, err := cl.NodeGetWithResponse(root, node.JSON200.Slug, nil, adminSession)
+				tests.Ok(t, err, nodeGet)
+				r.Equal([]string{golang}, tagNames(nodeGet.JSON200.Tags))
+			})
+
+			t.Run("merge_unknown_source", func(t *testing.T) {
+				id := xid.New().String()
+				target := "target-" + id
+				createThread(t, target)
+
+				merge, err := cl.TagMergeWithResponse(root, target, openapi.TagMergeProps{
+					Tags: []string{"missing-" + id},
+				}, adminSession)
+				tests.Status(t, err, merge, http.StatusNotFound)
+
+				self, err := cl.TagMergeWithResponse(root, target, openapi.TagMergeProps{
+					Tags: []string{target},
+				}, adminSession)
+				tests.Status(t, err, self, http.StatusBadRequest)
+			})
+
+			t.Run("rename_and_describe", func(t *testing.T) {
+				a := assert.New(t)
+
+				id := xid.New().String()
+				before := "before-" + id
+				after := "After " + id
+				th := createThread(t, before)
+
+				update, err := cl.TagUpdateWithResponse(root, before, openapi.TagMutableProps{
+					Name:        &after,
+					Description: opt.New("Things that happened afterwards.").Ptr(),
+					Colour:      opt.New("rgb(255, 0, 0)").Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, update)
+				a.Equal("after-"+id, update.JSON200.Name)
+				a.Equal("#ff0000", update.JSON200.Colour)
+				a.Equal("Things that happened afterwards.", *update.JSON200.Description)
+
+				get, err := cl.TagGetWithResponse(root, "after-"+id)
+				tests.Ok(t, err, get)
+				a.Equal(1, get.JSON200.ItemCount)
+				a.Equal("#ff0000", get.JSON200.Colour)
+
+				gone, err := cl.TagGetWithResponse(root, before)
+				tests.Status(t, err, gone, http.StatusNotFound)
+
+				a.Equal([]string{"after-" + id}, threadTags(t, th.Slug))
+			})
+
+			t.Run("invalid_updates", func(t *testing.T) {
+				id := xid.New().String()
+				first := "first-" + id
+				second := "second-" + id
+				createThread(t, first, second)
+
+				badColour, err := cl.TagUpdateWithResponse(root, first, openapi.TagMutableProps{
+					Colour: opt.New("not a colour").Ptr(),
+				}, adminSession)
+				tests.Status(t, err, badColour, http.StatusBadRequest)
+
+				emptyName, err := cl.TagUpdateWithResponse(root, first, openapi.TagMutableProps{
+					Name: opt.New("!!!").Ptr(),
+				}, adminSession)
+				tests.Status(t, err, emptyName, http.StatusBadRequest)
+
+				conflict, err := cl.TagUpdateWithResponse(root, first, openapi.TagMutableProps{
+					Name: &second,
+				}, adminSession)
+				tests.Status(t, err, conflict, http.StatusConflict)
+
+				missing, err := cl.TagUpdateWithResponse(root, "missing-"+id, openapi.TagMutableProps{
+					Name: opt.New("anything").Ptr(),
+				}, adminSession)
+				tests.Status(t, err, missing, http.StatusNotFound)
+			})
+
+			t.Run("delete", func(t *testing.T) {
+				a := assert.New(t)
+
+				id := xid.New().String()
+				doomed := "doomed-" + id
+				kept := "kept-" + id
+				th := createThread(t, doomed, kept)
+
+				del, err := cl.TagDeleteWithResponse(root, doomed, adminSession)
+				tests.Ok(t, err, del)
+
+				gone, err := cl.TagGetWithResponse(root, doomed)
+				tests.Status(t, err, gone, http.StatusNotFound)
+
+				a.Equal([]string{kept}, threadTags(t, th.Slug))
+			})
+
+			t.Run("members_forbidden", func(t *testing.T) {
+				id := xid.New().String()
+				name := "members-" + id
+				other := "members-other-" + id
+				createThread(t, name, other)
+
+				update, err := cl.TagUpdateWithResponse(root, name, openapi.TagMutableProps{
+					Description: opt.New("nope").Ptr(),
+				}, memberSession)
+				tests.Status(t, err, update, http.StatusForbidden)
+
+				merge, err := cl.TagMergeWithResponse(root, name, openapi.TagMergeProps{
+					Tags: []string{other},
+				}, memberSession)
+				tests.Status(t, err, merge, http.StatusForbidden)
+
+				del, err := cl.TagDeleteWithResponse(root, name, memberSession)
+				tests.Status(t, err, del, http.StatusForbidden)
+
+				unauthenticated, err := cl.TagDeleteWithResponse(root, name)
+				tests.Status(t, err, unauthenticated, http.StatusUnauthorized)
+			})
+		}))
+	}))
+}
+
+func tagNames(tags []openapi.TagReference) []string {
+	return lo.Map(tags, func(t openapi.TagReference, _ int) string { return t.Name })
+}
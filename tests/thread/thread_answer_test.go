@@ -0,0 +1,218 @@
+package thread_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestThreadAnswer(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		bus *pubsub.Bus,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			authorCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			authorSession := sh.WithSession(authorCtx)
+			otherCtx, _ := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			otherSession := sh.WithSession(otherCtx)
+
+			qa, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Name:   "Questions " + xid.New().String(),
+				Colour: "#123456",
+				QaMode: opt.New(true).Ptr(),
+			}, adminSession)
+			tests.Ok(t, err, qa)
+			require.True(t, qa.JSON200.QaMode)
+
+			general, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Name:   "General " + xid.New().String(),
+				Colour: "#654321",
+			}, adminSession)
+			tests.Ok(t, err, general)
+			require.False(t, general.JSON200.QaMode)
+
+			question, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				Title:      "How do I reset my password?",
+				Body:       opt.New("<p>help</p>").Ptr(),
+				Category:   opt.New(qa.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+			}, authorSession)
+			tests.Ok(t, err, question)
+			require.Nil(t, question.JSON200.AcceptedAnswerId)
+
+			discussion, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				Title:      "Favourite colours",
+				Body:       opt.New("<p>mine is blue</p>").Ptr(),
+				Category:   opt.New(general.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+			}, authorSession)
+			tests.Ok(t, err, discussion)
+
+			first, err := cl.ReplyCreateWithResponse(root, question.JSON200.Slug, openapi.ReplyInitialProps{Body: "<p>try turning it off and on again</p>"}, otherSession)
+			tests.Ok(t, err, first)
+
+			answer, err := cl.ReplyCreateWithResponse(root, question.JSON200.Slug, openapi.ReplyInitialProps{Body: "<p>use the forgot password link</p>"}, adminSession)
+			tests.Ok(t, err, answer)
+
+			offtopic, err := cl.ReplyCreateWithResponse(root, discussion.JSON200.Slug, openapi.ReplyInitialProps{Body: "<p>green</p>"}, adminSession)
+			tests.Ok(t, err, offtopic)
+
+			t.Run("not_a_question", func(t *testing.T) {
+				res, err := cl.ThreadAnswerAcceptWithResponse(root, discussion.JSON200.Slug, offtopic.JSON200.Id, authorSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("reply_from_another_thread", func(t *testing.T) {
+				res, err := cl.ThreadAnswerAcceptWithResponse(root, question.JSON200.Slug, offtopic.JSON200.Id, authorSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("non_author_cannot_accept", func(t *testing.T) {
+				res, err := cl.ThreadAnswerAcceptWithResponse(root, question.JSON200.Slug, first.JSON200.Id, otherSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+
+			t.Run("unanswered_filter", func(t *testing.T) {
+				a := assert.New(t)
+
+				unanswered, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{
+					Answered: opt.New(false).Ptr(),
+				}, authorSession)
+				tests.Ok(t, err, unanswered)
+				ids := threadIDs(unanswered.JSON200.Threads)
+				a.Contains(ids, question.JSON200.Id)
+				a.NotContains(ids, discussion.JSON200.Id)
+			})
+
+			t.Run("author_accepts", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res, err := cl.ThreadAnswerAcceptWithResponse(root, question.JSON200.Slug, answer.JSON200.Id, authorSession)
+				tests.Ok(t, err, res)
+				r.NotNil(res.JSON200.AcceptedAnswerId)
+				a.Equal(answer.JSON200.Id, *res.JSON200.AcceptedAnswerId)
+
+				// The accepted answer is listed first even though it was posted last.
+				get, err := cl.ThreadGetWithResponse(root, question.JSON200.Slug, nil, otherSession)
+				tests.Ok(t, err, get)
+				r.Len(get.JSON200.Replies.Replies, 2)
+				a.Equal(answer.JSON200.Id, get.JSON200.Replies.Replies[0].Id)
+				a.Equal(first.JSON200.Id, get.JSON200.Replies.Replies[1].Id)
+			})
+
+			t.Run("answered_filter", func(t *testing.T) {
+				a := assert.New(t)
+
+				answered, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{
+					Answered: opt.New(true).Ptr(),
+				}, authorSession)
+				tests.Ok(t, err, answered)
+				a.Contains(threadIDs(answered.JSON200.Threads), question.JSON200.Id)
+
+				unanswered, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{
+					Categories: &[]string{qa.JSON200.Slug},
+					Answered:   opt.New(false).Ptr(),
+				}, authorSession)
+				tests.Ok(t, err, unanswered)
+				a.Len(unanswered.JSON200.Threads, 0)
+			})
+
+			t.Run("answer_author_notified", func(t *testing.T) {
+				r := require.New(t)
+
+				r.Eventually(func() bool {
+					list, err := cl.NotificationListWithResponse(root, &openapi.NotificationListParams{}, adminSession)
+					if err != nil || list.JSON200 == nil {
+						return false
+					}
+					for _, n := range list.JSON200.Notifications {
+						if n.Event == openapi.NotificationEventAnswerAccepted {
+							return true
+						}
+					}
+					return false
+				}, 5*time.Second, 20*time.Millisecond)
+			})
+
+			t.Run("moderator_removes", func(t *testing.T) {
+				res, err := cl.ThreadAnswerRemoveWithResponse(root, question.JSON200.Slug, adminSession)
+				tests.Ok(t, err, res)
+				assert.Nil(t, res.JSON200.AcceptedAnswerId)
+			})
+
+			t.Run("deleting_answer_clears_it", func(t *testing.T) {
+				r := require.New(t)
+
+				res, err := cl.ThreadAnswerAcceptWithResponse(root, question.JSON200.Slug, first.JSON200.Id, adminSession)
+				tests.Ok(t, err, res)
+				r.NotNil(res.JSON200.AcceptedAnswerId)
+
+				cached, err := cl.ThreadGetWithResponse(root, question.JSON200.Slug, nil, authorSession)
+				tests.Ok(t, err, cached)
+				etag := cached.HTTPResponse.Header.Get("ETag")
+				r.NotEmpty(etag)
+
+				updated := make(chan struct{}, 1)
+				_, err = pubsub.Subscribe(root, bus, "test.answer_deleted_thread_updated", func(ctx context.Context, evt *message.EventThreadUpdated) error {
+					if openapi.Identifier(evt.ID.String()) == question.JSON200.Id {
+						updated <- struct{}{}
+					}
+					return nil
+				})
+				r.NoError(err)
+
+				del, err := cl.PostDeleteWithResponse(root, first.JSON200.Id, otherSession)
+				tests.Ok(t, err, del)
+
+				// The cached copy still has the answer so it must not be reused.
+				get, err := cl.ThreadGetWithResponse(root, question.JSON200.Slug, nil, authorSession, func(ctx context.Context, req *http.Request) error {
+					req.Header.Set("If-None-Match", etag)
+					return nil
+				})
+				tests.Ok(t, err, get)
+				r.Nil(get.JSON200.AcceptedAnswerId)
+
+				// The thread is reindexed now it's unanswered.
+				select {
+				case <-updated:
+				case <-time.After(5 * time.Second):
+					t.Fatal("thread was not updated after its answer was deleted")
+				}
+			})
+		}))
+	}))
+}
+
+func threadIDs(threads []openapi.ThreadReference) []string {
+	ids := make([]string, len(threads))
+	for i, t := range threads {
+		ids[i] = t.Id
+	}
+	return ids
+}
@@ -0,0 +1,205 @@
+package thread_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/services/thread/thread_lock"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/ent"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestThreadLock(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			adminCtx, admin := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			cat, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Name:   "Locking " + xid.New().String(),
+				Colour: "#123456",
+			}, adminSession)
+			tests.Ok(t, err, cat)
+
+			thread, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				Title:      "Going off the rails",
+				Body:       opt.New("<p>hello</p>").Ptr(),
+				Category:   opt.New(cat.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+			}, memberSession)
+			tests.Ok(t, err, thread)
+			r := require.New(t)
+			r.Nil(thread.JSON200.Lock)
+
+			t.Run("author_cannot_lock", func(t *testing.T) {
+				res, err := cl.ThreadUpdateWithResponse(root, thread.JSON200.Slug, openapi.ThreadMutableProps{
+					Locked: opt.New(true).Ptr(),
+				}, memberSession)
+				tests.Status(t, err, res, http.StatusForbidden)
+			})
+
+			t.Run("moderator_locks", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res, err := cl.ThreadUpdateWithResponse(root, thread.JSON200.Slug, openapi.ThreadMutableProps{
+					Locked:     opt.New(true).Ptr(),
+					LockReason: opt.New("Heated argument").Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, res)
+				r.NotNil(res.JSON200.Lock)
+				a.Equal(admin.ID.String(), string(*res.JSON200.Lock.LockedBy))
+				a.Equal("Heated argument", *res.JSON200.Lock.Reason)
+
+				get, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil, memberSession)
+				tests.Ok(t, err, get)
+				r.NotNil(get.JSON200.Lock)
+			})
+
+			t.Run("member_cannot_participate", func(t *testing.T) {
+				reply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, openapi.ReplyInitialProps{Body: "<p>one more thing</p>"}, memberSession)
+				tests.Status(t, err, reply, http.StatusForbidden)
+
+				react, err := cl.PostReactAddWithResponse(root, thread.JSON200.Id, openapi.ReactInitialProps{Emoji: "🔥"}, memberSession)
+				tests.Status(t, err, react, http.StatusForbidden)
+			})
+
+			t.Run("moderator_can_participate", func(t *testing.T) {
+				reply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, openapi.ReplyInitialProps{Body: "<p>locked, take it elsewhere</p>"}, adminSession)
+				tests.Ok(t, err, reply)
+
+				react, err := cl.PostReactAddWithResponse(root, thread.JSON200.Id, openapi.ReactInitialProps{Emoji: "🔒"}, adminSession)
+				tests.Ok(t, err, react)
+			})
+
+			t.Run("list_filter", func(t *testing.T) {
+				a := assert.New(t)
+
+				locked, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{
+					Categories: &[]string{cat.JSON200.Slug},
+					Locked:     opt.New(true).Ptr(),
+				}, memberSession)
+				tests.Ok(t, err, locked)
+				a.Len(locked.JSON200.Threads, 1)
+
+				unlocked, err := cl.ThreadListWithResponse(root, &openapi.ThreadListParams{
+					Categories: &[]string{cat.JSON200.Slug},
+					Locked:     opt.New(false).Ptr(),
+				}, memberSession)
+				tests.Ok(t, err, unlocked)
+				a.Len(unlocked.JSON200.Threads, 0)
+			})
+
+			t.Run("moderator_unlocks", func(t *testing.T) {
+				r := require.New(t)
+
+				res, err := cl.ThreadUpdateWithResponse(root, thread.JSON200.Slug, openapi.ThreadMutableProps{
+					Locked: opt.New(false).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, res)
+				r.Nil(res.JSON200.Lock)
+
+				reply, err := cl.ReplyCreateWithResponse(root, thread.JSON200.Slug, openapi.ReplyInitialProps{Body: "<p>thanks</p>"}, memberSession)
+				tests.Ok(t, err, reply)
+			})
+		}))
+	}))
+}
+
+func TestThreadAutoLock(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		db *ent.Client,
+		settingsRepo *settings.SettingsRepository,
+		bus *pubsub.Bus,
+		locker *thread_lock.AutoLocker,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			memberSession := sh.WithSession(memberCtx)
+
+			stale, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				Title:      "Quiet thread",
+				Body:       opt.New("<p>anyone?</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+			}, memberSession)
+			tests.Ok(t, err, stale)
+
+			active, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				Title:      "Busy thread",
+				Body:       opt.New("<p>hello</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+			}, memberSession)
+			tests.Ok(t, err, active)
+
+			staleID, err := xid.FromString(stale.JSON200.Id)
+			r.NoError(err)
+			err = db.Post.UpdateOneID(staleID).SetLastReplyAt(time.Now().AddDate(0, 0, -60)).Exec(root)
+			r.NoError(err)
+
+			// Disabled by default.
+			n, err := locker.LockInactive(root, time.Now())
+			r.NoError(err)
+			r.Zero(n)
+
+			updateModerationSettings(t, root, settingsRepo, bus, settings.ModerationServiceSettings{
+				ThreadAutoLockDays: opt.New(30),
+			})
+			defer updateModerationSettings(t, root, settingsRepo, bus, settings.ModerationServiceSettings{
+				ThreadAutoLockDays: opt.New(0),
+			})
+
+			_, err = locker.LockInactive(root, time.Now())
+			r.NoError(err)
+
+			// Running again, as another instance would, locks nothing twice.
+			n, err = locker.LockInactive(root, time.Now())
+			r.NoError(err)
+			a.Zero(n)
+
+			got, err := cl.ThreadGetWithResponse(root, stale.JSON200.Slug, nil, memberSession)
+			tests.Ok(t, err, got)
+			r.NotNil(got.JSON200.Lock)
+			a.Nil(got.JSON200.Lock.LockedBy)
+			a.Contains(opt.NewPtr(got.JSON200.Lock.Reason).OrZero(), "30 days")
+
+			got, err = cl.ThreadGetWithResponse(root, active.JSON200.Slug, nil, memberSession)
+			tests.Ok(t, err, got)
+			a.Nil(got.JSON200.Lock)
+		}))
+	}))
+}
@@ -0,0 +1,267 @@
+package thread_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestThreadPoll(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+	) {
+		lc.Append(fx.StartHook(func() {
+			authorCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			authorSession := sh.WithSession(authorCtx)
+			voterCtx, voter := e2e.WithAccount(root, aw, seed.Account_004_Loki)
+			voterSession := sh.WithSession(voterCtx)
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			adminSession := sh.WithSession(adminCtx)
+
+			t.Run("invalid_poll", func(t *testing.T) {
+				res, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+					Title:      "Only one choice",
+					Body:       opt.New("<p>hmm</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Poll:       &openapi.PollInitialProps{Options: []string{"Yes"}},
+				}, authorSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+
+				res, err = cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+					Title:      "Too many choices",
+					Body:       opt.New("<p>hmm</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Poll: &openapi.PollInitialProps{
+						Options:    []string{"Yes", "No"},
+						MaxChoices: opt.New(3).Ptr(),
+					},
+				}, authorSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			thread, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				Title:      "Best editor",
+				Body:       opt.New("<p>vote below</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Poll: &openapi.PollInitialProps{
+					Options: []string{"Vim", "Emacs", "Nano"},
+				},
+			}, authorSession)
+			tests.Ok(t, err, thread)
+			r := require.New(t)
+			r.NotNil(thread.JSON200.Poll)
+			r.Len(thread.JSON200.Poll.Options, 3)
+			r.Equal(1, thread.JSON200.Poll.MaxChoices)
+			r.Equal("Vim", thread.JSON200.Poll.Options[0].Text)
+
+			vim := thread.JSON200.Poll.Options[0].Id
+			emacs := thread.JSON200.Poll.Options[1].Id
+			nano := thread.JSON200.Poll.Options[2].Id
+
+			t.Run("vote", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				res, err := cl.ThreadPollVoteWithResponse(root, thread.JSON200.Slug, openapi.PollVoteProps{
+					Options: []openapi.Identifier{vim},
+				}, voterSession)
+				tests.Ok(t, err, res)
+				a.Equal(1, res.JSON200.Voters)
+				a.Equal(1, res.JSON200.Options[0].Votes)
+				a.True(res.JSON200.Options[0].Voted)
+				r.NotNil(res.JSON200.Options[0].Voters)
+				r.Len(*res.JSON200.Options[0].Voters, 1)
+				a.Equal(voter.ID.String(), (*res.JSON200.Options[0].Voters)[0].Id)
+			})
+
+			t.Run("single_choice", func(t *testing.T) {
+				res, err := cl.ThreadPollVoteWithResponse(root, thread.JSON200.Slug, openapi.PollVoteProps{
+					Options: []openapi.Identifier{vim, emacs},
+				}, voterSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("unknown_option", func(t *testing.T) {
+				res, err := cl.ThreadPollVoteWithResponse(root, thread.JSON200.Slug, openapi.PollVoteProps{
+					Options: []openapi.Identifier{thread.JSON200.Id},
+				}, voterSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("unauthenticated", func(t *testing.T) {
+				res, err := cl.ThreadPollVoteWithResponse(root, thread.JSON200.Slug, openapi.PollVoteProps{
+					Options: []openapi.Identifier{vim},
+				})
+				tests.Status(t, err, res, http.StatusUnauthorized)
+			})
+
+			t.Run("change_vote", func(t *testing.T) {
+				a := assert.New(t)
+
+				res, err := cl.ThreadPollVoteWithResponse(root, thread.JSON200.Slug, openapi.PollVoteProps{
+					Options: []openapi.Identifier{nano},
+				}, voterSession)
+				tests.Ok(t, err, res)
+				a.Equal(1, res.JSON200.Voters)
+				a.Equal(0, res.JSON200.Options[0].Votes)
+				a.Equal(1, res.JSON200.Options[2].Votes)
+
+				res, err = cl.ThreadPollVoteWithResponse(root, thread.JSON200.Slug, openapi.PollVoteProps{
+					Options: []openapi.Identifier{nano},
+				}, adminSession)
+				tests.Ok(t, err, res)
+				a.Equal(2, res.JSON200.Voters)
+				a.Equal(2, res.JSON200.Options[2].Votes)
+			})
+
+			t.Run("hydrated_in_thread", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				get, err := cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil, voterSession)
+				tests.Ok(t, err, get)
+				r.NotNil(get.JSON200.Poll)
+				a.Equal(2, get.JSON200.Poll.Voters)
+				a.False(get.JSON200.Poll.Options[0].Voted)
+				a.True(get.JSON200.Poll.Options[2].Voted)
+
+				get, err = cl.ThreadGetWithResponse(root, thread.JSON200.Slug, nil, authorSession)
+				tests.Ok(t, err, get)
+				r.NotNil(get.JSON200.Poll)
+				a.False(get.JSON200.Poll.Options[2].Voted)
+			})
+
+			t.Run("options_fixed_after_voting", func(t *testing.T) {
+				res, err := cl.ThreadUpdateWithResponse(root, thread.JSON200.Slug, openapi.ThreadMutableProps{
+					Poll: &openapi.PollMutableProps{
+						Options: &[]string{"Vim", "Emacs", "VS Code"},
+					},
+				}, authorSession)
+				tests.Status(t, err, res, http.StatusBadRequest)
+			})
+
+			t.Run("close", func(t *testing.T) {
+				r := require.New(t)
+
+				res, err := cl.ThreadUpdateWithResponse(root, thread.JSON200.Slug, openapi.ThreadMutableProps{
+					Poll: &openapi.PollMutableProps{
+						ClosesAt: opt.New(time.Now().Add(-time.Minute)).Ptr(),
+					},
+				}, authorSession)
+				tests.Ok(t, err, res)
+				r.NotNil(res.JSON200.Poll)
+				r.True(res.JSON200.Poll.Closed)
+
+				vote, err := cl.ThreadPollVoteWithResponse(root, thread.JSON200.Slug, openapi.PollVoteProps{
+					Options: []openapi.Identifier{emacs},
+				}, voterSession)
+				tests.Status(t, err, vote, http.StatusBadRequest)
+			})
+
+			t.Run("anonymous_multiple_choice", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				other, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+					Title:      "Which days work",
+					Body:       opt.New("<p>for the meetup</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+				}, authorSession)
+				tests.Ok(t, err, other)
+				r.Nil(other.JSON200.Poll)
+
+				// Polls can also be added to existing threads.
+				res, err := cl.ThreadUpdateWithResponse(root, other.JSON200.Slug, openapi.ThreadMutableProps{
+					Poll: &openapi.PollMutableProps{
+						Options:    &[]string{"Friday", "Saturday", "Sunday"},
+						MaxChoices: opt.New(2).Ptr(),
+						Anonymous:  opt.New(true).Ptr(),
+					},
+				}, authorSession)
+				tests.Ok(t, err, res)
+				r.NotNil(res.JSON200.Poll)
+				a.True(res.JSON200.Poll.Anonymous)
+
+				options := res.JSON200.Poll.Options
+
+				vote, err := cl.ThreadPollVoteWithResponse(root, other.JSON200.Slug, openapi.PollVoteProps{
+					Options: []openapi.Identifier{options[0].Id, options[1].Id},
+				}, voterSession)
+				tests.Ok(t, err, vote)
+				a.Equal(1, vote.JSON200.Voters)
+				a.Equal(1, vote.JSON200.Options[0].Votes)
+				a.Equal(1, vote.JSON200.Options[1].Votes)
+				a.Nil(vote.JSON200.Options[0].Voters)
+
+				vote, err = cl.ThreadPollVoteWithResponse(root, other.JSON200.Slug, openapi.PollVoteProps{
+					Options: []openapi.Identifier{options[0].Id, options[1].Id, options[2].Id},
+				}, voterSession)
+				tests.Status(t, err, vote, http.StatusBadRequest)
+			})
+
+			t.Run("concurrent_votes", func(t *testing.T) {
+				r := require.New(t)
+				a := assert.New(t)
+
+				other, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+					Title:      "Tabs or spaces",
+					Body:       opt.New("<p>settle it</p>").Ptr(),
+					Visibility: opt.New(openapi.Published).Ptr(),
+					Poll: &openapi.PollInitialProps{
+						Options: []string{"Tabs", "Spaces", "Both"},
+					},
+				}, authorSession)
+				tests.Ok(t, err, other)
+				r.NotNil(other.JSON200.Poll)
+
+				options := other.JSON200.Poll.Options
+
+				// Each request is valid on its own, together they must still
+				// leave the account with a single choice.
+				var wg sync.WaitGroup
+				for i := range 12 {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						res, err := cl.ThreadPollVoteWithResponse(root, other.JSON200.Slug, openapi.PollVoteProps{
+							Options: []openapi.Identifier{options[i%len(options)].Id},
+						}, voterSession)
+						tests.Ok(t, err, res)
+					}()
+				}
+				wg.Wait()
+
+				get, err := cl.ThreadGetWithResponse(root, other.JSON200.Slug, nil, voterSession)
+				tests.Ok(t, err, get)
+				r.NotNil(get.JSON200.Poll)
+				a.Equal(1, get.JSON200.Poll.Voters)
+
+				total := 0
+				for _, o := range get.JSON200.Poll.Options {
+					total += o.Votes
+				}
+				a.Equal(1, total)
+			})
+		}))
+	}))
+}
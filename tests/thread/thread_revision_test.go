@@ -0,0 +1,233 @@
+package thread_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Southclaws/opt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_hook "github.com/Southclaws/storyden/internal/ent/hook"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+func TestThreadRevisions(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		db *ent.Client,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+			failUpdates := failPostUpdates(db)
+
+			catCreate, err := cl.CategoryCreateWithResponse(root, openapi.CategoryInitialProps{
+				Colour:      "#fe4efd",
+				Description: "revision testing",
+				Name:        "Category " + uuid.NewString(),
+			}, adminSession)
+			tests.Ok(t, err, catCreate)
+
+			threadCreate, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>first version</p>").Ptr(),
+				Category:   opt.New(catCreate.JSON200.Id).Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "Original title",
+			}, memberSession)
+			tests.Ok(t, err, threadCreate)
+			threadID := threadCreate.JSON200.Id
+
+			t.Run("no_revisions_before_edit", func(t *testing.T) {
+				list, err := cl.PostRevisionListWithResponse(root, threadID, nil, adminSession)
+				tests.Ok(t, err, list)
+				a.Empty(list.JSON200.Revisions)
+			})
+
+			update, err := cl.ThreadUpdateWithResponse(root, threadCreate.JSON200.Slug, openapi.ThreadMutableProps{
+				Title: opt.New("Edited title").Ptr(),
+				Body:  opt.New("<p>second version</p>").Ptr(),
+			}, memberSession)
+			tests.Ok(t, err, update)
+
+			t.Run("non_moderator_cannot_list", func(t *testing.T) {
+				list, err := cl.PostRevisionListWithResponse(root, threadID, nil, memberSession)
+				tests.Status(t, err, list, http.StatusForbidden)
+			})
+
+			list, err := cl.PostRevisionListWithResponse(root, threadID, nil, adminSession)
+			tests.Ok(t, err, list)
+			r.Len(list.JSON200.Revisions, 1)
+			rev := list.JSON200.Revisions[0]
+			a.Equal("Original title", rev.Title)
+			a.Contains(rev.Body, "first version")
+
+			t.Run("failed_edit_keeps_no_revision", func(t *testing.T) {
+				a := assert.New(t)
+
+				failUpdates.Store(true)
+				defer failUpdates.Store(false)
+
+				update, err := cl.ThreadUpdateWithResponse(root, threadCreate.JSON200.Slug, openapi.ThreadMutableProps{
+					Body: opt.New("<p>never written</p>").Ptr(),
+				}, memberSession)
+				tests.Status(t, err, update, http.StatusInternalServerError)
+
+				list, err := cl.PostRevisionListWithResponse(root, threadID, nil, adminSession)
+				tests.Ok(t, err, list)
+				a.Len(list.JSON200.Revisions, 1)
+			})
+
+			t.Run("diff_against_current", func(t *testing.T) {
+				diff, err := cl.PostRevisionDiffWithResponse(root, threadID, rev.Id, nil, adminSession)
+				tests.Ok(t, err, diff)
+				a.True(diff.JSON200.TitleChanged)
+				a.Equal("current", diff.JSON200.To)
+				a.Contains(diff.JSON200.Changes, "-first version")
+				a.Contains(diff.JSON200.Changes, "+second version")
+			})
+
+			t.Run("restore", func(t *testing.T) {
+				restore, err := cl.PostRevisionRestoreWithResponse(root, threadID, rev.Id, adminSession)
+				tests.Ok(t, err, restore)
+				a.Contains(restore.JSON200.Body, "first version")
+
+				get, err := cl.ThreadGetWithResponse(root, threadCreate.JSON200.Slug, nil)
+				tests.Ok(t, err, get)
+				a.Equal("Original title", get.JSON200.Title)
+
+				// Restoring is itself an edit, so the replaced version is kept.
+				list, err := cl.PostRevisionListWithResponse(root, threadID, nil, adminSession)
+				tests.Ok(t, err, list)
+				r.Len(list.JSON200.Revisions, 2)
+				a.Equal("Edited title", list.JSON200.Revisions[0].Title)
+			})
+		}))
+	}))
+}
+
+func TestReplyRevisions(t *testing.T) {
+	t.Parallel()
+
+	integration.Test(t, nil, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		db *ent.Client,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+			failUpdates := failPostUpdates(db)
+
+			threadCreate, err := cl.ThreadCreateWithResponse(root, openapi.ThreadInitialProps{
+				Body:       opt.New("<p>thread</p>").Ptr(),
+				Visibility: opt.New(openapi.Published).Ptr(),
+				Title:      "Reply revisions " + uuid.NewString(),
+			}, adminSession)
+			tests.Ok(t, err, threadCreate)
+
+			replyCreate, err := cl.ReplyCreateWithResponse(root, threadCreate.JSON200.Slug, openapi.ReplyInitialProps{
+				Body: "<p>first reply</p>",
+			}, memberSession)
+			tests.Ok(t, err, replyCreate)
+			replyID := replyCreate.JSON200.Id
+
+			t.Run("no_revisions_before_edit", func(t *testing.T) {
+				list, err := cl.PostRevisionListWithResponse(root, replyID, nil, adminSession)
+				tests.Ok(t, err, list)
+				a.Empty(list.JSON200.Revisions)
+			})
+
+			update, err := cl.PostUpdateWithResponse(root, replyID, openapi.PostMutableProps{
+				Body: opt.New("<p>second reply</p>").Ptr(),
+			}, memberSession)
+			tests.Ok(t, err, update)
+
+			t.Run("non_moderator_cannot_list", func(t *testing.T) {
+				list, err := cl.PostRevisionListWithResponse(root, replyID, nil, memberSession)
+				tests.Status(t, err, list, http.StatusForbidden)
+			})
+
+			list, err := cl.PostRevisionListWithResponse(root, replyID, nil, adminSession)
+			tests.Ok(t, err, list)
+			r.Len(list.JSON200.Revisions, 1)
+			rev := list.JSON200.Revisions[0]
+			a.Empty(rev.Title)
+			a.Contains(rev.Body, "first reply")
+			a.Equal(replyID, rev.PostId)
+
+			t.Run("failed_edit_keeps_no_revision", func(t *testing.T) {
+				a := assert.New(t)
+
+				failUpdates.Store(true)
+				defer failUpdates.Store(false)
+
+				update, err := cl.PostUpdateWithResponse(root, replyID, openapi.PostMutableProps{
+					Body: opt.New("<p>never written</p>").Ptr(),
+				}, memberSession)
+				tests.Status(t, err, update, http.StatusInternalServerError)
+
+				list, err := cl.PostRevisionListWithResponse(root, replyID, nil, adminSession)
+				tests.Ok(t, err, list)
+				a.Len(list.JSON200.Revisions, 1)
+			})
+
+			t.Run("restore", func(t *testing.T) {
+				restore, err := cl.PostRevisionRestoreWithResponse(root, replyID, rev.Id, adminSession)
+				tests.Ok(t, err, restore)
+				a.Contains(restore.JSON200.Body, "first reply")
+
+				// Restoring is itself an edit, so the replaced version is kept.
+				list, err := cl.PostRevisionListWithResponse(root, replyID, nil, adminSession)
+				tests.Ok(t, err, list)
+				r.Len(list.JSON200.Revisions, 2)
+				a.Contains(list.JSON200.Revisions[0].Body, "second reply")
+			})
+		}))
+	}))
+}
+
+// failPostUpdates makes every update to a post fail while the returned flag is
+// set, to simulate the write failing after the edit has been accepted.
+func failPostUpdates(db *ent.Client) *atomic.Bool {
+	fail := &atomic.Bool{}
+	db.Post.Use(func(next ent.Mutator) ent.Mutator {
+		return ent_hook.PostFunc(func(ctx context.Context, m *ent.PostMutation) (ent.Value, error) {
+			if fail.Load() && m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) {
+				return nil, errors.New("post update failed")
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	return fail
+}
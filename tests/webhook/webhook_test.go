@@ -0,0 +1,292 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_writer"
+	"github.com/Southclaws/storyden/app/resources/seed"
+	"github.com/Southclaws/storyden/app/resources/webhook"
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_dispatch"
+	"github.com/Southclaws/storyden/app/services/webhook/webhook_sender"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/ent"
+	ent_hook "github.com/Southclaws/storyden/internal/ent/hook"
+	"github.com/Southclaws/storyden/internal/integration"
+	"github.com/Southclaws/storyden/internal/integration/e2e"
+	"github.com/Southclaws/storyden/tests"
+)
+
+type received struct {
+	header http.Header
+	body   []byte
+}
+
+func TestWebhooks(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		requests []received
+	)
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		requests = append(requests, received{header: r.Header.Clone(), body: body})
+		mu.Unlock()
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(receiver.Close)
+
+	receivedEvents := func(event string) []received {
+		mu.Lock()
+		defer mu.Unlock()
+		out := []received{}
+		for _, r := range requests {
+			if r.header.Get(webhook_sender.HeaderEvent) == event {
+				out = append(out, r)
+			}
+		}
+		return out
+	}
+
+	// Two attempts per delivery: one initial attempt and one retry.
+	cfg := &config.Config{
+		QueueMaxRetries:           1,
+		QueueRetryInitialInterval: 10 * time.Millisecond,
+		QueueRetryMaxInterval:     50 * time.Millisecond,
+	}
+
+	integration.Test(t, cfg, e2e.Setup(), fx.Invoke(func(
+		lc fx.Lifecycle,
+		root context.Context,
+		cl *openapi.ClientWithResponses,
+		sh *e2e.SessionHelper,
+		aw *account_writer.Writer,
+		dispatcher *webhook_dispatch.Dispatcher,
+		db *ent.Client,
+	) {
+		lc.Append(fx.StartHook(func() {
+			r := require.New(t)
+			a := assert.New(t)
+
+			adminCtx, _ := e2e.WithAccount(root, aw, seed.Account_001_Odin)
+			memberCtx, _ := e2e.WithAccount(root, aw, seed.Account_003_Baldur)
+			adminSession := sh.WithSession(adminCtx)
+			memberSession := sh.WithSession(memberCtx)
+
+			t.Run("member_cannot_manage", func(t *testing.T) {
+				list, err := cl.WebhookListWithResponse(root, memberSession)
+				tests.Status(t, err, list, http.StatusForbidden)
+			})
+
+			t.Run("invalid_url", func(t *testing.T) {
+				create, err := cl.WebhookCreateWithResponse(root, openapi.WebhookInitialProps{
+					Name:       "bad",
+					Url:        "not a url",
+					EventTypes: openapi.WebhookEventTypeList{openapi.WebhookEventTypeThreadPublished},
+				}, adminSession)
+				tests.Status(t, err, create, http.StatusBadRequest)
+			})
+
+			create, err := cl.WebhookCreateWithResponse(root, openapi.WebhookInitialProps{
+				Name:       "test hook",
+				Url:        receiver.URL,
+				EventTypes: openapi.WebhookEventTypeList{openapi.WebhookEventTypeThreadPublished},
+			}, adminSession)
+			tests.Ok(t, err, create)
+			hook := create.JSON200
+			a.True(hook.Enabled)
+			a.NotEmpty(hook.Secret)
+
+			t.Run("test_delivery_is_signed", func(t *testing.T) {
+				test, err := cl.WebhookTestWithResponse(root, hook.Id, adminSession)
+				tests.Ok(t, err, test)
+				a.Equal(openapi.Succeeded, test.JSON200.Status)
+				a.Equal(1, test.JSON200.Attempts)
+				a.Equal(http.StatusNoContent, *test.JSON200.ResponseStatus)
+
+				pings := receivedEvents("ping")
+				r.Len(pings, 1)
+				ping := pings[0]
+
+				want := webhook_sender.Sign(hook.Secret, ping.header.Get(webhook_sender.HeaderTimestamp), ping.body)
+				a.Equal("sha256="+want, ping.header.Get(webhook_sender.HeaderSignature))
+
+				var env webhook_sender.Envelope
+				r.NoError(json.Unmarshal(ping.body, &env))
+				a.Equal("ping", env.Event)
+				a.Equal(string(test.JSON200.Id), env.ID)
+			})
+
+			deliveryStatus := func(hookID openapi.Identifier, eventType openapi.WebhookEventType) func() openapi.WebhookDeliveryStatus {
+				return func() openapi.WebhookDeliveryStatus {
+					deliveries, err := cl.WebhookDeliveryListWithResponse(root, hookID, nil, adminSession)
+					tests.Ok(t, err, deliveries)
+					for _, d := range deliveries.JSON200.Deliveries {
+						if d.EventType == eventType {
+							return d.Status
+						}
+					}
+					return ""
+				}
+			}
+
+			t.Run("dispatch_to_subscribed_webhook", func(t *testing.T) {
+				err := dispatcher.Dispatch(root, webhook.EventTypeThreadPublished, map[string]string{"id": "abc"})
+				r.NoError(err)
+
+				err = dispatcher.Dispatch(root, webhook.EventTypeAccountCreated, map[string]string{"id": "def"})
+				r.NoError(err)
+
+				deliveries, err := cl.WebhookDeliveryListWithResponse(root, hook.Id, nil, adminSession)
+				tests.Ok(t, err, deliveries)
+				r.Len(deliveries.JSON200.Deliveries, 2, "only the ping and subscribed event should be logged")
+
+				delivery := deliveries.JSON200.Deliveries[0]
+				a.Equal(openapi.WebhookEventTypeThreadPublished, delivery.EventType)
+				a.Equal("abc", delivery.Payload["id"])
+
+				status := deliveryStatus(hook.Id, openapi.WebhookEventTypeThreadPublished)
+				r.Eventually(func() bool { return status() == openapi.Succeeded }, 5*time.Second, 20*time.Millisecond)
+
+				published := receivedEvents("thread.published")
+				r.Len(published, 1)
+
+				var env struct {
+					Data struct {
+						ID string `json:"id"`
+					} `json:"data"`
+				}
+				r.NoError(json.Unmarshal(published[0].body, &env))
+				a.Equal("abc", env.Data.ID)
+			})
+
+			t.Run("failed_delivery_is_retried", func(t *testing.T) {
+				failing, err := cl.WebhookCreateWithResponse(root, openapi.WebhookInitialProps{
+					Name:       "failing hook",
+					Url:        receiver.URL + "/fail",
+					EventTypes: openapi.WebhookEventTypeList{openapi.WebhookEventTypeReplyCreated},
+				}, adminSession)
+				tests.Ok(t, err, failing)
+
+				err = dispatcher.Dispatch(root, webhook.EventTypeReplyCreated, map[string]string{"reply_id": "xyz"})
+				r.NoError(err)
+
+				status := deliveryStatus(failing.JSON200.Id, openapi.WebhookEventTypeReplyCreated)
+				r.Eventually(func() bool { return status() == openapi.Failed }, 5*time.Second, 20*time.Millisecond)
+
+				deliveries, err := cl.WebhookDeliveryListWithResponse(root, failing.JSON200.Id, nil, adminSession)
+				tests.Ok(t, err, deliveries)
+				r.Len(deliveries.JSON200.Deliveries, 1)
+				d := deliveries.JSON200.Deliveries[0]
+				a.Equal(2, d.Attempts, "one attempt and one retry")
+				a.Equal(http.StatusInternalServerError, opt.NewPtr(d.ResponseStatus).OrZero())
+				a.Contains(opt.NewPtr(d.Error).OrZero(), "500")
+				a.Len(receivedEvents("reply.created"), 2)
+			})
+
+			t.Run("partial_failure_queues_the_rest", func(t *testing.T) {
+				create := func(name string) *openapi.WebhookWithSecret {
+					res, err := cl.WebhookCreateWithResponse(root, openapi.WebhookInitialProps{
+						Name:       name,
+						Url:        receiver.URL,
+						EventTypes: openapi.WebhookEventTypeList{openapi.WebhookEventTypeNodePublished},
+					}, adminSession)
+					tests.Ok(t, err, res)
+					return res.JSON200
+				}
+				first := create("first")
+				broken := create("broken")
+				last := create("last")
+
+				brokenID := openapi.ParseID(broken.Id)
+				db.WebhookDelivery.Use(func(next ent.Mutator) ent.Mutator {
+					return ent_hook.WebhookDeliveryFunc(func(ctx context.Context, m *ent.WebhookDeliveryMutation) (ent.Value, error) {
+						if id, ok := m.WebhookID(); ok && id == brokenID {
+							return nil, errors.New("database unavailable")
+						}
+						return next.Mutate(ctx, m)
+					})
+				})
+
+				err := dispatcher.Dispatch(root, webhook.EventTypeNodePublished, map[string]string{"id": "jkl"})
+				r.NoError(err, "one webhook failing must not fail the event and have it dispatched again")
+
+				for _, wh := range []*openapi.WebhookWithSecret{first, last} {
+					deliveries, err := cl.WebhookDeliveryListWithResponse(root, wh.Id, nil, adminSession)
+					tests.Ok(t, err, deliveries)
+					a.Len(deliveries.JSON200.Deliveries, 1, wh.Name)
+				}
+
+				deliveries, err := cl.WebhookDeliveryListWithResponse(root, broken.Id, nil, adminSession)
+				tests.Ok(t, err, deliveries)
+				a.Empty(deliveries.JSON200.Deliveries)
+			})
+
+			t.Run("disabled_webhook_receives_nothing", func(t *testing.T) {
+				update, err := cl.WebhookUpdateWithResponse(root, hook.Id, openapi.WebhookMutableProps{
+					Enabled: opt.New(false).Ptr(),
+				}, adminSession)
+				tests.Ok(t, err, update)
+				a.False(update.JSON200.Enabled)
+
+				err = dispatcher.Dispatch(root, webhook.EventTypeThreadPublished, map[string]string{"id": "ghi"})
+				r.NoError(err)
+
+				deliveries, err := cl.WebhookDeliveryListWithResponse(root, hook.Id, nil, adminSession)
+				tests.Ok(t, err, deliveries)
+				a.Len(deliveries.JSON200.Deliveries, 2)
+			})
+
+			t.Run("secret_only_shown_once", func(t *testing.T) {
+				get, err := cl.WebhookGetWithResponse(root, hook.Id, adminSession)
+				tests.Ok(t, err, get)
+				a.NotContains(string(get.Body), "secret")
+				a.NotContains(string(get.Body), hook.Secret)
+
+				list, err := cl.WebhookListWithResponse(root, adminSession)
+				tests.Ok(t, err, list)
+				a.NotContains(string(list.Body), "secret")
+				a.NotContains(string(list.Body), hook.Secret)
+			})
+
+			t.Run("rotate_secret", func(t *testing.T) {
+				rotate, err := cl.WebhookSecretRotateWithResponse(root, hook.Id, adminSession)
+				tests.Ok(t, err, rotate)
+				a.NotEmpty(rotate.JSON200.Secret)
+				a.NotEqual(hook.Secret, rotate.JSON200.Secret)
+
+				get, err := cl.WebhookGetWithResponse(root, hook.Id, adminSession)
+				tests.Ok(t, err, get)
+				a.NotContains(string(get.Body), rotate.JSON200.Secret)
+			})
+
+			t.Run("delete", func(t *testing.T) {
+				del, err := cl.WebhookDeleteWithResponse(root, hook.Id, adminSession)
+				tests.Status(t, err, del, http.StatusNoContent)
+
+				get, err := cl.WebhookGetWithResponse(root, hook.Id, adminSession)
+				tests.Status(t, err, get, http.StatusNotFound)
+			})
+		}))
+	}))
+}